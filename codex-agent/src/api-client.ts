@@ -52,6 +52,55 @@ export interface Ticket {
   updatedAt: string;
 }
 
+export interface TicketTemplateSubtask {
+  title: string;
+  description?: string;
+  type?: "feature" | "bug";
+  priority?: "low" | "medium" | "high" | "urgent";
+  storyPoints?: number;
+}
+
+export interface TicketTemplate {
+  id: string;
+  projectId: string;
+  name: string;
+  titlePattern: string;
+  description: string;
+  type?: "feature" | "bug";
+  priority?: "low" | "medium" | "high" | "urgent";
+  storyPoints: number | null;
+  timeEstimate: number | null;
+  incidentEnabled: boolean;
+  keywords: string[];
+  subtasks: TicketTemplateSubtask[];
+  createdAt: string;
+  updatedAt: string;
+}
+
+export interface TimeEntryTimer {
+  id: string;
+  projectId: string;
+  ticketId: string;
+  ticketKey: string;
+  ticketTitle: string;
+  userId: string;
+  userName: string;
+  description?: string;
+  startedAt: string;
+  idleNotifiedAt?: string | null;
+}
+
+export interface TimeEntry {
+  id: string;
+  ticketId: string;
+  userId: string;
+  userName: string;
+  minutes: number;
+  description?: string;
+  loggedAt: string;
+  createdAt: string;
+}
+
 export interface Project {
   id: string;
   key: string;
@@ -126,6 +175,65 @@ export class TicketingAPIClient {
     return this.request<{ items: Ticket[]; total: number }>(path);
   }
 
+  async createTicket(
+    projectId: string,
+    input: {
+      storyId: string;
+      title: string;
+      description?: string;
+      templateId?: string;
+      type?: Ticket["type"];
+      priority?: Ticket["priority"];
+    }
+  ): Promise<Ticket> {
+    return this.request<Ticket>(`/projects/${projectId}/tickets`, {
+      method: "POST",
+      body: JSON.stringify(input),
+    });
+  }
+
+  async listTicketTemplates(projectId: string): Promise<TicketTemplate[]> {
+    const response = await this.request<{ items: TicketTemplate[] }>(
+      `/projects/${projectId}/ticket-templates`
+    );
+    return response.items;
+  }
+
+  async startTicketTimer(
+    projectId: string,
+    ticketId: string,
+    input: { description?: string }
+  ): Promise<TimeEntryTimer> {
+    return this.request<TimeEntryTimer>(
+      `/projects/${projectId}/tickets/${ticketId}/timer`,
+      {
+        method: "POST",
+        body: JSON.stringify(input),
+      }
+    );
+  }
+
+  async stopTicketTimer(
+    projectId: string,
+    ticketId: string,
+    input: { description?: string }
+  ): Promise<TimeEntry> {
+    return this.request<TimeEntry>(
+      `/projects/${projectId}/tickets/${ticketId}/timer/stop`,
+      {
+        method: "POST",
+        body: JSON.stringify(input),
+      }
+    );
+  }
+
+  async listRunningTimers(projectId: string): Promise<TimeEntryTimer[]> {
+    const response = await this.request<{ items: TimeEntryTimer[] }>(
+      `/projects/${projectId}/timers`
+    );
+    return response.items;
+  }
+
   async getComments(ticketId: string): Promise<TicketComment[]> {
     const response = await this.request<{ items: TicketComment[] }>(
       `/tickets/${ticketId}/comments`
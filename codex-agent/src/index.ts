@@ -15,6 +15,20 @@ import {
   searchTicketsSchema,
 } from "./tools/tickets.js";
 import { addComment, addCommentSchema } from "./tools/comments.js";
+import {
+  createTicket,
+  createTicketSchema,
+  listTicketTemplates,
+  listTicketTemplatesSchema,
+} from "./tools/templates.js";
+import {
+  listRunningTimers,
+  listRunningTimersSchema,
+  startTicketTimer,
+  startTicketTimerSchema,
+  stopTicketTimer,
+  stopTicketTimerSchema,
+} from "./tools/timers.js";
 import {
   updateTicketState,
   updateTicketStateSchema,
@@ -61,6 +75,35 @@ const tools = [
       "Search for tickets across projects or within a specific project",
     inputSchema: searchTicketsSchema,
   },
+  {
+    name: "list_ticket_templates",
+    description:
+      "List a project's ticket templates, including their keywords and subtasks",
+    inputSchema: listTicketTemplatesSchema,
+  },
+  {
+    name: "create_ticket",
+    description:
+      "Create a ticket in a project, optionally pre-filled from a ticket template",
+    inputSchema: createTicketSchema,
+  },
+  {
+    name: "start_ticket_timer",
+    description:
+      "Start a server-side timer on a ticket for the current user; one timer per ticket",
+    inputSchema: startTicketTimerSchema,
+  },
+  {
+    name: "stop_ticket_timer",
+    description:
+      "Stop the current user's running timer on a ticket and log the elapsed time as a time entry",
+    inputSchema: stopTicketTimerSchema,
+  },
+  {
+    name: "list_running_timers",
+    description: "List the current user's running timers in a project",
+    inputSchema: listRunningTimersSchema,
+  },
   {
     name: "add_comment",
     description: "Add a comment to a ticket",
@@ -120,6 +163,38 @@ server.setRequestHandler(CallToolRequestSchema, async (request) => {
         );
         break;
 
+      case "list_ticket_templates":
+        result = await listTicketTemplates(
+          apiClient,
+          listTicketTemplatesSchema.parse(args),
+        );
+        break;
+
+      case "create_ticket":
+        result = await createTicket(apiClient, createTicketSchema.parse(args));
+        break;
+
+      case "start_ticket_timer":
+        result = await startTicketTimer(
+          apiClient,
+          startTicketTimerSchema.parse(args),
+        );
+        break;
+
+      case "stop_ticket_timer":
+        result = await stopTicketTimer(
+          apiClient,
+          stopTicketTimerSchema.parse(args),
+        );
+        break;
+
+      case "list_running_timers":
+        result = await listRunningTimers(
+          apiClient,
+          listRunningTimersSchema.parse(args),
+        );
+        break;
+
       case "add_comment":
         result = await addComment(apiClient, addCommentSchema.parse(args));
         break;
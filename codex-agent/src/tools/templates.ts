@@ -0,0 +1,39 @@
+import { TicketingAPIClient, Ticket, TicketTemplate } from "../api-client.js";
+import { z } from "zod";
+
+export const listTicketTemplatesSchema = z.object({
+  projectId: z.string().describe("The project ID (UUID)"),
+});
+
+export const createTicketSchema = z.object({
+  projectId: z.string().describe("The project ID (UUID)"),
+  storyId: z.string().describe("Story the ticket belongs to (UUID)"),
+  title: z.string().describe("Ticket title"),
+  description: z.string().optional().describe("Ticket description"),
+  templateId: z
+    .string()
+    .optional()
+    .describe(
+      "Ticket template to pre-fill from (UUID). Use list_ticket_templates to find one; explicit fields override the template.",
+    ),
+  type: z.enum(["feature", "bug"]).optional().describe("Ticket type"),
+  priority: z
+    .enum(["low", "medium", "high", "urgent"])
+    .optional()
+    .describe("Ticket priority"),
+});
+
+export async function listTicketTemplates(
+  client: TicketingAPIClient,
+  params: z.infer<typeof listTicketTemplatesSchema>,
+): Promise<TicketTemplate[]> {
+  return client.listTicketTemplates(params.projectId);
+}
+
+export async function createTicket(
+  client: TicketingAPIClient,
+  params: z.infer<typeof createTicketSchema>,
+): Promise<Ticket> {
+  const { projectId, ...input } = params;
+  return client.createTicket(projectId, input);
+}
@@ -0,0 +1,47 @@
+import { TicketingAPIClient, TimeEntry, TimeEntryTimer } from "../api-client.js";
+import { z } from "zod";
+
+export const startTicketTimerSchema = z.object({
+  projectId: z.string().describe("The project ID (UUID)"),
+  ticketId: z.string().describe("The ticket ID (UUID)"),
+  description: z
+    .string()
+    .optional()
+    .describe("Description for the time entry logged when the timer stops"),
+});
+
+export const stopTicketTimerSchema = z.object({
+  projectId: z.string().describe("The project ID (UUID)"),
+  ticketId: z.string().describe("The ticket ID (UUID)"),
+  description: z
+    .string()
+    .optional()
+    .describe("Replaces the description given when the timer started"),
+});
+
+export const listRunningTimersSchema = z.object({
+  projectId: z.string().describe("The project ID (UUID)"),
+});
+
+export async function startTicketTimer(
+  client: TicketingAPIClient,
+  params: z.infer<typeof startTicketTimerSchema>,
+): Promise<TimeEntryTimer> {
+  const { projectId, ticketId, ...input } = params;
+  return client.startTicketTimer(projectId, ticketId, input);
+}
+
+export async function stopTicketTimer(
+  client: TicketingAPIClient,
+  params: z.infer<typeof stopTicketTimerSchema>,
+): Promise<TimeEntry> {
+  const { projectId, ticketId, ...input } = params;
+  return client.stopTicketTimer(projectId, ticketId, input);
+}
+
+export async function listRunningTimers(
+  client: TicketingAPIClient,
+  params: z.infer<typeof listRunningTimersSchema>,
+): Promise<TimeEntryTimer[]> {
+  return client.listRunningTimers(params.projectId);
+}
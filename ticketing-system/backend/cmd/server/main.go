@@ -2,10 +2,13 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	"ticketing-system/backend/internal/auth"
@@ -60,11 +63,23 @@ func main() {
 	}
 
 	handler := httpapi.NewHandler(st, authClient, dispatcher, httpapi.HandlerOptions{
-		CookieName:     "ticketing_session",
-		CookieSecure:   cfg.CookieSecure,
-		AllowedOrigins: cfg.CORSAllowedOrigins,
-		BlobStore:      blobOpt,
+		CookieName:           "ticketing_session",
+		CookieSecure:         cfg.CookieSecure,
+		AllowedOrigins:       cfg.CORSAllowedOrigins,
+		BlobStore:            blobOpt,
+		TriageAPIKey:         cfg.AITriageAPIKey,
+		TriageAllowedOrigins: cfg.AITriageAllowedOrigins,
+		PublicBaseURL:        cfg.PublicBaseURL,
 	})
+	// Schedulers and the server stop on SIGINT or SIGTERM.
+	runCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go handler.RunPresetSubscriptionScheduler(runCtx, time.Minute)
+	go handler.RunTicketRecurrenceScheduler(runCtx, time.Minute)
+	go handler.RunAutomationScheduler(runCtx, time.Minute)
+	go handler.RunIdleTimerScheduler(runCtx, time.Minute)
+	go handler.RunTriageModelTrainer(runCtx, time.Hour)
+
 	router := httpapi.Router(handler)
 	apiHandler := http.Handler(router)
 
@@ -81,9 +96,19 @@ func main() {
 
 	addr := ":" + cfg.Port
 	log.Printf("ticketing-system api listening on %s (base path: %s)", addr, cfg.BasePath)
-	if err := http.ListenAndServe(addr, finalHandler); err != nil {
+	server := &http.Server{Addr: addr, Handler: finalHandler}
+	go func() {
+		<-runCtx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Printf("server shutdown error: %v", err)
+		}
+	}()
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		log.Fatalf("server error: %v", err)
 	}
+	log.Printf("ticketing-system api stopped")
 }
 
 func resolveMigrationsDir() string {
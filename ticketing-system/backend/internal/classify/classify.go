@@ -0,0 +1,275 @@
+// Package classify is a small multinomial naive Bayes text classifier with
+// histogram-binned confidence calibration. Models are plain structs that
+// round-trip through encoding/json so they can be stored between runs.
+package classify
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// calibrationBins is the number of equal-width confidence bins.
+const calibrationBins = 10
+
+// calibrationStrength is how many observations' worth of weight the overall
+// accuracy gets in each bin, so that sparse bins do not swing to 0 or 1.
+const calibrationStrength = 5
+
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "are": {}, "as": {}, "at": {}, "be": {}, "but": {}, "by": {},
+	"for": {}, "from": {}, "has": {}, "have": {}, "in": {}, "is": {}, "it": {}, "its": {},
+	"of": {}, "on": {}, "or": {}, "that": {}, "the": {}, "this": {}, "to": {}, "was": {},
+	"we": {}, "when": {}, "with": {}, "will": {}, "can": {}, "not": {}, "should": {},
+}
+
+// Example is one labelled document. Weight defaults to 1.
+type Example struct {
+	Text   string
+	Label  string
+	Weight float64
+}
+
+// Prediction is a label with its posterior probability.
+type Prediction struct {
+	Label       string
+	Probability float64
+}
+
+// Classifier is a trained model. Use Train or TrainCalibrated to build one.
+type Classifier struct {
+	Classes     []string             `json:"classes"`
+	ClassDocs   []float64            `json:"classDocs"`
+	TokenCounts []map[string]float64 `json:"tokenCounts"`
+	TokenTotals []float64            `json:"tokenTotals"`
+	Vocabulary  int                  `json:"vocabulary"`
+	Calibration Calibration          `json:"calibration"`
+}
+
+// Calibration maps raw posteriors to the accuracy observed for similar
+// posteriors.
+type Calibration struct {
+	Hits  []float64 `json:"hits"`
+	Total []float64 `json:"total"`
+}
+
+// Tokenize lower-cases text and splits it into words, dropping stop words
+// and single characters.
+func Tokenize(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := fields[:0]
+	for _, field := range fields {
+		if len([]rune(field)) < 2 {
+			continue
+		}
+		if _, stop := stopWords[field]; stop {
+			continue
+		}
+		tokens = append(tokens, field)
+	}
+	return tokens
+}
+
+// Train fits a classifier with add-one smoothing. It returns nil when the
+// examples cover fewer than two labels, since there is nothing to choose.
+func Train(examples []Example) *Classifier {
+	classIndex := map[string]int{}
+	c := &Classifier{}
+	vocabulary := map[string]struct{}{}
+	for _, example := range examples {
+		if example.Label == "" {
+			continue
+		}
+		weight := example.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+		i, ok := classIndex[example.Label]
+		if !ok {
+			i = len(c.Classes)
+			classIndex[example.Label] = i
+			c.Classes = append(c.Classes, example.Label)
+			c.ClassDocs = append(c.ClassDocs, 0)
+			c.TokenCounts = append(c.TokenCounts, map[string]float64{})
+			c.TokenTotals = append(c.TokenTotals, 0)
+		}
+		c.ClassDocs[i] += weight
+		for _, token := range Tokenize(example.Text) {
+			c.TokenCounts[i][token] += weight
+			c.TokenTotals[i] += weight
+			vocabulary[token] = struct{}{}
+		}
+	}
+	if len(c.Classes) < 2 {
+		return nil
+	}
+	c.Vocabulary = len(vocabulary)
+	return c
+}
+
+// TrainCalibrated trains on all examples and calibrates confidences by
+// k-fold cross-validation. Extra observations, such as user feedback on
+// earlier predictions, can be added afterwards with Observe.
+func TrainCalibrated(examples []Example, folds int) *Classifier {
+	c := Train(examples)
+	if c == nil {
+		return nil
+	}
+	c.Calibration = newCalibration()
+	if folds < 2 || len(examples) < folds {
+		return c
+	}
+	for fold := 0; fold < folds; fold++ {
+		var training, held []Example
+		for i, example := range examples {
+			if i%folds == fold {
+				held = append(held, example)
+			} else {
+				training = append(training, example)
+			}
+		}
+		model := Train(training)
+		if model == nil {
+			continue
+		}
+		for _, example := range held {
+			predictions := model.Predict(example.Text)
+			if len(predictions) == 0 {
+				continue
+			}
+			c.Calibration.Observe(predictions[0].Probability, predictions[0].Label == example.Label)
+		}
+	}
+	return c
+}
+
+// Predict returns every class with its raw posterior probability, most
+// likely first.
+func (c *Classifier) Predict(text string) []Prediction {
+	if c == nil || len(c.Classes) == 0 {
+		return nil
+	}
+	tokens := Tokenize(text)
+	totalDocs := 0.0
+	for _, docs := range c.ClassDocs {
+		totalDocs += docs
+	}
+
+	scores := make([]float64, len(c.Classes))
+	best := math.Inf(-1)
+	for i := range c.Classes {
+		score := math.Log(c.ClassDocs[i] / totalDocs)
+		denominator := c.TokenTotals[i] + float64(c.Vocabulary)
+		for _, token := range tokens {
+			if !c.known(token) {
+				continue
+			}
+			score += math.Log((c.TokenCounts[i][token] + 1) / denominator)
+		}
+		scores[i] = score
+		if score > best {
+			best = score
+		}
+	}
+
+	sum := 0.0
+	for i, score := range scores {
+		scores[i] = math.Exp(score - best)
+		sum += scores[i]
+	}
+	predictions := make([]Prediction, len(c.Classes))
+	for i, label := range c.Classes {
+		predictions[i] = Prediction{Label: label, Probability: scores[i] / sum}
+	}
+	sort.SliceStable(predictions, func(i, j int) bool {
+		return predictions[i].Probability > predictions[j].Probability
+	})
+	return predictions
+}
+
+// Confidence maps a raw probability from Predict to a calibrated one.
+func (c *Classifier) Confidence(probability float64) float64 {
+	if c == nil {
+		return probability
+	}
+	return c.Calibration.Apply(probability)
+}
+
+// Observe records whether a prediction made with the given raw probability
+// turned out to be right.
+func (c *Calibration) Observe(probability float64, correct bool) {
+	if len(c.Total) != calibrationBins {
+		*c = newCalibration()
+	}
+	bin := calibrationBin(probability)
+	c.Total[bin]++
+	if correct {
+		c.Hits[bin]++
+	}
+}
+
+// Apply returns the smoothed accuracy of the bin the probability falls in.
+// Without any observations the probability is returned unchanged.
+func (c Calibration) Apply(probability float64) float64 {
+	hits, total := 0.0, 0.0
+	for i := range c.Total {
+		hits += c.Hits[i]
+		total += c.Total[i]
+	}
+	if total == 0 {
+		return probability
+	}
+	bin := calibrationBin(probability)
+	prior := hits / total
+	return (c.Hits[bin] + calibrationStrength*prior) / (c.Total[bin] + calibrationStrength)
+}
+
+// Accuracy is the share of observed predictions that were right, or 0
+// without observations.
+func (c Calibration) Accuracy() float64 {
+	hits, total := 0.0, 0.0
+	for i := range c.Total {
+		hits += c.Hits[i]
+		total += c.Total[i]
+	}
+	if total == 0 {
+		return 0
+	}
+	return hits / total
+}
+
+// Observations is the number of predictions calibration is based on.
+func (c Calibration) Observations() int {
+	total := 0.0
+	for _, value := range c.Total {
+		total += value
+	}
+	return int(total)
+}
+
+func (c *Classifier) known(token string) bool {
+	for _, counts := range c.TokenCounts {
+		if _, ok := counts[token]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func newCalibration() Calibration {
+	return Calibration{Hits: make([]float64, calibrationBins), Total: make([]float64, calibrationBins)}
+}
+
+func calibrationBin(probability float64) int {
+	bin := int(probability * calibrationBins)
+	if bin < 0 {
+		return 0
+	}
+	if bin >= calibrationBins {
+		return calibrationBins - 1
+	}
+	return bin
+}
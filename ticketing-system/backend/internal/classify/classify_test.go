@@ -0,0 +1,105 @@
+package classify
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func trainingExamples() []Example {
+	var examples []Example
+	for i := 0; i < 12; i++ {
+		examples = append(examples,
+			Example{Text: fmt.Sprintf("Login page crashes with error %d", i), Label: "bug"},
+			Example{Text: fmt.Sprintf("Add export to CSV for report %d", i), Label: "feature"},
+		)
+	}
+	return examples
+}
+
+func TestTokenize(t *testing.T) {
+	got := Tokenize("The API returns a 500 error, when I log-in!")
+	want := []string{"api", "returns", "500", "error", "log"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("tokens = %v, want %v", got, want)
+	}
+}
+
+func TestTrainNeedsTwoLabels(t *testing.T) {
+	if c := Train([]Example{{Text: "one", Label: "bug"}, {Text: "two", Label: "bug"}}); c != nil {
+		t.Fatal("expected no classifier for a single label")
+	}
+}
+
+func TestPredict(t *testing.T) {
+	c := Train(trainingExamples())
+	predictions := c.Predict("checkout crashes with an error")
+	if len(predictions) != 2 {
+		t.Fatalf("predictions = %v", predictions)
+	}
+	if predictions[0].Label != "bug" || predictions[0].Probability < 0.9 {
+		t.Fatalf("top prediction = %+v", predictions[0])
+	}
+	sum := predictions[0].Probability + predictions[1].Probability
+	if math.Abs(sum-1) > 1e-9 {
+		t.Fatalf("probabilities sum to %f", sum)
+	}
+
+	unknown := c.Predict("zzz qqq")
+	if math.Abs(unknown[0].Probability-0.5) > 1e-9 {
+		t.Fatalf("unknown words should fall back to the prior, got %+v", unknown)
+	}
+}
+
+func TestWeightShiftsPrior(t *testing.T) {
+	c := Train([]Example{
+		{Text: "alpha", Label: "a", Weight: 3},
+		{Text: "beta", Label: "b"},
+	})
+	if got := c.Predict("gamma")[0]; got.Label != "a" || math.Abs(got.Probability-0.75) > 1e-9 {
+		t.Fatalf("prediction = %+v", got)
+	}
+}
+
+func TestCalibration(t *testing.T) {
+	var calibration Calibration
+	if got := calibration.Apply(0.8); got != 0.8 {
+		t.Fatalf("uncalibrated = %f", got)
+	}
+	for i := 0; i < 20; i++ {
+		calibration.Observe(0.95, i%2 == 0)
+	}
+	if got := calibration.Apply(0.95); math.Abs(got-0.5) > 1e-9 {
+		t.Fatalf("calibrated = %f, want 0.5", got)
+	}
+	if got := calibration.Observations(); got != 20 {
+		t.Fatalf("observations = %d", got)
+	}
+	if got := calibration.Accuracy(); math.Abs(got-0.5) > 1e-9 {
+		t.Fatalf("accuracy = %f", got)
+	}
+}
+
+func TestTrainCalibratedRoundTrip(t *testing.T) {
+	c := TrainCalibrated(trainingExamples(), 5)
+	if c.Calibration.Observations() != 24 {
+		t.Fatalf("observations = %d", c.Calibration.Observations())
+	}
+	if confidence := c.Confidence(c.Predict("report export")[0].Probability); confidence < 0.8 {
+		t.Fatalf("confidence = %f", confidence)
+	}
+
+	payload, err := json.Marshal(c)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var restored Classifier
+	if err := json.Unmarshal(payload, &restored); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(restored.Predict("crash"), c.Predict("crash")) {
+		t.Fatal("restored classifier predicts differently")
+	}
+}
@@ -22,6 +22,15 @@ type Config struct {
 	MinIOSecretKey     string
 	MinIOBucket        string
 	MinIOUseSSL        bool
+	AITriageAPIKey     string
+	// AITriageAllowedOrigins are the triage endpoint origins, such as
+	// https://api.example.com or http://ollama:11434, that AITriageAPIKey may
+	// be sent to and that may use plain http or private addresses.
+	AITriageAllowedOrigins []string
+	// PublicBaseURL is the external URL the API is served at, including any
+	// base path, such as https://tickets.example.com/app. Status feeds use it
+	// for their links.
+	PublicBaseURL string
 }
 
 func Load() Config {
@@ -96,24 +105,30 @@ func Load() Config {
 		minioBucket = "ticketing-attachments"
 	}
 	minioUseSSL := os.Getenv("MINIO_USE_SSL") == "true"
+	aiTriageAPIKey := os.Getenv("AI_TRIAGE_API_KEY")
+	aiTriageAllowedOrigins := parseCSV(os.Getenv("AI_TRIAGE_ALLOWED_ORIGINS"))
+	publicBaseURL := strings.TrimSuffix(strings.TrimSpace(os.Getenv("PUBLIC_BASE_URL")), "/")
 
 	return Config{
-		Port:               port,
-		DatabaseURL:        dbURL,
-		KeycloakBaseURL:    keycloakBase,
-		KeycloakRealm:      keycloakRealm,
-		KeycloakClientID:   keycloakClient,
-		KeycloakAdminUser:  keycloakAdminUser,
-		KeycloakAdminPass:  keycloakAdminPass,
-		CookieSecure:       cookieSecure,
-		CORSAllowedOrigins: allowedOrigins,
-		FrontendDir:        frontendDir,
-		BasePath:           basePath,
-		MinIOEndpoint:      minioEndpoint,
-		MinIOAccessKey:     minioAccessKey,
-		MinIOSecretKey:     minioSecretKey,
-		MinIOBucket:        minioBucket,
-		MinIOUseSSL:        minioUseSSL,
+		Port:                   port,
+		DatabaseURL:            dbURL,
+		KeycloakBaseURL:        keycloakBase,
+		KeycloakRealm:          keycloakRealm,
+		KeycloakClientID:       keycloakClient,
+		KeycloakAdminUser:      keycloakAdminUser,
+		KeycloakAdminPass:      keycloakAdminPass,
+		CookieSecure:           cookieSecure,
+		CORSAllowedOrigins:     allowedOrigins,
+		FrontendDir:            frontendDir,
+		BasePath:               basePath,
+		MinIOEndpoint:          minioEndpoint,
+		MinIOAccessKey:         minioAccessKey,
+		MinIOSecretKey:         minioSecretKey,
+		MinIOBucket:            minioBucket,
+		MinIOUseSSL:            minioUseSSL,
+		AITriageAPIKey:         aiTriageAPIKey,
+		AITriageAllowedOrigins: aiTriageAllowedOrigins,
+		PublicBaseURL:          publicBaseURL,
 	}
 }
 
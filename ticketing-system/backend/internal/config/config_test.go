@@ -10,6 +10,7 @@ func TestLoad_Defaults(t *testing.T) {
 	envVars := []string{
 		"PORT", "DATABASE_URL", "KEYCLOAK_BASE_URL", "KEYCLOAK_REALM",
 		"KEYCLOAK_CLIENT_ID", "COOKIE_SECURE", "CORS_ALLOWED_ORIGINS", "FRONTEND_DIR",
+		"PUBLIC_BASE_URL",
 	}
 	for _, v := range envVars {
 		os.Unsetenv(v)
@@ -41,6 +42,9 @@ func TestLoad_Defaults(t *testing.T) {
 	if cfg.FrontendDir != "" {
 		t.Errorf("expected empty frontend dir, got %q", cfg.FrontendDir)
 	}
+	if cfg.PublicBaseURL != "" {
+		t.Errorf("expected empty public base URL, got %q", cfg.PublicBaseURL)
+	}
 }
 
 func TestLoad_CustomValues(t *testing.T) {
@@ -53,6 +57,7 @@ func TestLoad_CustomValues(t *testing.T) {
 	os.Setenv("COOKIE_SECURE", "true")
 	os.Setenv("CORS_ALLOWED_ORIGINS", "https://app.example.com,https://admin.example.com")
 	os.Setenv("FRONTEND_DIR", "/var/www/html")
+	os.Setenv("PUBLIC_BASE_URL", "https://tickets.example.com/app/")
 
 	defer func() {
 		os.Unsetenv("PORT")
@@ -63,6 +68,7 @@ func TestLoad_CustomValues(t *testing.T) {
 		os.Unsetenv("COOKIE_SECURE")
 		os.Unsetenv("CORS_ALLOWED_ORIGINS")
 		os.Unsetenv("FRONTEND_DIR")
+		os.Unsetenv("PUBLIC_BASE_URL")
 	}()
 
 	cfg := Load()
@@ -97,6 +103,9 @@ func TestLoad_CustomValues(t *testing.T) {
 	if cfg.FrontendDir != "/var/www/html" {
 		t.Errorf("expected frontend dir '/var/www/html', got %q", cfg.FrontendDir)
 	}
+	if cfg.PublicBaseURL != "https://tickets.example.com/app" {
+		t.Errorf("expected public base URL without trailing slash, got %q", cfg.PublicBaseURL)
+	}
 }
 
 func TestLoad_CookieSecure_OnlyTrueIsTrue(t *testing.T) {
@@ -20,10 +20,74 @@ const (
 
 // Defines values for AiTriageField.
 const (
-	Assignee AiTriageField = "assignee"
-	Priority AiTriageField = "priority"
-	State    AiTriageField = "state"
-	Summary  AiTriageField = "summary"
+	AiTriageFieldAssignee AiTriageField = "assignee"
+	AiTriageFieldPriority AiTriageField = "priority"
+	AiTriageFieldState    AiTriageField = "state"
+	AiTriageFieldSummary  AiTriageField = "summary"
+	AiTriageFieldTemplate AiTriageField = "template"
+	AiTriageFieldType     AiTriageField = "type"
+)
+
+// Defines values for AiTriageModelFieldField.
+const (
+	AiTriageModelFieldFieldAssignee AiTriageModelFieldField = "assignee"
+	AiTriageModelFieldFieldPriority AiTriageModelFieldField = "priority"
+	AiTriageModelFieldFieldType     AiTriageModelFieldField = "type"
+)
+
+// Defines values for AiTriageProvider.
+const (
+	Heuristic AiTriageProvider = "heuristic"
+	Http      AiTriageProvider = "http"
+	Learned   AiTriageProvider = "learned"
+)
+
+// Defines values for AlertmanagerAlertStatus.
+const (
+	AlertmanagerAlertStatusFiring   AlertmanagerAlertStatus = "firing"
+	AlertmanagerAlertStatusResolved AlertmanagerAlertStatus = "resolved"
+)
+
+// Defines values for AutomationActionField.
+const (
+	AutomationActionFieldPriority     AutomationActionField = "priority"
+	AutomationActionFieldStoryPoints  AutomationActionField = "storyPoints"
+	AutomationActionFieldTimeEstimate AutomationActionField = "timeEstimate"
+	AutomationActionFieldType         AutomationActionField = "type"
+)
+
+// Defines values for AutomationActionType.
+const (
+	AutomationActionTypeAddComment         AutomationActionType = "add_comment"
+	AutomationActionTypeAssign             AutomationActionType = "assign"
+	AutomationActionTypeCallWebhook        AutomationActionType = "call_webhook"
+	AutomationActionTypeCreateLinkedTicket AutomationActionType = "create_linked_ticket"
+	AutomationActionTypeMoveState          AutomationActionType = "move_state"
+	AutomationActionTypeNotify             AutomationActionType = "notify"
+	AutomationActionTypeSetField           AutomationActionType = "set_field"
+)
+
+// Defines values for AutomationRunStatus.
+const (
+	Failed    AutomationRunStatus = "failed"
+	Skipped   AutomationRunStatus = "skipped"
+	Succeeded AutomationRunStatus = "succeeded"
+)
+
+// Defines values for AutomationTrigger.
+const (
+	AutomationTriggerCommentCreated     AutomationTrigger = "comment.created"
+	AutomationTriggerSchedule           AutomationTrigger = "schedule"
+	AutomationTriggerTicketCreated      AutomationTrigger = "ticket.created"
+	AutomationTriggerTicketStateChanged AutomationTrigger = "ticket.state_changed"
+	AutomationTriggerTicketUpdated      AutomationTrigger = "ticket.updated"
+)
+
+// Defines values for BoardFilterPresetVisibility.
+const (
+	BoardFilterPresetVisibilityGroups  BoardFilterPresetVisibility = "groups"
+	BoardFilterPresetVisibilityPrivate BoardFilterPresetVisibility = "private"
+	BoardFilterPresetVisibilityProject BoardFilterPresetVisibility = "project"
 )
 
 // Defines values for BulkTicketAction.
@@ -40,24 +104,47 @@ const (
 	CapacitySettingScopeUser CapacitySettingScope = "user"
 )
 
+// Defines values for CapacityUnit.
+const (
+	Hours   CapacityUnit = "hours"
+	Points  CapacityUnit = "points"
+	Tickets CapacityUnit = "tickets"
+)
+
 // Defines values for DependencyRelationType.
 const (
-	BlockedBy DependencyRelationType = "blocked_by"
-	Blocks    DependencyRelationType = "blocks"
-	Related   DependencyRelationType = "related"
+	BlockedBy    DependencyRelationType = "blocked_by"
+	Blocks       DependencyRelationType = "blocks"
+	DuplicateOf  DependencyRelationType = "duplicate_of"
+	DuplicatedBy DependencyRelationType = "duplicated_by"
+	Related      DependencyRelationType = "related"
+)
+
+// Defines values for IncidentStatus.
+const (
+	IncidentStatusAcknowledged IncidentStatus = "acknowledged"
+	IncidentStatusDetected     IncidentStatus = "detected"
+	IncidentStatusMitigated    IncidentStatus = "mitigated"
+	IncidentStatusResolved     IncidentStatus = "resolved"
 )
 
 // Defines values for IncidentTimelineItemType.
 const (
-	IncidentTimelineItemTypeActivity IncidentTimelineItemType = "activity"
-	IncidentTimelineItemTypeComment  IncidentTimelineItemType = "comment"
-	IncidentTimelineItemTypeWebhook  IncidentTimelineItemType = "webhook"
+	IncidentTimelineItemTypeActivity   IncidentTimelineItemType = "activity"
+	IncidentTimelineItemTypeAlert      IncidentTimelineItemType = "alert"
+	IncidentTimelineItemTypeAnnotation IncidentTimelineItemType = "annotation"
+	IncidentTimelineItemTypeComment    IncidentTimelineItemType = "comment"
+	IncidentTimelineItemTypeWebhook    IncidentTimelineItemType = "webhook"
 )
 
 // Defines values for NotificationType.
 const (
-	Assignment NotificationType = "assignment"
-	Mention    NotificationType = "mention"
+	Assignment        NotificationType = "assignment"
+	Automation        NotificationType = "automation"
+	IncidentCommander NotificationType = "incident_commander"
+	Mention           NotificationType = "mention"
+	PresetMatch       NotificationType = "preset_match"
+	TimerIdle         NotificationType = "timer_idle"
 )
 
 // Defines values for ProjectPermission.
@@ -71,9 +158,35 @@ const (
 
 // Defines values for ProjectRole.
 const (
-	Admin       ProjectRole = "admin"
-	Contributor ProjectRole = "contributor"
-	Viewer      ProjectRole = "viewer"
+	ProjectRoleAdmin       ProjectRole = "admin"
+	ProjectRoleContributor ProjectRole = "contributor"
+	ProjectRoleViewer      ProjectRole = "viewer"
+)
+
+// Defines values for SprintCarryOver.
+const (
+	Backlog    SprintCarryOver = "backlog"
+	NextSprint SprintCarryOver = "next_sprint"
+)
+
+// Defines values for SprintScopeChangeAction.
+const (
+	Added   SprintScopeChangeAction = "added"
+	Removed SprintScopeChangeAction = "removed"
+)
+
+// Defines values for SprintStatus.
+const (
+	Active    SprintStatus = "active"
+	Completed SprintStatus = "completed"
+	Planned   SprintStatus = "planned"
+)
+
+// Defines values for StatusPageStatus.
+const (
+	Degraded    StatusPageStatus = "degraded"
+	MajorOutage StatusPageStatus = "major_outage"
+	Operational StatusPageStatus = "operational"
 )
 
 // Defines values for TicketIncidentSeverity.
@@ -92,24 +205,49 @@ const (
 	Urgent TicketPriority = "urgent"
 )
 
+// Defines values for TicketRecurrenceScheduleKind.
+const (
+	Cron  TicketRecurrenceScheduleKind = "cron"
+	Rrule TicketRecurrenceScheduleKind = "rrule"
+)
+
 // Defines values for TicketType.
 const (
 	Bug     TicketType = "bug"
 	Feature TicketType = "feature"
 )
 
+// Defines values for TimeReportGroupBy.
+const (
+	TimeReportGroupByStory TimeReportGroupBy = "story"
+	TimeReportGroupByType  TimeReportGroupBy = "type"
+	TimeReportGroupByUser  TimeReportGroupBy = "user"
+)
+
 // Defines values for WebhookEvent.
 const (
-	TicketCreated      WebhookEvent = "ticket.created"
-	TicketDeleted      WebhookEvent = "ticket.deleted"
-	TicketStateChanged WebhookEvent = "ticket.state_changed"
-	TicketUpdated      WebhookEvent = "ticket.updated"
+	WebhookEventAutomationTriggered  WebhookEvent = "automation.triggered"
+	WebhookEventIncidentDeclared     WebhookEvent = "incident.declared"
+	WebhookEventIncidentEscalated    WebhookEvent = "incident.escalated"
+	WebhookEventIncidentResolved     WebhookEvent = "incident.resolved"
+	WebhookEventPresetMatchesChanged WebhookEvent = "preset.matches_changed"
+	WebhookEventTicketCreated        WebhookEvent = "ticket.created"
+	WebhookEventTicketDeleted        WebhookEvent = "ticket.deleted"
+	WebhookEventTicketStateChanged   WebhookEvent = "ticket.state_changed"
+	WebhookEventTicketUpdated        WebhookEvent = "ticket.updated"
 )
 
 // Defines values for ExportProjectReportingSnapshotParamsFormat.
 const (
-	Csv  ExportProjectReportingSnapshotParamsFormat = "csv"
-	Json ExportProjectReportingSnapshotParamsFormat = "json"
+	ExportProjectReportingSnapshotParamsFormatCsv  ExportProjectReportingSnapshotParamsFormat = "csv"
+	ExportProjectReportingSnapshotParamsFormatJson ExportProjectReportingSnapshotParamsFormat = "json"
+	ExportProjectReportingSnapshotParamsFormatXlsx ExportProjectReportingSnapshotParamsFormat = "xlsx"
+)
+
+// Defines values for GetProjectTimesheetParamsFormat.
+const (
+	GetProjectTimesheetParamsFormatCsv  GetProjectTimesheetParamsFormat = "csv"
+	GetProjectTimesheetParamsFormatJson GetProjectTimesheetParamsFormat = "json"
 )
 
 // AdminUserCreateRequest defines model for AdminUserCreateRequest.
@@ -121,39 +259,206 @@ type AdminUserCreateRequest struct {
 	Username  string              `json:"username"`
 }
 
+// AgingWipItem defines model for AgingWipItem.
+type AgingWipItem struct {
+	AgeHours       float64             `json:"ageHours"`
+	AssigneeId     *openapi_types.UUID `json:"assigneeId"`
+	AssigneeName   *string             `json:"assigneeName"`
+	EnteredStateAt time.Time           `json:"enteredStateAt"`
+	Key            string              `json:"key"`
+	Priority       TicketPriority      `json:"priority"`
+	StateAgeHours  float64             `json:"stateAgeHours"`
+	StateId        openapi_types.UUID  `json:"stateId"`
+	StateName      string              `json:"stateName"`
+	TicketId       openapi_types.UUID  `json:"ticketId"`
+	Title          string              `json:"title"`
+	Type           TicketType          `json:"type"`
+}
+
+// AgingWipReport defines model for AgingWipReport.
+type AgingWipReport struct {
+	GeneratedAt time.Time       `json:"generatedAt"`
+	Items       []AgingWipItem  `json:"items"`
+	States      []AgingWipState `json:"states"`
+}
+
+// AgingWipState defines model for AgingWipState.
+type AgingWipState struct {
+	Count       int                `json:"count"`
+	MaxAgeHours float64            `json:"maxAgeHours"`
+	P50AgeHours float64            `json:"p50AgeHours"`
+	P85AgeHours float64            `json:"p85AgeHours"`
+	StateId     openapi_types.UUID `json:"stateId"`
+	StateName   string             `json:"stateName"`
+}
+
+// AiTriageAcceptancePoint defines model for AiTriageAcceptancePoint.
+type AiTriageAcceptancePoint struct {
+	Date   openapi_types.Date        `json:"date"`
+	Fields []AiTriageFieldAcceptance `json:"fields"`
+}
+
+// AiTriageAccuracyReport defines model for AiTriageAccuracyReport.
+type AiTriageAccuracyReport struct {
+	Calibration []AiTriageCalibrationBucket `json:"calibration"`
+	Fields      []AiTriageFieldAcceptance   `json:"fields"`
+	From        openapi_types.Date          `json:"from"`
+	Points      []AiTriageAcceptancePoint   `json:"points"`
+	To          openapi_types.Date          `json:"to"`
+	Versions    []AiTriageVersionAccuracy   `json:"versions"`
+}
+
+// AiTriageAutoApplyThresholds Lowest confidence at which each field is applied to a new ticket.
+// Fields without a threshold are never applied. Fields set when the
+// ticket is created are kept.
+type AiTriageAutoApplyThresholds struct {
+	Assignee *float32 `json:"assignee"`
+	Priority *float32 `json:"priority"`
+	State    *float32 `json:"state"`
+	Type     *float32 `json:"type"`
+}
+
+// AiTriageCalibrationBucket Fields suggested with a confidence in [minConfidence, maxConfidence); the last bucket includes 1.
+type AiTriageCalibrationBucket struct {
+	AcceptanceRate    float64 `json:"acceptanceRate"`
+	Accepted          int     `json:"accepted"`
+	AverageConfidence float64 `json:"averageConfidence"`
+	MaxConfidence     float64 `json:"maxConfidence"`
+	MinConfidence     float64 `json:"minConfidence"`
+	Rejected          int     `json:"rejected"`
+}
+
 // AiTriageConfidence defines model for AiTriageConfidence.
 type AiTriageConfidence struct {
 	Assignee float32 `json:"assignee"`
 	Priority float32 `json:"priority"`
 	State    float32 `json:"state"`
 	Summary  float32 `json:"summary"`
+	Template float32 `json:"template"`
+	Type     float32 `json:"type"`
 }
 
 // AiTriageField defines model for AiTriageField.
 type AiTriageField string
 
+// AiTriageFieldAcceptance defines model for AiTriageFieldAcceptance.
+type AiTriageFieldAcceptance struct {
+	AcceptanceRate float64       `json:"acceptanceRate"`
+	Accepted       int           `json:"accepted"`
+	Field          AiTriageField `json:"field"`
+	Rejected       int           `json:"rejected"`
+}
+
+// AiTriageModelField defines model for AiTriageModelField.
+type AiTriageModelField struct {
+	// Accuracy Share of those predictions that were right.
+	Accuracy float32 `json:"accuracy"`
+
+	// Classes Number of distinct values the model can predict.
+	Classes int                     `json:"classes"`
+	Field   AiTriageModelFieldField `json:"field"`
+
+	// Observations Held-out predictions and feedback the confidences are calibrated on.
+	Observations int `json:"observations"`
+}
+
+// AiTriageModelFieldField defines model for AiTriageModelField.Field.
+type AiTriageModelFieldField string
+
+// AiTriageModelStatus defines model for AiTriageModelStatus.
+type AiTriageModelStatus struct {
+	ExampleCount  int                  `json:"exampleCount"`
+	FeedbackCount int                  `json:"feedbackCount"`
+	Fields        []AiTriageModelField `json:"fields"`
+	Trained       bool                 `json:"trained"`
+	TrainedAt     *time.Time           `json:"trainedAt"`
+}
+
+// AiTriageProvider `heuristic` uses the built-in keyword rules. `http` calls an
+// OpenAI-compatible chat completions endpoint and falls back to the
+// heuristics for anything it cannot answer. `learned` uses a naive
+// Bayes model trained on the project's closed tickets and recorded
+// suggestion decisions, retrained daily.
+type AiTriageProvider string
+
 // AiTriageSettings defines model for AiTriageSettings.
 type AiTriageSettings struct {
-	Enabled bool `json:"enabled"`
+	// ApiKeySet Whether the project has its own API key for the endpoint. The key itself is never returned.
+	ApiKeySet bool `json:"apiKeySet"`
+
+	// AutoApply Apply confident suggestions to new tickets automatically.
+	AutoApply bool `json:"autoApply"`
+
+	// AutoApplyThresholds Lowest confidence at which each field is applied to a new ticket.
+	// Fields without a threshold are never applied. Fields set when the
+	// ticket is created are kept.
+	AutoApplyThresholds AiTriageAutoApplyThresholds `json:"autoApplyThresholds"`
+	Enabled             bool                        `json:"enabled"`
+
+	// EndpointUrl Base URL of the chat completions API, e.g. `http://localhost:11434/v1`.
+	EndpointUrl   *string `json:"endpointUrl"`
+	Model         *string `json:"model"`
+	PromptVersion string  `json:"promptVersion"`
+
+	// PromptVersions Prompt versions that can be selected.
+	PromptVersions []string `json:"promptVersions"`
+
+	// Provider `heuristic` uses the built-in keyword rules. `http` calls an
+	// OpenAI-compatible chat completions endpoint and falls back to the
+	// heuristics for anything it cannot answer. `learned` uses a naive
+	// Bayes model trained on the project's closed tickets and recorded
+	// suggestion decisions, retrained daily.
+	Provider  AiTriageProvider `json:"provider"`
+	TimeoutMs int              `json:"timeoutMs"`
 }
 
-// AiTriageSettingsUpdateRequest defines model for AiTriageSettingsUpdateRequest.
+// AiTriageSettingsUpdateRequest Omitted fields keep their current value.
 type AiTriageSettingsUpdateRequest struct {
-	Enabled bool `json:"enabled"`
+	// ApiKey Bearer token for endpointUrl. An empty string clears it. Without
+	// a project key, the server's key is only sent to hosts the
+	// operator allows.
+	ApiKey    *string `json:"apiKey"`
+	AutoApply *bool   `json:"autoApply,omitempty"`
+
+	// AutoApplyThresholds Lowest confidence at which each field is applied to a new ticket.
+	// Fields without a threshold are never applied. Fields set when the
+	// ticket is created are kept.
+	AutoApplyThresholds *AiTriageAutoApplyThresholds `json:"autoApplyThresholds,omitempty"`
+	Enabled             *bool                        `json:"enabled,omitempty"`
+
+	// EndpointUrl Changing the endpoint clears the project's API key unless apiKey is sent as well.
+	EndpointUrl   *string `json:"endpointUrl"`
+	Model         *string `json:"model"`
+	PromptVersion *string `json:"promptVersion,omitempty"`
+
+	// Provider `heuristic` uses the built-in keyword rules. `http` calls an
+	// OpenAI-compatible chat completions endpoint and falls back to the
+	// heuristics for anything it cannot answer. `learned` uses a naive
+	// Bayes model trained on the project's closed tickets and recorded
+	// suggestion decisions, retrained daily.
+	Provider  *AiTriageProvider `json:"provider,omitempty"`
+	TimeoutMs *int              `json:"timeoutMs,omitempty"`
 }
 
 // AiTriageSuggestion defines model for AiTriageSuggestion.
 type AiTriageSuggestion struct {
-	AssigneeId    *openapi_types.UUID `json:"assigneeId"`
-	Confidence    AiTriageConfidence  `json:"confidence"`
-	CreatedAt     time.Time           `json:"createdAt"`
-	Id            openapi_types.UUID  `json:"id"`
-	Model         string              `json:"model"`
-	Priority      TicketPriority      `json:"priority"`
-	ProjectId     openapi_types.UUID  `json:"projectId"`
-	PromptVersion string              `json:"promptVersion"`
-	StateId       openapi_types.UUID  `json:"stateId"`
-	Summary       string              `json:"summary"`
+	AssigneeId *openapi_types.UUID `json:"assigneeId"`
+	Confidence AiTriageConfidence  `json:"confidence"`
+	CreatedAt  time.Time           `json:"createdAt"`
+
+	// Duplicates Open tickets that look like the same report. Only included when the suggestion is created.
+	Duplicates    *[]DuplicateCandidate `json:"duplicates,omitempty"`
+	Id            openapi_types.UUID    `json:"id"`
+	Model         string                `json:"model"`
+	Priority      TicketPriority        `json:"priority"`
+	ProjectId     openapi_types.UUID    `json:"projectId"`
+	PromptVersion string                `json:"promptVersion"`
+	StateId       openapi_types.UUID    `json:"stateId"`
+	Summary       string                `json:"summary"`
+
+	// TemplateId Ticket template that best matches the input, if any.
+	TemplateId *openapi_types.UUID `json:"templateId"`
+	Type       *TicketType         `json:"type,omitempty"`
 }
 
 // AiTriageSuggestionCreateRequest defines model for AiTriageSuggestionCreateRequest.
@@ -180,6 +485,42 @@ type AiTriageSuggestionDecisionRequest struct {
 	RejectedFields []AiTriageField `json:"rejectedFields"`
 }
 
+// AiTriageVersionAccuracy defines model for AiTriageVersionAccuracy.
+type AiTriageVersionAccuracy struct {
+	AcceptanceRate float64 `json:"acceptanceRate"`
+	Accepted       int     `json:"accepted"`
+	Model          string  `json:"model"`
+	PromptVersion  string  `json:"promptVersion"`
+	Rejected       int     `json:"rejected"`
+}
+
+// AlertmanagerAlert defines model for AlertmanagerAlert.
+type AlertmanagerAlert struct {
+	Annotations  *map[string]string      `json:"annotations,omitempty"`
+	EndsAt       *time.Time              `json:"endsAt,omitempty"`
+	Fingerprint  *string                 `json:"fingerprint,omitempty"`
+	GeneratorURL *string                 `json:"generatorURL,omitempty"`
+	Labels       *map[string]string      `json:"labels,omitempty"`
+	StartsAt     *time.Time              `json:"startsAt,omitempty"`
+	Status       AlertmanagerAlertStatus `json:"status"`
+}
+
+// AlertmanagerAlertStatus defines model for AlertmanagerAlert.Status.
+type AlertmanagerAlertStatus string
+
+// AlertmanagerWebhook defines model for AlertmanagerWebhook.
+type AlertmanagerWebhook struct {
+	Alerts            []AlertmanagerAlert `json:"alerts"`
+	CommonAnnotations *map[string]string  `json:"commonAnnotations,omitempty"`
+	CommonLabels      *map[string]string  `json:"commonLabels,omitempty"`
+	ExternalURL       *string             `json:"externalURL,omitempty"`
+	GroupKey          string              `json:"groupKey"`
+	GroupLabels       *map[string]string  `json:"groupLabels,omitempty"`
+	Receiver          *string             `json:"receiver,omitempty"`
+	Status            string              `json:"status"`
+	Version           *string             `json:"version,omitempty"`
+}
+
 // Attachment defines model for Attachment.
 type Attachment struct {
 	ContentType    string             `json:"contentType"`
@@ -214,6 +555,118 @@ type AuthLoginResponse struct {
 	User User `json:"user"`
 }
 
+// AutomationAction One step of a rule. `set_field` uses field and value; `assign` uses
+// userId; `move_state` uses stateId; `add_comment` and `notify` use
+// message (`notify` sends to userId, or the assignee when omitted);
+// `call_webhook` sends an `automation.triggered` event to the project's
+// webhooks with the optional message; `create_linked_ticket` uses title
+// and relationType. Message and title accept the placeholders `{key}`,
+// `{title}` and `{rule}`.
+type AutomationAction struct {
+	Field   *AutomationActionField `json:"field,omitempty"`
+	Message *string                `json:"message,omitempty"`
+
+	// RelationType `duplicate_of` marks the ticket as a duplicate of the related ticket
+	// and closes it; `duplicated_by` is the same relation from the other
+	// side.
+	RelationType *DependencyRelationType `json:"relationType,omitempty"`
+	StateId      *openapi_types.UUID     `json:"stateId,omitempty"`
+	Title        *string                 `json:"title,omitempty"`
+	Type         AutomationActionType    `json:"type"`
+	UserId       *openapi_types.UUID     `json:"userId,omitempty"`
+	Value        *string                 `json:"value,omitempty"`
+}
+
+// AutomationActionField defines model for AutomationAction.Field.
+type AutomationActionField string
+
+// AutomationActionResult defines model for AutomationActionResult.
+type AutomationActionResult struct {
+	Message *string              `json:"message,omitempty"`
+	Status  AutomationRunStatus  `json:"status"`
+	Type    AutomationActionType `json:"type"`
+}
+
+// AutomationActionType defines model for AutomationActionType.
+type AutomationActionType string
+
+// AutomationRule defines model for AutomationRule.
+type AutomationRule struct {
+	Actions    []AutomationAction  `json:"actions"`
+	Conditions BoardFilter         `json:"conditions"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	CreatedBy  *openapi_types.UUID `json:"createdBy"`
+	Enabled    bool                `json:"enabled"`
+	Id         openapi_types.UUID  `json:"id"`
+	LastRunAt  *time.Time          `json:"lastRunAt"`
+	Name       string              `json:"name"`
+	NextRunAt  *time.Time          `json:"nextRunAt"`
+	ProjectId  openapi_types.UUID  `json:"projectId"`
+
+	// Schedule Cron expression or RRULE for `schedule` rules.
+	Schedule     *string                       `json:"schedule"`
+	ScheduleKind *TicketRecurrenceScheduleKind `json:"scheduleKind,omitempty"`
+	Timezone     string                        `json:"timezone"`
+	Trigger      AutomationTrigger             `json:"trigger"`
+	UpdatedAt    time.Time                     `json:"updatedAt"`
+}
+
+// AutomationRuleCreateRequest defines model for AutomationRuleCreateRequest.
+type AutomationRuleCreateRequest struct {
+	Actions      []AutomationAction            `json:"actions"`
+	Conditions   *BoardFilter                  `json:"conditions,omitempty"`
+	Enabled      *bool                         `json:"enabled,omitempty"`
+	Name         string                        `json:"name"`
+	Schedule     *string                       `json:"schedule,omitempty"`
+	ScheduleKind *TicketRecurrenceScheduleKind `json:"scheduleKind,omitempty"`
+	Timezone     *string                       `json:"timezone,omitempty"`
+	Trigger      AutomationTrigger             `json:"trigger"`
+}
+
+// AutomationRuleListResponse defines model for AutomationRuleListResponse.
+type AutomationRuleListResponse struct {
+	Items []AutomationRule `json:"items"`
+}
+
+// AutomationRuleUpdateRequest defines model for AutomationRuleUpdateRequest.
+type AutomationRuleUpdateRequest struct {
+	Actions      *[]AutomationAction           `json:"actions,omitempty"`
+	Conditions   *BoardFilter                  `json:"conditions,omitempty"`
+	Enabled      *bool                         `json:"enabled,omitempty"`
+	Name         *string                       `json:"name,omitempty"`
+	Schedule     *string                       `json:"schedule,omitempty"`
+	ScheduleKind *TicketRecurrenceScheduleKind `json:"scheduleKind,omitempty"`
+	Timezone     *string                       `json:"timezone,omitempty"`
+	Trigger      *AutomationTrigger            `json:"trigger,omitempty"`
+}
+
+// AutomationRun defines model for AutomationRun.
+type AutomationRun struct {
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Depth How many rules ran before this one in the same chain.
+	Depth     int                      `json:"depth"`
+	Error     *string                  `json:"error"`
+	Id        openapi_types.UUID       `json:"id"`
+	ProjectId openapi_types.UUID       `json:"projectId"`
+	Results   []AutomationActionResult `json:"results"`
+	RuleId    openapi_types.UUID       `json:"ruleId"`
+	Status    AutomationRunStatus      `json:"status"`
+	TicketId  *openapi_types.UUID      `json:"ticketId"`
+	Trigger   AutomationTrigger        `json:"trigger"`
+}
+
+// AutomationRunListResponse defines model for AutomationRunListResponse.
+type AutomationRunListResponse struct {
+	Items []AutomationRun `json:"items"`
+}
+
+// AutomationRunStatus defines model for AutomationRunStatus.
+type AutomationRunStatus string
+
+// AutomationTrigger defines model for AutomationTrigger.
+type AutomationTrigger string
+
 // BoardFilter defines model for BoardFilter.
 type BoardFilter struct {
 	AssigneeId *openapi_types.UUID `json:"assigneeId,omitempty"`
@@ -226,21 +679,35 @@ type BoardFilter struct {
 
 // BoardFilterPreset defines model for BoardFilterPreset.
 type BoardFilterPreset struct {
-	CreatedAt  time.Time          `json:"createdAt"`
-	Filters    BoardFilter        `json:"filters"`
-	Id         openapi_types.UUID `json:"id"`
-	Name       string             `json:"name"`
-	OwnerId    openapi_types.UUID `json:"ownerId"`
-	ProjectId  openapi_types.UUID `json:"projectId"`
-	ShareToken *string            `json:"shareToken"`
-	UpdatedAt  time.Time          `json:"updatedAt"`
+	CreatedAt time.Time   `json:"createdAt"`
+	Filters   BoardFilter `json:"filters"`
+
+	// GroupIds Groups the preset is shared with when visibility is `groups`.
+	GroupIds []openapi_types.UUID `json:"groupIds"`
+	Id       openapi_types.UUID   `json:"id"`
+
+	// IsDefault Whether this is the project's default preset.
+	IsDefault  bool                        `json:"isDefault"`
+	Name       string                      `json:"name"`
+	OwnerId    openapi_types.UUID          `json:"ownerId"`
+	Pinned     bool                        `json:"pinned"`
+	Position   int                         `json:"position"`
+	ProjectId  openapi_types.UUID          `json:"projectId"`
+	ShareToken *string                     `json:"shareToken"`
+	UpdatedAt  time.Time                   `json:"updatedAt"`
+	Visibility BoardFilterPresetVisibility `json:"visibility"`
 }
 
 // BoardFilterPresetCreateRequest defines model for BoardFilterPresetCreateRequest.
 type BoardFilterPresetCreateRequest struct {
-	Filters            BoardFilter `json:"filters"`
-	GenerateShareToken *bool       `json:"generateShareToken,omitempty"`
-	Name               string      `json:"name"`
+	Filters            BoardFilter                  `json:"filters"`
+	GenerateShareToken *bool                        `json:"generateShareToken,omitempty"`
+	GroupIds           *[]openapi_types.UUID        `json:"groupIds,omitempty"`
+	IsDefault          *bool                        `json:"isDefault,omitempty"`
+	Name               string                       `json:"name"`
+	Pinned             *bool                        `json:"pinned,omitempty"`
+	Position           *int                         `json:"position,omitempty"`
+	Visibility         *BoardFilterPresetVisibility `json:"visibility,omitempty"`
 }
 
 // BoardFilterPresetListResponse defines model for BoardFilterPresetListResponse.
@@ -248,13 +715,41 @@ type BoardFilterPresetListResponse struct {
 	Items []BoardFilterPreset `json:"items"`
 }
 
+// BoardFilterPresetSubscription defines model for BoardFilterPresetSubscription.
+type BoardFilterPresetSubscription struct {
+	CreatedAt       time.Time          `json:"createdAt"`
+	Id              openapi_types.UUID `json:"id"`
+	IntervalMinutes int                `json:"intervalMinutes"`
+	LastEvaluatedAt *time.Time         `json:"lastEvaluatedAt"`
+	NotifyInApp     bool               `json:"notifyInApp"`
+	PresetId        openapi_types.UUID `json:"presetId"`
+	SendWebhook     bool               `json:"sendWebhook"`
+	UpdatedAt       time.Time          `json:"updatedAt"`
+	UserId          openapi_types.UUID `json:"userId"`
+}
+
+// BoardFilterPresetSubscriptionRequest defines model for BoardFilterPresetSubscriptionRequest.
+type BoardFilterPresetSubscriptionRequest struct {
+	IntervalMinutes *int  `json:"intervalMinutes,omitempty"`
+	NotifyInApp     *bool `json:"notifyInApp,omitempty"`
+	SendWebhook     *bool `json:"sendWebhook,omitempty"`
+}
+
 // BoardFilterPresetUpdateRequest defines model for BoardFilterPresetUpdateRequest.
 type BoardFilterPresetUpdateRequest struct {
-	Filters            *BoardFilter `json:"filters,omitempty"`
-	GenerateShareToken *bool        `json:"generateShareToken,omitempty"`
-	Name               *string      `json:"name,omitempty"`
+	Filters            *BoardFilter                 `json:"filters,omitempty"`
+	GenerateShareToken *bool                        `json:"generateShareToken,omitempty"`
+	GroupIds           *[]openapi_types.UUID        `json:"groupIds,omitempty"`
+	IsDefault          *bool                        `json:"isDefault,omitempty"`
+	Name               *string                      `json:"name,omitempty"`
+	Pinned             *bool                        `json:"pinned,omitempty"`
+	Position           *int                         `json:"position,omitempty"`
+	Visibility         *BoardFilterPresetVisibility `json:"visibility,omitempty"`
 }
 
+// BoardFilterPresetVisibility defines model for BoardFilterPresetVisibility.
+type BoardFilterPresetVisibility string
+
 // BoardResponse defines model for BoardResponse.
 type BoardResponse struct {
 	Project Project         `json:"project"`
@@ -292,6 +787,18 @@ type BulkTicketOperationResult struct {
 	TicketId  openapi_types.UUID `json:"ticketId"`
 }
 
+// CapacityAllocation One person's committed work against their capacity after planned absences.
+type CapacityAllocation struct {
+	AbsenceDays   int                `json:"absenceDays"`
+	BaseCapacity  int                `json:"baseCapacity"`
+	Capacity      int                `json:"capacity"`
+	Committed     int                `json:"committed"`
+	Delta         int                `json:"delta"`
+	Name          string             `json:"name"`
+	OverAllocated bool               `json:"overAllocated"`
+	UserId        openapi_types.UUID `json:"userId"`
+}
+
 // CapacitySetting defines model for CapacitySetting.
 type CapacitySetting struct {
 	Capacity  int                  `json:"capacity"`
@@ -300,8 +807,11 @@ type CapacitySetting struct {
 	Label     string               `json:"label"`
 	ProjectId openapi_types.UUID   `json:"projectId"`
 	Scope     CapacitySettingScope `json:"scope"`
-	UpdatedAt time.Time            `json:"updatedAt"`
-	UserId    *openapi_types.UUID  `json:"userId"`
+
+	// Unit Hours are derived from ticket time estimates.
+	Unit      CapacityUnit        `json:"unit"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+	UserId    *openapi_types.UUID `json:"userId"`
 }
 
 // CapacitySettingInput defines model for CapacitySettingInput.
@@ -309,7 +819,10 @@ type CapacitySettingInput struct {
 	Capacity int                  `json:"capacity"`
 	Label    string               `json:"label"`
 	Scope    CapacitySettingScope `json:"scope"`
-	UserId   *openapi_types.UUID  `json:"userId"`
+
+	// Unit Hours are derived from ticket time estimates.
+	Unit   *CapacityUnit       `json:"unit,omitempty"`
+	UserId *openapi_types.UUID `json:"userId"`
 }
 
 // CapacitySettingScope defines model for CapacitySettingScope.
@@ -325,21 +838,82 @@ type CapacitySettingsResponse struct {
 	Items []CapacitySetting `json:"items"`
 }
 
+// CapacityUnit Hours are derived from ticket time estimates.
+type CapacityUnit string
+
+// CumulativeFlowReport defines model for CumulativeFlowReport.
+type CumulativeFlowReport struct {
+	From   openapi_types.Date    `json:"from"`
+	Points []StateOpenPoint      `json:"points"`
+	States []CumulativeFlowState `json:"states"`
+	To     openapi_types.Date    `json:"to"`
+}
+
+// CumulativeFlowState defines model for CumulativeFlowState.
+type CumulativeFlowState struct {
+	Id       openapi_types.UUID `json:"id"`
+	IsClosed bool               `json:"isClosed"`
+	Name     string             `json:"name"`
+}
+
 // DateValuePoint defines model for DateValuePoint.
 type DateValuePoint struct {
 	Date  openapi_types.Date `json:"date"`
 	Value int                `json:"value"`
 }
 
-// DependencyRelationType defines model for DependencyRelationType.
+// DependencyRelationType `duplicate_of` marks the ticket as a duplicate of the related ticket
+// and closes it; `duplicated_by` is the same relation from the other
+// side.
 type DependencyRelationType string
 
+// DuplicateCandidate defines model for DuplicateCandidate.
+type DuplicateCandidate struct {
+	// Key Ticket key in format PROJECT-###, where
+	Key TicketKey `json:"key"`
+
+	// Score Text similarity from 0 to 1.
+	Score     float32            `json:"score"`
+	StateName string             `json:"stateName"`
+	TicketId  openapi_types.UUID `json:"ticketId"`
+	Title     string             `json:"title"`
+}
+
+// DurationDistribution defines model for DurationDistribution.
+type DurationDistribution struct {
+	AverageHours float64                   `json:"averageHours"`
+	Count        int                       `json:"count"`
+	Histogram    []DurationHistogramBucket `json:"histogram"`
+	P50Hours     float64                   `json:"p50Hours"`
+	P85Hours     float64                   `json:"p85Hours"`
+	P95Hours     float64                   `json:"p95Hours"`
+}
+
+// DurationHistogramBucket defines model for DurationHistogramBucket.
+type DurationHistogramBucket struct {
+	Count int `json:"count"`
+
+	// MaxHours Exclusive upper bound; null for the open-ended last bucket.
+	MaxHours *float64 `json:"maxHours"`
+	MinHours float64  `json:"minHours"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error   string  `json:"error"`
 	Message *string `json:"message,omitempty"`
 }
 
+// ForecastTicketInput defines model for ForecastTicketInput.
+type ForecastTicketInput struct {
+	AssigneeId  *openapi_types.UUID `json:"assigneeId,omitempty"`
+	StoryPoints *int                `json:"storyPoints,omitempty"`
+	TicketId    *openapi_types.UUID `json:"ticketId,omitempty"`
+
+	// TimeEstimate Estimated effort in minutes
+	TimeEstimate *int `json:"timeEstimate,omitempty"`
+}
+
 // Group defines model for Group.
 type Group struct {
 	CreatedAt   time.Time          `json:"createdAt"`
@@ -388,6 +962,123 @@ type HealthResponse struct {
 	Status string `json:"status"`
 }
 
+// IncidentAlertIngestResponse defines model for IncidentAlertIngestResponse.
+type IncidentAlertIngestResponse struct {
+	// Declared Whether the notification declared a new incident.
+	Declared bool `json:"declared"`
+
+	// Recorded Number of new timeline entries. Repeated notifications record nothing.
+	Recorded int `json:"recorded"`
+
+	// TicketId Incident the alerts were recorded on. Absent when nothing was recorded.
+	TicketId *openapi_types.UUID `json:"ticketId,omitempty"`
+}
+
+// IncidentAlertSettings defines model for IncidentAlertSettings.
+type IncidentAlertSettings struct {
+	DefaultSeverity *TicketIncidentSeverity `json:"defaultSeverity,omitempty"`
+	Enabled         bool                    `json:"enabled"`
+
+	// StoryId Story that receives incidents declared from alerts.
+	StoryId *openapi_types.UUID `json:"storyId,omitempty"`
+
+	// Token Secret for the ingestion endpoint. Set once ingestion has been enabled.
+	Token *string `json:"token,omitempty"`
+}
+
+// IncidentAlertSettingsUpdateRequest Omitted fields keep their current value. Enabling ingestion requires a story.
+type IncidentAlertSettingsUpdateRequest struct {
+	DefaultSeverity *TicketIncidentSeverity `json:"defaultSeverity,omitempty"`
+	Enabled         *bool                   `json:"enabled,omitempty"`
+
+	// RotateToken Issue a new token. The old token stops working.
+	RotateToken *bool               `json:"rotateToken,omitempty"`
+	StoryId     *openapi_types.UUID `json:"storyId,omitempty"`
+}
+
+// IncidentMetrics defines model for IncidentMetrics.
+type IncidentMetrics struct {
+	Declared int `json:"declared"`
+
+	// Open Incidents not resolved yet.
+	Open              int                  `json:"open"`
+	TimeToAcknowledge DurationDistribution `json:"timeToAcknowledge"`
+	TimeToResolve     DurationDistribution `json:"timeToResolve"`
+}
+
+// IncidentMetricsReport MTTA (detected to acknowledged) and MTTR (detected to resolved) for
+// incidents detected in the range. Averages and percentiles only cover
+// incidents that reached the status.
+type IncidentMetricsReport struct {
+	// BySeverity Most severe first; incidents without a severity last.
+	BySeverity []IncidentSeverityMetrics `json:"bySeverity"`
+	From       openapi_types.Date        `json:"from"`
+	Overall    IncidentMetrics           `json:"overall"`
+	To         openapi_types.Date        `json:"to"`
+}
+
+// IncidentPostmortem defines model for IncidentPostmortem.
+type IncidentPostmortem struct {
+	ActionItems         []PostmortemActionItem `json:"actionItems"`
+	ContributingFactors string                 `json:"contributingFactors"`
+	CreatedAt           time.Time              `json:"createdAt"`
+	Impact              string                 `json:"impact"`
+	Lessons             string                 `json:"lessons"`
+	RootCause           string                 `json:"rootCause"`
+	Summary             string                 `json:"summary"`
+	TicketId            openapi_types.UUID     `json:"ticketId"`
+	UpdatedAt           time.Time              `json:"updatedAt"`
+	UpdatedBy           *UserSummary           `json:"updatedBy,omitempty"`
+}
+
+// IncidentPostmortemUpdateRequest defines model for IncidentPostmortemUpdateRequest.
+type IncidentPostmortemUpdateRequest struct {
+	ContributingFactors *string `json:"contributingFactors,omitempty"`
+	Impact              *string `json:"impact,omitempty"`
+	Lessons             *string `json:"lessons,omitempty"`
+	RootCause           *string `json:"rootCause,omitempty"`
+	Summary             *string `json:"summary,omitempty"`
+}
+
+// IncidentSeverityChange defines model for IncidentSeverityChange.
+type IncidentSeverityChange struct {
+	Actor     *UserSummary `json:"actor,omitempty"`
+	CreatedAt time.Time    `json:"createdAt"`
+
+	// Escalated Whether the change made the incident more severe.
+	Escalated    bool                    `json:"escalated"`
+	FromSeverity *TicketIncidentSeverity `json:"fromSeverity,omitempty"`
+	Id           openapi_types.UUID      `json:"id"`
+	TicketId     openapi_types.UUID      `json:"ticketId"`
+	ToSeverity   *TicketIncidentSeverity `json:"toSeverity,omitempty"`
+}
+
+// IncidentSeverityChangeListResponse defines model for IncidentSeverityChangeListResponse.
+type IncidentSeverityChangeListResponse struct {
+	Items []IncidentSeverityChange `json:"items"`
+}
+
+// IncidentSeverityMetrics defines model for IncidentSeverityMetrics.
+type IncidentSeverityMetrics struct {
+	Declared          int                     `json:"declared"`
+	Open              int                     `json:"open"`
+	Severity          *TicketIncidentSeverity `json:"severity,omitempty"`
+	TimeToAcknowledge DurationDistribution    `json:"timeToAcknowledge"`
+	TimeToResolve     DurationDistribution    `json:"timeToResolve"`
+}
+
+// IncidentStatus Incident lifecycle status. Statuses only move forward.
+type IncidentStatus string
+
+// IncidentTimelineAnnotationCreateRequest defines model for IncidentTimelineAnnotationCreateRequest.
+type IncidentTimelineAnnotationCreateRequest struct {
+	Body *string `json:"body,omitempty"`
+
+	// OccurredAt When the annotated event happened. Defaults to now.
+	OccurredAt *time.Time `json:"occurredAt,omitempty"`
+	Title      string     `json:"title"`
+}
+
 // IncidentTimelineItem defines model for IncidentTimelineItem.
 type IncidentTimelineItem struct {
 	Body      *string                  `json:"body,omitempty"`
@@ -406,6 +1097,14 @@ type IncidentTimelineResponse struct {
 	Items []IncidentTimelineItem `json:"items"`
 }
 
+// LeadTimeReport defines model for LeadTimeReport.
+type LeadTimeReport struct {
+	CycleTime DurationDistribution `json:"cycleTime"`
+	From      openapi_types.Date   `json:"from"`
+	LeadTime  DurationDistribution `json:"leadTime"`
+	To        openapi_types.Date   `json:"to"`
+}
+
 // Notification defines model for Notification.
 type Notification struct {
 	CreatedAt time.Time          `json:"createdAt"`
@@ -452,6 +1151,126 @@ type NotificationUnreadCountResponse struct {
 	Count int `json:"count"`
 }
 
+// OnCallLayer Rotates through the participants in order, handing off at each
+// occurrence of the rotation in the schedule's timezone.
+type OnCallLayer struct {
+	EndsAt         *time.Time                   `json:"endsAt,omitempty"`
+	Id             openapi_types.UUID           `json:"id"`
+	Name           string                       `json:"name"`
+	ParticipantIds []openapi_types.UUID         `json:"participantIds"`
+	Rotation       string                       `json:"rotation"`
+	RotationKind   TicketRecurrenceScheduleKind `json:"rotationKind"`
+	StartsAt       time.Time                    `json:"startsAt"`
+}
+
+// OnCallLayerRequest defines model for OnCallLayerRequest.
+type OnCallLayerRequest struct {
+	EndsAt         *time.Time           `json:"endsAt,omitempty"`
+	Name           *string              `json:"name,omitempty"`
+	ParticipantIds []openapi_types.UUID `json:"participantIds"`
+
+	// Rotation Cron expression or RRULE whose occurrences are the handoff times.
+	Rotation     string                       `json:"rotation"`
+	RotationKind TicketRecurrenceScheduleKind `json:"rotationKind"`
+
+	// StartsAt Start of the first participant's shift.
+	StartsAt time.Time `json:"startsAt"`
+}
+
+// OnCallOverride defines model for OnCallOverride.
+type OnCallOverride struct {
+	CreatedAt  time.Time          `json:"createdAt"`
+	EndsAt     time.Time          `json:"endsAt"`
+	Id         openapi_types.UUID `json:"id"`
+	ScheduleId openapi_types.UUID `json:"scheduleId"`
+	StartsAt   time.Time          `json:"startsAt"`
+	User       UserSummary        `json:"user"`
+}
+
+// OnCallOverrideCreateRequest defines model for OnCallOverrideCreateRequest.
+type OnCallOverrideCreateRequest struct {
+	EndsAt   time.Time          `json:"endsAt"`
+	StartsAt time.Time          `json:"startsAt"`
+	UserId   openapi_types.UUID `json:"userId"`
+}
+
+// OnCallSchedule Where several layers are active, the last one is on call.
+type OnCallSchedule struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	GroupId   *openapi_types.UUID `json:"groupId,omitempty"`
+	Id        openapi_types.UUID  `json:"id"`
+	Layers    []OnCallLayer       `json:"layers"`
+	Name      string              `json:"name"`
+
+	// Overrides Overrides that have not ended yet.
+	Overrides []OnCallOverride    `json:"overrides"`
+	ProjectId *openapi_types.UUID `json:"projectId,omitempty"`
+	Timezone  string              `json:"timezone"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+// OnCallScheduleListResponse defines model for OnCallScheduleListResponse.
+type OnCallScheduleListResponse struct {
+	Items []OnCallSchedule `json:"items"`
+}
+
+// OnCallScheduleRequest defines model for OnCallScheduleRequest.
+type OnCallScheduleRequest struct {
+	Layers []OnCallLayerRequest `json:"layers"`
+	Name   string               `json:"name"`
+
+	// Timezone IANA timezone the rotations are evaluated in. Defaults to UTC.
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// OnCallShift defines model for OnCallShift.
+type OnCallShift struct {
+	// EndsAt Next handoff. Omitted when there is none.
+	EndsAt       *time.Time          `json:"endsAt,omitempty"`
+	LayerId      *openapi_types.UUID `json:"layerId,omitempty"`
+	LayerName    *string             `json:"layerName,omitempty"`
+	OverrideId   *openapi_types.UUID `json:"overrideId,omitempty"`
+	ScheduleId   openapi_types.UUID  `json:"scheduleId"`
+	ScheduleName string              `json:"scheduleName"`
+	StartsAt     time.Time           `json:"startsAt"`
+	User         UserSummary         `json:"user"`
+}
+
+// OnCallShiftListResponse defines model for OnCallShiftListResponse.
+type OnCallShiftListResponse struct {
+	Items []OnCallShift `json:"items"`
+}
+
+// PostmortemActionItem defines model for PostmortemActionItem.
+type PostmortemActionItem struct {
+	Assignee         *UserSummary       `json:"assignee,omitempty"`
+	CreatedAt        time.Time          `json:"createdAt"`
+	IncidentKey      string             `json:"incidentKey"`
+	IncidentTicketId openapi_types.UUID `json:"incidentTicketId"`
+	IncidentTitle    string             `json:"incidentTitle"`
+	IsClosed         bool               `json:"isClosed"`
+	Key              string             `json:"key"`
+	Priority         TicketPriority     `json:"priority"`
+	ProjectId        openapi_types.UUID `json:"projectId"`
+	ProjectKey       string             `json:"projectKey"`
+	StateName        string             `json:"stateName"`
+	TicketId         openapi_types.UUID `json:"ticketId"`
+	Title            string             `json:"title"`
+}
+
+// PostmortemActionItemCreateRequest defines model for PostmortemActionItemCreateRequest.
+type PostmortemActionItemCreateRequest struct {
+	AssigneeId  *openapi_types.UUID `json:"assigneeId,omitempty"`
+	Description *string             `json:"description,omitempty"`
+	Priority    *TicketPriority     `json:"priority,omitempty"`
+	Title       string              `json:"title"`
+}
+
+// PostmortemActionItemListResponse defines model for PostmortemActionItemListResponse.
+type PostmortemActionItemListResponse struct {
+	Items []PostmortemActionItem `json:"items"`
+}
+
 // Project defines model for Project.
 type Project struct {
 	CreatedAt                 time.Time          `json:"createdAt"`
@@ -544,6 +1363,14 @@ type ProjectReportingSummary struct {
 	To                    openapi_types.Date `json:"to"`
 }
 
+// ProjectReportingTicketExportJson defines model for ProjectReportingTicketExportJson.
+type ProjectReportingTicketExportJson struct {
+	From        openapi_types.Date      `json:"from"`
+	GeneratedAt time.Time               `json:"generatedAt"`
+	Tickets     []ReportingTicketDetail `json:"tickets"`
+	To          openapi_types.Date      `json:"to"`
+}
+
 // ProjectRole defines model for ProjectRole.
 type ProjectRole string
 
@@ -565,9 +1392,26 @@ type ProjectUpdateRequest struct {
 	Name                      *string `json:"name,omitempty"`
 }
 
+// ReportingTicketDetail defines model for ReportingTicketDetail.
+type ReportingTicketDetail struct {
+	AssigneeName        *string        `json:"assigneeName"`
+	ClosedAt            *time.Time     `json:"closedAt"`
+	CreatedAt           time.Time      `json:"createdAt"`
+	CycleTimeHours      *float64       `json:"cycleTimeHours"`
+	Key                 string         `json:"key"`
+	LoggedMinutes       int            `json:"loggedMinutes"`
+	Priority            TicketPriority `json:"priority"`
+	State               string         `json:"state"`
+	StoryPoints         *int           `json:"storyPoints"`
+	TimeEstimateMinutes *int           `json:"timeEstimateMinutes"`
+	Title               string         `json:"title"`
+	Type                TicketType     `json:"type"`
+}
+
 // Sprint defines model for Sprint.
 type Sprint struct {
 	CommittedTickets int                  `json:"committedTickets"`
+	CompletedAt      *time.Time           `json:"completedAt"`
 	CreatedAt        time.Time            `json:"createdAt"`
 	EndDate          openapi_types.Date   `json:"endDate"`
 	Goal             *string              `json:"goal,omitempty"`
@@ -575,10 +1419,102 @@ type Sprint struct {
 	Name             string               `json:"name"`
 	ProjectId        openapi_types.UUID   `json:"projectId"`
 	StartDate        openapi_types.Date   `json:"startDate"`
+	StartedAt        *time.Time           `json:"startedAt"`
+	Status           SprintStatus         `json:"status"`
 	TicketIds        []openapi_types.UUID `json:"ticketIds"`
 	UpdatedAt        time.Time            `json:"updatedAt"`
 }
 
+// SprintAbsence defines model for SprintAbsence.
+type SprintAbsence struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Days      int                `json:"days"`
+	Id        openapi_types.UUID `json:"id"`
+	Note      *string            `json:"note"`
+	SprintId  openapi_types.UUID `json:"sprintId"`
+	UserId    openapi_types.UUID `json:"userId"`
+	UserName  string             `json:"userName"`
+}
+
+// SprintAbsenceInput defines model for SprintAbsenceInput.
+type SprintAbsenceInput struct {
+	// Days Workdays absent; at most the sprint's Monday-to-Friday days.
+	Days   int                `json:"days"`
+	Note   *string            `json:"note,omitempty"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// SprintAbsencesReplaceRequest defines model for SprintAbsencesReplaceRequest.
+type SprintAbsencesReplaceRequest struct {
+	Items []SprintAbsenceInput `json:"items"`
+}
+
+// SprintAbsencesResponse defines model for SprintAbsencesResponse.
+type SprintAbsencesResponse struct {
+	Items []SprintAbsence `json:"items"`
+}
+
+// SprintBurndown defines model for SprintBurndown.
+type SprintBurndown struct {
+	EndDate             openapi_types.Date    `json:"endDate"`
+	InitialScopePoints  int                   `json:"initialScopePoints"`
+	InitialScopeTickets int                   `json:"initialScopeTickets"`
+	Points              []SprintBurndownPoint `json:"points"`
+	ScopeChanges        []SprintScopeChange   `json:"scopeChanges"`
+	SprintId            openapi_types.UUID    `json:"sprintId"`
+	StartDate           openapi_types.Date    `json:"startDate"`
+}
+
+// SprintBurndownPoint End-of-day sprint state. Actual values are null for days that have not happened yet.
+type SprintBurndownPoint struct {
+	Date             openapi_types.Date `json:"date"`
+	DonePoints       *int               `json:"donePoints"`
+	DoneTickets      *int               `json:"doneTickets"`
+	IdealPoints      float64            `json:"idealPoints"`
+	IdealTickets     float64            `json:"idealTickets"`
+	RemainingPoints  *int               `json:"remainingPoints"`
+	RemainingTickets *int               `json:"remainingTickets"`
+	ScopePoints      *int               `json:"scopePoints"`
+	ScopeTickets     *int               `json:"scopeTickets"`
+}
+
+// SprintCarryOver defines model for SprintCarryOver.
+type SprintCarryOver string
+
+// SprintCompleteRequest defines model for SprintCompleteRequest.
+type SprintCompleteRequest struct {
+	CarryOver SprintCarryOver `json:"carryOver"`
+
+	// TargetSprintId Planned sprint to receive unfinished tickets. Defaults to the earliest planned sprint.
+	TargetSprintId *openapi_types.UUID `json:"targetSprintId,omitempty"`
+}
+
+// SprintCompletion defines model for SprintCompletion.
+type SprintCompletion struct {
+	CarriedOverTickets int                      `json:"carriedOverTickets"`
+	CarryOver          SprintCarryOver          `json:"carryOver"`
+	CarryOverSprintId  *openapi_types.UUID      `json:"carryOverSprintId"`
+	CommittedPoints    int                      `json:"committedPoints"`
+	CommittedTickets   int                      `json:"committedTickets"`
+	CompletedBy        *openapi_types.UUID      `json:"completedBy"`
+	CompletedPoints    int                      `json:"completedPoints"`
+	CompletedTickets   int                      `json:"completedTickets"`
+	CreatedAt          time.Time                `json:"createdAt"`
+	ProjectId          openapi_types.UUID       `json:"projectId"`
+	SprintId           openapi_types.UUID       `json:"sprintId"`
+	Tickets            []SprintCompletionTicket `json:"tickets"`
+}
+
+// SprintCompletionTicket defines model for SprintCompletionTicket.
+type SprintCompletionTicket struct {
+	Done        bool               `json:"done"`
+	Key         string             `json:"key"`
+	StateName   string             `json:"stateName"`
+	StoryPoints *int               `json:"storyPoints"`
+	TicketId    openapi_types.UUID `json:"ticketId"`
+	Title       string             `json:"title"`
+}
+
 // SprintCreateRequest defines model for SprintCreateRequest.
 type SprintCreateRequest struct {
 	EndDate   openapi_types.Date    `json:"endDate"`
@@ -588,15 +1524,41 @@ type SprintCreateRequest struct {
 	TicketIds *[]openapi_types.UUID `json:"ticketIds,omitempty"`
 }
 
-// SprintForecastSummary defines model for SprintForecastSummary.
+// SprintForecastSummary Committed, capacity, projectedCompletion and overCapacityDelta are expressed in unit.
 type SprintForecastSummary struct {
-	Capacity            int     `json:"capacity"`
-	CommittedTickets    int     `json:"committedTickets"`
-	Confidence          float32 `json:"confidence"`
-	Iterations          int     `json:"iterations"`
-	OverCapacityDelta   int     `json:"overCapacityDelta"`
-	ProjectedCompletion int     `json:"projectedCompletion"`
-	Sprint              *Sprint `json:"sprint,omitempty"`
+	Allocations            []CapacityAllocation `json:"allocations"`
+	AverageVelocityPoints  float32              `json:"averageVelocityPoints"`
+	AverageVelocityTickets float32              `json:"averageVelocityTickets"`
+	Capacity               int                  `json:"capacity"`
+	Committed              int                  `json:"committed"`
+	CommittedPoints        int                  `json:"committedPoints"`
+	CommittedTickets       int                  `json:"committedTickets"`
+	Confidence             float32              `json:"confidence"`
+	Iterations             int                  `json:"iterations"`
+	OverCapacityDelta      int                  `json:"overCapacityDelta"`
+	PointsConfidence       float32              `json:"pointsConfidence"`
+	ProjectedCompletion    int                  `json:"projectedCompletion"`
+
+	// ProjectedPoints Median story points completed across velocity samples scaled to the sprint length.
+	ProjectedPoints int     `json:"projectedPoints"`
+	Sprint          *Sprint `json:"sprint,omitempty"`
+
+	// Unit Hours are derived from ticket time estimates.
+	Unit CapacityUnit `json:"unit"`
+
+	// VelocitySprints Number of completed sprints the story-point forecast is based on.
+	VelocitySprints int      `json:"velocitySprints"`
+	Warnings        []string `json:"warnings"`
+}
+
+// SprintForecastWhatIfRequest defines model for SprintForecastWhatIfRequest.
+type SprintForecastWhatIfRequest struct {
+	Iterations *int                  `json:"iterations,omitempty"`
+	SprintId   *openapi_types.UUID   `json:"sprintId,omitempty"`
+	Tickets    []ForecastTicketInput `json:"tickets"`
+
+	// Unit Hours are derived from ticket time estimates.
+	Unit *CapacityUnit `json:"unit,omitempty"`
 }
 
 // SprintListResponse defines model for SprintListResponse.
@@ -604,11 +1566,56 @@ type SprintListResponse struct {
 	Items []Sprint `json:"items"`
 }
 
+// SprintScopeChange defines model for SprintScopeChange.
+type SprintScopeChange struct {
+	Action      SprintScopeChangeAction `json:"action"`
+	At          time.Time               `json:"at"`
+	Date        openapi_types.Date      `json:"date"`
+	Key         string                  `json:"key"`
+	StoryPoints *int                    `json:"storyPoints"`
+	TicketId    openapi_types.UUID      `json:"ticketId"`
+}
+
+// SprintScopeChangeAction defines model for SprintScopeChangeAction.
+type SprintScopeChangeAction string
+
+// SprintStatus defines model for SprintStatus.
+type SprintStatus string
+
 // SprintTicketsRequest defines model for SprintTicketsRequest.
 type SprintTicketsRequest struct {
 	TicketIds []openapi_types.UUID `json:"ticketIds"`
 }
 
+// SprintUpdateRequest defines model for SprintUpdateRequest.
+type SprintUpdateRequest struct {
+	ClearGoal *bool               `json:"clearGoal,omitempty"`
+	EndDate   *openapi_types.Date `json:"endDate,omitempty"`
+	Goal      *string             `json:"goal,omitempty"`
+	Name      *string             `json:"name,omitempty"`
+	StartDate *openapi_types.Date `json:"startDate,omitempty"`
+}
+
+// SprintVelocity defines model for SprintVelocity.
+type SprintVelocity struct {
+	CommittedPoints  int                `json:"committedPoints"`
+	CommittedTickets int                `json:"committedTickets"`
+	CompletedAt      time.Time          `json:"completedAt"`
+	CompletedPoints  int                `json:"completedPoints"`
+	CompletedTickets int                `json:"completedTickets"`
+	EndDate          openapi_types.Date `json:"endDate"`
+	Name             string             `json:"name"`
+	SprintId         openapi_types.UUID `json:"sprintId"`
+	StartDate        openapi_types.Date `json:"startDate"`
+}
+
+// SprintVelocityHistory defines model for SprintVelocityHistory.
+type SprintVelocityHistory struct {
+	AveragePoints  float64          `json:"averagePoints"`
+	AverageTickets float64          `json:"averageTickets"`
+	Items          []SprintVelocity `json:"items"`
+}
+
 // StatCount defines model for StatCount.
 type StatCount struct {
 	Label string `json:"label"`
@@ -621,6 +1628,65 @@ type StateOpenPoint struct {
 	Date   openapi_types.Date `json:"date"`
 }
 
+// StatusFeed defines model for StatusFeed.
+type StatusFeed struct {
+	Incidents []StatusFeedIncident `json:"incidents"`
+
+	// Status major_outage while a sev1 incident is unresolved, degraded while any
+	// other incident is, operational otherwise.
+	Status    StatusPageStatus `json:"status"`
+	Title     string           `json:"title"`
+	UpdatedAt time.Time        `json:"updatedAt"`
+}
+
+// StatusFeedIncident defines model for StatusFeedIncident.
+type StatusFeedIncident struct {
+	Id          openapi_types.UUID      `json:"id"`
+	Impact      *string                 `json:"impact,omitempty"`
+	MitigatedAt *time.Time              `json:"mitigatedAt,omitempty"`
+	ResolvedAt  *time.Time              `json:"resolvedAt,omitempty"`
+	Severity    *TicketIncidentSeverity `json:"severity,omitempty"`
+	StartedAt   time.Time               `json:"startedAt"`
+
+	// Status Incident lifecycle status. Statuses only move forward.
+	Status    *IncidentStatus `json:"status,omitempty"`
+	Title     string          `json:"title"`
+	UpdatedAt time.Time       `json:"updatedAt"`
+
+	// Updates Public comments, newest first.
+	Updates []StatusFeedUpdate `json:"updates"`
+}
+
+// StatusFeedUpdate defines model for StatusFeedUpdate.
+type StatusFeedUpdate struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Message   string             `json:"message"`
+}
+
+// StatusPageSettings defines model for StatusPageSettings.
+type StatusPageSettings struct {
+	Enabled bool `json:"enabled"`
+
+	// Slug Public address of the feed, /status/{slug}. Set once the page has been enabled.
+	Slug *string `json:"slug,omitempty"`
+
+	// Title Defaults to the project name.
+	Title string `json:"title"`
+}
+
+// StatusPageSettingsUpdateRequest Omitted fields keep their current value. Enabling the page without a
+// slug uses the lowercased project key.
+type StatusPageSettingsUpdateRequest struct {
+	Enabled *bool   `json:"enabled,omitempty"`
+	Slug    *string `json:"slug,omitempty"`
+	Title   *string `json:"title,omitempty"`
+}
+
+// StatusPageStatus major_outage while a sev1 incident is unresolved, degraded while any
+// other incident is, operational otherwise.
+type StatusPageStatus string
+
 // Story defines model for Story.
 type Story struct {
 	CreatedAt   time.Time          `json:"createdAt"`
@@ -644,6 +1710,20 @@ type StoryListResponse struct {
 	Items []Story `json:"items"`
 }
 
+// StoryTimeVariance defines model for StoryTimeVariance.
+type StoryTimeVariance struct {
+	EstimateMinutes    *int               `json:"estimateMinutes"`
+	LoggedMinutes      int                `json:"loggedMinutes"`
+	StoryId            openapi_types.UUID `json:"storyId"`
+	TicketCount        int                `json:"ticketCount"`
+	Title              string             `json:"title"`
+	UnestimatedTickets int                `json:"unestimatedTickets"`
+
+	// VarianceMinutes Logged minus estimate; positive values are overruns.
+	VarianceMinutes *int     `json:"varianceMinutes"`
+	VariancePercent *float64 `json:"variancePercent"`
+}
+
 // StoryUpdateRequest defines model for StoryUpdateRequest.
 type StoryUpdateRequest struct {
 	Description *string `json:"description,omitempty"`
@@ -662,18 +1742,34 @@ type SyncUsersResponse struct {
 
 // Ticket defines model for Ticket.
 type Ticket struct {
-	Assignee            *UserSummary            `json:"assignee,omitempty"`
-	AssigneeId          *openapi_types.UUID     `json:"assigneeId"`
-	BlockedByCount      int                     `json:"blockedByCount"`
-	CreatedAt           time.Time               `json:"createdAt"`
-	Description         *string                 `json:"description,omitempty"`
-	Id                  openapi_types.UUID      `json:"id"`
-	IncidentCommander   *UserSummary            `json:"incidentCommander,omitempty"`
-	IncidentCommanderId *openapi_types.UUID     `json:"incidentCommanderId"`
-	IncidentEnabled     bool                    `json:"incidentEnabled"`
-	IncidentImpact      *string                 `json:"incidentImpact"`
-	IncidentSeverity    *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
-	IsBlocked           bool                    `json:"isBlocked"`
+	Assignee       *UserSummary        `json:"assignee,omitempty"`
+	AssigneeId     *openapi_types.UUID `json:"assigneeId"`
+	BlockedByCount int                 `json:"blockedByCount"`
+	CreatedAt      time.Time           `json:"createdAt"`
+	Description    *string             `json:"description,omitempty"`
+
+	// DuplicateCandidates Open tickets that look like the same report. Only included in the
+	// response to creating a ticket.
+	DuplicateCandidates     *[]DuplicateCandidate   `json:"duplicateCandidates,omitempty"`
+	Id                      openapi_types.UUID      `json:"id"`
+	IncidentAcknowledgedAt  *time.Time              `json:"incidentAcknowledgedAt"`
+	IncidentCommander       *UserSummary            `json:"incidentCommander,omitempty"`
+	IncidentCommanderId     *openapi_types.UUID     `json:"incidentCommanderId"`
+	IncidentCommsLead       *UserSummary            `json:"incidentCommsLead,omitempty"`
+	IncidentCommsLeadId     *openapi_types.UUID     `json:"incidentCommsLeadId"`
+	IncidentCustomerVisible bool                    `json:"incidentCustomerVisible"`
+	IncidentDetectedAt      *time.Time              `json:"incidentDetectedAt"`
+	IncidentEnabled         bool                    `json:"incidentEnabled"`
+	IncidentImpact          *string                 `json:"incidentImpact"`
+	IncidentMitigatedAt     *time.Time              `json:"incidentMitigatedAt"`
+	IncidentResolvedAt      *time.Time              `json:"incidentResolvedAt"`
+	IncidentScribe          *UserSummary            `json:"incidentScribe,omitempty"`
+	IncidentScribeId        *openapi_types.UUID     `json:"incidentScribeId"`
+	IncidentSeverity        *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
+
+	// IncidentStatus Incident lifecycle status. Statuses only move forward.
+	IncidentStatus *IncidentStatus `json:"incidentStatus,omitempty"`
+	IsBlocked      bool            `json:"isBlocked"`
 
 	// Key Ticket key in format PROJECT-###, where
 	Key       TicketKey          `json:"key"`
@@ -719,19 +1815,35 @@ type TicketActivityListResponse struct {
 	Items []TicketActivity `json:"items"`
 }
 
+// TicketChangesResponse defines model for TicketChangesResponse.
+type TicketChangesResponse struct {
+	// Deleted Tickets deleted in the sync window. Deletions are paged after the updated tickets with the same cursor.
+	Deleted    []TicketDeletion `json:"deleted"`
+	Items      []Ticket         `json:"items"`
+	NextCursor *string          `json:"nextCursor,omitempty"`
+
+	// SyncedAt Upper bound of the sync window; use as the next `since`.
+	SyncedAt time.Time `json:"syncedAt"`
+}
+
 // TicketComment defines model for TicketComment.
 type TicketComment struct {
-	AuthorId   openapi_types.UUID `json:"authorId"`
-	AuthorName string             `json:"authorName"`
-	CreatedAt  time.Time          `json:"createdAt"`
-	Id         openapi_types.UUID `json:"id"`
-	Message    string             `json:"message"`
-	TicketId   openapi_types.UUID `json:"ticketId"`
+	// AuthorId Null for comments written by an automation rule without an owner.
+	AuthorId   *openapi_types.UUID `json:"authorId"`
+	AuthorName string              `json:"authorName"`
+	CreatedAt  time.Time           `json:"createdAt"`
+	Id         openapi_types.UUID  `json:"id"`
+	Message    string              `json:"message"`
+	Public     bool                `json:"public"`
+	TicketId   openapi_types.UUID  `json:"ticketId"`
 }
 
 // TicketCommentCreateRequest defines model for TicketCommentCreateRequest.
 type TicketCommentCreateRequest struct {
 	Message string `json:"message"`
+
+	// Public Publish the comment as an update on the status page.
+	Public *bool `json:"public,omitempty"`
 }
 
 // TicketCommentListResponse defines model for TicketCommentListResponse.
@@ -739,43 +1851,79 @@ type TicketCommentListResponse struct {
 	Items []TicketComment `json:"items"`
 }
 
+// TicketCommentUpdateRequest defines model for TicketCommentUpdateRequest.
+type TicketCommentUpdateRequest struct {
+	// Public Publish the comment as an update on the status page.
+	Public bool `json:"public"`
+}
+
 // TicketCreateRequest defines model for TicketCreateRequest.
 type TicketCreateRequest struct {
-	AssigneeId          *openapi_types.UUID     `json:"assigneeId"`
-	Description         *string                 `json:"description,omitempty"`
-	IncidentCommanderId *openapi_types.UUID     `json:"incidentCommanderId"`
-	IncidentEnabled     *bool                   `json:"incidentEnabled,omitempty"`
-	IncidentImpact      *string                 `json:"incidentImpact"`
-	IncidentSeverity    *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
-	Priority            *TicketPriority         `json:"priority,omitempty"`
-	StateId             *openapi_types.UUID     `json:"stateId,omitempty"`
-	StoryId             openapi_types.UUID      `json:"storyId"`
-	StoryPoints         *int                    `json:"storyPoints"`
-	TimeEstimate        *int                    `json:"timeEstimate"`
-	Title               string                  `json:"title"`
-	Type                *TicketType             `json:"type,omitempty"`
+	AssigneeId          *openapi_types.UUID `json:"assigneeId"`
+	Description         *string             `json:"description,omitempty"`
+	IncidentCommanderId *openapi_types.UUID `json:"incidentCommanderId"`
+	IncidentCommsLeadId *openapi_types.UUID `json:"incidentCommsLeadId"`
+
+	// IncidentCustomerVisible Show the incident on the project's status page.
+	IncidentCustomerVisible *bool                   `json:"incidentCustomerVisible,omitempty"`
+	IncidentEnabled         *bool                   `json:"incidentEnabled,omitempty"`
+	IncidentImpact          *string                 `json:"incidentImpact"`
+	IncidentScribeId        *openapi_types.UUID     `json:"incidentScribeId"`
+	IncidentSeverity        *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
+	Priority                *TicketPriority         `json:"priority,omitempty"`
+	StateId                 *openapi_types.UUID     `json:"stateId,omitempty"`
+	StoryId                 openapi_types.UUID      `json:"storyId"`
+	StoryPoints             *int                    `json:"storyPoints"`
+
+	// TemplateId Template to pre-fill the ticket from. Fields set on the request take
+	// precedence. The template title pattern is applied to `title`, and
+	// its subtasks are created as tickets that block the new ticket. The
+	// ticket and its subtasks are created together or not at all.
+	TemplateId   *openapi_types.UUID `json:"templateId,omitempty"`
+	TimeEstimate *int                `json:"timeEstimate"`
+	Title        string              `json:"title"`
+	Type         *TicketType         `json:"type,omitempty"`
+}
+
+// TicketDeletion defines model for TicketDeletion.
+type TicketDeletion struct {
+	DeletedAt time.Time          `json:"deletedAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Key       string             `json:"key"`
 }
 
 // TicketDependency defines model for TicketDependency.
 type TicketDependency struct {
-	CreatedAt       time.Time              `json:"createdAt"`
-	Id              openapi_types.UUID     `json:"id"`
-	ProjectId       openapi_types.UUID     `json:"projectId"`
-	RelatedTicket   *Ticket                `json:"relatedTicket,omitempty"`
-	RelatedTicketId openapi_types.UUID     `json:"relatedTicketId"`
-	RelationType    DependencyRelationType `json:"relationType"`
-	TicketId        openapi_types.UUID     `json:"ticketId"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	Id              openapi_types.UUID `json:"id"`
+	ProjectId       openapi_types.UUID `json:"projectId"`
+	RelatedTicket   *Ticket            `json:"relatedTicket,omitempty"`
+	RelatedTicketId openapi_types.UUID `json:"relatedTicketId"`
+
+	// RelationType `duplicate_of` marks the ticket as a duplicate of the related ticket
+	// and closes it; `duplicated_by` is the same relation from the other
+	// side.
+	RelationType DependencyRelationType `json:"relationType"`
+	TicketId     openapi_types.UUID     `json:"ticketId"`
 }
 
 // TicketDependencyCreateRequest defines model for TicketDependencyCreateRequest.
 type TicketDependencyCreateRequest struct {
-	RelatedTicketId openapi_types.UUID     `json:"relatedTicketId"`
-	RelationType    DependencyRelationType `json:"relationType"`
+	RelatedTicketId openapi_types.UUID `json:"relatedTicketId"`
+
+	// RelationType `duplicate_of` marks the ticket as a duplicate of the related ticket
+	// and closes it; `duplicated_by` is the same relation from the other
+	// side.
+	RelationType DependencyRelationType `json:"relationType"`
 }
 
 // TicketDependencyGraphEdge defines model for TicketDependencyGraphEdge.
 type TicketDependencyGraphEdge struct {
-	Id             openapi_types.UUID     `json:"id"`
+	Id openapi_types.UUID `json:"id"`
+
+	// RelationType `duplicate_of` marks the ticket as a duplicate of the related ticket
+	// and closes it; `duplicated_by` is the same relation from the other
+	// side.
 	RelationType   DependencyRelationType `json:"relationType"`
 	SourceTicketId openapi_types.UUID     `json:"sourceTicketId"`
 	TargetTicketId openapi_types.UUID     `json:"targetTicketId"`
@@ -807,31 +1955,201 @@ type TicketKey = string
 // TicketListResponse defines model for TicketListResponse.
 type TicketListResponse struct {
 	Items []Ticket `json:"items"`
-	Total int      `json:"total"`
+
+	// NextCursor Cursor for the next page; absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
+
+	// Total Total matching tickets; absent in cursor mode.
+	Total *int `json:"total,omitempty"`
 }
 
 // TicketPriority defines model for TicketPriority.
 type TicketPriority string
 
+// TicketRecurrence defines model for TicketRecurrence.
+type TicketRecurrence struct {
+	AddToActiveSprint bool `json:"addToActiveSprint"`
+
+	// AssigneeGroupId Assign created tickets round-robin across this group's members.
+	AssigneeGroupId *openapi_types.UUID `json:"assigneeGroupId"`
+	CreatedAt       time.Time           `json:"createdAt"`
+	Enabled         bool                `json:"enabled"`
+	Id              openapi_types.UUID  `json:"id"`
+	LastAssigneeId  *openapi_types.UUID `json:"lastAssigneeId"`
+	LastError       *string             `json:"lastError"`
+	LastRunAt       *time.Time          `json:"lastRunAt"`
+	LastTicketId    *openapi_types.UUID `json:"lastTicketId"`
+	Name            string              `json:"name"`
+	NextRunAt       *time.Time          `json:"nextRunAt"`
+	ProjectId       openapi_types.UUID  `json:"projectId"`
+
+	// Schedule A five-field cron expression (for example `0 9 * * MON`) or an
+	// RRULE (for example `FREQ=MONTHLY;BYDAY=1MO;BYHOUR=9`).
+	Schedule     string                       `json:"schedule"`
+	ScheduleKind TicketRecurrenceScheduleKind `json:"scheduleKind"`
+
+	// StartsAt No tickets are created before this instant. Anchors RRULE intervals.
+	StartsAt   time.Time          `json:"startsAt"`
+	StoryId    openapi_types.UUID `json:"storyId"`
+	TemplateId openapi_types.UUID `json:"templateId"`
+
+	// Timezone IANA time zone the schedule is evaluated in.
+	Timezone string `json:"timezone"`
+
+	// Title Substituted for `{title}` in the template's title pattern.
+	Title     string    `json:"title"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// TicketRecurrenceCreateRequest defines model for TicketRecurrenceCreateRequest.
+type TicketRecurrenceCreateRequest struct {
+	AddToActiveSprint *bool                        `json:"addToActiveSprint,omitempty"`
+	AssigneeGroupId   *openapi_types.UUID          `json:"assigneeGroupId"`
+	Enabled           *bool                        `json:"enabled,omitempty"`
+	Name              string                       `json:"name"`
+	Schedule          string                       `json:"schedule"`
+	ScheduleKind      TicketRecurrenceScheduleKind `json:"scheduleKind"`
+	StartsAt          *time.Time                   `json:"startsAt,omitempty"`
+	StoryId           openapi_types.UUID           `json:"storyId"`
+	TemplateId        openapi_types.UUID           `json:"templateId"`
+	Timezone          *string                      `json:"timezone,omitempty"`
+	Title             string                       `json:"title"`
+}
+
+// TicketRecurrenceListResponse defines model for TicketRecurrenceListResponse.
+type TicketRecurrenceListResponse struct {
+	Items []TicketRecurrence `json:"items"`
+}
+
+// TicketRecurrenceScheduleKind defines model for TicketRecurrenceScheduleKind.
+type TicketRecurrenceScheduleKind string
+
+// TicketRecurrenceUpdateRequest defines model for TicketRecurrenceUpdateRequest.
+type TicketRecurrenceUpdateRequest struct {
+	AddToActiveSprint *bool               `json:"addToActiveSprint,omitempty"`
+	AssigneeGroupId   *openapi_types.UUID `json:"assigneeGroupId,omitempty"`
+
+	// ClearAssigneeGroup Stop assigning created tickets.
+	ClearAssigneeGroup *bool                         `json:"clearAssigneeGroup,omitempty"`
+	Enabled            *bool                         `json:"enabled,omitempty"`
+	Name               *string                       `json:"name,omitempty"`
+	Schedule           *string                       `json:"schedule,omitempty"`
+	ScheduleKind       *TicketRecurrenceScheduleKind `json:"scheduleKind,omitempty"`
+	StartsAt           *time.Time                    `json:"startsAt,omitempty"`
+	StoryId            *openapi_types.UUID           `json:"storyId,omitempty"`
+	TemplateId         *openapi_types.UUID           `json:"templateId,omitempty"`
+	Timezone           *string                       `json:"timezone,omitempty"`
+	Title              *string                       `json:"title,omitempty"`
+}
+
+// TicketTemplate defines model for TicketTemplate.
+type TicketTemplate struct {
+	CreatedAt        time.Time               `json:"createdAt"`
+	Description      string                  `json:"description"`
+	Id               openapi_types.UUID      `json:"id"`
+	IncidentEnabled  bool                    `json:"incidentEnabled"`
+	IncidentSeverity *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
+
+	// Keywords Words AI triage matches against to suggest this template.
+	Keywords     []string                `json:"keywords"`
+	Name         string                  `json:"name"`
+	Priority     *TicketPriority         `json:"priority,omitempty"`
+	ProjectId    openapi_types.UUID      `json:"projectId"`
+	StoryPoints  *int                    `json:"storyPoints"`
+	Subtasks     []TicketTemplateSubtask `json:"subtasks"`
+	TimeEstimate *int                    `json:"timeEstimate"`
+
+	// TitlePattern Supports `{title}`, `{date}` and `{project}` placeholders.
+	TitlePattern string      `json:"titlePattern"`
+	Type         *TicketType `json:"type,omitempty"`
+	UpdatedAt    time.Time   `json:"updatedAt"`
+}
+
+// TicketTemplateCreateRequest defines model for TicketTemplateCreateRequest.
+type TicketTemplateCreateRequest struct {
+	Description      *string                  `json:"description,omitempty"`
+	IncidentEnabled  *bool                    `json:"incidentEnabled,omitempty"`
+	IncidentSeverity *TicketIncidentSeverity  `json:"incidentSeverity,omitempty"`
+	Keywords         *[]string                `json:"keywords,omitempty"`
+	Name             string                   `json:"name"`
+	Priority         *TicketPriority          `json:"priority,omitempty"`
+	StoryPoints      *int                     `json:"storyPoints"`
+	Subtasks         *[]TicketTemplateSubtask `json:"subtasks,omitempty"`
+	TimeEstimate     *int                     `json:"timeEstimate"`
+	TitlePattern     *string                  `json:"titlePattern,omitempty"`
+	Type             *TicketType              `json:"type,omitempty"`
+}
+
+// TicketTemplateListResponse defines model for TicketTemplateListResponse.
+type TicketTemplateListResponse struct {
+	Items []TicketTemplate `json:"items"`
+}
+
+// TicketTemplateSubtask defines model for TicketTemplateSubtask.
+type TicketTemplateSubtask struct {
+	Description *string         `json:"description,omitempty"`
+	Priority    *TicketPriority `json:"priority,omitempty"`
+	StoryPoints *int            `json:"storyPoints,omitempty"`
+	Title       string          `json:"title"`
+	Type        *TicketType     `json:"type,omitempty"`
+}
+
+// TicketTemplateUpdateRequest defines model for TicketTemplateUpdateRequest.
+type TicketTemplateUpdateRequest struct {
+	Description      *string                  `json:"description,omitempty"`
+	IncidentEnabled  *bool                    `json:"incidentEnabled,omitempty"`
+	IncidentSeverity *TicketIncidentSeverity  `json:"incidentSeverity,omitempty"`
+	Keywords         *[]string                `json:"keywords,omitempty"`
+	Name             *string                  `json:"name,omitempty"`
+	Priority         *TicketPriority          `json:"priority,omitempty"`
+	StoryPoints      *int                     `json:"storyPoints,omitempty"`
+	Subtasks         *[]TicketTemplateSubtask `json:"subtasks,omitempty"`
+	TimeEstimate     *int                     `json:"timeEstimate,omitempty"`
+	TitlePattern     *string                  `json:"titlePattern,omitempty"`
+	Type             *TicketType              `json:"type,omitempty"`
+}
+
+// TicketTimeVariance defines model for TicketTimeVariance.
+type TicketTimeVariance struct {
+	EstimateMinutes *int               `json:"estimateMinutes"`
+	Key             string             `json:"key"`
+	LoggedMinutes   int                `json:"loggedMinutes"`
+	StoryId         openapi_types.UUID `json:"storyId"`
+	TicketId        openapi_types.UUID `json:"ticketId"`
+	Title           string             `json:"title"`
+
+	// VarianceMinutes Logged minus estimate; positive values are overruns.
+	VarianceMinutes *int     `json:"varianceMinutes"`
+	VariancePercent *float64 `json:"variancePercent"`
+}
+
 // TicketType defines model for TicketType.
 type TicketType string
 
 // TicketUpdateRequest defines model for TicketUpdateRequest.
 type TicketUpdateRequest struct {
-	AssigneeId          *openapi_types.UUID     `json:"assigneeId"`
-	Description         *string                 `json:"description,omitempty"`
-	IncidentCommanderId *openapi_types.UUID     `json:"incidentCommanderId"`
-	IncidentEnabled     *bool                   `json:"incidentEnabled,omitempty"`
-	IncidentImpact      *string                 `json:"incidentImpact"`
-	IncidentSeverity    *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
-	Position            *float32                `json:"position,omitempty"`
-	Priority            *TicketPriority         `json:"priority,omitempty"`
-	StateId             *openapi_types.UUID     `json:"stateId,omitempty"`
-	StoryId             *openapi_types.UUID     `json:"storyId,omitempty"`
-	StoryPoints         *int                    `json:"storyPoints"`
-	TimeEstimate        *int                    `json:"timeEstimate"`
-	Title               *string                 `json:"title,omitempty"`
-	Type                *TicketType             `json:"type,omitempty"`
+	AssigneeId          *openapi_types.UUID `json:"assigneeId"`
+	Description         *string             `json:"description,omitempty"`
+	IncidentCommanderId *openapi_types.UUID `json:"incidentCommanderId"`
+	IncidentCommsLeadId *openapi_types.UUID `json:"incidentCommsLeadId"`
+
+	// IncidentCustomerVisible Show the incident on the project's status page.
+	IncidentCustomerVisible *bool                   `json:"incidentCustomerVisible,omitempty"`
+	IncidentEnabled         *bool                   `json:"incidentEnabled,omitempty"`
+	IncidentImpact          *string                 `json:"incidentImpact"`
+	IncidentScribeId        *openapi_types.UUID     `json:"incidentScribeId"`
+	IncidentSeverity        *TicketIncidentSeverity `json:"incidentSeverity,omitempty"`
+
+	// IncidentStatus Incident lifecycle status. Statuses only move forward.
+	IncidentStatus *IncidentStatus     `json:"incidentStatus,omitempty"`
+	Position       *float32            `json:"position,omitempty"`
+	Priority       *TicketPriority     `json:"priority,omitempty"`
+	StateId        *openapi_types.UUID `json:"stateId,omitempty"`
+	StoryId        *openapi_types.UUID `json:"storyId,omitempty"`
+	StoryPoints    *int                `json:"storyPoints"`
+	TimeEstimate   *int                `json:"timeEstimate"`
+	Title          *string             `json:"title,omitempty"`
+	Type           *TicketType         `json:"type,omitempty"`
 }
 
 // TimeEntry defines model for TimeEntry.
@@ -859,14 +2177,128 @@ type TimeEntryListResponse struct {
 	TotalMinutes int         `json:"totalMinutes"`
 }
 
-// User defines model for User.
-type User struct {
-	CreatedAt time.Time           `json:"createdAt"`
-	Email     openapi_types.Email `json:"email"`
-	Id        openapi_types.UUID  `json:"id"`
-	Name      string              `json:"name"`
-}
-
+// TimeEntryTimer defines model for TimeEntryTimer.
+type TimeEntryTimer struct {
+	Description    *string            `json:"description,omitempty"`
+	Id             openapi_types.UUID `json:"id"`
+	IdleNotifiedAt *time.Time         `json:"idleNotifiedAt"`
+	ProjectId      openapi_types.UUID `json:"projectId"`
+	StartedAt      time.Time          `json:"startedAt"`
+	TicketId       openapi_types.UUID `json:"ticketId"`
+	TicketKey      string             `json:"ticketKey"`
+	TicketTitle    string             `json:"ticketTitle"`
+	UserId         openapi_types.UUID `json:"userId"`
+	UserName       string             `json:"userName"`
+}
+
+// TimeEntryTimerListResponse defines model for TimeEntryTimerListResponse.
+type TimeEntryTimerListResponse struct {
+	Items []TimeEntryTimer `json:"items"`
+}
+
+// TimeEntryTimerStartRequest defines model for TimeEntryTimerStartRequest.
+type TimeEntryTimerStartRequest struct {
+	Description *string `json:"description,omitempty"`
+}
+
+// TimeEntryTimerStopRequest defines model for TimeEntryTimerStopRequest.
+type TimeEntryTimerStopRequest struct {
+	// Description Replaces the description given when the timer started.
+	Description *string `json:"description,omitempty"`
+}
+
+// TimeReport defines model for TimeReport.
+type TimeReport struct {
+	From         openapi_types.Date `json:"from"`
+	GroupBy      TimeReportGroupBy  `json:"groupBy"`
+	Groups       []TimeReportGroup  `json:"groups"`
+	To           openapi_types.Date `json:"to"`
+	TotalMinutes int                `json:"totalMinutes"`
+}
+
+// TimeReportGroup defines model for TimeReportGroup.
+type TimeReportGroup struct {
+	EntryCount  int    `json:"entryCount"`
+	Key         string `json:"key"`
+	Label       string `json:"label"`
+	Minutes     int    `json:"minutes"`
+	TicketCount int    `json:"ticketCount"`
+}
+
+// TimeReportGroupBy defines model for TimeReportGroupBy.
+type TimeReportGroupBy string
+
+// TimeTrackingSettings defines model for TimeTrackingSettings.
+type TimeTrackingSettings struct {
+	// AutoStopOnClose Stop running timers when their ticket moves to a closed state.
+	AutoStopOnClose bool `json:"autoStopOnClose"`
+
+	// IdleTimerHours Hours a timer may run before its owner is notified.
+	IdleTimerHours int `json:"idleTimerHours"`
+}
+
+// TimeTrackingSettingsUpdateRequest defines model for TimeTrackingSettingsUpdateRequest.
+type TimeTrackingSettingsUpdateRequest struct {
+	AutoStopOnClose *bool `json:"autoStopOnClose,omitempty"`
+	IdleTimerHours  *int  `json:"idleTimerHours,omitempty"`
+}
+
+// TimeVarianceReport defines model for TimeVarianceReport.
+type TimeVarianceReport struct {
+	Stories []StoryTimeVariance  `json:"stories"`
+	Tickets []TicketTimeVariance `json:"tickets"`
+}
+
+// Timesheet defines model for Timesheet.
+type Timesheet struct {
+	Approval     *TimesheetApproval   `json:"approval,omitempty"`
+	DailyTotals  []int                `json:"dailyTotals"`
+	Days         []openapi_types.Date `json:"days"`
+	Locked       bool                 `json:"locked"`
+	ProjectId    openapi_types.UUID   `json:"projectId"`
+	Rows         []TimesheetRow       `json:"rows"`
+	TotalMinutes int                  `json:"totalMinutes"`
+	UserId       openapi_types.UUID   `json:"userId"`
+	UserName     string               `json:"userName"`
+	WeekStart    openapi_types.Date   `json:"weekStart"`
+}
+
+// TimesheetApproval defines model for TimesheetApproval.
+type TimesheetApproval struct {
+	ApprovedAt     time.Time           `json:"approvedAt"`
+	ApprovedBy     *openapi_types.UUID `json:"approvedBy"`
+	ApprovedByName string              `json:"approvedByName"`
+	Id             openapi_types.UUID  `json:"id"`
+	ProjectId      openapi_types.UUID  `json:"projectId"`
+	UserId         openapi_types.UUID  `json:"userId"`
+	WeekStart      openapi_types.Date  `json:"weekStart"`
+}
+
+// TimesheetApprovalRequest defines model for TimesheetApprovalRequest.
+type TimesheetApprovalRequest struct {
+	UserId openapi_types.UUID `json:"userId"`
+
+	// Week Any day in the week to approve.
+	Week openapi_types.Date `json:"week"`
+}
+
+// TimesheetRow defines model for TimesheetRow.
+type TimesheetRow struct {
+	Key          string             `json:"key"`
+	MinutesByDay []int              `json:"minutesByDay"`
+	TicketId     openapi_types.UUID `json:"ticketId"`
+	Title        string             `json:"title"`
+	TotalMinutes int                `json:"totalMinutes"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Email     openapi_types.Email `json:"email"`
+	Id        openapi_types.UUID  `json:"id"`
+	Name      string              `json:"name"`
+}
+
 // UserListResponse defines model for UserListResponse.
 type UserListResponse struct {
 	Items []UserSummary `json:"items"`
@@ -978,11 +2410,42 @@ type WorkflowUpdateRequest struct {
 	States []WorkflowStateInput `json:"states"`
 }
 
+// IngestIncidentAlertsParams defines parameters for IngestIncidentAlerts.
+type IngestIncidentAlertsParams struct {
+	TicketId *openapi_types.UUID `form:"ticketId,omitempty" json:"ticketId,omitempty"`
+}
+
+// GetOnCallScheduleShiftParams defines parameters for GetOnCallScheduleShift.
+type GetOnCallScheduleShiftParams struct {
+	// At Defaults to now.
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// ListPostmortemActionItemsParams defines parameters for ListPostmortemActionItems.
+type ListPostmortemActionItemsParams struct {
+	ProjectId     *openapi_types.UUID `form:"projectId,omitempty" json:"projectId,omitempty"`
+	IncludeClosed *bool               `form:"includeClosed,omitempty" json:"includeClosed,omitempty"`
+	Limit         *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListProjectActivitiesParams defines parameters for ListProjectActivities.
 type ListProjectActivitiesParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetAiTriageAccuracyParams defines parameters for GetAiTriageAccuracy.
+type GetAiTriageAccuracyParams struct {
+	From  *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To    *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	Field *AiTriageField      `form:"field,omitempty" json:"field,omitempty"`
+}
+
+// ListAutomationRunsParams defines parameters for ListAutomationRuns.
+type ListAutomationRunsParams struct {
+	RuleId *openapi_types.UUID `form:"ruleId,omitempty" json:"ruleId,omitempty"`
+	Limit  *int                `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // GetProjectDependencyGraphParams defines parameters for GetProjectDependencyGraph.
 type GetProjectDependencyGraphParams struct {
 	RootTicketId *openapi_types.UUID `form:"rootTicketId,omitempty" json:"rootTicketId,omitempty"`
@@ -995,16 +2458,57 @@ type ListNotificationsParams struct {
 	UnreadOnly *bool `form:"unreadOnly,omitempty" json:"unreadOnly,omitempty"`
 }
 
+// GetProjectOnCallParams defines parameters for GetProjectOnCall.
+type GetProjectOnCallParams struct {
+	// At Defaults to now.
+	At *time.Time `form:"at,omitempty" json:"at,omitempty"`
+}
+
+// GetProjectAgingWipParams defines parameters for GetProjectAgingWip.
+type GetProjectAgingWipParams struct {
+	Type       *TicketType         `form:"type,omitempty" json:"type,omitempty"`
+	Priority   *TicketPriority     `form:"priority,omitempty" json:"priority,omitempty"`
+	AssigneeId *openapi_types.UUID `form:"assigneeId,omitempty" json:"assigneeId,omitempty"`
+	StoryId    *openapi_types.UUID `form:"storyId,omitempty" json:"storyId,omitempty"`
+}
+
+// GetProjectCumulativeFlowParams defines parameters for GetProjectCumulativeFlow.
+type GetProjectCumulativeFlowParams struct {
+	From       *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To         *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	Type       *TicketType         `form:"type,omitempty" json:"type,omitempty"`
+	Priority   *TicketPriority     `form:"priority,omitempty" json:"priority,omitempty"`
+	AssigneeId *openapi_types.UUID `form:"assigneeId,omitempty" json:"assigneeId,omitempty"`
+	StoryId    *openapi_types.UUID `form:"storyId,omitempty" json:"storyId,omitempty"`
+}
+
 // ExportProjectReportingSnapshotParams defines parameters for ExportProjectReportingSnapshot.
 type ExportProjectReportingSnapshotParams struct {
 	From   *openapi_types.Date                         `form:"from,omitempty" json:"from,omitempty"`
 	To     *openapi_types.Date                         `form:"to,omitempty" json:"to,omitempty"`
 	Format *ExportProjectReportingSnapshotParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+	Detail *bool                                       `form:"detail,omitempty" json:"detail,omitempty"`
 }
 
 // ExportProjectReportingSnapshotParamsFormat defines parameters for ExportProjectReportingSnapshot.
 type ExportProjectReportingSnapshotParamsFormat string
 
+// GetProjectIncidentMetricsParams defines parameters for GetProjectIncidentMetrics.
+type GetProjectIncidentMetricsParams struct {
+	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To   *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetProjectLeadTimeDistributionParams defines parameters for GetProjectLeadTimeDistribution.
+type GetProjectLeadTimeDistributionParams struct {
+	From       *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To         *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	Type       *TicketType         `form:"type,omitempty" json:"type,omitempty"`
+	Priority   *TicketPriority     `form:"priority,omitempty" json:"priority,omitempty"`
+	AssigneeId *openapi_types.UUID `form:"assigneeId,omitempty" json:"assigneeId,omitempty"`
+	StoryId    *openapi_types.UUID `form:"storyId,omitempty" json:"storyId,omitempty"`
+}
+
 // GetProjectReportingSummaryParams defines parameters for GetProjectReportingSummary.
 type GetProjectReportingSummaryParams struct {
 	From *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
@@ -1015,6 +2519,12 @@ type GetProjectReportingSummaryParams struct {
 type GetProjectSprintForecastParams struct {
 	SprintId   *openapi_types.UUID `form:"sprintId,omitempty" json:"sprintId,omitempty"`
 	Iterations *int                `form:"iterations,omitempty" json:"iterations,omitempty"`
+	Unit       *CapacityUnit       `form:"unit,omitempty" json:"unit,omitempty"`
+}
+
+// GetProjectSprintVelocityParams defines parameters for GetProjectSprintVelocity.
+type GetProjectSprintVelocityParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // ListTicketsParams defines parameters for ListTickets.
@@ -1025,6 +2535,18 @@ type ListTicketsParams struct {
 	Blocked    *bool               `form:"blocked,omitempty" json:"blocked,omitempty"`
 	Limit      *int                `form:"limit,omitempty" json:"limit,omitempty"`
 	Offset     *int                `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Cursor Opaque keyset cursor from a previous page's nextCursor. Pass an
+	// empty value to request the first page in cursor mode. When set,
+	// offset is ignored and total is not computed.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// ListTicketChangesParams defines parameters for ListTicketChanges.
+type ListTicketChangesParams struct {
+	Since  time.Time `form:"since" json:"since"`
+	Cursor *string   `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int      `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // UploadTicketAttachmentMultipartBody defines parameters for UploadTicketAttachment.
@@ -1032,6 +2554,34 @@ type UploadTicketAttachmentMultipartBody struct {
 	File openapi_types.File `json:"file"`
 }
 
+// GetProjectTimeReportParams defines parameters for GetProjectTimeReport.
+type GetProjectTimeReportParams struct {
+	From    *openapi_types.Date `form:"from,omitempty" json:"from,omitempty"`
+	To      *openapi_types.Date `form:"to,omitempty" json:"to,omitempty"`
+	GroupBy *TimeReportGroupBy  `form:"groupBy,omitempty" json:"groupBy,omitempty"`
+}
+
+// GetProjectTimeVarianceParams defines parameters for GetProjectTimeVariance.
+type GetProjectTimeVarianceParams struct {
+	StoryId *openapi_types.UUID `form:"storyId,omitempty" json:"storyId,omitempty"`
+}
+
+// GetProjectTimesheetParams defines parameters for GetProjectTimesheet.
+type GetProjectTimesheetParams struct {
+	UserId *openapi_types.UUID              `form:"userId,omitempty" json:"userId,omitempty"`
+	Week   *openapi_types.Date              `form:"week,omitempty" json:"week,omitempty"`
+	Format *GetProjectTimesheetParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetProjectTimesheetParamsFormat defines parameters for GetProjectTimesheet.
+type GetProjectTimesheetParamsFormat string
+
+// ReopenProjectTimesheetParams defines parameters for ReopenProjectTimesheet.
+type ReopenProjectTimesheetParams struct {
+	UserId openapi_types.UUID `form:"userId" json:"userId"`
+	Week   openapi_types.Date `form:"week" json:"week"`
+}
+
 // ListUsersParams defines parameters for ListUsers.
 type ListUsersParams struct {
 	// Q Search by name or email
@@ -1053,6 +2603,18 @@ type UpdateGroupJSONRequestBody = GroupUpdateRequest
 // AddGroupMemberJSONRequestBody defines body for AddGroupMember for application/json ContentType.
 type AddGroupMemberJSONRequestBody = GroupMemberCreateRequest
 
+// CreateGroupOnCallScheduleJSONRequestBody defines body for CreateGroupOnCallSchedule for application/json ContentType.
+type CreateGroupOnCallScheduleJSONRequestBody = OnCallScheduleRequest
+
+// IngestIncidentAlertsJSONRequestBody defines body for IngestIncidentAlerts for application/json ContentType.
+type IngestIncidentAlertsJSONRequestBody = AlertmanagerWebhook
+
+// UpdateOnCallScheduleJSONRequestBody defines body for UpdateOnCallSchedule for application/json ContentType.
+type UpdateOnCallScheduleJSONRequestBody = OnCallScheduleRequest
+
+// CreateOnCallOverrideJSONRequestBody defines body for CreateOnCallOverride for application/json ContentType.
+type CreateOnCallOverrideJSONRequestBody = OnCallOverrideCreateRequest
+
 // CreateProjectJSONRequestBody defines body for CreateProject for application/json ContentType.
 type CreateProjectJSONRequestBody = ProjectCreateRequest
 
@@ -1068,12 +2630,21 @@ type CreateAiTriageSuggestionJSONRequestBody = AiTriageSuggestionCreateRequest
 // RecordAiTriageSuggestionDecisionJSONRequestBody defines body for RecordAiTriageSuggestionDecision for application/json ContentType.
 type RecordAiTriageSuggestionDecisionJSONRequestBody = AiTriageSuggestionDecisionRequest
 
+// CreateAutomationRuleJSONRequestBody defines body for CreateAutomationRule for application/json ContentType.
+type CreateAutomationRuleJSONRequestBody = AutomationRuleCreateRequest
+
+// UpdateAutomationRuleJSONRequestBody defines body for UpdateAutomationRule for application/json ContentType.
+type UpdateAutomationRuleJSONRequestBody = AutomationRuleUpdateRequest
+
 // CreateBoardFilterPresetJSONRequestBody defines body for CreateBoardFilterPreset for application/json ContentType.
 type CreateBoardFilterPresetJSONRequestBody = BoardFilterPresetCreateRequest
 
 // UpdateBoardFilterPresetJSONRequestBody defines body for UpdateBoardFilterPreset for application/json ContentType.
 type UpdateBoardFilterPresetJSONRequestBody = BoardFilterPresetUpdateRequest
 
+// UpsertBoardFilterPresetSubscriptionJSONRequestBody defines body for UpsertBoardFilterPresetSubscription for application/json ContentType.
+type UpsertBoardFilterPresetSubscriptionJSONRequestBody = BoardFilterPresetSubscriptionRequest
+
 // ReplaceProjectCapacitySettingsJSONRequestBody defines body for ReplaceProjectCapacitySettings for application/json ContentType.
 type ReplaceProjectCapacitySettingsJSONRequestBody = CapacitySettingsReplaceRequest
 
@@ -1083,21 +2654,54 @@ type AddProjectGroupJSONRequestBody = ProjectGroupCreateRequest
 // UpdateProjectGroupJSONRequestBody defines body for UpdateProjectGroup for application/json ContentType.
 type UpdateProjectGroupJSONRequestBody = ProjectGroupUpdateRequest
 
+// UpdateProjectIncidentAlertSettingsJSONRequestBody defines body for UpdateProjectIncidentAlertSettings for application/json ContentType.
+type UpdateProjectIncidentAlertSettingsJSONRequestBody = IncidentAlertSettingsUpdateRequest
+
 // UpdateNotificationPreferencesJSONRequestBody defines body for UpdateNotificationPreferences for application/json ContentType.
 type UpdateNotificationPreferencesJSONRequestBody = NotificationPreferencesUpdateRequest
 
+// CreateProjectOnCallScheduleJSONRequestBody defines body for CreateProjectOnCallSchedule for application/json ContentType.
+type CreateProjectOnCallScheduleJSONRequestBody = OnCallScheduleRequest
+
+// WhatIfProjectSprintForecastJSONRequestBody defines body for WhatIfProjectSprintForecast for application/json ContentType.
+type WhatIfProjectSprintForecastJSONRequestBody = SprintForecastWhatIfRequest
+
 // CreateProjectSprintJSONRequestBody defines body for CreateProjectSprint for application/json ContentType.
 type CreateProjectSprintJSONRequestBody = SprintCreateRequest
 
+// UpdateProjectSprintJSONRequestBody defines body for UpdateProjectSprint for application/json ContentType.
+type UpdateProjectSprintJSONRequestBody = SprintUpdateRequest
+
+// ReplaceSprintAbsencesJSONRequestBody defines body for ReplaceSprintAbsences for application/json ContentType.
+type ReplaceSprintAbsencesJSONRequestBody = SprintAbsencesReplaceRequest
+
+// CompleteProjectSprintJSONRequestBody defines body for CompleteProjectSprint for application/json ContentType.
+type CompleteProjectSprintJSONRequestBody = SprintCompleteRequest
+
 // RemoveSprintTicketsJSONRequestBody defines body for RemoveSprintTickets for application/json ContentType.
 type RemoveSprintTicketsJSONRequestBody = SprintTicketsRequest
 
 // AddSprintTicketsJSONRequestBody defines body for AddSprintTickets for application/json ContentType.
 type AddSprintTicketsJSONRequestBody = SprintTicketsRequest
 
+// UpdateProjectStatusPageSettingsJSONRequestBody defines body for UpdateProjectStatusPageSettings for application/json ContentType.
+type UpdateProjectStatusPageSettingsJSONRequestBody = StatusPageSettingsUpdateRequest
+
 // CreateStoryJSONRequestBody defines body for CreateStory for application/json ContentType.
 type CreateStoryJSONRequestBody = StoryCreateRequest
 
+// CreateTicketRecurrenceJSONRequestBody defines body for CreateTicketRecurrence for application/json ContentType.
+type CreateTicketRecurrenceJSONRequestBody = TicketRecurrenceCreateRequest
+
+// UpdateTicketRecurrenceJSONRequestBody defines body for UpdateTicketRecurrence for application/json ContentType.
+type UpdateTicketRecurrenceJSONRequestBody = TicketRecurrenceUpdateRequest
+
+// CreateTicketTemplateJSONRequestBody defines body for CreateTicketTemplate for application/json ContentType.
+type CreateTicketTemplateJSONRequestBody = TicketTemplateCreateRequest
+
+// UpdateTicketTemplateJSONRequestBody defines body for UpdateTicketTemplate for application/json ContentType.
+type UpdateTicketTemplateJSONRequestBody = TicketTemplateUpdateRequest
+
 // CreateTicketJSONRequestBody defines body for CreateTicket for application/json ContentType.
 type CreateTicketJSONRequestBody = TicketCreateRequest
 
@@ -1110,6 +2714,18 @@ type UploadTicketAttachmentMultipartRequestBody UploadTicketAttachmentMultipartB
 // CreateTicketTimeEntryJSONRequestBody defines body for CreateTicketTimeEntry for application/json ContentType.
 type CreateTicketTimeEntryJSONRequestBody = TimeEntryCreateRequest
 
+// StartTicketTimerJSONRequestBody defines body for StartTicketTimer for application/json ContentType.
+type StartTicketTimerJSONRequestBody = TimeEntryTimerStartRequest
+
+// StopTicketTimerJSONRequestBody defines body for StopTicketTimer for application/json ContentType.
+type StopTicketTimerJSONRequestBody = TimeEntryTimerStopRequest
+
+// UpdateTimeTrackingSettingsJSONRequestBody defines body for UpdateTimeTrackingSettings for application/json ContentType.
+type UpdateTimeTrackingSettingsJSONRequestBody = TimeTrackingSettingsUpdateRequest
+
+// ApproveProjectTimesheetJSONRequestBody defines body for ApproveProjectTimesheet for application/json ContentType.
+type ApproveProjectTimesheetJSONRequestBody = TimesheetApprovalRequest
+
 // CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
 type CreateWebhookJSONRequestBody = WebhookCreateRequest
 
@@ -1131,9 +2747,21 @@ type UpdateTicketJSONRequestBody = TicketUpdateRequest
 // AddTicketCommentJSONRequestBody defines body for AddTicketComment for application/json ContentType.
 type AddTicketCommentJSONRequestBody = TicketCommentCreateRequest
 
+// UpdateTicketCommentJSONRequestBody defines body for UpdateTicketComment for application/json ContentType.
+type UpdateTicketCommentJSONRequestBody = TicketCommentUpdateRequest
+
 // CreateTicketDependencyJSONRequestBody defines body for CreateTicketDependency for application/json ContentType.
 type CreateTicketDependencyJSONRequestBody = TicketDependencyCreateRequest
 
+// CreateIncidentTimelineAnnotationJSONRequestBody defines body for CreateIncidentTimelineAnnotation for application/json ContentType.
+type CreateIncidentTimelineAnnotationJSONRequestBody = IncidentTimelineAnnotationCreateRequest
+
+// UpdateTicketPostmortemJSONRequestBody defines body for UpdateTicketPostmortem for application/json ContentType.
+type UpdateTicketPostmortemJSONRequestBody = IncidentPostmortemUpdateRequest
+
+// CreatePostmortemActionItemJSONRequestBody defines body for CreatePostmortemActionItem for application/json ContentType.
+type CreatePostmortemActionItemJSONRequestBody = PostmortemActionItemCreateRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Sync all Keycloak users to database
@@ -1175,9 +2803,39 @@ type ServerInterface interface {
 	// Remove user from group
 	// (DELETE /groups/{groupId}/members/{userId})
 	DeleteGroupMember(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID, userId openapi_types.UUID)
+	// List on-call schedules for group
+	// (GET /groups/{groupId}/oncall-schedules)
+	ListGroupOnCallSchedules(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
+	// Create on-call schedule for group
+	// (POST /groups/{groupId}/oncall-schedules)
+	CreateGroupOnCallSchedule(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID)
 	// Health check
 	// (GET /health)
 	HealthCheck(w http.ResponseWriter, r *http.Request)
+	// Ingest an Alertmanager webhook notification
+	// (POST /incident-alerts/{token})
+	IngestIncidentAlerts(w http.ResponseWriter, r *http.Request, token string, params IngestIncidentAlertsParams)
+	// Delete on-call schedule
+	// (DELETE /oncall-schedules/{scheduleId})
+	DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Get on-call schedule
+	// (GET /oncall-schedules/{scheduleId})
+	GetOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Replace on-call schedule
+	// (PUT /oncall-schedules/{scheduleId})
+	UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Who is on call in schedule
+	// (GET /oncall-schedules/{scheduleId}/oncall)
+	GetOnCallScheduleShift(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, params GetOnCallScheduleShiftParams)
+	// Add an on-call override
+	// (POST /oncall-schedules/{scheduleId}/overrides)
+	CreateOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID)
+	// Remove an on-call override
+	// (DELETE /oncall-schedules/{scheduleId}/overrides/{overrideId})
+	DeleteOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, overrideId openapi_types.UUID)
+	// List postmortem action items across the caller's projects
+	// (GET /postmortem-action-items)
+	ListPostmortemActionItems(w http.ResponseWriter, r *http.Request, params ListPostmortemActionItemsParams)
 	// List projects
 	// (GET /projects)
 	ListProjects(w http.ResponseWriter, r *http.Request)
@@ -1196,6 +2854,15 @@ type ServerInterface interface {
 	// List recent project activity
 	// (GET /projects/{projectId}/activities)
 	ListProjectActivities(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListProjectActivitiesParams)
+	// Report how often AI triage suggestions are accepted
+	// (GET /projects/{projectId}/ai-triage/accuracy)
+	GetAiTriageAccuracy(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetAiTriageAccuracyParams)
+	// Get the status of the project's learned triage model
+	// (GET /projects/{projectId}/ai-triage/model)
+	GetAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Retrain the project's learned triage model now
+	// (POST /projects/{projectId}/ai-triage/model/train)
+	TrainAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// Get AI triage settings for project
 	// (GET /projects/{projectId}/ai-triage/settings)
 	GetProjectAiTriageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
@@ -1208,24 +2875,51 @@ type ServerInterface interface {
 	// Record field-by-field acceptance or rejection for an AI triage suggestion
 	// (POST /projects/{projectId}/ai-triage/suggestions/{suggestionId}/decision)
 	RecordAiTriageSuggestionDecision(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, suggestionId openapi_types.UUID)
+	// List automation rules
+	// (GET /projects/{projectId}/automation-rules)
+	ListAutomationRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create automation rule
+	// (POST /projects/{projectId}/automation-rules)
+	CreateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Delete automation rule
+	// (DELETE /projects/{projectId}/automation-rules/{ruleId})
+	DeleteAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID)
+	// Get automation rule
+	// (GET /projects/{projectId}/automation-rules/{ruleId})
+	GetAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID)
+	// Update automation rule
+	// (PATCH /projects/{projectId}/automation-rules/{ruleId})
+	UpdateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID)
+	// List automation execution log
+	// (GET /projects/{projectId}/automation-runs)
+	ListAutomationRuns(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListAutomationRunsParams)
 	// Kanban board snapshot
 	// (GET /projects/{projectId}/board)
 	GetBoard(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// List personal board filter presets
+	// List board filter presets visible to the current user
 	// (GET /projects/{projectId}/board-filters)
 	ListBoardFilterPresets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
-	// Create personal board filter preset
+	// Create board filter preset
 	// (POST /projects/{projectId}/board-filters)
 	CreateBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// Resolve shared board filter preset token
 	// (GET /projects/{projectId}/board-filters/shared/{token})
 	GetSharedBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, token string)
-	// Delete personal board filter preset
+	// Delete board filter preset
 	// (DELETE /projects/{projectId}/board-filters/{presetId})
 	DeleteBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID)
-	// Update personal board filter preset
+	// Update board filter preset
 	// (PATCH /projects/{projectId}/board-filters/{presetId})
 	UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID)
+	// Unsubscribe from a board filter preset
+	// (DELETE /projects/{projectId}/board-filters/{presetId}/subscription)
+	DeleteBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID)
+	// Get the current user's subscription to a board filter preset
+	// (GET /projects/{projectId}/board-filters/{presetId}/subscription)
+	GetBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID)
+	// Subscribe to a board filter preset
+	// (PUT /projects/{projectId}/board-filters/{presetId}/subscription)
+	UpsertBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID)
 	// List project capacity settings
 	// (GET /projects/{projectId}/capacity-settings)
 	ListProjectCapacitySettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
@@ -1250,6 +2944,12 @@ type ServerInterface interface {
 	// Update project group role
 	// (PATCH /projects/{projectId}/groups/{groupId})
 	UpdateProjectGroup(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, groupId openapi_types.UUID)
+	// Get alert ingestion settings for project
+	// (GET /projects/{projectId}/incident-alerts/settings)
+	GetProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Update alert ingestion settings for project
+	// (PATCH /projects/{projectId}/incident-alerts/settings)
+	UpdateProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// Get the current user's role on this project
 	// (GET /projects/{projectId}/my-role)
 	GetMyProjectRole(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
@@ -1271,21 +2971,75 @@ type ServerInterface interface {
 	// Mark a notification as read
 	// (POST /projects/{projectId}/notifications/{notificationId}/read)
 	MarkNotificationRead(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, notificationId openapi_types.UUID)
+	// Who is on call for project
+	// (GET /projects/{projectId}/oncall)
+	GetProjectOnCall(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectOnCallParams)
+	// List on-call schedules covering project
+	// (GET /projects/{projectId}/oncall-schedules)
+	ListProjectOnCallSchedules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create on-call schedule for project
+	// (POST /projects/{projectId}/oncall-schedules)
+	CreateProjectOnCallSchedule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get aging work in progress
+	// (GET /projects/{projectId}/reporting/aging-wip)
+	GetProjectAgingWip(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectAgingWipParams)
+	// Get cumulative flow series
+	// (GET /projects/{projectId}/reporting/cumulative-flow)
+	GetProjectCumulativeFlow(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectCumulativeFlowParams)
 	// Export project reporting snapshot
 	// (GET /projects/{projectId}/reporting/export)
 	ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ExportProjectReportingSnapshotParams)
+	// Get MTTA and MTTR for incidents detected in a date range
+	// (GET /projects/{projectId}/reporting/incidents)
+	GetProjectIncidentMetrics(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectIncidentMetricsParams)
+	// Get lead and cycle time distribution
+	// (GET /projects/{projectId}/reporting/lead-time)
+	GetProjectLeadTimeDistribution(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectLeadTimeDistributionParams)
 	// Get lightweight project reporting summary
 	// (GET /projects/{projectId}/reporting/summary)
 	GetProjectReportingSummary(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectReportingSummaryParams)
 	// Get sprint forecast summary
 	// (GET /projects/{projectId}/sprint-forecast)
 	GetProjectSprintForecast(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectSprintForecastParams)
+	// Forecast a hypothetical ticket set
+	// (POST /projects/{projectId}/sprint-forecast/what-if)
+	WhatIfProjectSprintForecast(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get velocity history across completed sprints
+	// (GET /projects/{projectId}/sprint-velocity)
+	GetProjectSprintVelocity(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectSprintVelocityParams)
 	// List project sprints
 	// (GET /projects/{projectId}/sprints)
 	ListProjectSprints(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// Create a project sprint
 	// (POST /projects/{projectId}/sprints)
 	CreateProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Delete project sprint
+	// (DELETE /projects/{projectId}/sprints/{sprintId})
+	DeleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Get project sprint
+	// (GET /projects/{projectId}/sprints/{sprintId})
+	GetProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Update project sprint
+	// (PATCH /projects/{projectId}/sprints/{sprintId})
+	UpdateProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// List planned absences for a sprint
+	// (GET /projects/{projectId}/sprints/{sprintId}/absences)
+	ListSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Replace planned absences for a sprint
+	// (PUT /projects/{projectId}/sprints/{sprintId}/absences)
+	ReplaceSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Get sprint burndown and burnup series
+	// (GET /projects/{projectId}/sprints/{sprintId}/burndown)
+	GetProjectSprintBurndown(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Complete sprint
+	// (POST /projects/{projectId}/sprints/{sprintId}/complete)
+	CompleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Get sprint completion snapshot
+	// (GET /projects/{projectId}/sprints/{sprintId}/completion)
+	GetProjectSprintCompletion(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
+	// Start sprint
+	// (POST /projects/{projectId}/sprints/{sprintId}/start)
+	StartProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
 	// Remove tickets from sprint
 	// (DELETE /projects/{projectId}/sprints/{sprintId}/tickets)
 	RemoveSprintTickets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID)
@@ -1295,12 +3049,48 @@ type ServerInterface interface {
 	// Get project statistics
 	// (GET /projects/{projectId}/stats)
 	GetProjectStats(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get status page settings for project
+	// (GET /projects/{projectId}/status-page)
+	GetProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Update status page settings for project
+	// (PATCH /projects/{projectId}/status-page)
+	UpdateProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// List stories
 	// (GET /projects/{projectId}/stories)
 	ListStories(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// Create story
 	// (POST /projects/{projectId}/stories)
 	CreateStory(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// List recurring tickets
+	// (GET /projects/{projectId}/ticket-recurrences)
+	ListTicketRecurrences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create recurring ticket
+	// (POST /projects/{projectId}/ticket-recurrences)
+	CreateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Delete recurring ticket
+	// (DELETE /projects/{projectId}/ticket-recurrences/{recurrenceId})
+	DeleteTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID)
+	// Get recurring ticket
+	// (GET /projects/{projectId}/ticket-recurrences/{recurrenceId})
+	GetTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID)
+	// Update recurring ticket
+	// (PATCH /projects/{projectId}/ticket-recurrences/{recurrenceId})
+	UpdateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID)
+	// List ticket templates
+	// (GET /projects/{projectId}/ticket-templates)
+	ListTicketTemplates(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Create ticket template
+	// (POST /projects/{projectId}/ticket-templates)
+	CreateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Delete ticket template
+	// (DELETE /projects/{projectId}/ticket-templates/{templateId})
+	DeleteTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID)
+	// Get ticket template
+	// (GET /projects/{projectId}/ticket-templates/{templateId})
+	GetTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID)
+	// Update ticket template
+	// (PATCH /projects/{projectId}/ticket-templates/{templateId})
+	UpdateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID)
 	// List tickets for project
 	// (GET /projects/{projectId}/tickets)
 	ListTickets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketsParams)
@@ -1310,6 +3100,12 @@ type ServerInterface interface {
 	// Execute bulk ticket operation
 	// (POST /projects/{projectId}/tickets/bulk)
 	BulkTicketOperation(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// List tickets changed or deleted since a point in time
+	// (GET /projects/{projectId}/tickets/changes)
+	ListTicketChanges(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketChangesParams)
+	// Undo the fields auto-triage applied to a ticket
+	// (POST /projects/{projectId}/tickets/{ticketId}/ai-triage/revert)
+	RevertAiTriageAutoApplication(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID)
 	// List ticket attachments
 	// (GET /projects/{projectId}/tickets/{ticketId}/attachments)
 	ListTicketAttachments(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID)
@@ -1331,6 +3127,39 @@ type ServerInterface interface {
 	// Delete a time entry
 	// (DELETE /projects/{projectId}/tickets/{ticketId}/time-entries/{timeEntryId})
 	DeleteTicketTimeEntry(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID, timeEntryId openapi_types.UUID)
+	// Discard a running timer without logging time
+	// (DELETE /projects/{projectId}/tickets/{ticketId}/timer)
+	DiscardTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID)
+	// Start a timer on a ticket
+	// (POST /projects/{projectId}/tickets/{ticketId}/timer)
+	StartTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID)
+	// Stop a running timer and log the elapsed time
+	// (POST /projects/{projectId}/tickets/{ticketId}/timer/stop)
+	StopTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID)
+	// Get logged time grouped by user, story or type
+	// (GET /projects/{projectId}/time-report)
+	GetProjectTimeReport(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeReportParams)
+	// Get project time tracking settings
+	// (GET /projects/{projectId}/time-tracking-settings)
+	GetTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Update project time tracking settings
+	// (PATCH /projects/{projectId}/time-tracking-settings)
+	UpdateTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Compare time estimates with time logged
+	// (GET /projects/{projectId}/time-variance)
+	GetProjectTimeVariance(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeVarianceParams)
+	// List the current user's running timers
+	// (GET /projects/{projectId}/timers)
+	ListProjectTimers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Get a user's weekly timesheet
+	// (GET /projects/{projectId}/timesheets)
+	GetProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimesheetParams)
+	// Reopen an approved weekly timesheet
+	// (DELETE /projects/{projectId}/timesheets/approval)
+	ReopenProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ReopenProjectTimesheetParams)
+	// Approve and lock a weekly timesheet
+	// (PUT /projects/{projectId}/timesheets/approval)
+	ApproveProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
 	// List webhooks
 	// (GET /projects/{projectId}/webhooks)
 	ListWebhooks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
@@ -1358,6 +3187,15 @@ type ServerInterface interface {
 	// Update workflow states
 	// (PUT /projects/{projectId}/workflow)
 	UpdateWorkflow(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID)
+	// Public status feed
+	// (GET /status/{slug})
+	GetStatusFeed(w http.ResponseWriter, r *http.Request, slug string)
+	// Public status feed as Atom
+	// (GET /status/{slug}/feed.atom)
+	GetStatusFeedAtom(w http.ResponseWriter, r *http.Request, slug string)
+	// Public status feed as RSS
+	// (GET /status/{slug}/feed.rss)
+	GetStatusFeedRss(w http.ResponseWriter, r *http.Request, slug string)
 	// Delete story
 	// (DELETE /stories/{id})
 	DeleteStory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -1388,6 +3226,9 @@ type ServerInterface interface {
 	// Delete ticket comment
 	// (DELETE /tickets/{id}/comments/{commentId})
 	DeleteTicketComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID)
+	// Publish or unpublish a ticket comment on the status page
+	// (PATCH /tickets/{id}/comments/{commentId})
+	UpdateTicketComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID)
 	// List dependencies for ticket
 	// (GET /tickets/{id}/dependencies)
 	ListTicketDependencies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
@@ -1400,9 +3241,27 @@ type ServerInterface interface {
 	// Export incident postmortem draft as Markdown
 	// (GET /tickets/{id}/incident-postmortem)
 	GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// List severity changes of an incident, oldest first
+	// (GET /tickets/{id}/incident-severity-history)
+	ListTicketIncidentSeverityHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List incident timeline events for ticket
 	// (GET /tickets/{id}/incident-timeline)
 	ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Add an annotation to an incident timeline
+	// (POST /tickets/{id}/incident-timeline)
+	CreateIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Delete an incident timeline annotation
+	// (DELETE /tickets/{id}/incident-timeline/{entryId})
+	DeleteIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID)
+	// Get the postmortem document of an incident
+	// (GET /tickets/{id}/postmortem)
+	GetTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Create or update the postmortem document of an incident
+	// (PUT /tickets/{id}/postmortem)
+	UpdateTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
+	// Create a postmortem action item ticket
+	// (POST /tickets/{id}/postmortem/action-items)
+	CreatePostmortemActionItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID)
 	// List users
 	// (GET /users)
 	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
@@ -1490,12 +3349,72 @@ func (_ Unimplemented) DeleteGroupMember(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List on-call schedules for group
+// (GET /groups/{groupId}/oncall-schedules)
+func (_ Unimplemented) ListGroupOnCallSchedules(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create on-call schedule for group
+// (POST /groups/{groupId}/oncall-schedules)
+func (_ Unimplemented) CreateGroupOnCallSchedule(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /health)
 func (_ Unimplemented) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Ingest an Alertmanager webhook notification
+// (POST /incident-alerts/{token})
+func (_ Unimplemented) IngestIncidentAlerts(w http.ResponseWriter, r *http.Request, token string, params IngestIncidentAlertsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete on-call schedule
+// (DELETE /oncall-schedules/{scheduleId})
+func (_ Unimplemented) DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get on-call schedule
+// (GET /oncall-schedules/{scheduleId})
+func (_ Unimplemented) GetOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace on-call schedule
+// (PUT /oncall-schedules/{scheduleId})
+func (_ Unimplemented) UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Who is on call in schedule
+// (GET /oncall-schedules/{scheduleId}/oncall)
+func (_ Unimplemented) GetOnCallScheduleShift(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, params GetOnCallScheduleShiftParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Add an on-call override
+// (POST /oncall-schedules/{scheduleId}/overrides)
+func (_ Unimplemented) CreateOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Remove an on-call override
+// (DELETE /oncall-schedules/{scheduleId}/overrides/{overrideId})
+func (_ Unimplemented) DeleteOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, overrideId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List postmortem action items across the caller's projects
+// (GET /postmortem-action-items)
+func (_ Unimplemented) ListPostmortemActionItems(w http.ResponseWriter, r *http.Request, params ListPostmortemActionItemsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List projects
 // (GET /projects)
 func (_ Unimplemented) ListProjects(w http.ResponseWriter, r *http.Request) {
@@ -1532,6 +3451,24 @@ func (_ Unimplemented) ListProjectActivities(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Report how often AI triage suggestions are accepted
+// (GET /projects/{projectId}/ai-triage/accuracy)
+func (_ Unimplemented) GetAiTriageAccuracy(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetAiTriageAccuracyParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the status of the project's learned triage model
+// (GET /projects/{projectId}/ai-triage/model)
+func (_ Unimplemented) GetAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retrain the project's learned triage model now
+// (POST /projects/{projectId}/ai-triage/model/train)
+func (_ Unimplemented) TrainAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get AI triage settings for project
 // (GET /projects/{projectId}/ai-triage/settings)
 func (_ Unimplemented) GetProjectAiTriageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1556,19 +3493,55 @@ func (_ Unimplemented) RecordAiTriageSuggestionDecision(w http.ResponseWriter, r
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List automation rules
+// (GET /projects/{projectId}/automation-rules)
+func (_ Unimplemented) ListAutomationRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create automation rule
+// (POST /projects/{projectId}/automation-rules)
+func (_ Unimplemented) CreateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete automation rule
+// (DELETE /projects/{projectId}/automation-rules/{ruleId})
+func (_ Unimplemented) DeleteAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get automation rule
+// (GET /projects/{projectId}/automation-rules/{ruleId})
+func (_ Unimplemented) GetAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update automation rule
+// (PATCH /projects/{projectId}/automation-rules/{ruleId})
+func (_ Unimplemented) UpdateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List automation execution log
+// (GET /projects/{projectId}/automation-runs)
+func (_ Unimplemented) ListAutomationRuns(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListAutomationRunsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Kanban board snapshot
 // (GET /projects/{projectId}/board)
 func (_ Unimplemented) GetBoard(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List personal board filter presets
+// List board filter presets visible to the current user
 // (GET /projects/{projectId}/board-filters)
 func (_ Unimplemented) ListBoardFilterPresets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create personal board filter preset
+// Create board filter preset
 // (POST /projects/{projectId}/board-filters)
 func (_ Unimplemented) CreateBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -1580,18 +3553,36 @@ func (_ Unimplemented) GetSharedBoardFilterPreset(w http.ResponseWriter, r *http
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Delete personal board filter preset
+// Delete board filter preset
 // (DELETE /projects/{projectId}/board-filters/{presetId})
 func (_ Unimplemented) DeleteBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Update personal board filter preset
+// Update board filter preset
 // (PATCH /projects/{projectId}/board-filters/{presetId})
 func (_ Unimplemented) UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Unsubscribe from a board filter preset
+// (DELETE /projects/{projectId}/board-filters/{presetId}/subscription)
+func (_ Unimplemented) DeleteBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the current user's subscription to a board filter preset
+// (GET /projects/{projectId}/board-filters/{presetId}/subscription)
+func (_ Unimplemented) GetBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Subscribe to a board filter preset
+// (PUT /projects/{projectId}/board-filters/{presetId}/subscription)
+func (_ Unimplemented) UpsertBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List project capacity settings
 // (GET /projects/{projectId}/capacity-settings)
 func (_ Unimplemented) ListProjectCapacitySettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1640,6 +3631,18 @@ func (_ Unimplemented) UpdateProjectGroup(w http.ResponseWriter, r *http.Request
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get alert ingestion settings for project
+// (GET /projects/{projectId}/incident-alerts/settings)
+func (_ Unimplemented) GetProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update alert ingestion settings for project
+// (PATCH /projects/{projectId}/incident-alerts/settings)
+func (_ Unimplemented) UpdateProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the current user's role on this project
 // (GET /projects/{projectId}/my-role)
 func (_ Unimplemented) GetMyProjectRole(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1682,12 +3685,54 @@ func (_ Unimplemented) MarkNotificationRead(w http.ResponseWriter, r *http.Reque
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Who is on call for project
+// (GET /projects/{projectId}/oncall)
+func (_ Unimplemented) GetProjectOnCall(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectOnCallParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List on-call schedules covering project
+// (GET /projects/{projectId}/oncall-schedules)
+func (_ Unimplemented) ListProjectOnCallSchedules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create on-call schedule for project
+// (POST /projects/{projectId}/oncall-schedules)
+func (_ Unimplemented) CreateProjectOnCallSchedule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get aging work in progress
+// (GET /projects/{projectId}/reporting/aging-wip)
+func (_ Unimplemented) GetProjectAgingWip(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectAgingWipParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get cumulative flow series
+// (GET /projects/{projectId}/reporting/cumulative-flow)
+func (_ Unimplemented) GetProjectCumulativeFlow(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectCumulativeFlowParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Export project reporting snapshot
 // (GET /projects/{projectId}/reporting/export)
 func (_ Unimplemented) ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ExportProjectReportingSnapshotParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get MTTA and MTTR for incidents detected in a date range
+// (GET /projects/{projectId}/reporting/incidents)
+func (_ Unimplemented) GetProjectIncidentMetrics(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectIncidentMetricsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get lead and cycle time distribution
+// (GET /projects/{projectId}/reporting/lead-time)
+func (_ Unimplemented) GetProjectLeadTimeDistribution(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectLeadTimeDistributionParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get lightweight project reporting summary
 // (GET /projects/{projectId}/reporting/summary)
 func (_ Unimplemented) GetProjectReportingSummary(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectReportingSummaryParams) {
@@ -1700,6 +3745,18 @@ func (_ Unimplemented) GetProjectSprintForecast(w http.ResponseWriter, r *http.R
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Forecast a hypothetical ticket set
+// (POST /projects/{projectId}/sprint-forecast/what-if)
+func (_ Unimplemented) WhatIfProjectSprintForecast(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get velocity history across completed sprints
+// (GET /projects/{projectId}/sprint-velocity)
+func (_ Unimplemented) GetProjectSprintVelocity(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectSprintVelocityParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List project sprints
 // (GET /projects/{projectId}/sprints)
 func (_ Unimplemented) ListProjectSprints(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1712,6 +3769,60 @@ func (_ Unimplemented) CreateProjectSprint(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Delete project sprint
+// (DELETE /projects/{projectId}/sprints/{sprintId})
+func (_ Unimplemented) DeleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get project sprint
+// (GET /projects/{projectId}/sprints/{sprintId})
+func (_ Unimplemented) GetProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update project sprint
+// (PATCH /projects/{projectId}/sprints/{sprintId})
+func (_ Unimplemented) UpdateProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List planned absences for a sprint
+// (GET /projects/{projectId}/sprints/{sprintId}/absences)
+func (_ Unimplemented) ListSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Replace planned absences for a sprint
+// (PUT /projects/{projectId}/sprints/{sprintId}/absences)
+func (_ Unimplemented) ReplaceSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get sprint burndown and burnup series
+// (GET /projects/{projectId}/sprints/{sprintId}/burndown)
+func (_ Unimplemented) GetProjectSprintBurndown(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Complete sprint
+// (POST /projects/{projectId}/sprints/{sprintId}/complete)
+func (_ Unimplemented) CompleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get sprint completion snapshot
+// (GET /projects/{projectId}/sprints/{sprintId}/completion)
+func (_ Unimplemented) GetProjectSprintCompletion(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start sprint
+// (POST /projects/{projectId}/sprints/{sprintId}/start)
+func (_ Unimplemented) StartProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Remove tickets from sprint
 // (DELETE /projects/{projectId}/sprints/{sprintId}/tickets)
 func (_ Unimplemented) RemoveSprintTickets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
@@ -1730,6 +3841,18 @@ func (_ Unimplemented) GetProjectStats(w http.ResponseWriter, r *http.Request, p
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get status page settings for project
+// (GET /projects/{projectId}/status-page)
+func (_ Unimplemented) GetProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update status page settings for project
+// (PATCH /projects/{projectId}/status-page)
+func (_ Unimplemented) UpdateProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List stories
 // (GET /projects/{projectId}/stories)
 func (_ Unimplemented) ListStories(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1742,33 +3865,105 @@ func (_ Unimplemented) CreateStory(w http.ResponseWriter, r *http.Request, proje
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List tickets for project
-// (GET /projects/{projectId}/tickets)
-func (_ Unimplemented) ListTickets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketsParams) {
+// List recurring tickets
+// (GET /projects/{projectId}/ticket-recurrences)
+func (_ Unimplemented) ListTicketRecurrences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Create ticket
-// (POST /projects/{projectId}/tickets)
-func (_ Unimplemented) CreateTicket(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+// Create recurring ticket
+// (POST /projects/{projectId}/ticket-recurrences)
+func (_ Unimplemented) CreateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Execute bulk ticket operation
-// (POST /projects/{projectId}/tickets/bulk)
-func (_ Unimplemented) BulkTicketOperation(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+// Delete recurring ticket
+// (DELETE /projects/{projectId}/ticket-recurrences/{recurrenceId})
+func (_ Unimplemented) DeleteTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// List ticket attachments
-// (GET /projects/{projectId}/tickets/{ticketId}/attachments)
-func (_ Unimplemented) ListTicketAttachments(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+// Get recurring ticket
+// (GET /projects/{projectId}/ticket-recurrences/{recurrenceId})
+func (_ Unimplemented) GetTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
-// Upload file attachment to ticket
-// (POST /projects/{projectId}/tickets/{ticketId}/attachments)
-func (_ Unimplemented) UploadTicketAttachment(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+// Update recurring ticket
+// (PATCH /projects/{projectId}/ticket-recurrences/{recurrenceId})
+func (_ Unimplemented) UpdateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List ticket templates
+// (GET /projects/{projectId}/ticket-templates)
+func (_ Unimplemented) ListTicketTemplates(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create ticket template
+// (POST /projects/{projectId}/ticket-templates)
+func (_ Unimplemented) CreateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete ticket template
+// (DELETE /projects/{projectId}/ticket-templates/{templateId})
+func (_ Unimplemented) DeleteTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get ticket template
+// (GET /projects/{projectId}/ticket-templates/{templateId})
+func (_ Unimplemented) GetTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update ticket template
+// (PATCH /projects/{projectId}/ticket-templates/{templateId})
+func (_ Unimplemented) UpdateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tickets for project
+// (GET /projects/{projectId}/tickets)
+func (_ Unimplemented) ListTickets(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create ticket
+// (POST /projects/{projectId}/tickets)
+func (_ Unimplemented) CreateTicket(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Execute bulk ticket operation
+// (POST /projects/{projectId}/tickets/bulk)
+func (_ Unimplemented) BulkTicketOperation(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List tickets changed or deleted since a point in time
+// (GET /projects/{projectId}/tickets/changes)
+func (_ Unimplemented) ListTicketChanges(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketChangesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Undo the fields auto-triage applied to a ticket
+// (POST /projects/{projectId}/tickets/{ticketId}/ai-triage/revert)
+func (_ Unimplemented) RevertAiTriageAutoApplication(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List ticket attachments
+// (GET /projects/{projectId}/tickets/{ticketId}/attachments)
+func (_ Unimplemented) ListTicketAttachments(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Upload file attachment to ticket
+// (POST /projects/{projectId}/tickets/{ticketId}/attachments)
+func (_ Unimplemented) UploadTicketAttachment(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -1802,6 +3997,72 @@ func (_ Unimplemented) DeleteTicketTimeEntry(w http.ResponseWriter, r *http.Requ
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Discard a running timer without logging time
+// (DELETE /projects/{projectId}/tickets/{ticketId}/timer)
+func (_ Unimplemented) DiscardTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a timer on a ticket
+// (POST /projects/{projectId}/tickets/{ticketId}/timer)
+func (_ Unimplemented) StartTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Stop a running timer and log the elapsed time
+// (POST /projects/{projectId}/tickets/{ticketId}/timer/stop)
+func (_ Unimplemented) StopTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get logged time grouped by user, story or type
+// (GET /projects/{projectId}/time-report)
+func (_ Unimplemented) GetProjectTimeReport(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get project time tracking settings
+// (GET /projects/{projectId}/time-tracking-settings)
+func (_ Unimplemented) GetTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update project time tracking settings
+// (PATCH /projects/{projectId}/time-tracking-settings)
+func (_ Unimplemented) UpdateTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Compare time estimates with time logged
+// (GET /projects/{projectId}/time-variance)
+func (_ Unimplemented) GetProjectTimeVariance(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeVarianceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the current user's running timers
+// (GET /projects/{projectId}/timers)
+func (_ Unimplemented) ListProjectTimers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a user's weekly timesheet
+// (GET /projects/{projectId}/timesheets)
+func (_ Unimplemented) GetProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimesheetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Reopen an approved weekly timesheet
+// (DELETE /projects/{projectId}/timesheets/approval)
+func (_ Unimplemented) ReopenProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ReopenProjectTimesheetParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Approve and lock a weekly timesheet
+// (PUT /projects/{projectId}/timesheets/approval)
+func (_ Unimplemented) ApproveProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List webhooks
 // (GET /projects/{projectId}/webhooks)
 func (_ Unimplemented) ListWebhooks(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -1856,6 +4117,24 @@ func (_ Unimplemented) UpdateWorkflow(w http.ResponseWriter, r *http.Request, pr
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Public status feed
+// (GET /status/{slug})
+func (_ Unimplemented) GetStatusFeed(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Public status feed as Atom
+// (GET /status/{slug}/feed.atom)
+func (_ Unimplemented) GetStatusFeedAtom(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Public status feed as RSS
+// (GET /status/{slug}/feed.rss)
+func (_ Unimplemented) GetStatusFeedRss(w http.ResponseWriter, r *http.Request, slug string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Delete story
 // (DELETE /stories/{id})
 func (_ Unimplemented) DeleteStory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -1916,6 +4195,12 @@ func (_ Unimplemented) DeleteTicketComment(w http.ResponseWriter, r *http.Reques
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Publish or unpublish a ticket comment on the status page
+// (PATCH /tickets/{id}/comments/{commentId})
+func (_ Unimplemented) UpdateTicketComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List dependencies for ticket
 // (GET /tickets/{id}/dependencies)
 func (_ Unimplemented) ListTicketDependencies(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
@@ -1940,12 +4225,48 @@ func (_ Unimplemented) GetTicketIncidentPostmortem(w http.ResponseWriter, r *htt
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List severity changes of an incident, oldest first
+// (GET /tickets/{id}/incident-severity-history)
+func (_ Unimplemented) ListTicketIncidentSeverityHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List incident timeline events for ticket
 // (GET /tickets/{id}/incident-timeline)
 func (_ Unimplemented) ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Add an annotation to an incident timeline
+// (POST /tickets/{id}/incident-timeline)
+func (_ Unimplemented) CreateIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete an incident timeline annotation
+// (DELETE /tickets/{id}/incident-timeline/{entryId})
+func (_ Unimplemented) DeleteIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the postmortem document of an incident
+// (GET /tickets/{id}/postmortem)
+func (_ Unimplemented) GetTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create or update the postmortem document of an incident
+// (PUT /tickets/{id}/postmortem)
+func (_ Unimplemented) UpdateTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a postmortem action item ticket
+// (POST /tickets/{id}/postmortem/action-items)
+func (_ Unimplemented) CreatePostmortemActionItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List users
 // (GET /users)
 func (_ Unimplemented) ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams) {
@@ -2290,22 +4611,19 @@ func (siw *ServerInterfaceWrapper) DeleteGroupMember(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// HealthCheck operation middleware
-func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HealthCheck(w, r)
-	}))
+// ListGroupOnCallSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListGroupOnCallSchedules(w http.ResponseWriter, r *http.Request) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	var err error
 
-	handler.ServeHTTP(w, r)
-}
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
 
-// ListProjects operation middleware
-func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2314,7 +4632,7 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjects(w, r)
+		siw.Handler.ListGroupOnCallSchedules(w, r, groupId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2324,8 +4642,19 @@ func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProject operation middleware
-func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+// CreateGroupOnCallSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateGroupOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -2334,7 +4663,7 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProject(w, r)
+		siw.Handler.CreateGroupOnCallSchedule(w, r, groupId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2344,28 +4673,11 @@ func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteProject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
+// HealthCheck operation middleware
+func (siw *ServerInterfaceWrapper) HealthCheck(w http.ResponseWriter, r *http.Request) {
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProject(w, r, projectId)
+		siw.Handler.HealthCheck(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2375,28 +4687,33 @@ func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetProject operation middleware
-func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
+// IngestIncidentAlerts operation middleware
+func (siw *ServerInterfaceWrapper) IngestIncidentAlerts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "token" -------------
+	var token string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IngestIncidentAlertsParams
 
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+	// ------------- Optional query parameter "ticketId" -------------
 
-	r = r.WithContext(ctx)
+	err = runtime.BindQueryParameter("form", true, false, "ticketId", r.URL.Query(), &params.TicketId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProject(w, r, projectId)
+		siw.Handler.IngestIncidentAlerts(w, r, token, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2406,17 +4723,17 @@ func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProject operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
+// DeleteOnCallSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
@@ -2427,7 +4744,7 @@ func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProject(w, r, projectId)
+		siw.Handler.DeleteOnCallSchedule(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2437,17 +4754,17 @@ func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectActivities operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectActivities(w http.ResponseWriter, r *http.Request) {
+// GetOnCallSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetOnCallSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
@@ -2457,19 +4774,8 @@ func (siw *ServerInterfaceWrapper) ListProjectActivities(w http.ResponseWriter,
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListProjectActivitiesParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectActivities(w, r, projectId, params)
+		siw.Handler.GetOnCallSchedule(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2479,17 +4785,17 @@ func (siw *ServerInterfaceWrapper) ListProjectActivities(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectAiTriageSettings operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectAiTriageSettings(w http.ResponseWriter, r *http.Request) {
+// UpdateOnCallSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
@@ -2500,7 +4806,7 @@ func (siw *ServerInterfaceWrapper) GetProjectAiTriageSettings(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectAiTriageSettings(w, r, projectId)
+		siw.Handler.UpdateOnCallSchedule(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2510,17 +4816,17 @@ func (siw *ServerInterfaceWrapper) GetProjectAiTriageSettings(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProjectAiTriageSettings operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProjectAiTriageSettings(w http.ResponseWriter, r *http.Request) {
+// GetOnCallScheduleShift operation middleware
+func (siw *ServerInterfaceWrapper) GetOnCallScheduleShift(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
@@ -2530,8 +4836,19 @@ func (siw *ServerInterfaceWrapper) UpdateProjectAiTriageSettings(w http.Response
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOnCallScheduleShiftParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", r.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "at", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProjectAiTriageSettings(w, r, projectId)
+		siw.Handler.GetOnCallScheduleShift(w, r, scheduleId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2541,17 +4858,17 @@ func (siw *ServerInterfaceWrapper) UpdateProjectAiTriageSettings(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// CreateAiTriageSuggestion operation middleware
-func (siw *ServerInterfaceWrapper) CreateAiTriageSuggestion(w http.ResponseWriter, r *http.Request) {
+// CreateOnCallOverride operation middleware
+func (siw *ServerInterfaceWrapper) CreateOnCallOverride(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
@@ -2562,7 +4879,7 @@ func (siw *ServerInterfaceWrapper) CreateAiTriageSuggestion(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateAiTriageSuggestion(w, r, projectId)
+		siw.Handler.CreateOnCallOverride(w, r, scheduleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2572,26 +4889,26 @@ func (siw *ServerInterfaceWrapper) CreateAiTriageSuggestion(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// RecordAiTriageSuggestionDecision operation middleware
-func (siw *ServerInterfaceWrapper) RecordAiTriageSuggestionDecision(w http.ResponseWriter, r *http.Request) {
+// DeleteOnCallOverride operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOnCallOverride(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "scheduleId" -------------
+	var scheduleId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "scheduleId", chi.URLParam(r, "scheduleId"), &scheduleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "suggestionId" -------------
-	var suggestionId openapi_types.UUID
+	// ------------- Path parameter "overrideId" -------------
+	var overrideId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "suggestionId", chi.URLParam(r, "suggestionId"), &suggestionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "overrideId", chi.URLParam(r, "overrideId"), &overrideId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suggestionId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "overrideId", Err: err})
 		return
 	}
 
@@ -2602,7 +4919,7 @@ func (siw *ServerInterfaceWrapper) RecordAiTriageSuggestionDecision(w http.Respo
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RecordAiTriageSuggestionDecision(w, r, projectId, suggestionId)
+		siw.Handler.DeleteOnCallOverride(w, r, scheduleId, overrideId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2612,20 +4929,58 @@ func (siw *ServerInterfaceWrapper) RecordAiTriageSuggestionDecision(w http.Respo
 	handler.ServeHTTP(w, r)
 }
 
-// GetBoard operation middleware
-func (siw *ServerInterfaceWrapper) GetBoard(w http.ResponseWriter, r *http.Request) {
+// ListPostmortemActionItems operation middleware
+func (siw *ServerInterfaceWrapper) ListPostmortemActionItems(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPostmortemActionItemsParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
+	// ------------- Optional query parameter "includeClosed" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeClosed", r.URL.Query(), &params.IncludeClosed)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "includeClosed", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPostmortemActionItems(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjects operation middleware
+func (siw *ServerInterfaceWrapper) ListProjects(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -2633,7 +4988,7 @@ func (siw *ServerInterfaceWrapper) GetBoard(w http.ResponseWriter, r *http.Reque
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBoard(w, r, projectId)
+		siw.Handler.ListProjects(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2643,8 +4998,28 @@ func (siw *ServerInterfaceWrapper) GetBoard(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// ListBoardFilterPresets operation middleware
-func (siw *ServerInterfaceWrapper) ListBoardFilterPresets(w http.ResponseWriter, r *http.Request) {
+// CreateProject operation middleware
+func (siw *ServerInterfaceWrapper) CreateProject(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProject(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2664,7 +5039,7 @@ func (siw *ServerInterfaceWrapper) ListBoardFilterPresets(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListBoardFilterPresets(w, r, projectId)
+		siw.Handler.DeleteProject(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2674,8 +5049,8 @@ func (siw *ServerInterfaceWrapper) ListBoardFilterPresets(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateBoardFilterPreset operation middleware
-func (siw *ServerInterfaceWrapper) CreateBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+// GetProject operation middleware
+func (siw *ServerInterfaceWrapper) GetProject(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2695,7 +5070,7 @@ func (siw *ServerInterfaceWrapper) CreateBoardFilterPreset(w http.ResponseWriter
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateBoardFilterPreset(w, r, projectId)
+		siw.Handler.GetProject(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2705,8 +5080,8 @@ func (siw *ServerInterfaceWrapper) CreateBoardFilterPreset(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetSharedBoardFilterPreset operation middleware
-func (siw *ServerInterfaceWrapper) GetSharedBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+// UpdateProject operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProject(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2719,15 +5094,6 @@ func (siw *ServerInterfaceWrapper) GetSharedBoardFilterPreset(w http.ResponseWri
 		return
 	}
 
-	// ------------- Path parameter "token" -------------
-	var token string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -2735,7 +5101,7 @@ func (siw *ServerInterfaceWrapper) GetSharedBoardFilterPreset(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSharedBoardFilterPreset(w, r, projectId, token)
+		siw.Handler.UpdateProject(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2745,8 +5111,8 @@ func (siw *ServerInterfaceWrapper) GetSharedBoardFilterPreset(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteBoardFilterPreset operation middleware
-func (siw *ServerInterfaceWrapper) DeleteBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+// ListProjectActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectActivities(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2759,23 +5125,25 @@ func (siw *ServerInterfaceWrapper) DeleteBoardFilterPreset(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "presetId" -------------
-	var presetId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProjectActivitiesParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteBoardFilterPreset(w, r, projectId, presetId)
+		siw.Handler.ListProjectActivities(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2785,8 +5153,8 @@ func (siw *ServerInterfaceWrapper) DeleteBoardFilterPreset(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateBoardFilterPreset operation middleware
-func (siw *ServerInterfaceWrapper) UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+// GetAiTriageAccuracy operation middleware
+func (siw *ServerInterfaceWrapper) GetAiTriageAccuracy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2799,23 +5167,41 @@ func (siw *ServerInterfaceWrapper) UpdateBoardFilterPreset(w http.ResponseWriter
 		return
 	}
 
-	// ------------- Path parameter "presetId" -------------
-	var presetId openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAiTriageAccuracyParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "to" -------------
 
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "field" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "field", r.URL.Query(), &params.Field)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "field", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateBoardFilterPreset(w, r, projectId, presetId)
+		siw.Handler.GetAiTriageAccuracy(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2825,8 +5211,8 @@ func (siw *ServerInterfaceWrapper) UpdateBoardFilterPreset(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectCapacitySettings operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectCapacitySettings(w http.ResponseWriter, r *http.Request) {
+// GetAiTriageModel operation middleware
+func (siw *ServerInterfaceWrapper) GetAiTriageModel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2846,7 +5232,7 @@ func (siw *ServerInterfaceWrapper) ListProjectCapacitySettings(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectCapacitySettings(w, r, projectId)
+		siw.Handler.GetAiTriageModel(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2856,8 +5242,8 @@ func (siw *ServerInterfaceWrapper) ListProjectCapacitySettings(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// ReplaceProjectCapacitySettings operation middleware
-func (siw *ServerInterfaceWrapper) ReplaceProjectCapacitySettings(w http.ResponseWriter, r *http.Request) {
+// TrainAiTriageModel operation middleware
+func (siw *ServerInterfaceWrapper) TrainAiTriageModel(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2877,7 +5263,7 @@ func (siw *ServerInterfaceWrapper) ReplaceProjectCapacitySettings(w http.Respons
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ReplaceProjectCapacitySettings(w, r, projectId)
+		siw.Handler.TrainAiTriageModel(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2887,8 +5273,8 @@ func (siw *ServerInterfaceWrapper) ReplaceProjectCapacitySettings(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectDependencyGraph operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectDependencyGraph(w http.ResponseWriter, r *http.Request) {
+// GetProjectAiTriageSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAiTriageSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2907,27 +5293,8 @@ func (siw *ServerInterfaceWrapper) GetProjectDependencyGraph(w http.ResponseWrit
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectDependencyGraphParams
-
-	// ------------- Optional query parameter "rootTicketId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "rootTicketId", r.URL.Query(), &params.RootTicketId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rootTicketId", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "depth" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
-		return
-	}
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectDependencyGraph(w, r, projectId, params)
+		siw.Handler.GetProjectAiTriageSettings(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2937,8 +5304,8 @@ func (siw *ServerInterfaceWrapper) GetProjectDependencyGraph(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// StreamProjectEvents operation middleware
-func (siw *ServerInterfaceWrapper) StreamProjectEvents(w http.ResponseWriter, r *http.Request) {
+// UpdateProjectAiTriageSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectAiTriageSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2958,7 +5325,7 @@ func (siw *ServerInterfaceWrapper) StreamProjectEvents(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.StreamProjectEvents(w, r, projectId)
+		siw.Handler.UpdateProjectAiTriageSettings(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2968,8 +5335,8 @@ func (siw *ServerInterfaceWrapper) StreamProjectEvents(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectGroups operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectGroups(w http.ResponseWriter, r *http.Request) {
+// CreateAiTriageSuggestion operation middleware
+func (siw *ServerInterfaceWrapper) CreateAiTriageSuggestion(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -2989,7 +5356,7 @@ func (siw *ServerInterfaceWrapper) ListProjectGroups(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectGroups(w, r, projectId)
+		siw.Handler.CreateAiTriageSuggestion(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -2999,8 +5366,8 @@ func (siw *ServerInterfaceWrapper) ListProjectGroups(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// AddProjectGroup operation middleware
-func (siw *ServerInterfaceWrapper) AddProjectGroup(w http.ResponseWriter, r *http.Request) {
+// RecordAiTriageSuggestionDecision operation middleware
+func (siw *ServerInterfaceWrapper) RecordAiTriageSuggestionDecision(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3013,6 +5380,15 @@ func (siw *ServerInterfaceWrapper) AddProjectGroup(w http.ResponseWriter, r *htt
 		return
 	}
 
+	// ------------- Path parameter "suggestionId" -------------
+	var suggestionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "suggestionId", chi.URLParam(r, "suggestionId"), &suggestionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "suggestionId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3020,7 +5396,7 @@ func (siw *ServerInterfaceWrapper) AddProjectGroup(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddProjectGroup(w, r, projectId)
+		siw.Handler.RecordAiTriageSuggestionDecision(w, r, projectId, suggestionId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3030,8 +5406,8 @@ func (siw *ServerInterfaceWrapper) AddProjectGroup(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteProjectGroup operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProjectGroup(w http.ResponseWriter, r *http.Request) {
+// ListAutomationRules operation middleware
+func (siw *ServerInterfaceWrapper) ListAutomationRules(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3044,15 +5420,6 @@ func (siw *ServerInterfaceWrapper) DeleteProjectGroup(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3060,7 +5427,7 @@ func (siw *ServerInterfaceWrapper) DeleteProjectGroup(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProjectGroup(w, r, projectId, groupId)
+		siw.Handler.ListAutomationRules(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3070,8 +5437,8 @@ func (siw *ServerInterfaceWrapper) DeleteProjectGroup(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateProjectGroup operation middleware
-func (siw *ServerInterfaceWrapper) UpdateProjectGroup(w http.ResponseWriter, r *http.Request) {
+// CreateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) CreateAutomationRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3084,15 +5451,6 @@ func (siw *ServerInterfaceWrapper) UpdateProjectGroup(w http.ResponseWriter, r *
 		return
 	}
 
-	// ------------- Path parameter "groupId" -------------
-	var groupId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3100,7 +5458,7 @@ func (siw *ServerInterfaceWrapper) UpdateProjectGroup(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateProjectGroup(w, r, projectId, groupId)
+		siw.Handler.CreateAutomationRule(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3110,8 +5468,8 @@ func (siw *ServerInterfaceWrapper) UpdateProjectGroup(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// GetMyProjectRole operation middleware
-func (siw *ServerInterfaceWrapper) GetMyProjectRole(w http.ResponseWriter, r *http.Request) {
+// DeleteAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAutomationRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3124,6 +5482,15 @@ func (siw *ServerInterfaceWrapper) GetMyProjectRole(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", chi.URLParam(r, "ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3131,7 +5498,7 @@ func (siw *ServerInterfaceWrapper) GetMyProjectRole(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMyProjectRole(w, r, projectId)
+		siw.Handler.DeleteAutomationRule(w, r, projectId, ruleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3141,8 +5508,8 @@ func (siw *ServerInterfaceWrapper) GetMyProjectRole(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetNotificationPreferences operation middleware
-func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+// GetAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) GetAutomationRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3155,6 +5522,15 @@ func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", chi.URLParam(r, "ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3162,7 +5538,7 @@ func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNotificationPreferences(w, r, projectId)
+		siw.Handler.GetAutomationRule(w, r, projectId, ruleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3172,8 +5548,8 @@ func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateNotificationPreferences operation middleware
-func (siw *ServerInterfaceWrapper) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+// UpdateAutomationRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAutomationRule(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3186,6 +5562,15 @@ func (siw *ServerInterfaceWrapper) UpdateNotificationPreferences(w http.Response
 		return
 	}
 
+	// ------------- Path parameter "ruleId" -------------
+	var ruleId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ruleId", chi.URLParam(r, "ruleId"), &ruleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3193,7 +5578,7 @@ func (siw *ServerInterfaceWrapper) UpdateNotificationPreferences(w http.Response
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateNotificationPreferences(w, r, projectId)
+		siw.Handler.UpdateAutomationRule(w, r, projectId, ruleId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3203,8 +5588,8 @@ func (siw *ServerInterfaceWrapper) UpdateNotificationPreferences(w http.Response
 	handler.ServeHTTP(w, r)
 }
 
-// ListNotifications operation middleware
-func (siw *ServerInterfaceWrapper) ListNotifications(w http.ResponseWriter, r *http.Request) {
+// ListAutomationRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListAutomationRuns(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3224,26 +5609,2860 @@ func (siw *ServerInterfaceWrapper) ListNotifications(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListNotificationsParams
+	var params ListAutomationRunsParams
 
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Optional query parameter "ruleId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindQueryParameter("form", true, false, "ruleId", r.URL.Query(), &params.RuleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAutomationRuns(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBoard operation middleware
+func (siw *ServerInterfaceWrapper) GetBoard(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoard(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListBoardFilterPresets operation middleware
+func (siw *ServerInterfaceWrapper) ListBoardFilterPresets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBoardFilterPresets(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateBoardFilterPreset operation middleware
+func (siw *ServerInterfaceWrapper) CreateBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateBoardFilterPreset(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSharedBoardFilterPreset operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", chi.URLParam(r, "token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSharedBoardFilterPreset(w, r, projectId, token)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBoardFilterPreset operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetId" -------------
+	var presetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardFilterPreset(w, r, projectId, presetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateBoardFilterPreset operation middleware
+func (siw *ServerInterfaceWrapper) UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetId" -------------
+	var presetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateBoardFilterPreset(w, r, projectId, presetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteBoardFilterPresetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetId" -------------
+	var presetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBoardFilterPresetSubscription(w, r, projectId, presetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBoardFilterPresetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetId" -------------
+	var presetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBoardFilterPresetSubscription(w, r, projectId, presetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpsertBoardFilterPresetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpsertBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "presetId" -------------
+	var presetId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "presetId", chi.URLParam(r, "presetId"), &presetId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "presetId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertBoardFilterPresetSubscription(w, r, projectId, presetId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectCapacitySettings operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectCapacitySettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectCapacitySettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceProjectCapacitySettings operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceProjectCapacitySettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceProjectCapacitySettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectDependencyGraph operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectDependencyGraph(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectDependencyGraphParams
+
+	// ------------- Optional query parameter "rootTicketId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rootTicketId", r.URL.Query(), &params.RootTicketId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rootTicketId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectDependencyGraph(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StreamProjectEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamProjectEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamProjectEvents(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectGroups(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddProjectGroup operation middleware
+func (siw *ServerInterfaceWrapper) AddProjectGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddProjectGroup(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectGroup operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectGroup(w, r, projectId, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectGroup operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "groupId", chi.URLParam(r, "groupId"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectGroup(w, r, projectId, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectIncidentAlertSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectIncidentAlertSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectIncidentAlertSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectIncidentAlertSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMyProjectRole operation middleware
+func (siw *ServerInterfaceWrapper) GetMyProjectRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMyProjectRole(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationPreferences(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) UpdateNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateNotificationPreferences(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListNotifications(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListNotificationsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unreadOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unreadOnly", r.URL.Query(), &params.UnreadOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unreadOnly", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNotifications(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkAllNotificationsRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkAllNotificationsRead(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNotificationUnreadCount operation middleware
+func (siw *ServerInterfaceWrapper) GetNotificationUnreadCount(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNotificationUnreadCount(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MarkNotificationRead operation middleware
+func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "notificationId" -------------
+	var notificationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", chi.URLParam(r, "notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notificationId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MarkNotificationRead(w, r, projectId, notificationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectOnCall operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectOnCall(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectOnCallParams
+
+	// ------------- Optional query parameter "at" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "at", r.URL.Query(), &params.At)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "at", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectOnCall(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectOnCallSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectOnCallSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectOnCallSchedules(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectOnCallSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectOnCallSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectOnCallSchedule(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectAgingWip operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectAgingWip(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectAgingWipParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assigneeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "storyId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storyId", r.URL.Query(), &params.StoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectAgingWip(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectCumulativeFlow operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectCumulativeFlow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectCumulativeFlowParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assigneeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "storyId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storyId", r.URL.Query(), &params.StoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectCumulativeFlow(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportProjectReportingSnapshot operation middleware
+func (siw *ServerInterfaceWrapper) ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportProjectReportingSnapshotParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "detail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "detail", r.URL.Query(), &params.Detail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "detail", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportProjectReportingSnapshot(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectIncidentMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectIncidentMetrics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectIncidentMetricsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectIncidentMetrics(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectLeadTimeDistribution operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectLeadTimeDistribution(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectLeadTimeDistributionParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "priority" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "priority", r.URL.Query(), &params.Priority)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "priority", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assigneeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "storyId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storyId", r.URL.Query(), &params.StoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storyId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectLeadTimeDistribution(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectReportingSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectReportingSummary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectReportingSummaryParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectReportingSummary(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSprintForecast operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSprintForecast(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectSprintForecastParams
+
+	// ------------- Optional query parameter "sprintId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sprintId", r.URL.Query(), &params.SprintId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "iterations" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "iterations", r.URL.Query(), &params.Iterations)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "iterations", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "unit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "unit", r.URL.Query(), &params.Unit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSprintForecast(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// WhatIfProjectSprintForecast operation middleware
+func (siw *ServerInterfaceWrapper) WhatIfProjectSprintForecast(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.WhatIfProjectSprintForecast(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSprintVelocity operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSprintVelocity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectSprintVelocityParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSprintVelocity(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProjectSprints operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectSprints(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProjectSprints(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) CreateProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateProjectSprint(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectSprint(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSprint(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectSprint(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListSprintAbsences operation middleware
+func (siw *ServerInterfaceWrapper) ListSprintAbsences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSprintAbsences(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaceSprintAbsences operation middleware
+func (siw *ServerInterfaceWrapper) ReplaceSprintAbsences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaceSprintAbsences(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSprintBurndown operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSprintBurndown(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSprintBurndown(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CompleteProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) CompleteProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CompleteProjectSprint(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectSprintCompletion operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectSprintCompletion(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectSprintCompletion(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// StartProjectSprint operation middleware
+func (siw *ServerInterfaceWrapper) StartProjectSprint(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StartProjectSprint(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RemoveSprintTickets operation middleware
+func (siw *ServerInterfaceWrapper) RemoveSprintTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RemoveSprintTickets(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddSprintTickets operation middleware
+func (siw *ServerInterfaceWrapper) AddSprintTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "sprintId" -------------
+	var sprintId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddSprintTickets(w, r, projectId, sprintId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectStats operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectStats(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectStats(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjectStatusPageSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectStatusPageSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectStatusPageSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateProjectStatusPageSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateProjectStatusPageSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateProjectStatusPageSettings(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStories operation middleware
+func (siw *ServerInterfaceWrapper) ListStories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStories(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateStory operation middleware
+func (siw *ServerInterfaceWrapper) CreateStory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStory(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTicketRecurrences operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketRecurrences(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTicketRecurrences(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTicketRecurrence operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicketRecurrence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTicketRecurrence(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTicketRecurrence operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketRecurrence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recurrenceId" -------------
+	var recurrenceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurrenceId", chi.URLParam(r, "recurrenceId"), &recurrenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurrenceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTicketRecurrence(w, r, projectId, recurrenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTicketRecurrence operation middleware
+func (siw *ServerInterfaceWrapper) GetTicketRecurrence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recurrenceId" -------------
+	var recurrenceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurrenceId", chi.URLParam(r, "recurrenceId"), &recurrenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurrenceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTicketRecurrence(w, r, projectId, recurrenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTicketRecurrence operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicketRecurrence(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "recurrenceId" -------------
+	var recurrenceId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "recurrenceId", chi.URLParam(r, "recurrenceId"), &recurrenceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "recurrenceId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTicketRecurrence(w, r, projectId, recurrenceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTicketTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketTemplates(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTicketTemplates(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTicketTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicketTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTicketTemplate(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteTicketTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTicketTemplate(w, r, projectId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetTicketTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetTicketTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTicketTemplate(w, r, projectId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateTicketTemplate operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicketTemplate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", chi.URLParam(r, "templateId"), &templateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "templateId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateTicketTemplate(w, r, projectId, templateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTickets operation middleware
+func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTicketsParams
+
+	// ------------- Optional query parameter "stateId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stateId", r.URL.Query(), &params.StateId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stateId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "assigneeId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "blocked" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "blocked", r.URL.Query(), &params.Blocked)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blocked", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTickets(w, r, projectId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTicket operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateTicket(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkTicketOperation operation middleware
+func (siw *ServerInterfaceWrapper) BulkTicketOperation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkTicketOperation(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListTicketChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketChanges(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListTicketChangesParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := r.URL.Query().Get("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "since"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "since", r.URL.Query(), &params.Since)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "since", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cursor", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
 		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "unreadOnly" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTicketChanges(w, r, projectId, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "unreadOnly", r.URL.Query(), &params.UnreadOnly)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevertAiTriageAutoApplication operation middleware
+func (siw *ServerInterfaceWrapper) RevertAiTriageAutoApplication(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unreadOnly", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListNotifications(w, r, projectId, params)
+		siw.Handler.RevertAiTriageAutoApplication(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3253,8 +8472,8 @@ func (siw *ServerInterfaceWrapper) ListNotifications(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// MarkAllNotificationsRead operation middleware
-func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWriter, r *http.Request) {
+// ListTicketAttachments operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketAttachments(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3267,6 +8486,15 @@ func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3274,7 +8502,7 @@ func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MarkAllNotificationsRead(w, r, projectId)
+		siw.Handler.ListTicketAttachments(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3284,8 +8512,8 @@ func (siw *ServerInterfaceWrapper) MarkAllNotificationsRead(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// GetNotificationUnreadCount operation middleware
-func (siw *ServerInterfaceWrapper) GetNotificationUnreadCount(w http.ResponseWriter, r *http.Request) {
+// UploadTicketAttachment operation middleware
+func (siw *ServerInterfaceWrapper) UploadTicketAttachment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3298,6 +8526,15 @@ func (siw *ServerInterfaceWrapper) GetNotificationUnreadCount(w http.ResponseWri
 		return
 	}
 
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3305,7 +8542,7 @@ func (siw *ServerInterfaceWrapper) GetNotificationUnreadCount(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetNotificationUnreadCount(w, r, projectId)
+		siw.Handler.UploadTicketAttachment(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3315,8 +8552,8 @@ func (siw *ServerInterfaceWrapper) GetNotificationUnreadCount(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// MarkNotificationRead operation middleware
-func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r *http.Request) {
+// DeleteTicketAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketAttachment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3329,12 +8566,21 @@ func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "notificationId" -------------
-	var notificationId openapi_types.UUID
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "notificationId", chi.URLParam(r, "notificationId"), &notificationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "notificationId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
 		return
 	}
 
@@ -3345,7 +8591,7 @@ func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MarkNotificationRead(w, r, projectId, notificationId)
+		siw.Handler.DeleteTicketAttachment(w, r, projectId, ticketId, attachmentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3355,8 +8601,8 @@ func (siw *ServerInterfaceWrapper) MarkNotificationRead(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ExportProjectReportingSnapshot operation middleware
-func (siw *ServerInterfaceWrapper) ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Request) {
+// DownloadTicketAttachment operation middleware
+func (siw *ServerInterfaceWrapper) DownloadTicketAttachment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3369,41 +8615,112 @@ func (siw *ServerInterfaceWrapper) ExportProjectReportingSnapshot(w http.Respons
 		return
 	}
 
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ExportProjectReportingSnapshotParams
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadTicketAttachment(w, r, projectId, ticketId, attachmentId)
+	}))
 
-	// ------------- Optional query parameter "from" -------------
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	handler.ServeHTTP(w, r)
+}
+
+// ListTicketTimeEntries operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketTimeEntries(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "format" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTicketTimeEntries(w, r, projectId, ticketId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateTicketTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicketTimeEntry(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ExportProjectReportingSnapshot(w, r, projectId, params)
+		siw.Handler.CreateTicketTimeEntry(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3413,8 +8730,8 @@ func (siw *ServerInterfaceWrapper) ExportProjectReportingSnapshot(w http.Respons
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectReportingSummary operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectReportingSummary(w http.ResponseWriter, r *http.Request) {
+// DeleteTicketTimeEntry operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketTimeEntry(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3427,33 +8744,32 @@ func (siw *ServerInterfaceWrapper) GetProjectReportingSummary(w http.ResponseWri
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectReportingSummaryParams
-
-	// ------------- Optional query parameter "from" -------------
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "to" -------------
+	// ------------- Path parameter "timeEntryId" -------------
+	var timeEntryId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	err = runtime.BindStyledParameterWithOptions("simple", "timeEntryId", chi.URLParam(r, "timeEntryId"), &timeEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeEntryId", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectReportingSummary(w, r, projectId, params)
+		siw.Handler.DeleteTicketTimeEntry(w, r, projectId, ticketId, timeEntryId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3463,8 +8779,8 @@ func (siw *ServerInterfaceWrapper) GetProjectReportingSummary(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectSprintForecast operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectSprintForecast(w http.ResponseWriter, r *http.Request) {
+// DiscardTicketTimer operation middleware
+func (siw *ServerInterfaceWrapper) DiscardTicketTimer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3477,33 +8793,23 @@ func (siw *ServerInterfaceWrapper) GetProjectSprintForecast(w http.ResponseWrite
 		return
 	}
 
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
-
-	r = r.WithContext(ctx)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetProjectSprintForecastParams
-
-	// ------------- Optional query parameter "sprintId" -------------
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "sprintId", r.URL.Query(), &params.SprintId)
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "iterations" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "iterations", r.URL.Query(), &params.Iterations)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "iterations", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectSprintForecast(w, r, projectId, params)
+		siw.Handler.DiscardTicketTimer(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3513,8 +8819,8 @@ func (siw *ServerInterfaceWrapper) GetProjectSprintForecast(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// ListProjectSprints operation middleware
-func (siw *ServerInterfaceWrapper) ListProjectSprints(w http.ResponseWriter, r *http.Request) {
+// StartTicketTimer operation middleware
+func (siw *ServerInterfaceWrapper) StartTicketTimer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3527,6 +8833,15 @@ func (siw *ServerInterfaceWrapper) ListProjectSprints(w http.ResponseWriter, r *
 		return
 	}
 
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3534,7 +8849,7 @@ func (siw *ServerInterfaceWrapper) ListProjectSprints(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListProjectSprints(w, r, projectId)
+		siw.Handler.StartTicketTimer(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3544,8 +8859,8 @@ func (siw *ServerInterfaceWrapper) ListProjectSprints(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// CreateProjectSprint operation middleware
-func (siw *ServerInterfaceWrapper) CreateProjectSprint(w http.ResponseWriter, r *http.Request) {
+// StopTicketTimer operation middleware
+func (siw *ServerInterfaceWrapper) StopTicketTimer(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3558,6 +8873,15 @@ func (siw *ServerInterfaceWrapper) CreateProjectSprint(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "ticketId" -------------
+	var ticketId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3565,7 +8889,7 @@ func (siw *ServerInterfaceWrapper) CreateProjectSprint(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProjectSprint(w, r, projectId)
+		siw.Handler.StopTicketTimer(w, r, projectId, ticketId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3575,8 +8899,8 @@ func (siw *ServerInterfaceWrapper) CreateProjectSprint(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// RemoveSprintTickets operation middleware
-func (siw *ServerInterfaceWrapper) RemoveSprintTickets(w http.ResponseWriter, r *http.Request) {
+// GetProjectTimeReport operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTimeReport(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3589,23 +8913,41 @@ func (siw *ServerInterfaceWrapper) RemoveSprintTickets(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "sprintId" -------------
-	var sprintId openapi_types.UUID
+	ctx := r.Context()
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectTimeReportParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	// ------------- Optional query parameter "to" -------------
 
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
 
-	r = r.WithContext(ctx)
+	// ------------- Optional query parameter "groupBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "groupBy", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "groupBy", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RemoveSprintTickets(w, r, projectId, sprintId)
+		siw.Handler.GetProjectTimeReport(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3615,8 +8957,8 @@ func (siw *ServerInterfaceWrapper) RemoveSprintTickets(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// AddSprintTickets operation middleware
-func (siw *ServerInterfaceWrapper) AddSprintTickets(w http.ResponseWriter, r *http.Request) {
+// GetTimeTrackingSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTimeTrackingSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3629,15 +8971,6 @@ func (siw *ServerInterfaceWrapper) AddSprintTickets(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Path parameter "sprintId" -------------
-	var sprintId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "sprintId", chi.URLParam(r, "sprintId"), &sprintId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sprintId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3645,7 +8978,7 @@ func (siw *ServerInterfaceWrapper) AddSprintTickets(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddSprintTickets(w, r, projectId, sprintId)
+		siw.Handler.GetTimeTrackingSettings(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3655,8 +8988,8 @@ func (siw *ServerInterfaceWrapper) AddSprintTickets(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetProjectStats operation middleware
-func (siw *ServerInterfaceWrapper) GetProjectStats(w http.ResponseWriter, r *http.Request) {
+// UpdateTimeTrackingSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTimeTrackingSettings(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3676,7 +9009,7 @@ func (siw *ServerInterfaceWrapper) GetProjectStats(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjectStats(w, r, projectId)
+		siw.Handler.UpdateTimeTrackingSettings(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3686,8 +9019,8 @@ func (siw *ServerInterfaceWrapper) GetProjectStats(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListStories operation middleware
-func (siw *ServerInterfaceWrapper) ListStories(w http.ResponseWriter, r *http.Request) {
+// GetProjectTimeVariance operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTimeVariance(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3706,8 +9039,19 @@ func (siw *ServerInterfaceWrapper) ListStories(w http.ResponseWriter, r *http.Re
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProjectTimeVarianceParams
+
+	// ------------- Optional query parameter "storyId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "storyId", r.URL.Query(), &params.StoryId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "storyId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListStories(w, r, projectId)
+		siw.Handler.GetProjectTimeVariance(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3717,8 +9061,8 @@ func (siw *ServerInterfaceWrapper) ListStories(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateStory operation middleware
-func (siw *ServerInterfaceWrapper) CreateStory(w http.ResponseWriter, r *http.Request) {
+// ListProjectTimers operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectTimers(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3738,7 +9082,7 @@ func (siw *ServerInterfaceWrapper) CreateStory(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateStory(w, r, projectId)
+		siw.Handler.ListProjectTimers(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3748,8 +9092,8 @@ func (siw *ServerInterfaceWrapper) CreateStory(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// ListTickets operation middleware
-func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Request) {
+// GetProjectTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetProjectTimesheet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3769,58 +9113,98 @@ func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListTicketsParams
+	var params GetProjectTimesheetParams
 
-	// ------------- Optional query parameter "stateId" -------------
+	// ------------- Optional query parameter "userId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "stateId", r.URL.Query(), &params.StateId)
+	err = runtime.BindQueryParameter("form", true, false, "userId", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stateId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "assigneeId" -------------
+	// ------------- Optional query parameter "week" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "assigneeId", r.URL.Query(), &params.AssigneeId)
+	err = runtime.BindQueryParameter("form", true, false, "week", r.URL.Query(), &params.Week)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "assigneeId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "week", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "q" -------------
+	// ------------- Optional query parameter "format" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "q", r.URL.Query(), &params.Q)
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "blocked" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjectTimesheet(w, r, projectId, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "blocked", r.URL.Query(), &params.Blocked)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReopenProjectTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) ReopenProjectTimesheet(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "blocked", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	ctx := r.Context()
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ReopenProjectTimesheetParams
+
+	// ------------- Required query parameter "userId" -------------
+
+	if paramValue := r.URL.Query().Get("userId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "userId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "userId", r.URL.Query(), &params.UserId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Required query parameter "week" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if paramValue := r.URL.Query().Get("week"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "week"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "week", r.URL.Query(), &params.Week)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "week", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTickets(w, r, projectId, params)
+		siw.Handler.ReopenProjectTimesheet(w, r, projectId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3830,8 +9214,8 @@ func (siw *ServerInterfaceWrapper) ListTickets(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTicket operation middleware
-func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.Request) {
+// ApproveProjectTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) ApproveProjectTimesheet(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3851,7 +9235,7 @@ func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTicket(w, r, projectId)
+		siw.Handler.ApproveProjectTimesheet(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3861,8 +9245,8 @@ func (siw *ServerInterfaceWrapper) CreateTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// BulkTicketOperation operation middleware
-func (siw *ServerInterfaceWrapper) BulkTicketOperation(w http.ResponseWriter, r *http.Request) {
+// ListWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3882,7 +9266,7 @@ func (siw *ServerInterfaceWrapper) BulkTicketOperation(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.BulkTicketOperation(w, r, projectId)
+		siw.Handler.ListWebhooks(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3892,8 +9276,8 @@ func (siw *ServerInterfaceWrapper) BulkTicketOperation(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketAttachments operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketAttachments(w http.ResponseWriter, r *http.Request) {
+// CreateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3906,15 +9290,6 @@ func (siw *ServerInterfaceWrapper) ListTicketAttachments(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -3922,7 +9297,7 @@ func (siw *ServerInterfaceWrapper) ListTicketAttachments(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketAttachments(w, r, projectId, ticketId)
+		siw.Handler.CreateWebhook(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3932,8 +9307,8 @@ func (siw *ServerInterfaceWrapper) ListTicketAttachments(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// UploadTicketAttachment operation middleware
-func (siw *ServerInterfaceWrapper) UploadTicketAttachment(w http.ResponseWriter, r *http.Request) {
+// DeleteWebhook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -3946,12 +9321,12 @@ func (siw *ServerInterfaceWrapper) UploadTicketAttachment(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -3962,7 +9337,7 @@ func (siw *ServerInterfaceWrapper) UploadTicketAttachment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadTicketAttachment(w, r, projectId, ticketId)
+		siw.Handler.DeleteWebhook(w, r, projectId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -3972,35 +9347,26 @@ func (siw *ServerInterfaceWrapper) UploadTicketAttachment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicketAttachment operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicketAttachment(w http.ResponseWriter, r *http.Request) {
+// GetWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "projectId" -------------
 	var projectId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "attachmentId" -------------
-	var attachmentId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4011,7 +9377,7 @@ func (siw *ServerInterfaceWrapper) DeleteTicketAttachment(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicketAttachment(w, r, projectId, ticketId, attachmentId)
+		siw.Handler.GetWebhook(w, r, projectId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4021,8 +9387,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicketAttachment(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DownloadTicketAttachment operation middleware
-func (siw *ServerInterfaceWrapper) DownloadTicketAttachment(w http.ResponseWriter, r *http.Request) {
+// UpdateWebhook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4035,21 +9401,12 @@ func (siw *ServerInterfaceWrapper) DownloadTicketAttachment(w http.ResponseWrite
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "attachmentId" -------------
-	var attachmentId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "attachmentId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4060,7 +9417,7 @@ func (siw *ServerInterfaceWrapper) DownloadTicketAttachment(w http.ResponseWrite
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DownloadTicketAttachment(w, r, projectId, ticketId, attachmentId)
+		siw.Handler.UpdateWebhook(w, r, projectId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4070,8 +9427,8 @@ func (siw *ServerInterfaceWrapper) DownloadTicketAttachment(w http.ResponseWrite
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketTimeEntries operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketTimeEntries(w http.ResponseWriter, r *http.Request) {
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4084,12 +9441,12 @@ func (siw *ServerInterfaceWrapper) ListTicketTimeEntries(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4100,7 +9457,7 @@ func (siw *ServerInterfaceWrapper) ListTicketTimeEntries(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketTimeEntries(w, r, projectId, ticketId)
+		siw.Handler.ListWebhookDeliveries(w, r, projectId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4110,8 +9467,8 @@ func (siw *ServerInterfaceWrapper) ListTicketTimeEntries(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTicketTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) CreateTicketTimeEntry(w http.ResponseWriter, r *http.Request) {
+// TestWebhook operation middleware
+func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4124,12 +9481,12 @@ func (siw *ServerInterfaceWrapper) CreateTicketTimeEntry(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4140,7 +9497,7 @@ func (siw *ServerInterfaceWrapper) CreateTicketTimeEntry(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTicketTimeEntry(w, r, projectId, ticketId)
+		siw.Handler.TestWebhook(w, r, projectId, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4150,8 +9507,8 @@ func (siw *ServerInterfaceWrapper) CreateTicketTimeEntry(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicketTimeEntry operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicketTimeEntry(w http.ResponseWriter, r *http.Request) {
+// GetWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4164,24 +9521,6 @@ func (siw *ServerInterfaceWrapper) DeleteTicketTimeEntry(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "ticketId" -------------
-	var ticketId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "ticketId", chi.URLParam(r, "ticketId"), &ticketId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ticketId", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "timeEntryId" -------------
-	var timeEntryId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "timeEntryId", chi.URLParam(r, "timeEntryId"), &timeEntryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "timeEntryId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4189,7 +9528,7 @@ func (siw *ServerInterfaceWrapper) DeleteTicketTimeEntry(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicketTimeEntry(w, r, projectId, ticketId, timeEntryId)
+		siw.Handler.GetWorkflow(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4199,8 +9538,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicketTimeEntry(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhooks operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.Request) {
+// UpdateWorkflow operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4220,7 +9559,7 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhooks(w, r, projectId)
+		siw.Handler.UpdateWorkflow(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4230,28 +9569,47 @@ func (siw *ServerInterfaceWrapper) ListWebhooks(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// CreateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.Request) {
+// GetStatusFeed operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusFeed(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
-	ctx := r.Context()
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusFeed(w, r, slug)
+	}))
 
-	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r = r.WithContext(ctx)
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatusFeedAtom operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusFeedAtom(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "slug" -------------
+	var slug string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateWebhook(w, r, projectId)
+		siw.Handler.GetStatusFeedAtom(w, r, slug)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4261,20 +9619,36 @@ func (siw *ServerInterfaceWrapper) CreateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteWebhook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.Request) {
+// GetStatusFeedRss operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusFeedRss(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "slug" -------------
+	var slug string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "slug", chi.URLParam(r, "slug"), &slug, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "slug", Err: err})
 		return
 	}
 
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusFeedRss(w, r, slug)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteStory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteStory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -4291,7 +9665,7 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteWebhook(w, r, projectId, id)
+		siw.Handler.DeleteStory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4301,20 +9675,11 @@ func (siw *ServerInterfaceWrapper) DeleteWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// GetWebhook operation middleware
-func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Request) {
+// GetStory operation middleware
+func (siw *ServerInterfaceWrapper) GetStory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -4331,7 +9696,7 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWebhook(w, r, projectId, id)
+		siw.Handler.GetStory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4341,20 +9706,11 @@ func (siw *ServerInterfaceWrapper) GetWebhook(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWebhook operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.Request) {
+// UpdateStory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -4371,7 +9727,7 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWebhook(w, r, projectId, id)
+		siw.Handler.UpdateStory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4381,20 +9737,11 @@ func (siw *ServerInterfaceWrapper) UpdateWebhook(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListWebhookDeliveries operation middleware
-func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+// DeleteTicket operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -4411,7 +9758,7 @@ func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListWebhookDeliveries(w, r, projectId, id)
+		siw.Handler.DeleteTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4421,20 +9768,11 @@ func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// TestWebhook operation middleware
-func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Request) {
+// GetTicket operation middleware
+func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
-	}
-
 	// ------------- Path parameter "id" -------------
 	var id openapi_types.UUID
 
@@ -4451,7 +9789,7 @@ func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TestWebhook(w, r, projectId, id)
+		siw.Handler.GetTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4461,17 +9799,17 @@ func (siw *ServerInterfaceWrapper) TestWebhook(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Request) {
+// UpdateTicket operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4482,7 +9820,7 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetWorkflow(w, r, projectId)
+		siw.Handler.UpdateTicket(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4492,17 +9830,17 @@ func (siw *ServerInterfaceWrapper) GetWorkflow(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateWorkflow operation middleware
-func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http.Request) {
+// ListTicketActivities operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketActivities(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", chi.URLParam(r, "projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
@@ -4513,7 +9851,7 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateWorkflow(w, r, projectId)
+		siw.Handler.ListTicketActivities(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4523,8 +9861,8 @@ func (siw *ServerInterfaceWrapper) UpdateWorkflow(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteStory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteStory(w http.ResponseWriter, r *http.Request) {
+// ListTicketComments operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketComments(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4544,7 +9882,7 @@ func (siw *ServerInterfaceWrapper) DeleteStory(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteStory(w, r, id)
+		siw.Handler.ListTicketComments(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4554,8 +9892,8 @@ func (siw *ServerInterfaceWrapper) DeleteStory(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// GetStory operation middleware
-func (siw *ServerInterfaceWrapper) GetStory(w http.ResponseWriter, r *http.Request) {
+// AddTicketComment operation middleware
+func (siw *ServerInterfaceWrapper) AddTicketComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4575,7 +9913,7 @@ func (siw *ServerInterfaceWrapper) GetStory(w http.ResponseWriter, r *http.Reque
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetStory(w, r, id)
+		siw.Handler.AddTicketComment(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4585,17 +9923,26 @@ func (siw *ServerInterfaceWrapper) GetStory(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateStory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateStory(w http.ResponseWriter, r *http.Request) {
+// DeleteTicketComment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "id" -------------
-	var id openapi_types.UUID
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", chi.URLParam(r, "id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
 		return
 	}
 
@@ -4606,7 +9953,7 @@ func (siw *ServerInterfaceWrapper) UpdateStory(w http.ResponseWriter, r *http.Re
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateStory(w, r, id)
+		siw.Handler.DeleteTicketComment(w, r, id, commentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4616,8 +9963,8 @@ func (siw *ServerInterfaceWrapper) UpdateStory(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicket operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.Request) {
+// UpdateTicketComment operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicketComment(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4630,6 +9977,15 @@ func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.R
 		return
 	}
 
+	// ------------- Path parameter "commentId" -------------
+	var commentId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4637,7 +9993,7 @@ func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicket(w, r, id)
+		siw.Handler.UpdateTicketComment(w, r, id, commentId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4647,8 +10003,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetTicket operation middleware
-func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Request) {
+// ListTicketDependencies operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketDependencies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4668,7 +10024,7 @@ func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Requ
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTicket(w, r, id)
+		siw.Handler.ListTicketDependencies(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4678,8 +10034,8 @@ func (siw *ServerInterfaceWrapper) GetTicket(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// UpdateTicket operation middleware
-func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.Request) {
+// CreateTicketDependency operation middleware
+func (siw *ServerInterfaceWrapper) CreateTicketDependency(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4699,7 +10055,7 @@ func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateTicket(w, r, id)
+		siw.Handler.CreateTicketDependency(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4709,8 +10065,8 @@ func (siw *ServerInterfaceWrapper) UpdateTicket(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketActivities operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketActivities(w http.ResponseWriter, r *http.Request) {
+// DeleteTicketDependency operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTicketDependency(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4723,6 +10079,15 @@ func (siw *ServerInterfaceWrapper) ListTicketActivities(w http.ResponseWriter, r
 		return
 	}
 
+	// ------------- Path parameter "dependencyId" -------------
+	var dependencyId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "dependencyId", chi.URLParam(r, "dependencyId"), &dependencyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dependencyId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4730,7 +10095,7 @@ func (siw *ServerInterfaceWrapper) ListTicketActivities(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketActivities(w, r, id)
+		siw.Handler.DeleteTicketDependency(w, r, id, dependencyId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4740,8 +10105,8 @@ func (siw *ServerInterfaceWrapper) ListTicketActivities(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketComments operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketComments(w http.ResponseWriter, r *http.Request) {
+// GetTicketIncidentPostmortem operation middleware
+func (siw *ServerInterfaceWrapper) GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4761,7 +10126,7 @@ func (siw *ServerInterfaceWrapper) ListTicketComments(w http.ResponseWriter, r *
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketComments(w, r, id)
+		siw.Handler.GetTicketIncidentPostmortem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4771,8 +10136,8 @@ func (siw *ServerInterfaceWrapper) ListTicketComments(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r)
 }
 
-// AddTicketComment operation middleware
-func (siw *ServerInterfaceWrapper) AddTicketComment(w http.ResponseWriter, r *http.Request) {
+// ListTicketIncidentSeverityHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketIncidentSeverityHistory(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4792,7 +10157,7 @@ func (siw *ServerInterfaceWrapper) AddTicketComment(w http.ResponseWriter, r *ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTicketComment(w, r, id)
+		siw.Handler.ListTicketIncidentSeverityHistory(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4802,8 +10167,8 @@ func (siw *ServerInterfaceWrapper) AddTicketComment(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicketComment operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicketComment(w http.ResponseWriter, r *http.Request) {
+// ListTicketIncidentTimeline operation middleware
+func (siw *ServerInterfaceWrapper) ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4816,15 +10181,6 @@ func (siw *ServerInterfaceWrapper) DeleteTicketComment(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Path parameter "commentId" -------------
-	var commentId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "commentId", chi.URLParam(r, "commentId"), &commentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "commentId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4832,7 +10188,7 @@ func (siw *ServerInterfaceWrapper) DeleteTicketComment(w http.ResponseWriter, r
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicketComment(w, r, id, commentId)
+		siw.Handler.ListTicketIncidentTimeline(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4842,8 +10198,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicketComment(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketDependencies operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketDependencies(w http.ResponseWriter, r *http.Request) {
+// CreateIncidentTimelineAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) CreateIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4863,7 +10219,7 @@ func (siw *ServerInterfaceWrapper) ListTicketDependencies(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketDependencies(w, r, id)
+		siw.Handler.CreateIncidentTimelineAnnotation(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4873,8 +10229,8 @@ func (siw *ServerInterfaceWrapper) ListTicketDependencies(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// CreateTicketDependency operation middleware
-func (siw *ServerInterfaceWrapper) CreateTicketDependency(w http.ResponseWriter, r *http.Request) {
+// DeleteIncidentTimelineAnnotation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4887,6 +10243,15 @@ func (siw *ServerInterfaceWrapper) CreateTicketDependency(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "entryId" -------------
+	var entryId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "entryId", chi.URLParam(r, "entryId"), &entryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "entryId", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4894,7 +10259,7 @@ func (siw *ServerInterfaceWrapper) CreateTicketDependency(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateTicketDependency(w, r, id)
+		siw.Handler.DeleteIncidentTimelineAnnotation(w, r, id, entryId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4904,8 +10269,8 @@ func (siw *ServerInterfaceWrapper) CreateTicketDependency(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// DeleteTicketDependency operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTicketDependency(w http.ResponseWriter, r *http.Request) {
+// GetTicketPostmortem operation middleware
+func (siw *ServerInterfaceWrapper) GetTicketPostmortem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4918,15 +10283,6 @@ func (siw *ServerInterfaceWrapper) DeleteTicketDependency(w http.ResponseWriter,
 		return
 	}
 
-	// ------------- Path parameter "dependencyId" -------------
-	var dependencyId openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "dependencyId", chi.URLParam(r, "dependencyId"), &dependencyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dependencyId", Err: err})
-		return
-	}
-
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, SessionAuthScopes, []string{})
@@ -4934,7 +10290,7 @@ func (siw *ServerInterfaceWrapper) DeleteTicketDependency(w http.ResponseWriter,
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTicketDependency(w, r, id, dependencyId)
+		siw.Handler.GetTicketPostmortem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4944,8 +10300,8 @@ func (siw *ServerInterfaceWrapper) DeleteTicketDependency(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetTicketIncidentPostmortem operation middleware
-func (siw *ServerInterfaceWrapper) GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request) {
+// UpdateTicketPostmortem operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTicketPostmortem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4965,7 +10321,7 @@ func (siw *ServerInterfaceWrapper) GetTicketIncidentPostmortem(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTicketIncidentPostmortem(w, r, id)
+		siw.Handler.UpdateTicketPostmortem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4975,8 +10331,8 @@ func (siw *ServerInterfaceWrapper) GetTicketIncidentPostmortem(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// ListTicketIncidentTimeline operation middleware
-func (siw *ServerInterfaceWrapper) ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request) {
+// CreatePostmortemActionItem operation middleware
+func (siw *ServerInterfaceWrapper) CreatePostmortemActionItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -4996,7 +10352,7 @@ func (siw *ServerInterfaceWrapper) ListTicketIncidentTimeline(w http.ResponseWri
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTicketIncidentTimeline(w, r, id)
+		siw.Handler.CreatePostmortemActionItem(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5191,9 +10547,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/groups/{groupId}/members/{userId}", wrapper.DeleteGroupMember)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/groups/{groupId}/oncall-schedules", wrapper.ListGroupOnCallSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/groups/{groupId}/oncall-schedules", wrapper.CreateGroupOnCallSchedule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.HealthCheck)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/incident-alerts/{token}", wrapper.IngestIncidentAlerts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/oncall-schedules/{scheduleId}", wrapper.DeleteOnCallSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/oncall-schedules/{scheduleId}", wrapper.GetOnCallSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/oncall-schedules/{scheduleId}", wrapper.UpdateOnCallSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/oncall-schedules/{scheduleId}/oncall", wrapper.GetOnCallScheduleShift)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/oncall-schedules/{scheduleId}/overrides", wrapper.CreateOnCallOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/oncall-schedules/{scheduleId}/overrides/{overrideId}", wrapper.DeleteOnCallOverride)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/postmortem-action-items", wrapper.ListPostmortemActionItems)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects", wrapper.ListProjects)
 	})
@@ -5212,6 +10598,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/activities", wrapper.ListProjectActivities)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ai-triage/accuracy", wrapper.GetAiTriageAccuracy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ai-triage/model", wrapper.GetAiTriageModel)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/ai-triage/model/train", wrapper.TrainAiTriageModel)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/ai-triage/settings", wrapper.GetProjectAiTriageSettings)
 	})
@@ -5224,6 +10619,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/projects/{projectId}/ai-triage/suggestions/{suggestionId}/decision", wrapper.RecordAiTriageSuggestionDecision)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/automation-rules", wrapper.ListAutomationRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/automation-rules", wrapper.CreateAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/automation-rules/{ruleId}", wrapper.DeleteAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/automation-rules/{ruleId}", wrapper.GetAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/automation-rules/{ruleId}", wrapper.UpdateAutomationRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/automation-runs", wrapper.ListAutomationRuns)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/board", wrapper.GetBoard)
 	})
@@ -5242,6 +10655,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/projects/{projectId}/board-filters/{presetId}", wrapper.UpdateBoardFilterPreset)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/board-filters/{presetId}/subscription", wrapper.DeleteBoardFilterPresetSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/board-filters/{presetId}/subscription", wrapper.GetBoardFilterPresetSubscription)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/projects/{projectId}/board-filters/{presetId}/subscription", wrapper.UpsertBoardFilterPresetSubscription)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/capacity-settings", wrapper.ListProjectCapacitySettings)
 	})
@@ -5266,6 +10688,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Patch(options.BaseURL+"/projects/{projectId}/groups/{groupId}", wrapper.UpdateProjectGroup)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/incident-alerts/settings", wrapper.GetProjectIncidentAlertSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/incident-alerts/settings", wrapper.UpdateProjectIncidentAlertSettings)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/my-role", wrapper.GetMyProjectRole)
 	})
@@ -5287,21 +10715,75 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/projects/{projectId}/notifications/{notificationId}/read", wrapper.MarkNotificationRead)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/oncall", wrapper.GetProjectOnCall)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/oncall-schedules", wrapper.ListProjectOnCallSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/oncall-schedules", wrapper.CreateProjectOnCallSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/reporting/aging-wip", wrapper.GetProjectAgingWip)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/reporting/cumulative-flow", wrapper.GetProjectCumulativeFlow)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/reporting/export", wrapper.ExportProjectReportingSnapshot)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/reporting/incidents", wrapper.GetProjectIncidentMetrics)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/reporting/lead-time", wrapper.GetProjectLeadTimeDistribution)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/reporting/summary", wrapper.GetProjectReportingSummary)
 	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/sprint-forecast", wrapper.GetProjectSprintForecast)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/sprint-forecast/what-if", wrapper.WhatIfProjectSprintForecast)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/sprint-velocity", wrapper.GetProjectSprintVelocity)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/sprints", wrapper.ListProjectSprints)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/projects/{projectId}/sprints", wrapper.CreateProjectSprint)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}", wrapper.DeleteProjectSprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}", wrapper.GetProjectSprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}", wrapper.UpdateProjectSprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/absences", wrapper.ListSprintAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/absences", wrapper.ReplaceSprintAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/burndown", wrapper.GetProjectSprintBurndown)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/complete", wrapper.CompleteProjectSprint)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/completion", wrapper.GetProjectSprintCompletion)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/start", wrapper.StartProjectSprint)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/projects/{projectId}/sprints/{sprintId}/tickets", wrapper.RemoveSprintTickets)
 	})
@@ -5311,12 +10793,48 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/stats", wrapper.GetProjectStats)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/status-page", wrapper.GetProjectStatusPageSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/status-page", wrapper.UpdateProjectStatusPageSettings)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/stories", wrapper.ListStories)
 	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/projects/{projectId}/stories", wrapper.CreateStory)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ticket-recurrences", wrapper.ListTicketRecurrences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/ticket-recurrences", wrapper.CreateTicketRecurrence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/ticket-recurrences/{recurrenceId}", wrapper.DeleteTicketRecurrence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ticket-recurrences/{recurrenceId}", wrapper.GetTicketRecurrence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/ticket-recurrences/{recurrenceId}", wrapper.UpdateTicketRecurrence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ticket-templates", wrapper.ListTicketTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/ticket-templates", wrapper.CreateTicketTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/ticket-templates/{templateId}", wrapper.DeleteTicketTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/ticket-templates/{templateId}", wrapper.GetTicketTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/ticket-templates/{templateId}", wrapper.UpdateTicketTemplate)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/tickets", wrapper.ListTickets)
 	})
@@ -5326,6 +10844,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/projects/{projectId}/tickets/bulk", wrapper.BulkTicketOperation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/tickets/changes", wrapper.ListTicketChanges)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/ai-triage/revert", wrapper.RevertAiTriageAutoApplication)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/attachments", wrapper.ListTicketAttachments)
 	})
@@ -5347,6 +10871,39 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/time-entries/{timeEntryId}", wrapper.DeleteTicketTimeEntry)
 	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/timer", wrapper.DiscardTicketTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/timer", wrapper.StartTicketTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/projects/{projectId}/tickets/{ticketId}/timer/stop", wrapper.StopTicketTimer)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/time-report", wrapper.GetProjectTimeReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/time-tracking-settings", wrapper.GetTimeTrackingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/projects/{projectId}/time-tracking-settings", wrapper.UpdateTimeTrackingSettings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/time-variance", wrapper.GetProjectTimeVariance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/timers", wrapper.ListProjectTimers)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/projects/{projectId}/timesheets", wrapper.GetProjectTimesheet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/projects/{projectId}/timesheets/approval", wrapper.ReopenProjectTimesheet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/projects/{projectId}/timesheets/approval", wrapper.ApproveProjectTimesheet)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/projects/{projectId}/webhooks", wrapper.ListWebhooks)
 	})
@@ -5374,6 +10931,15 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/projects/{projectId}/workflow", wrapper.UpdateWorkflow)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status/{slug}", wrapper.GetStatusFeed)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status/{slug}/feed.atom", wrapper.GetStatusFeedAtom)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/status/{slug}/feed.rss", wrapper.GetStatusFeedRss)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/stories/{id}", wrapper.DeleteStory)
 	})
@@ -5404,6 +10970,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/tickets/{id}/comments/{commentId}", wrapper.DeleteTicketComment)
 	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/tickets/{id}/comments/{commentId}", wrapper.UpdateTicketComment)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/tickets/{id}/dependencies", wrapper.ListTicketDependencies)
 	})
@@ -5416,9 +10985,27 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/tickets/{id}/incident-postmortem", wrapper.GetTicketIncidentPostmortem)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tickets/{id}/incident-severity-history", wrapper.ListTicketIncidentSeverityHistory)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/tickets/{id}/incident-timeline", wrapper.ListTicketIncidentTimeline)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tickets/{id}/incident-timeline", wrapper.CreateIncidentTimelineAnnotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/tickets/{id}/incident-timeline/{entryId}", wrapper.DeleteIncidentTimelineAnnotation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/tickets/{id}/postmortem", wrapper.GetTicketPostmortem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/tickets/{id}/postmortem", wrapper.UpdateTicketPostmortem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/tickets/{id}/postmortem/action-items", wrapper.CreatePostmortemActionItem)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.ListUsers)
 	})
@@ -18,6 +18,7 @@ import (
 	"ticketing-system/backend/internal/webhook"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -51,10 +52,13 @@ type Store interface {
 	DeleteStory(ctx context.Context, id uuid.UUID) error
 	ListComments(ctx context.Context, ticketID uuid.UUID) ([]store.Comment, error)
 	CreateComment(ctx context.Context, ticketID uuid.UUID, input store.CommentCreateInput) (store.Comment, error)
+	SetCommentPublic(ctx context.Context, ticketID, commentID uuid.UUID, public bool) (store.Comment, error)
 	DeleteComment(ctx context.Context, id uuid.UUID) error
 	ListWorkflowStates(ctx context.Context, projectID uuid.UUID) ([]store.WorkflowState, error)
 	ReplaceWorkflowStates(ctx context.Context, projectID uuid.UUID, inputs []store.WorkflowStateInput) ([]store.WorkflowState, error)
 	ListTickets(ctx context.Context, filter store.TicketFilter) ([]store.Ticket, int, error)
+	ListTicketsAfter(ctx context.Context, filter store.TicketFilter, cursor string) ([]store.Ticket, *string, error)
+	ListTicketChanges(ctx context.Context, projectID uuid.UUID, since time.Time, cursor string, limit int) (store.TicketChanges, error)
 	ListTicketsForBoard(ctx context.Context, projectID uuid.UUID) ([]store.Ticket, error)
 	GetTicket(ctx context.Context, id uuid.UUID) (store.Ticket, error)
 	ListTicketDependencies(ctx context.Context, projectID, ticketID uuid.UUID) ([]store.TicketDependency, error)
@@ -63,6 +67,7 @@ type Store interface {
 	DeleteTicketDependency(ctx context.Context, dependencyID, projectID, ticketID uuid.UUID) error
 	GetTicketDependencyGraph(ctx context.Context, projectID uuid.UUID, rootTicketID *uuid.UUID, depth int) (store.TicketDependencyGraph, error)
 	CreateTicket(ctx context.Context, projectID uuid.UUID, input store.TicketCreateInput) (store.Ticket, error)
+	CreateTicketWithSubtasks(ctx context.Context, projectID uuid.UUID, input store.TicketCreateInput, subtasks []store.TicketCreateInput, createdBy *uuid.UUID) (store.Ticket, []store.Ticket, error)
 	UpdateTicket(ctx context.Context, id uuid.UUID, input store.TicketUpdateInput) (store.Ticket, error)
 	DeleteTicket(ctx context.Context, id uuid.UUID) error
 	GetProjectStats(ctx context.Context, projectID uuid.UUID) (store.ProjectStats, error)
@@ -71,15 +76,70 @@ type Store interface {
 	GetSprint(ctx context.Context, projectID, sprintID uuid.UUID) (store.Sprint, error)
 	AddSprintTickets(ctx context.Context, projectID, sprintID uuid.UUID, ticketIDs []uuid.UUID) (store.Sprint, error)
 	RemoveSprintTickets(ctx context.Context, projectID, sprintID uuid.UUID, ticketIDs []uuid.UUID) (store.Sprint, error)
+	UpdateSprint(ctx context.Context, projectID, sprintID uuid.UUID, input store.SprintUpdateInput) (store.Sprint, error)
+	DeleteSprint(ctx context.Context, projectID, sprintID uuid.UUID) error
+	StartSprint(ctx context.Context, projectID, sprintID uuid.UUID) (store.Sprint, error)
+	CompleteSprint(ctx context.Context, projectID, sprintID uuid.UUID, input store.SprintCompleteInput) (store.SprintCompletion, error)
+	GetSprintCompletion(ctx context.Context, projectID, sprintID uuid.UUID) (store.SprintCompletion, error)
+	GetSprintBurndown(ctx context.Context, projectID, sprintID uuid.UUID) (store.SprintBurndown, error)
+	ListSprintVelocity(ctx context.Context, projectID uuid.UUID, limit int) (store.SprintVelocityHistory, error)
 	ListCapacitySettings(ctx context.Context, projectID uuid.UUID) ([]store.CapacitySetting, error)
 	ReplaceCapacitySettings(ctx context.Context, projectID uuid.UUID, inputs []store.CapacitySettingInput) ([]store.CapacitySetting, error)
-	GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, sprintID *uuid.UUID, iterations int) (store.SprintForecastSummary, error)
+	GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, input store.SprintForecastInput) (store.SprintForecastSummary, error)
+	ListSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID) ([]store.SprintAbsence, error)
+	ReplaceSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID, inputs []store.SprintAbsenceInput) ([]store.SprintAbsence, error)
 	GetAiTriageSettings(ctx context.Context, projectID uuid.UUID) (store.AiTriageSettings, error)
-	UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, enabled bool) (store.AiTriageSettings, error)
+	UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, settings store.AiTriageSettings) (store.AiTriageSettings, error)
 	CreateAiTriageSuggestion(ctx context.Context, projectID uuid.UUID, input store.AiTriageSuggestionCreateInput) (store.AiTriageSuggestion, error)
 	GetAiTriageSuggestion(ctx context.Context, projectID, suggestionID uuid.UUID) (store.AiTriageSuggestion, error)
 	CreateAiTriageSuggestionDecision(ctx context.Context, projectID, suggestionID uuid.UUID, input store.AiTriageSuggestionDecisionCreateInput) (store.AiTriageSuggestionDecision, error)
+	ListAiTriageTrainingTickets(ctx context.Context, projectID uuid.UUID, limit int) ([]store.AiTriageTrainingTicket, error)
+	ListAiTriageFeedback(ctx context.Context, projectID uuid.UUID, limit int) ([]store.AiTriageFeedback, error)
+	GetAiTriageModel(ctx context.Context, projectID uuid.UUID) (store.AiTriageModel, error)
+	GetAiTriageModelTrainedAt(ctx context.Context, projectID uuid.UUID) (time.Time, error)
+	SaveAiTriageModel(ctx context.Context, projectID uuid.UUID, model json.RawMessage, exampleCount, feedbackCount int) (store.AiTriageModel, error)
+	ClaimDueAiTriageModels(ctx context.Context, maxAge time.Duration, limit int) ([]uuid.UUID, error)
+	CreateAiTriageAutoApplication(ctx context.Context, projectID, ticketID, suggestionID uuid.UUID, fields []store.AiTriageAppliedField) (store.AiTriageAutoApplication, error)
+	GetAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID) (store.AiTriageAutoApplication, error)
+	RevertAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID, restore func(store.AiTriageAutoApplication, store.Ticket) (store.TicketUpdateInput, bool)) (store.AiTriageAutoApplication, store.Ticket, store.Ticket, error)
+	FindDuplicateTickets(ctx context.Context, projectID uuid.UUID, title, description string, excludeID *uuid.UUID) ([]store.DuplicateCandidate, error)
+	GetAiTriageAccuracy(ctx context.Context, projectID uuid.UUID, from, to time.Time, field *string) (store.AiTriageAccuracyReport, error)
+	CreateIncidentSeverityChange(ctx context.Context, ticketID uuid.UUID, from, to *string, actorID *uuid.UUID) (store.IncidentSeverityChange, error)
+	ListIncidentSeverityChanges(ctx context.Context, ticketID uuid.UUID) ([]store.IncidentSeverityChange, error)
+	GetIncidentMetrics(ctx context.Context, projectID uuid.UUID, from, to time.Time) (store.IncidentMetricsReport, error)
+	ListIncidentTimelineEntries(ctx context.Context, ticketID uuid.UUID) ([]store.IncidentTimelineEntry, error)
+	CreateIncidentTimelineEntry(ctx context.Context, ticketID uuid.UUID, input store.IncidentTimelineEntryCreateInput) (store.IncidentTimelineEntry, error)
+	DeleteIncidentTimelineAnnotation(ctx context.Context, ticketID, entryID uuid.UUID) error
+	FindIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string) (uuid.UUID, error)
+	DeclareIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string, input store.TicketCreateInput) (store.Ticket, bool, error)
+	GetIncidentAlertSettings(ctx context.Context, projectID uuid.UUID) (store.IncidentAlertSettings, error)
+	GetIncidentAlertSettingsByToken(ctx context.Context, token string) (store.IncidentAlertSettings, error)
+	UpdateIncidentAlertSettings(ctx context.Context, projectID uuid.UUID, input store.IncidentAlertSettingsUpdateInput) (store.IncidentAlertSettings, error)
+	GetIncidentPostmortem(ctx context.Context, ticketID uuid.UUID) (store.IncidentPostmortem, error)
+	GetStatusPageSettings(ctx context.Context, projectID uuid.UUID) (store.StatusPageSettings, error)
+	GetStatusPageBySlug(ctx context.Context, slug string) (store.StatusPageSettings, error)
+	UpdateStatusPageSettings(ctx context.Context, projectID uuid.UUID, input store.StatusPageSettingsUpdateInput) (store.StatusPageSettings, error)
+	ListStatusPageIncidents(ctx context.Context, projectID uuid.UUID, limit int) ([]store.StatusPageIncident, error)
+	GetOnCallSchedule(ctx context.Context, id uuid.UUID) (store.OnCallSchedule, error)
+	ListOnCallSchedulesForProject(ctx context.Context, projectID uuid.UUID) ([]store.OnCallSchedule, error)
+	ListOnCallSchedulesForGroup(ctx context.Context, groupID uuid.UUID) ([]store.OnCallSchedule, error)
+	CreateOnCallSchedule(ctx context.Context, input store.OnCallScheduleInput) (store.OnCallSchedule, error)
+	UpdateOnCallSchedule(ctx context.Context, id uuid.UUID, input store.OnCallScheduleInput) (store.OnCallSchedule, error)
+	DeleteOnCallSchedule(ctx context.Context, id uuid.UUID) error
+	ListOnCallOverrides(ctx context.Context, scheduleID uuid.UUID, since time.Time) ([]store.OnCallOverride, error)
+	CreateOnCallOverride(ctx context.Context, scheduleID uuid.UUID, input store.OnCallOverrideCreateInput) (store.OnCallOverride, error)
+	DeleteOnCallOverride(ctx context.Context, scheduleID, overrideID uuid.UUID) error
+	GetOnCallShift(ctx context.Context, sched store.OnCallSchedule, at time.Time) (store.OnCallShift, bool, error)
+	ListProjectOnCall(ctx context.Context, projectID uuid.UUID, at time.Time) ([]store.OnCallShift, error)
+	SaveIncidentPostmortem(ctx context.Context, ticketID uuid.UUID, input store.IncidentPostmortemUpdateInput) (store.IncidentPostmortem, error)
+	CreatePostmortemActionItem(ctx context.Context, projectID, incidentTicketID uuid.UUID, input store.TicketCreateInput, createdBy *uuid.UUID) (store.Ticket, store.PostmortemActionItem, error)
+	ListPostmortemActionItems(ctx context.Context, incidentTicketID uuid.UUID) ([]store.PostmortemActionItem, error)
+	ListPostmortemActionItemsForProjects(ctx context.Context, projectIDs []uuid.UUID, includeClosed bool, limit int) ([]store.PostmortemActionItem, error)
 	GetProjectReportingSummary(ctx context.Context, projectID uuid.UUID, from, to time.Time) (store.ProjectReportingSummary, error)
+	GetProjectCumulativeFlow(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter store.ReportingFilter) (store.CumulativeFlowReport, error)
+	GetProjectLeadTimeDistribution(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter store.ReportingFilter) (store.LeadTimeReport, error)
+	GetProjectAgingWip(ctx context.Context, projectID uuid.UUID, filter store.ReportingFilter) (store.AgingWipReport, error)
+	StreamReportingTicketDetails(ctx context.Context, projectID uuid.UUID, from, to time.Time, fn func(store.ReportingTicketDetail) error) error
 	ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]store.Webhook, error)
 	GetWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (store.Webhook, error)
 	CreateWebhook(ctx context.Context, projectID uuid.UUID, input store.WebhookCreateInput) (store.Webhook, error)
@@ -103,14 +163,60 @@ type Store interface {
 	MarkAllNotificationsRead(ctx context.Context, projectID, userID uuid.UUID) (int, error)
 	GetNotificationPreferences(ctx context.Context, userID uuid.UUID) (store.NotificationPreferences, error)
 	UpdateNotificationPreferences(ctx context.Context, userID uuid.UUID, input store.NotificationPreferencesUpdateInput) (store.NotificationPreferences, error)
-	ListBoardFilterPresets(ctx context.Context, projectID, ownerID uuid.UUID) ([]store.BoardFilterPreset, error)
+	ListBoardFilterPresets(ctx context.Context, projectID, userID uuid.UUID) ([]store.BoardFilterPreset, error)
 	CreateBoardFilterPreset(ctx context.Context, projectID, ownerID uuid.UUID, input store.BoardFilterPresetCreateInput) (store.BoardFilterPreset, error)
-	UpdateBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID, input store.BoardFilterPresetUpdateInput) (store.BoardFilterPreset, error)
-	DeleteBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID) error
+	UpdateBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID, input store.BoardFilterPresetUpdateInput) (store.BoardFilterPreset, error)
+	DeleteBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID) error
 	GetSharedBoardFilterPreset(ctx context.Context, projectID uuid.UUID, token string) (store.BoardFilterPreset, error)
+	GetBoardFilterPreset(ctx context.Context, projectID, userID, presetID uuid.UUID) (store.BoardFilterPreset, error)
+	GetBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) (store.BoardFilterPresetSubscription, error)
+	UpsertBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID, input store.BoardFilterPresetSubscriptionInput) (store.BoardFilterPresetSubscription, error)
+	DeleteBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) error
+	ClaimDuePresetSubscriptions(ctx context.Context, limit int) ([]store.DuePresetSubscription, error)
+	RecordPresetSubscriptionMatches(ctx context.Context, subscriptionID uuid.UUID, matches []store.BoardFilterMatch, truncated bool) error
+	MarkPresetSubscriptionEvaluated(ctx context.Context, subscriptionID uuid.UUID) error
+	ListBoardFilterMatches(ctx context.Context, projectID uuid.UUID, filter store.BoardFilter, limit int) ([]store.BoardFilterMatch, error)
+	ListTicketTemplates(ctx context.Context, projectID uuid.UUID) ([]store.TicketTemplate, error)
+	GetTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) (store.TicketTemplate, error)
+	CreateTicketTemplate(ctx context.Context, projectID uuid.UUID, input store.TicketTemplateCreateInput) (store.TicketTemplate, error)
+	UpdateTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID, input store.TicketTemplateUpdateInput) (store.TicketTemplate, error)
+	DeleteTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) error
+	ListTicketRecurrences(ctx context.Context, projectID uuid.UUID) ([]store.TicketRecurrence, error)
+	GetTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) (store.TicketRecurrence, error)
+	CreateTicketRecurrence(ctx context.Context, projectID uuid.UUID, input store.TicketRecurrenceCreateInput) (store.TicketRecurrence, error)
+	UpdateTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID, input store.TicketRecurrenceUpdateInput) (store.TicketRecurrence, error)
+	DeleteTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) error
+	ClaimDueTicketRecurrences(ctx context.Context, limit int) ([]store.TicketRecurrence, error)
+	CreateRecurringTicket(ctx context.Context, rec store.TicketRecurrence, input store.TicketCreateInput, subtasks []store.TicketCreateInput, nextRunAt *time.Time) (store.Ticket, []store.Ticket, error)
+	FailTicketRecurrenceRun(ctx context.Context, recurrenceID uuid.UUID, message string) error
+	NextRecurrenceAssignee(ctx context.Context, groupID uuid.UUID, previous *uuid.UUID) (*uuid.UUID, error)
+	ActiveSprintID(ctx context.Context, projectID uuid.UUID, on time.Time) (*uuid.UUID, error)
+	ListAutomationRules(ctx context.Context, projectID uuid.UUID) ([]store.AutomationRule, error)
+	ListAutomationRulesForTrigger(ctx context.Context, projectID uuid.UUID, trigger string) ([]store.AutomationRule, error)
+	GetAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) (store.AutomationRule, error)
+	CreateAutomationRule(ctx context.Context, projectID uuid.UUID, input store.AutomationRuleCreateInput) (store.AutomationRule, error)
+	UpdateAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID, input store.AutomationRuleUpdateInput) (store.AutomationRule, error)
+	DeleteAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) error
+	ClaimDueAutomationRules(ctx context.Context, limit int) ([]store.AutomationRule, error)
+	CompleteScheduledAutomationRule(ctx context.Context, ruleID uuid.UUID, nextRunAt *time.Time) error
+	CreateAutomationRun(ctx context.Context, input store.AutomationRunCreateInput) (store.AutomationRun, error)
+	ListAutomationRuns(ctx context.Context, filter store.AutomationRunFilter) ([]store.AutomationRun, error)
 	ListTimeEntries(ctx context.Context, ticketID uuid.UUID) ([]store.TimeEntry, int, error)
 	CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input store.TimeEntryCreateInput) (store.TimeEntry, error)
 	DeleteTimeEntry(ctx context.Context, id uuid.UUID) error
+	GetTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) (store.Timesheet, error)
+	ApproveTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time, approvedBy *uuid.UUID, approvedByName string) (store.TimesheetApproval, error)
+	ReopenTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) error
+	GetTimeReport(ctx context.Context, projectID uuid.UUID, from, to time.Time, groupBy string) (store.TimeReport, error)
+	GetTimeVariance(ctx context.Context, projectID uuid.UUID, storyID *uuid.UUID) (store.TimeVarianceReport, error)
+	ListTimeEntryTimers(ctx context.Context, projectID, userID uuid.UUID) ([]store.TimeEntryTimer, error)
+	StartTimeEntryTimer(ctx context.Context, projectID, ticketID uuid.UUID, input store.TimeEntryTimerStartInput) (store.TimeEntryTimer, error)
+	StopTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID, description *string) (store.TimeEntry, error)
+	DiscardTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID) error
+	AutoStopTimeEntryTimers(ctx context.Context, ticketID uuid.UUID) ([]store.TimeEntry, error)
+	ClaimIdleTimeEntryTimers(ctx context.Context, limit int) ([]store.TimeEntryTimer, error)
+	GetTimeTrackingSettings(ctx context.Context, projectID uuid.UUID) (store.TimeTrackingSettings, error)
+	UpdateTimeTrackingSettings(ctx context.Context, projectID uuid.UUID, input store.TimeTrackingSettingsUpdateInput) (store.TimeTrackingSettings, error)
 }
 
 type Authenticator interface {
@@ -143,6 +249,12 @@ type API struct {
 	defaultProjectDescription *string
 	defaultProjectCreatedAt   time.Time
 	defaultProjectUpdatedAt   time.Time
+	triageAPIKey              string
+	triageAllowedOrigins      []string
+	triageClient              *http.Client
+	triagePublicClient        *http.Client
+	triageModels              *triageModelCache
+	publicBaseURL             string
 }
 
 func NewHandler(st Store, authClient Authenticator, webhookDispatcher WebhookDispatcher, opts HandlerOptions) *API {
@@ -178,6 +290,11 @@ func NewHandler(st Store, authClient Authenticator, webhookDispatcher WebhookDis
 		maxUpload = 10 << 20 // 10 MB
 	}
 
+	triageClient := opts.TriageClient
+	if triageClient == nil {
+		triageClient = &http.Client{}
+	}
+
 	now := time.Now()
 
 	return &API{
@@ -197,6 +314,12 @@ func NewHandler(st Store, authClient Authenticator, webhookDispatcher WebhookDis
 		defaultProjectDescription: opts.DefaultProjectDescription,
 		defaultProjectCreatedAt:   now,
 		defaultProjectUpdatedAt:   now,
+		triageAPIKey:              opts.TriageAPIKey,
+		triageAllowedOrigins:      triageOrigins(opts.TriageAllowedOrigins),
+		triageClient:              triageClient,
+		triagePublicClient:        newPublicTriageClient(),
+		triageModels:              newTriageModelCache(),
+		publicBaseURL:             strings.TrimSuffix(opts.PublicBaseURL, "/"),
 	}
 }
 
@@ -211,6 +334,23 @@ type HandlerOptions struct {
 	DefaultProjectDescription *string
 	BlobStore                 blob.ObjectStore
 	MaxUploadSize             int64
+	// TriageAPIKey is sent as a bearer token to http triage providers whose
+	// origin is in TriageAllowedOrigins and that have no key of their own.
+	TriageAPIKey string
+	// TriageAllowedOrigins lists the scheme and host, with an optional port,
+	// of endpoints the operator trusts. Only these receive TriageAPIKey and
+	// may use plain http or private addresses; project admins choose the
+	// endpoint, so any other must be https on a public address. An entry
+	// without a scheme means https.
+	TriageAllowedOrigins []string
+	// TriageClient calls trusted http triage providers. Defaults to a plain
+	// client; request timeouts come from each project's settings.
+	TriageClient *http.Client
+	// PublicBaseURL is the external URL the API is served at, including any
+	// base path. Status feeds build their links from it instead of the
+	// request's Host and forwarding headers, since the feeds are publicly
+	// cached. When empty the links are paths relative to the server.
+	PublicBaseURL string
 }
 
 func (h *API) projectFor(projectID openapi_types.UUID) Project {
@@ -725,12 +865,54 @@ func (h *API) ListTickets(w http.ResponseWriter, r *http.Request, projectId open
 		filter.Blocked = params.Blocked
 	}
 
+	if params.Cursor != nil {
+		tickets, next, err := h.store.ListTicketsAfter(r.Context(), filter, *params.Cursor)
+		if errors.Is(err, store.ErrInvalidCursor) {
+			writeError(w, http.StatusBadRequest, "invalid_cursor", "cursor is invalid")
+			return
+		}
+		if handleListError(w, r, err, "tickets", "ticket_list") {
+			return
+		}
+
+		writeJSON(w, http.StatusOK, ticketListResponse{Items: mapSlice(tickets, mapTicket), NextCursor: next})
+		return
+	}
+
 	tickets, total, err := h.store.ListTickets(r.Context(), filter)
 	if handleListError(w, r, err, "tickets", "ticket_list") {
 		return
 	}
 
-	writeJSON(w, http.StatusOK, ticketListResponse{Items: mapSlice(tickets, mapTicket), Total: total})
+	resp := ticketListResponse{Items: mapSlice(tickets, mapTicket), Total: &total}
+	if len(tickets) > 0 && filter.Offset+len(tickets) < total {
+		next := store.TicketCursor(tickets[len(tickets)-1])
+		resp.NextCursor = &next
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func (h *API) ListTicketChanges(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListTicketChangesParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	changes, err := h.store.ListTicketChanges(r.Context(), projectUUID, params.Since, derefString(params.Cursor), derefInt(params.Limit, 200))
+	if errors.Is(err, store.ErrInvalidCursor) {
+		writeError(w, http.StatusBadRequest, "invalid_cursor", "cursor is invalid")
+		return
+	}
+	if handleListError(w, r, err, "ticket changes", "ticket_changes") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ticketChangesResponse{
+		Items:      mapSlice(changes.Items, mapTicket),
+		Deleted:    mapSlice(changes.Deleted, mapTicketDeletion),
+		NextCursor: changes.NextCursor,
+		SyncedAt:   changes.SyncedAt,
+	})
 }
 
 func (h *API) CreateTicket(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
@@ -783,24 +965,59 @@ func (h *API) CreateTicket(w http.ResponseWriter, r *http.Request, projectId ope
 		return
 	}
 
-	ticket, err := h.store.CreateTicket(r.Context(), projectUUID, store.TicketCreateInput{
-		Title:               req.Title,
-		Description:         derefString(req.Description),
-		Type:                ticketType,
-		StoryID:             storyID,
-		StateID:             stateID,
-		AssigneeID:          assigneeID,
-		Priority:            priority,
-		IncidentEnabled:     req.IncidentEnabled != nil && *req.IncidentEnabled,
-		IncidentSeverity:    mapStringPtr(req.IncidentSeverity, func(v TicketIncidentSeverity) string { return string(v) }),
-		IncidentImpact:      req.IncidentImpact,
-		IncidentCommanderID: parseOpenapiUUIDPtr(req.IncidentCommanderId),
-		StoryPoints:         req.StoryPoints,
-		TimeEstimate:        req.TimeEstimate,
-	})
+	input := store.TicketCreateInput{
+		Title:                   req.Title,
+		Description:             derefString(req.Description),
+		Type:                    ticketType,
+		StoryID:                 storyID,
+		StateID:                 stateID,
+		AssigneeID:              assigneeID,
+		Priority:                priority,
+		IncidentEnabled:         req.IncidentEnabled != nil && *req.IncidentEnabled,
+		IncidentSeverity:        mapStringPtr(req.IncidentSeverity, func(v TicketIncidentSeverity) string { return string(v) }),
+		IncidentImpact:          req.IncidentImpact,
+		IncidentCommanderID:     parseOpenapiUUIDPtr(req.IncidentCommanderId),
+		IncidentScribeID:        parseOpenapiUUIDPtr(req.IncidentScribeId),
+		IncidentCommsLeadID:     parseOpenapiUUIDPtr(req.IncidentCommsLeadId),
+		IncidentCustomerVisible: req.IncidentCustomerVisible != nil && *req.IncidentCustomerVisible,
+		StoryPoints:             req.StoryPoints,
+		TimeEstimate:            req.TimeEstimate,
+	}
+
+	var template *store.TicketTemplate
+	projectKey := ""
+	now := time.Now().UTC()
+	if req.TemplateId != nil {
+		tmpl, key, err := h.loadTicketTemplate(r.Context(), projectUUID, uuid.UUID(*req.TemplateId))
+		if errors.Is(err, pgx.ErrNoRows) {
+			writeError(w, http.StatusBadRequest, "invalid_template", "templateId does not reference a template in this project")
+			return
+		}
+		if handleDBError(w, r, err, "ticket template", "ticket_create_template") {
+			return
+		}
+		applyTicketTemplate(&input, req, tmpl, key, now)
+		template = &tmpl
+		projectKey = key
+	}
+
+	var ticket store.Ticket
+	var subtasks []store.Ticket
+	if template != nil {
+		var createdBy *uuid.UUID
+		if actorID, _, ok := currentActor(r); ok {
+			createdBy = &actorID
+		}
+		subtaskInputs := templateSubtaskInputs(input.StoryID, *template, req.Title, projectKey, now)
+		ticket, subtasks, err = h.store.CreateTicketWithSubtasks(r.Context(), projectUUID, input, subtaskInputs, createdBy)
+	} else {
+		ticket, err = h.store.CreateTicket(r.Context(), projectUUID, input)
+	}
 	if handleDBErrorWithCode(w, r, err, "ticket", "ticket_create", "ticket_create_failed") {
 		return
 	}
+	ticket = h.autoTriageTicket(r, input, ticket)
+	ticket = h.assignOnCallCommander(r, store.Ticket{}, ticket)
 
 	response := mapTicket(ticket)
 	if actor, ok := authUser(r.Context()); ok {
@@ -809,13 +1026,17 @@ func (h *API) CreateTicket(w http.ResponseWriter, r *http.Request, projectId ope
 		}
 	}
 	h.dispatchTicketWebhook(r.Context(), projectUUID, ticket.ID, "ticket.created", map[string]any{"ticket": response})
+	h.recordIncidentChanges(r, store.Ticket{}, ticket)
+	h.announceTemplateSubtasks(r.Context(), projectUUID, subtasks)
 	h.publishProjectLiveEvent(projectUUID, projectEventBoardRefresh, map[string]any{
 		"reason": "ticket.created",
 	})
 	h.publishProjectLiveEvent(projectUUID, projectEventActivityChanged, map[string]any{
 		"reason": "ticket.created",
 	})
+	h.runAutomations(r.Context(), store.AutomationTriggerTicketCreated, ticket)
 
+	response.DuplicateCandidates = h.findDuplicateTickets(r.Context(), projectUUID, ticket.Title, ticket.Description, &ticket.ID)
 	writeJSON(w, http.StatusCreated, response)
 }
 
@@ -925,6 +1146,7 @@ func (h *API) BulkTicketOperation(w http.ResponseWriter, r *http.Request, projec
 			if actorID != nil {
 				h.recordTicketActivities(r.Context(), ticket, updated, *actorID, actorName)
 			}
+			h.autoStopTimers(r.Context(), ticket, updated)
 			mapped := mapTicket(updated)
 			result.Success = true
 			result.Ticket = &mapped
@@ -1117,6 +1339,37 @@ func (h *API) UpdateTicket(w http.ResponseWriter, r *http.Request, id openapi_ty
 		}
 		input.IncidentCommanderID = &incidentCommanderID
 	}
+	if req.IncidentScribeId != nil {
+		incidentScribeID, err := parseOpenapiUUID(*req.IncidentScribeId)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_incident_scribe_id", "incidentScribeId must be a UUID")
+			return
+		}
+		input.IncidentScribeID = &incidentScribeID
+	}
+	if req.IncidentCommsLeadId != nil {
+		incidentCommsLeadID, err := parseOpenapiUUID(*req.IncidentCommsLeadId)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_incident_comms_lead_id", "incidentCommsLeadId must be a UUID")
+			return
+		}
+		input.IncidentCommsLeadID = &incidentCommsLeadID
+	}
+	if req.IncidentCustomerVisible != nil {
+		input.IncidentCustomerVisible = req.IncidentCustomerVisible
+	}
+	if req.IncidentStatus != nil {
+		status := string(*req.IncidentStatus)
+		if err := validateIncidentStatusChange(current, req.IncidentEnabled, status); err != nil {
+			code, httpStatus := "invalid_incident_status", http.StatusBadRequest
+			if errors.Is(err, errInvalidIncidentTransition) {
+				code, httpStatus = "invalid_incident_transition", http.StatusConflict
+			}
+			writeError(w, httpStatus, code, err.Error())
+			return
+		}
+		input.IncidentStatus = &status
+	}
 	if req.Type != nil {
 		ticketType := string(*req.Type)
 		input.Type = &ticketType
@@ -1140,6 +1393,7 @@ func (h *API) UpdateTicket(w http.ResponseWriter, r *http.Request, id openapi_ty
 	if handleDBErrorWithCode(w, r, err, "ticket", "ticket_update", "ticket_update_failed") {
 		return
 	}
+	ticket = h.assignOnCallCommander(r, current, ticket)
 
 	var actorID uuid.UUID
 	var actorName string
@@ -1157,10 +1411,12 @@ func (h *API) UpdateTicket(w http.ResponseWriter, r *http.Request, id openapi_ty
 	if actorResolved && req.Description != nil && current.Description != ticket.Description {
 		h.notifyMentions(r, ticket.ProjectID, ticket, actorID, actorName, ticket.Description)
 	}
+	h.autoStopTimers(r.Context(), current, ticket)
 
 	response := mapTicket(ticket)
 	projectUUID := uuid.UUID(response.ProjectId)
 	h.dispatchTicketWebhook(r.Context(), projectUUID, ticket.ID, "ticket.updated", map[string]any{"ticket": response})
+	h.recordIncidentChanges(r, current, ticket)
 	if previous != nil && previous.StateID != ticket.StateID {
 		h.dispatchTicketWebhook(r.Context(), projectUUID, ticket.ID, "ticket.state_changed", map[string]any{
 			"ticket":      response,
@@ -1176,6 +1432,10 @@ func (h *API) UpdateTicket(w http.ResponseWriter, r *http.Request, id openapi_ty
 		"reason": "ticket.updated",
 		"id":     ticket.ID.String(),
 	})
+	h.runAutomations(r.Context(), store.AutomationTriggerTicketUpdated, ticket)
+	if current.StateID != ticket.StateID {
+		h.runAutomations(r.Context(), store.AutomationTriggerStateChanged, ticket)
+	}
 
 	writeJSON(w, http.StatusOK, response)
 }
@@ -1485,15 +1745,40 @@ func (h *API) ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	format := "json"
+	if params.Format != nil {
+		format = strings.ToLower(string(*params.Format))
+	}
+	switch ExportProjectReportingSnapshotParamsFormat(format) {
+	case ExportProjectReportingSnapshotParamsFormatJson, ExportProjectReportingSnapshotParamsFormatCsv, ExportProjectReportingSnapshotParamsFormatXlsx:
+	default:
+		writeError(w, http.StatusBadRequest, "invalid_format", "format must be json, csv or xlsx")
+		return
+	}
+
+	if derefBool(params.Detail, false) {
+		h.exportReportingTicketDetails(w, r, projectUUID, from, to, format)
+		return
+	}
+
 	report, err := h.store.GetProjectReportingSummary(r.Context(), projectUUID, from, to)
 	if err != nil {
 		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load project reporting summary")
 		return
 	}
 
-	format := "json"
-	if params.Format != nil {
-		format = strings.ToLower(string(*params.Format))
+	if format == "xlsx" {
+		content, err := renderProjectReportingXLSX(report)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "reporting_export_error", "Failed to render reporting export")
+			return
+		}
+		filename := fmt.Sprintf("project-reporting-%s-to-%s.xlsx", report.From.Format("2006-01-02"), report.To.Format("2006-01-02"))
+		w.Header().Set("Content-Type", xlsxContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+		return
 	}
 
 	if format == "csv" {
@@ -1516,6 +1801,102 @@ func (h *API) ExportProjectReportingSnapshot(w http.ResponseWriter, r *http.Requ
 	})
 }
 
+func (h *API) GetProjectCumulativeFlow(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectCumulativeFlowParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	from, to, ok := parseReportingRange(params.From, params.To)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid_date_range", "`to` must be on or after `from`")
+		return
+	}
+	filter, ok := parseReportingFilter(w, params.Type, params.Priority, params.AssigneeId, params.StoryId)
+	if !ok {
+		return
+	}
+
+	report, err := h.store.GetProjectCumulativeFlow(r.Context(), projectUUID, from, to, filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load cumulative flow")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapCumulativeFlowReport(report))
+}
+
+func (h *API) GetProjectLeadTimeDistribution(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectLeadTimeDistributionParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	from, to, ok := parseReportingRange(params.From, params.To)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid_date_range", "`to` must be on or after `from`")
+		return
+	}
+	filter, ok := parseReportingFilter(w, params.Type, params.Priority, params.AssigneeId, params.StoryId)
+	if !ok {
+		return
+	}
+
+	report, err := h.store.GetProjectLeadTimeDistribution(r.Context(), projectUUID, from, to, filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load lead time distribution")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapLeadTimeReport(report))
+}
+
+func (h *API) GetProjectAgingWip(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectAgingWipParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	filter, ok := parseReportingFilter(w, params.Type, params.Priority, params.AssigneeId, params.StoryId)
+	if !ok {
+		return
+	}
+
+	report, err := h.store.GetProjectAgingWip(r.Context(), projectUUID, filter)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load aging work in progress")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapAgingWipReport(report))
+}
+
+// parseReportingFilter validates the filter query parameters shared by the
+// flow reports, writing a 400 when one is invalid.
+func parseReportingFilter(w http.ResponseWriter, ticketType *TicketType, priority *TicketPriority, assigneeID, storyID *openapi_types.UUID) (store.ReportingFilter, bool) {
+	var filter store.ReportingFilter
+	if ticketType != nil {
+		switch *ticketType {
+		case Feature, Bug:
+		default:
+			writeError(w, http.StatusBadRequest, "invalid_type", "type must be feature or bug")
+			return filter, false
+		}
+		value := string(*ticketType)
+		filter.Type = &value
+	}
+	if priority != nil {
+		switch *priority {
+		case Low, Medium, High, Urgent:
+		default:
+			writeError(w, http.StatusBadRequest, "invalid_priority", "priority must be low, medium, high or urgent")
+			return filter, false
+		}
+		value := string(*priority)
+		filter.Priority = &value
+	}
+	filter.AssigneeID = parseOpenapiUUIDPtr(assigneeID)
+	filter.StoryID = parseOpenapiUUIDPtr(storyID)
+	return filter, true
+}
+
 func parseReportingRange(fromParam, toParam *openapi_types.Date) (time.Time, time.Time, bool) {
 	now := time.Now().UTC()
 	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
@@ -1858,8 +2239,9 @@ func (h *API) ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request,
 	activities, _ := h.store.ListActivities(r.Context(), ticketID)
 	comments, _ := h.store.ListComments(r.Context(), ticketID)
 	webhookEvents, _ := h.store.ListTicketWebhookEvents(r.Context(), ticketID)
+	entries, _ := h.store.ListIncidentTimelineEntries(r.Context(), ticketID)
 
-	items := make([]IncidentTimelineItem, 0, len(activities)+len(comments)+len(webhookEvents))
+	items := make([]IncidentTimelineItem, 0, len(activities)+len(comments)+len(webhookEvents)+len(entries))
 	for _, activity := range activities {
 		title := fmt.Sprintf("%s by %s", activity.Action, activity.ActorName)
 		body := ""
@@ -1896,6 +2278,7 @@ func (h *API) ListTicketIncidentTimeline(w http.ResponseWriter, r *http.Request,
 			CreatedAt: evt.CreatedAt,
 		})
 	}
+	items = append(items, mapSlice(entries, mapIncidentTimelineEntry)...)
 	sort.Slice(items, func(i, j int) bool {
 		return items[i].CreatedAt.Before(items[j].CreatedAt)
 	})
@@ -1916,12 +2299,13 @@ func (h *API) GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request
 	activities, _ := h.store.ListActivities(r.Context(), ticketID)
 	comments, _ := h.store.ListComments(r.Context(), ticketID)
 	webhookEvents, _ := h.store.ListTicketWebhookEvents(r.Context(), ticketID)
+	entries, _ := h.store.ListIncidentTimelineEntries(r.Context(), ticketID)
 
 	type line struct {
 		at   time.Time
 		text string
 	}
-	lines := make([]line, 0, len(activities)+len(comments)+len(webhookEvents))
+	lines := make([]line, 0, len(activities)+len(comments)+len(webhookEvents)+len(entries))
 	for _, a := range activities {
 		lines = append(lines, line{
 			at:   a.CreatedAt,
@@ -1940,6 +2324,13 @@ func (h *API) GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request
 			text: fmt.Sprintf("- %s: webhook `%s` (delivered=%t)", e.CreatedAt.Format(time.RFC3339), e.Event, e.Delivered),
 		})
 	}
+	for _, e := range entries {
+		text := fmt.Sprintf("- %s: %s", e.OccurredAt.Format(time.RFC3339), e.Title)
+		if e.Body != nil {
+			text += "\n\n  " + strings.ReplaceAll(*e.Body, "\n", "\n  ")
+		}
+		lines = append(lines, line{at: e.OccurredAt, text: text})
+	}
 	sort.Slice(lines, func(i, j int) bool { return lines[i].at.Before(lines[j].at) })
 
 	var b strings.Builder
@@ -1952,16 +2343,69 @@ func (h *API) GetTicketIncidentPostmortem(w http.ResponseWriter, r *http.Request
 	for _, l := range lines {
 		b.WriteString(l.text + "\n")
 	}
-	b.WriteString("\n## Root Cause\n\n")
-	b.WriteString("- TBD\n\n")
-	b.WriteString("## Action Items\n\n")
-	b.WriteString("- TBD\n")
+	postmortem, _ := h.store.GetIncidentPostmortem(r.Context(), ticketID)
+	actionItems, _ := h.store.ListPostmortemActionItems(r.Context(), ticketID)
+	writeSection := func(title, body string) {
+		if strings.TrimSpace(body) == "" {
+			body = "- TBD"
+		}
+		b.WriteString("\n## " + title + "\n\n")
+		b.WriteString(strings.TrimSpace(body) + "\n")
+	}
+	if postmortem.Summary != "" {
+		writeSection("Summary", postmortem.Summary)
+	}
+	if postmortem.Impact != "" {
+		writeSection("Impact", postmortem.Impact)
+	}
+	writeSection("Root Cause", postmortem.RootCause)
+	if postmortem.ContributingFactors != "" {
+		writeSection("Contributing Factors", postmortem.ContributingFactors)
+	}
+	if postmortem.Lessons != "" {
+		writeSection("Lessons Learned", postmortem.Lessons)
+	}
+	items := make([]string, 0, len(actionItems))
+	for _, item := range actionItems {
+		entry := fmt.Sprintf("- %s %s (%s", item.Key, item.Title, item.StateName)
+		if item.AssigneeName != nil {
+			entry += ", " + *item.AssigneeName
+		}
+		items = append(items, entry+")")
+	}
+	writeSection("Action Items", strings.Join(items, "\n"))
 
 	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	_, _ = w.Write([]byte(b.String()))
 }
 
+// publishTicketUpdated sends the webhooks, live events and automations of a
+// ticket update made outside UpdateTicket.
+func (h *API) publishTicketUpdated(ctx context.Context, before, after store.Ticket) {
+	response := mapTicket(after)
+	h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "ticket.updated", map[string]any{"ticket": response})
+	if before.StateID != after.StateID {
+		h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "ticket.state_changed", map[string]any{
+			"ticket":      response,
+			"fromStateId": before.StateID.String(),
+			"toStateId":   after.StateID.String(),
+		})
+	}
+	h.publishProjectLiveEvent(after.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.updated",
+		"id":     after.ID.String(),
+	})
+	h.publishProjectLiveEvent(after.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "ticket.updated",
+		"id":     after.ID.String(),
+	})
+	h.runAutomations(ctx, store.AutomationTriggerTicketUpdated, after)
+	if before.StateID != after.StateID {
+		h.runAutomations(ctx, store.AutomationTriggerStateChanged, after)
+	}
+}
+
 func (h *API) recordTicketActivities(ctx context.Context, before, after store.Ticket, actorID uuid.UUID, actorName string) {
 	type fieldChange struct {
 		action   string
@@ -2022,6 +2466,14 @@ func (h *API) recordTicketActivities(ctx context.Context, before, after store.Ti
 			newValue: after.Title,
 		})
 	}
+	if derefString(before.IncidentStatus) != derefString(after.IncidentStatus) {
+		changes = append(changes, fieldChange{
+			action:   "incident_status_changed",
+			field:    "incidentStatus",
+			oldValue: derefString(before.IncidentStatus),
+			newValue: derefString(after.IncidentStatus),
+		})
+	}
 	if derefString(before.IncidentSeverity) != derefString(after.IncidentSeverity) {
 		changes = append(changes, fieldChange{
 			action:   "incident_severity_changed",
@@ -1,10 +1,14 @@
 package httpapi
 
 import (
+	"context"
 	"errors"
+	"log"
 	"net/http"
+	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	"ticketing-system/backend/internal/store"
 
@@ -13,10 +17,7 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-const (
-	aiTriagePromptVersion = "triage-v1"
-	aiTriageModel         = "heuristic-local-v1"
-)
+const aiTriageModel = "heuristic-local-v1"
 
 func (h *API) GetProjectAiTriageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	projectUUID := uuid.UUID(projectId)
@@ -39,7 +40,17 @@ func (h *API) UpdateProjectAiTriageSettings(w http.ResponseWriter, r *http.Reque
 	if !ok {
 		return
 	}
-	settings, err := h.store.UpdateAiTriageSettings(r.Context(), projectUUID, req.Enabled)
+	current, err := h.store.GetAiTriageSettings(r.Context(), projectUUID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "ai_triage_settings_error", "unable to load ai triage settings")
+		return
+	}
+	next, err := h.applyAiTriageSettingsUpdate(current, req)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_ai_triage_settings", err.Error())
+		return
+	}
+	settings, err := h.store.UpdateAiTriageSettings(r.Context(), projectUUID, next)
 	if handleDBErrorWithCode(w, r, err, "ai triage settings", "ai_triage_settings_update", "ai_triage_settings_update_failed") {
 		return
 	}
@@ -77,49 +88,24 @@ func (h *API) CreateAiTriageSuggestion(w http.ResponseWriter, r *http.Request, p
 		return
 	}
 
-	stateID, stateConfidence, err := h.pickSuggestedState(r, projectUUID)
-	if err != nil {
-		writeError(w, http.StatusInternalServerError, "ai_triage_state_error", "unable to resolve workflow state")
-		return
-	}
-	priority, priorityConfidence := suggestPriority(title, req.Description, req.Type)
-	summary, summaryConfidence := suggestSummary(title, req.Description)
-	assigneeID, assigneeConfidence := h.suggestAssignee(r, projectUUID, title, req.Description)
-
-	var inputDescription *string
-	if req.Description != nil {
-		trimmed := strings.TrimSpace(*req.Description)
-		if trimmed != "" {
-			inputDescription = &trimmed
-		}
-	}
 	var inputType *string
 	if req.Type != nil {
 		value := string(*req.Type)
 		inputType = &value
 	}
-
-	suggestion, err := h.store.CreateAiTriageSuggestion(r.Context(), projectUUID, store.AiTriageSuggestionCreateInput{
-		ActorID:            actorID,
-		InputTitle:         title,
-		InputDescription:   inputDescription,
-		InputType:          inputType,
-		Summary:            summary,
-		Priority:           priority,
-		StateID:            stateID,
-		AssigneeID:         assigneeID,
-		ConfidenceSummary:  summaryConfidence,
-		ConfidencePriority: priorityConfidence,
-		ConfidenceState:    stateConfidence,
-		ConfidenceAssignee: assigneeConfidence,
-		PromptVersion:      aiTriagePromptVersion,
-		Model:              aiTriageModel,
-	})
+	input, err := h.triageSuggestionInput(r.Context(), projectUUID, settings, actorID, title, req.Description, inputType)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "ai_triage_state_error", "unable to resolve workflow state")
+		return
+	}
+	suggestion, err := h.store.CreateAiTriageSuggestion(r.Context(), projectUUID, input)
 	if handleDBErrorWithCode(w, r, err, "ai triage suggestion", "ai_triage_suggestion_create", "ai_triage_suggestion_create_failed") {
 		return
 	}
 
-	writeJSON(w, http.StatusCreated, mapAiTriageSuggestion(suggestion))
+	response := mapAiTriageSuggestion(suggestion)
+	response.Duplicates = h.findDuplicateTickets(r.Context(), projectUUID, title, derefString(input.InputDescription), nil)
+	writeJSON(w, http.StatusCreated, response)
 }
 
 func (h *API) RecordAiTriageSuggestionDecision(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, suggestionId openapi_types.UUID) {
@@ -180,11 +166,135 @@ func (h *API) RecordAiTriageSuggestionDecision(w http.ResponseWriter, r *http.Re
 	writeJSON(w, http.StatusCreated, mapAiTriageSuggestionDecision(decision))
 }
 
-func (h *API) pickSuggestedState(r *http.Request, projectID uuid.UUID) (uuid.UUID, float32, error) {
-	states, err := h.store.ListWorkflowStates(r.Context(), projectID)
+func (h *API) GetAiTriageAccuracy(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetAiTriageAccuracyParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	from, to, ok := parseReportingRange(params.From, params.To)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid_date_range", "`to` must be on or after `from`")
+		return
+	}
+	var field *string
+	if params.Field != nil {
+		fields, err := normalizeAiFields([]AiTriageField{*params.Field})
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_ai_triage_fields", err.Error())
+			return
+		}
+		field = &fields[0]
+	}
+
+	report, err := h.store.GetAiTriageAccuracy(r.Context(), projectUUID, from, to, field)
 	if err != nil {
-		return uuid.Nil, 0, err
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load ai triage accuracy")
+		return
 	}
+	writeJSON(w, http.StatusOK, mapAiTriageAccuracyReport(report))
+}
+
+// triageSuggestionInput runs the project's provider and template matching on
+// a ticket draft and returns the suggestion to record.
+func (h *API) triageSuggestionInput(ctx context.Context, projectID uuid.UUID, settings store.AiTriageSettings, actorID uuid.UUID, title string, description, inputType *string) (store.AiTriageSuggestionCreateInput, error) {
+	states, err := h.store.ListWorkflowStates(ctx, projectID)
+	if err != nil {
+		return store.AiTriageSuggestionCreateInput{}, err
+	}
+	suggested, err := h.suggestTriage(ctx, settings, TriageInput{
+		ProjectID:     projectID,
+		Title:         title,
+		Description:   description,
+		Type:          inputType,
+		States:        states,
+		PromptVersion: settings.PromptVersion,
+	})
+	if err != nil {
+		return store.AiTriageSuggestionCreateInput{}, err
+	}
+	var templateID *uuid.UUID
+	var templateConfidence float32
+	if templates, err := h.store.ListTicketTemplates(ctx, projectID); err == nil {
+		templateID, templateConfidence = suggestTemplate(templates, title, description)
+	}
+
+	var inputDescription *string
+	if description != nil {
+		trimmed := strings.TrimSpace(*description)
+		if trimmed != "" {
+			inputDescription = &trimmed
+		}
+	}
+	var suggestedType *string
+	if suggested.Type != "" {
+		suggestedType = &suggested.Type
+	}
+
+	return store.AiTriageSuggestionCreateInput{
+		ActorID:            actorID,
+		InputTitle:         title,
+		InputDescription:   inputDescription,
+		InputType:          inputType,
+		Summary:            suggested.Summary,
+		Priority:           suggested.Priority,
+		StateID:            suggested.StateID,
+		AssigneeID:         suggested.AssigneeID,
+		TemplateID:         templateID,
+		Type:               suggestedType,
+		ConfidenceSummary:  suggested.SummaryConfidence,
+		ConfidencePriority: suggested.PriorityConfidence,
+		ConfidenceState:    suggested.StateConfidence,
+		ConfidenceAssignee: suggested.AssigneeConfidence,
+		ConfidenceTemplate: templateConfidence,
+		ConfidenceType:     suggested.TypeConfidence,
+		PromptVersion:      settings.PromptVersion,
+		Model:              suggested.Model,
+	}, nil
+}
+
+// suggestTriage runs the project's provider within its timeout. A failing
+// http or learned provider falls back to the heuristics so triage keeps
+// working when the model endpoint is down.
+func (h *API) suggestTriage(ctx context.Context, settings store.AiTriageSettings, input TriageInput) (TriageSuggestion, error) {
+	timeout := settings.TimeoutMS
+	if timeout <= 0 {
+		timeout = store.DefaultAiTriageTimeoutMS
+	}
+	providerCtx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+	defer cancel()
+	provider := h.triageProvider(ctx, input.ProjectID, settings)
+	suggestion, err := provider.Suggest(providerCtx, input)
+	if err == nil {
+		return suggestion, nil
+	}
+	if _, isHeuristic := provider.(heuristicTriageProvider); isHeuristic {
+		return TriageSuggestion{}, err
+	}
+	log.Printf("ai_triage_provider_error project=%s provider=%s error=%s", input.ProjectID, settings.Provider, err.Error())
+	return heuristicTriageProvider{resolveMember: h.resolveProjectMember}.Suggest(ctx, input)
+}
+
+// heuristicTriageProvider is the default provider: keyword rules that need
+// no model and no network.
+type heuristicTriageProvider struct {
+	resolveMember memberResolver
+}
+
+func (p heuristicTriageProvider) Suggest(ctx context.Context, input TriageInput) (TriageSuggestion, error) {
+	stateID, stateConfidence, err := suggestState(input.States)
+	if err != nil {
+		return TriageSuggestion{}, err
+	}
+	out := TriageSuggestion{StateID: stateID, StateConfidence: stateConfidence, Model: aiTriageModel}
+	out.Priority, out.PriorityConfidence = suggestPriority(input.Title, input.Description, input.Type)
+	out.Summary, out.SummaryConfidence = suggestSummary(input.Title, input.Description)
+	out.AssigneeID, out.AssigneeConfidence = suggestAssignee(ctx, p.resolveMember, input)
+	out.Type, out.TypeConfidence = suggestType(input.Title, input.Description, input.Type)
+	return out, nil
+}
+
+func suggestState(states []store.WorkflowState) (uuid.UUID, float32, error) {
 	if len(states) == 0 {
 		return uuid.Nil, 0, errors.New("no workflow states")
 	}
@@ -196,7 +306,7 @@ func (h *API) pickSuggestedState(r *http.Request, projectID uuid.UUID) (uuid.UUI
 	return states[0].ID, 0.55, nil
 }
 
-func suggestPriority(title string, description *string, ticketType *TicketType) (string, float32) {
+func suggestPriority(title string, description *string, ticketType *string) (string, float32) {
 	text := strings.ToLower(strings.TrimSpace(title))
 	if description != nil {
 		text += " " + strings.ToLower(strings.TrimSpace(*description))
@@ -238,20 +348,50 @@ func suggestSummary(title string, description *string) (string, float32) {
 	return text, 0.74
 }
 
-func (h *API) suggestAssignee(r *http.Request, projectID uuid.UUID, title string, description *string) (*uuid.UUID, float32) {
-	text := title
+// suggestType keeps a type the reporter chose and otherwise looks for
+// words that usually describe a defect.
+func suggestType(title string, description *string, ticketType *string) (string, float32) {
+	if ticketType != nil && isTicketType(*ticketType) {
+		return *ticketType, 0.95
+	}
+	text := strings.ToLower(strings.TrimSpace(title))
 	if description != nil {
-		text += "\n" + *description
+		text += " " + strings.ToLower(strings.TrimSpace(*description))
+	}
+	for _, keyword := range []string{"bug", "error", "fail", "crash", "broken", "exception", "regression"} {
+		if strings.Contains(text, keyword) {
+			return string(Bug), 0.76
+		}
+	}
+	return string(Feature), 0.55
+}
+
+func suggestAssignee(ctx context.Context, resolve memberResolver, input TriageInput) (*uuid.UUID, float32) {
+	text := input.Title
+	if input.Description != nil {
+		text += "\n" + *input.Description
 	}
 	mentions := extractMentions(text)
 	if len(mentions) == 0 {
 		return nil, 0.15
 	}
-
-	users, err := h.store.ListUsers(r.Context(), "")
+	assigneeID, err := resolve(ctx, input.ProjectID, mentions)
 	if err != nil {
 		return nil, 0.15
 	}
+	if assigneeID == nil {
+		return nil, 0.25
+	}
+	return assigneeID, 0.88
+}
+
+// resolveProjectMember returns the first handle that names a user, by name
+// or email local part, with a role in the project.
+func (h *API) resolveProjectMember(ctx context.Context, projectID uuid.UUID, handles []string) (*uuid.UUID, error) {
+	users, err := h.store.ListUsers(ctx, "")
+	if err != nil {
+		return nil, err
+	}
 	byAlias := map[string]uuid.UUID{}
 	for _, user := range users {
 		byAlias[strings.ToLower(user.Name)] = user.ID
@@ -259,18 +399,54 @@ func (h *API) suggestAssignee(r *http.Request, projectID uuid.UUID, title string
 			byAlias[parts[0]] = user.ID
 		}
 	}
-	for _, mention := range mentions {
-		candidate, ok := byAlias[strings.ToLower(mention)]
+	for _, handle := range handles {
+		candidate, ok := byAlias[strings.ToLower(handle)]
 		if !ok {
 			continue
 		}
-		role, roleErr := h.store.GetProjectRoleForUser(r.Context(), projectID, candidate)
+		role, roleErr := h.store.GetProjectRoleForUser(ctx, projectID, candidate)
 		if roleErr != nil || role == "" {
 			continue
 		}
-		return &candidate, 0.88
+		return &candidate, nil
+	}
+	return nil, nil
+}
+
+// suggestTemplate picks the template whose keywords and name best match the
+// input. Each matching keyword counts once; a name match counts double.
+func suggestTemplate(templates []store.TicketTemplate, title string, description *string) (*uuid.UUID, float32) {
+	text := strings.ToLower(strings.TrimSpace(title))
+	if description != nil {
+		text += " " + strings.ToLower(strings.TrimSpace(*description))
+	}
+
+	var best *store.TicketTemplate
+	bestScore := 0
+	for i := range templates {
+		score := 0
+		for _, keyword := range templates[i].Keywords {
+			if keyword != "" && strings.Contains(text, strings.ToLower(keyword)) {
+				score++
+			}
+		}
+		if name := strings.ToLower(strings.TrimSpace(templates[i].Name)); name != "" && strings.Contains(text, name) {
+			score += 2
+		}
+		if score > bestScore {
+			best = &templates[i]
+			bestScore = score
+		}
+	}
+	if best == nil {
+		return nil, 0
 	}
-	return nil, 0.25
+	confidence := 0.5 + 0.15*float32(bestScore)
+	if confidence > 0.9 {
+		confidence = 0.9
+	}
+	id := best.ID
+	return &id, confidence
 }
 
 func normalizeAiFields(fields []AiTriageField) ([]string, error) {
@@ -282,7 +458,7 @@ func normalizeAiFields(fields []AiTriageField) ([]string, error) {
 	for _, field := range fields {
 		value := string(field)
 		switch value {
-		case "summary", "priority", "state", "assignee":
+		case "summary", "priority", "state", "assignee", "template", "type":
 		default:
 			return nil, errors.New("invalid ai triage field")
 		}
@@ -295,3 +471,86 @@ func normalizeAiFields(fields []AiTriageField) ([]string, error) {
 	sort.Strings(out)
 	return out, nil
 }
+
+// applyAiTriageSettingsUpdate merges an update into the current settings and
+// validates the result.
+func (h *API) applyAiTriageSettingsUpdate(current store.AiTriageSettings, req aiTriageSettingsUpdateRequest) (store.AiTriageSettings, error) {
+	next := current
+	if req.Enabled != nil {
+		next.Enabled = *req.Enabled
+	}
+	if req.Provider != nil {
+		switch *req.Provider {
+		case Heuristic, Http, Learned:
+			next.Provider = string(*req.Provider)
+		default:
+			return current, errors.New("provider must be heuristic, http or learned")
+		}
+	}
+	if req.EndpointUrl != nil {
+		next.EndpointURL = nil
+		if endpoint := strings.TrimSpace(*req.EndpointUrl); endpoint != "" {
+			parsed, err := url.Parse(endpoint)
+			if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+				return current, errors.New("endpointUrl must be an http or https URL")
+			}
+			if parsed.Scheme != "https" && !h.triageEndpointTrusted(endpoint) {
+				return current, errors.New("endpointUrl must be an https URL unless the server allows its origin")
+			}
+			next.EndpointURL = &endpoint
+		}
+		// A key set for one endpoint must not follow the settings to another.
+		if derefString(next.EndpointURL) != derefString(current.EndpointURL) {
+			next.APIKey = nil
+		}
+	}
+	if req.ApiKey != nil {
+		next.APIKey = nil
+		if apiKey := strings.TrimSpace(*req.ApiKey); apiKey != "" {
+			next.APIKey = &apiKey
+		}
+	}
+	if req.Model != nil {
+		next.Model = nil
+		if model := strings.TrimSpace(*req.Model); model != "" {
+			next.Model = &model
+		}
+	}
+	if req.TimeoutMs != nil {
+		if *req.TimeoutMs < 500 || *req.TimeoutMs > 60000 {
+			return current, errors.New("timeoutMs must be between 500 and 60000")
+		}
+		next.TimeoutMS = *req.TimeoutMs
+	}
+	if req.PromptVersion != nil {
+		if _, ok := aiTriagePrompts[*req.PromptVersion]; !ok {
+			return current, errors.New("unknown promptVersion")
+		}
+		next.PromptVersion = *req.PromptVersion
+	}
+	if req.AutoApply != nil {
+		next.AutoApply = *req.AutoApply
+	}
+	if req.AutoApplyThresholds != nil {
+		thresholds := map[string]float32{}
+		for field, value := range map[string]*float32{
+			"priority": req.AutoApplyThresholds.Priority,
+			"state":    req.AutoApplyThresholds.State,
+			"assignee": req.AutoApplyThresholds.Assignee,
+			"type":     req.AutoApplyThresholds.Type,
+		} {
+			if value == nil {
+				continue
+			}
+			if *value <= 0 || *value > 1 {
+				return current, errors.New("autoApplyThresholds must be greater than 0 and at most 1")
+			}
+			thresholds[field] = *value
+		}
+		next.AutoApplyThresholds = thresholds
+	}
+	if next.Provider == store.AiTriageProviderHTTP && next.EndpointURL == nil {
+		return current, errors.New("the http provider requires endpointUrl")
+	}
+	return next, nil
+}
@@ -0,0 +1,514 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	webhookEventAutomationTriggered = "automation.triggered"
+	notificationTypeAutomation      = "automation"
+
+	automationRuleBatchSize = 20
+	// maxAutomationDepth bounds how many rules can run in one chain of changes,
+	// where each rule's actions trigger the next.
+	maxAutomationDepth = 3
+	// maxScheduledAutomationTickets caps how many tickets one scheduled run of
+	// a rule acts on.
+	maxScheduledAutomationTickets = 100
+)
+
+var errAutomationNoChange = errors.New("no change")
+
+func (h *API) ListAutomationRules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	rules, err := h.store.ListAutomationRules(r.Context(), projectUUID)
+	if handleListError(w, r, err, "automation rules", "automation_rule_list") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, automationRuleListResponse{Items: mapSlice(rules, mapAutomationRule)})
+}
+
+func (h *API) GetAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	rule, err := h.store.GetAutomationRule(r.Context(), projectUUID, uuid.UUID(ruleId))
+	if handleDBError(w, r, err, "automation rule", "automation_rule_get") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapAutomationRule(rule))
+}
+
+func (h *API) CreateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	req, ok := decodeJSON[automationRuleCreateRequest](w, r, "automation_rule_create")
+	if !ok {
+		return
+	}
+
+	input := store.AutomationRuleCreateInput{
+		Name:         req.Name,
+		Trigger:      string(req.Trigger),
+		Actions:      mapSlice(req.Actions, mapStoreAutomationAction),
+		ScheduleKind: mapStringPtr(req.ScheduleKind, func(v TicketRecurrenceScheduleKind) string { return string(v) }),
+		Schedule:     req.Schedule,
+		Timezone:     derefString(req.Timezone),
+		Enabled:      derefBool(req.Enabled, true),
+	}
+	if req.Conditions != nil {
+		input.Conditions = mapStoreBoardFilter(*req.Conditions)
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.CreatedBy = &actorID
+	}
+
+	rule, err := h.store.CreateAutomationRule(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "automation rule", "automation_rule_create", "automation_rule_create_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapAutomationRule(rule))
+}
+
+func (h *API) UpdateAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	req, ok := decodeJSON[automationRuleUpdateRequest](w, r, "automation_rule_update")
+	if !ok {
+		return
+	}
+
+	input := store.AutomationRuleUpdateInput{
+		Name:         req.Name,
+		Trigger:      mapStringPtr(req.Trigger, func(v AutomationTrigger) string { return string(v) }),
+		ScheduleKind: mapStringPtr(req.ScheduleKind, func(v TicketRecurrenceScheduleKind) string { return string(v) }),
+		Schedule:     req.Schedule,
+		Timezone:     req.Timezone,
+		Enabled:      req.Enabled,
+	}
+	if req.Conditions != nil {
+		conditions := mapStoreBoardFilter(*req.Conditions)
+		input.Conditions = &conditions
+	}
+	if req.Actions != nil {
+		actions := mapSlice(*req.Actions, mapStoreAutomationAction)
+		input.Actions = &actions
+	}
+
+	rule, err := h.store.UpdateAutomationRule(r.Context(), projectUUID, uuid.UUID(ruleId), input)
+	if handleDBErrorWithCode(w, r, err, "automation rule", "automation_rule_update", "automation_rule_update_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapAutomationRule(rule))
+}
+
+func (h *API) DeleteAutomationRule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ruleId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	if err := h.store.DeleteAutomationRule(r.Context(), projectUUID, uuid.UUID(ruleId)); handleDeleteError(w, r, err, "automation rule", "automation_rule_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) ListAutomationRuns(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ListAutomationRunsParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	filter := store.AutomationRunFilter{
+		ProjectID: projectUUID,
+		RuleID:    parseOpenapiUUIDPtr(params.RuleId),
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	runs, err := h.store.ListAutomationRuns(r.Context(), filter)
+	if handleListError(w, r, err, "automation runs", "automation_run_list") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, automationRunListResponse{Items: mapSlice(runs, mapAutomationRun)})
+}
+
+type automationChainKey struct{}
+
+// automationChain records the rules that already ran for the change being
+// handled. Actions taken by a rule fire triggers of their own; the chain stops
+// a rule from re-triggering itself and bounds how deep the cascade goes.
+type automationChain struct {
+	rules []uuid.UUID
+}
+
+func automationChainFrom(ctx context.Context) automationChain {
+	chain, _ := ctx.Value(automationChainKey{}).(automationChain)
+	return chain
+}
+
+func (c automationChain) contains(ruleID uuid.UUID) bool {
+	for _, id := range c.rules {
+		if id == ruleID {
+			return true
+		}
+	}
+	return false
+}
+
+func (c automationChain) with(ruleID uuid.UUID) automationChain {
+	rules := make([]uuid.UUID, len(c.rules), len(c.rules)+1)
+	copy(rules, c.rules)
+	return automationChain{rules: append(rules, ruleID)}
+}
+
+// runAutomations runs the project's enabled rules for trigger whose
+// conditions match ticket. Each rule sees the ticket as the previous rules
+// left it.
+func (h *API) runAutomations(ctx context.Context, trigger string, ticket store.Ticket) {
+	rules, err := h.store.ListAutomationRulesForTrigger(ctx, ticket.ProjectID, trigger)
+	if err != nil {
+		log.Printf("automation_error project=%s trigger=%s error=%s", ticket.ProjectID, trigger, err.Error())
+		return
+	}
+	for _, rule := range rules {
+		if !rule.Conditions.Matches(ticket) {
+			continue
+		}
+		ticket = h.runAutomationRule(ctx, rule, trigger, ticket)
+	}
+}
+
+// runAutomationRule executes rule's actions against ticket, writes the result
+// to the execution log and returns the ticket as the actions left it.
+func (h *API) runAutomationRule(ctx context.Context, rule store.AutomationRule, trigger string, ticket store.Ticket) store.Ticket {
+	chain := automationChainFrom(ctx)
+	run := store.AutomationRunCreateInput{
+		RuleID:    rule.ID,
+		ProjectID: rule.ProjectID,
+		TicketID:  &ticket.ID,
+		Trigger:   trigger,
+		Depth:     len(chain.rules),
+	}
+
+	switch {
+	case chain.contains(rule.ID):
+		run.Status = store.AutomationRunSkipped
+		run.Error = nullableString("loop detected: rule already ran for this change")
+	case len(chain.rules) >= maxAutomationDepth:
+		run.Status = store.AutomationRunSkipped
+		run.Error = nullableString(fmt.Sprintf("chain depth limit of %d reached", maxAutomationDepth))
+	default:
+		ctx = context.WithValue(ctx, automationChainKey{}, chain.with(rule.ID))
+		run.Status = store.AutomationRunSucceeded
+		for i, action := range rule.Actions {
+			updated, err := h.executeAutomationAction(ctx, rule, ticket, action)
+			result := store.AutomationActionResult{Type: action.Type, Status: store.AutomationRunSucceeded}
+			switch {
+			case errors.Is(err, errAutomationNoChange):
+				result.Status = store.AutomationRunSkipped
+			case err != nil:
+				result.Status = store.AutomationRunFailed
+				result.Message = err.Error()
+				run.Status = store.AutomationRunFailed
+				run.Error = nullableString(fmt.Sprintf("action %d (%s): %s", i+1, action.Type, err.Error()))
+			default:
+				ticket = updated
+			}
+			run.Results = append(run.Results, result)
+			if err != nil && !errors.Is(err, errAutomationNoChange) {
+				for _, rest := range rule.Actions[i+1:] {
+					run.Results = append(run.Results, store.AutomationActionResult{Type: rest.Type, Status: store.AutomationRunSkipped})
+				}
+				break
+			}
+		}
+	}
+
+	if _, err := h.store.CreateAutomationRun(ctx, run); err != nil {
+		log.Printf("automation_error rule=%s ticket=%s error=%s", rule.ID, ticket.ID, err.Error())
+	}
+	return ticket
+}
+
+// executeAutomationAction performs one action and returns the ticket as it
+// left it. It returns errAutomationNoChange when the ticket already matched.
+func (h *API) executeAutomationAction(ctx context.Context, rule store.AutomationRule, ticket store.Ticket, action store.AutomationAction) (store.Ticket, error) {
+	switch action.Type {
+	case store.AutomationActionSetField:
+		input, err := automationFieldUpdate(ticket, action.Field, action.Value)
+		if err != nil {
+			return ticket, err
+		}
+		return h.applyAutomationUpdate(ctx, rule, ticket, input)
+	case store.AutomationActionAssign:
+		if ticket.AssigneeID != nil && *ticket.AssigneeID == *action.UserID {
+			return ticket, errAutomationNoChange
+		}
+		return h.applyAutomationUpdate(ctx, rule, ticket, store.TicketUpdateInput{AssigneeID: action.UserID})
+	case store.AutomationActionMoveState:
+		if ticket.StateID == *action.StateID {
+			return ticket, errAutomationNoChange
+		}
+		return h.applyAutomationUpdate(ctx, rule, ticket, store.TicketUpdateInput{StateID: action.StateID})
+	case store.AutomationActionAddComment:
+		if _, err := h.store.CreateComment(ctx, ticket.ID, store.CommentCreateInput{
+			AuthorID:   rule.CreatedBy,
+			AuthorName: automationActorName(rule),
+			Message:    store.RenderAutomationText(action.Message, ticket, rule.Name),
+		}); err != nil {
+			return ticket, err
+		}
+		h.publishProjectLiveEvent(ticket.ProjectID, projectEventActivityChanged, map[string]any{
+			"reason": "comment.created",
+			"id":     ticket.ID.String(),
+		})
+		h.runAutomations(ctx, store.AutomationTriggerCommentCreated, ticket)
+		return ticket, nil
+	case store.AutomationActionNotify:
+		recipient := action.UserID
+		if recipient == nil {
+			recipient = ticket.AssigneeID
+		}
+		if recipient == nil {
+			return ticket, errAutomationNoChange
+		}
+		if _, err := h.store.CreateNotification(ctx, store.NotificationCreateInput{
+			ProjectID: ticket.ProjectID,
+			UserID:    *recipient,
+			TicketID:  ticket.ID,
+			Type:      notificationTypeAutomation,
+			Message:   store.RenderAutomationText(action.Message, ticket, rule.Name),
+		}); err != nil {
+			return ticket, err
+		}
+		h.publishUserNotificationEvents(ctx, ticket.ProjectID, *recipient)
+		return ticket, nil
+	case store.AutomationActionCallWebhook:
+		if h.webhooks == nil {
+			return ticket, errAutomationNoChange
+		}
+		h.dispatchTicketWebhook(ctx, ticket.ProjectID, ticket.ID, webhookEventAutomationTriggered, map[string]any{
+			"ruleId":   rule.ID.String(),
+			"ruleName": rule.Name,
+			"ticket":   mapTicket(ticket),
+			"message":  store.RenderAutomationText(action.Message, ticket, rule.Name),
+		})
+		return ticket, nil
+	case store.AutomationActionCreateLinkedTicket:
+		return ticket, h.createAutomationLinkedTicket(ctx, rule, ticket, action)
+	default:
+		return ticket, fmt.Errorf("unknown action type %q", action.Type)
+	}
+}
+
+// automationFieldUpdate builds the update for a set_field action. Values were
+// validated when the rule was saved.
+func automationFieldUpdate(ticket store.Ticket, field, value string) (store.TicketUpdateInput, error) {
+	switch field {
+	case "priority":
+		if ticket.Priority == value {
+			return store.TicketUpdateInput{}, errAutomationNoChange
+		}
+		return store.TicketUpdateInput{Priority: &value}, nil
+	case "type":
+		if ticket.Type == value {
+			return store.TicketUpdateInput{}, errAutomationNoChange
+		}
+		return store.TicketUpdateInput{Type: &value}, nil
+	case "storyPoints", "timeEstimate":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return store.TicketUpdateInput{}, err
+		}
+		if field == "storyPoints" {
+			if ticket.StoryPoints != nil && *ticket.StoryPoints == n {
+				return store.TicketUpdateInput{}, errAutomationNoChange
+			}
+			return store.TicketUpdateInput{StoryPoints: &n}, nil
+		}
+		if ticket.TimeEstimate != nil && *ticket.TimeEstimate == n {
+			return store.TicketUpdateInput{}, errAutomationNoChange
+		}
+		return store.TicketUpdateInput{TimeEstimate: &n}, nil
+	default:
+		return store.TicketUpdateInput{}, fmt.Errorf("unsupported field %q", field)
+	}
+}
+
+// applyAutomationUpdate saves a change made by rule and fires the same side
+// effects and triggers as a change made through the API.
+func (h *API) applyAutomationUpdate(ctx context.Context, rule store.AutomationRule, before store.Ticket, input store.TicketUpdateInput) (store.Ticket, error) {
+	after, err := h.store.UpdateTicket(ctx, before.ID, input)
+	if err != nil {
+		return before, err
+	}
+
+	if rule.CreatedBy != nil {
+		h.recordTicketActivities(ctx, before, after, *rule.CreatedBy, automationActorName(rule))
+	}
+	h.autoStopTimers(ctx, before, after)
+	assigneeChanged := after.AssigneeID != nil && (before.AssigneeID == nil || *before.AssigneeID != *after.AssigneeID)
+	if assigneeChanged {
+		message := fmt.Sprintf("%s assigned you to %s", automationActorName(rule), after.Key)
+		if err := h.notifyBackgroundAssignment(ctx, after, message); err != nil {
+			log.Printf("automation_error rule=%s ticket=%s error=%s", rule.ID, after.ID, err.Error())
+		}
+	}
+
+	response := mapTicket(after)
+	h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "ticket.updated", map[string]any{
+		"ticket":           response,
+		"automationRuleId": rule.ID.String(),
+	})
+	stateChanged := before.StateID != after.StateID
+	if stateChanged {
+		h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "ticket.state_changed", map[string]any{
+			"ticket":           response,
+			"fromStateId":      before.StateID.String(),
+			"toStateId":        after.StateID.String(),
+			"automationRuleId": rule.ID.String(),
+		})
+	}
+	h.publishProjectLiveEvent(after.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.updated",
+		"id":     after.ID.String(),
+	})
+	h.publishProjectLiveEvent(after.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "ticket.updated",
+		"id":     after.ID.String(),
+	})
+
+	h.runAutomations(ctx, store.AutomationTriggerTicketUpdated, after)
+	if stateChanged {
+		h.runAutomations(ctx, store.AutomationTriggerStateChanged, after)
+	}
+	// Rules triggered above may have changed the ticket again.
+	if latest, err := h.store.GetTicket(ctx, after.ID); err == nil {
+		after = latest
+	}
+	return after, nil
+}
+
+// createAutomationLinkedTicket creates a ticket in the source ticket's story
+// and links it using the action's relation type, read from the new ticket's
+// side.
+func (h *API) createAutomationLinkedTicket(ctx context.Context, rule store.AutomationRule, source store.Ticket, action store.AutomationAction) error {
+	linked, err := h.store.CreateTicket(ctx, source.ProjectID, store.TicketCreateInput{
+		Title:    store.RenderAutomationText(action.Title, source, rule.Name),
+		Type:     source.Type,
+		StoryID:  source.StoryID,
+		Priority: source.Priority,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := h.store.CreateTicketDependency(ctx, source.ProjectID, store.TicketDependencyCreateInput{
+		TicketID:        linked.ID,
+		RelatedTicketID: source.ID,
+		RelationType:    action.RelationType,
+		CreatedBy:       rule.CreatedBy,
+	}); err != nil {
+		return fmt.Errorf("link %s: %w", linked.Key, err)
+	}
+
+	h.dispatchTicketWebhook(ctx, source.ProjectID, linked.ID, "ticket.created", map[string]any{
+		"ticket":           mapTicket(linked),
+		"automationRuleId": rule.ID.String(),
+	})
+	h.publishProjectLiveEvent(source.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.runAutomations(ctx, store.AutomationTriggerTicketCreated, linked)
+	return nil
+}
+
+func automationActorName(rule store.AutomationRule) string {
+	return "Automation: " + rule.Name
+}
+
+// RunAutomationScheduler runs due scheduled automation rules every interval
+// until ctx is cancelled. Rules are leased before they run, so several
+// replicas can run it concurrently.
+func (h *API) RunAutomationScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := h.RunDueAutomationRules(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("scheduler_error job=automation_rules error=%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDueAutomationRules claims one batch of due scheduled rules and runs each
+// against the tickets matching its conditions. A rule whose run fails keeps
+// its lease and is retried once the lease expires.
+func (h *API) RunDueAutomationRules(ctx context.Context) error {
+	rules, err := h.store.ClaimDueAutomationRules(ctx, automationRuleBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, rule := range rules {
+		if err := h.runScheduledAutomationRule(ctx, rule, time.Now().UTC()); err != nil {
+			log.Printf("scheduler_error job=automation_rules rule=%s error=%s", rule.ID, err.Error())
+		}
+	}
+	return nil
+}
+
+func (h *API) runScheduledAutomationRule(ctx context.Context, rule store.AutomationRule, now time.Time) error {
+	matches, err := h.store.ListBoardFilterMatches(ctx, rule.ProjectID, rule.Conditions, maxScheduledAutomationTickets)
+	if err != nil {
+		return err
+	}
+	for _, match := range matches {
+		ticket, err := h.store.GetTicket(ctx, match.ID)
+		if err != nil {
+			log.Printf("scheduler_error job=automation_rules rule=%s ticket=%s error=%s", rule.ID, match.ID, err.Error())
+			continue
+		}
+		h.runAutomationRule(ctx, rule, store.AutomationTriggerSchedule, ticket)
+	}
+
+	next, err := store.NextAutomationRuleRun(rule, now)
+	if err != nil {
+		// The schedule was validated when saved; stop rather than loop.
+		log.Printf("scheduler_error job=automation_rules rule=%s error=%s", rule.ID, err.Error())
+		next = nil
+	}
+	return h.store.CompleteScheduledAutomationRule(ctx, rule.ID, next)
+}
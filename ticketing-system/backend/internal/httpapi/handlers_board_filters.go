@@ -15,12 +15,12 @@ func (h *API) ListBoardFilterPresets(w http.ResponseWriter, r *http.Request, pro
 	if !h.requireProjectAccess(w, r, projectUUID) {
 		return
 	}
-	ownerID, ok := currentUserID(w, r)
+	userID, ok := currentUserID(w, r)
 	if !ok {
 		return
 	}
 
-	items, err := h.store.ListBoardFilterPresets(r.Context(), projectUUID, ownerID)
+	items, err := h.store.ListBoardFilterPresets(r.Context(), projectUUID, userID)
 	if handleListError(w, r, err, "board filter presets", "board_filter_preset_list") {
 		return
 	}
@@ -47,12 +47,26 @@ func (h *API) CreateBoardFilterPreset(w http.ResponseWriter, r *http.Request, pr
 		writeError(w, http.StatusBadRequest, "invalid_board_filter_preset", "name is required")
 		return
 	}
+	if (req.Pinned != nil || req.IsDefault != nil || req.Position != nil) && !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
 
-	preset, err := h.store.CreateBoardFilterPreset(r.Context(), projectUUID, ownerID, store.BoardFilterPresetCreateInput{
+	input := store.BoardFilterPresetCreateInput{
 		Name:               name,
 		Filters:            mapStoreBoardFilter(req.Filters),
 		GenerateShareToken: derefBool(req.GenerateShareToken, false),
-	})
+		Pinned:             derefBool(req.Pinned, false),
+		IsDefault:          derefBool(req.IsDefault, false),
+		Position:           derefInt(req.Position, 0),
+	}
+	if req.Visibility != nil {
+		input.Visibility = string(*req.Visibility)
+	}
+	if req.GroupIds != nil {
+		input.GroupIDs = mapSlice(*req.GroupIds, func(id openapi_types.UUID) uuid.UUID { return uuid.UUID(id) })
+	}
+
+	preset, err := h.store.CreateBoardFilterPreset(r.Context(), projectUUID, ownerID, input)
 	if handleDBErrorWithCode(w, r, err, "board filter preset", "board_filter_preset_create", "board_filter_preset_create_failed") {
 		return
 	}
@@ -65,18 +79,25 @@ func (h *API) UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request, pr
 	if !h.requireProjectAccess(w, r, projectUUID) {
 		return
 	}
-	ownerID, ok := currentUserID(w, r)
+
+	req, ok := decodeJSON[boardFilterPresetUpdateRequest](w, r, "board_filter_preset_update")
 	if !ok {
 		return
 	}
 
-	req, ok := decodeJSON[boardFilterPresetUpdateRequest](w, r, "board_filter_preset_update")
+	existing, ok := h.requireEditableBoardFilterPreset(w, r, projectUUID, uuid.UUID(presetId), "board_filter_preset_update")
 	if !ok {
 		return
 	}
+	if (req.Pinned != nil || req.IsDefault != nil || req.Position != nil) && !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
 
 	input := store.BoardFilterPresetUpdateInput{
 		GenerateShareToken: req.GenerateShareToken,
+		Pinned:             req.Pinned,
+		IsDefault:          req.IsDefault,
+		Position:           req.Position,
 	}
 	if req.Name != nil {
 		name := strings.TrimSpace(*req.Name)
@@ -86,8 +107,28 @@ func (h *API) UpdateBoardFilterPreset(w http.ResponseWriter, r *http.Request, pr
 		mapped := mapStoreBoardFilter(*req.Filters)
 		input.Filters = &mapped
 	}
-
-	preset, err := h.store.UpdateBoardFilterPreset(r.Context(), projectUUID, ownerID, uuid.UUID(presetId), input)
+	if req.Visibility != nil {
+		visibility := string(*req.Visibility)
+		input.Visibility = &visibility
+		// Pinned and default presets are shown to everyone; unsharing a preset
+		// withdraws it from both.
+		if visibility != store.BoardFilterPresetVisibilityProject {
+			if input.Pinned == nil && existing.Pinned {
+				unpinned := false
+				input.Pinned = &unpinned
+			}
+			if input.IsDefault == nil && existing.IsDefault {
+				notDefault := false
+				input.IsDefault = &notDefault
+			}
+		}
+	}
+	if req.GroupIds != nil {
+		groupIDs := mapSlice(*req.GroupIds, func(id openapi_types.UUID) uuid.UUID { return uuid.UUID(id) })
+		input.GroupIDs = &groupIDs
+	}
+
+	preset, err := h.store.UpdateBoardFilterPreset(r.Context(), projectUUID, uuid.UUID(presetId), input)
 	if handleDBErrorWithCode(w, r, err, "board filter preset", "board_filter_preset_update", "board_filter_preset_update_failed") {
 		return
 	}
@@ -100,17 +141,33 @@ func (h *API) DeleteBoardFilterPreset(w http.ResponseWriter, r *http.Request, pr
 	if !h.requireProjectAccess(w, r, projectUUID) {
 		return
 	}
-	ownerID, ok := currentUserID(w, r)
-	if !ok {
+	if _, ok := h.requireEditableBoardFilterPreset(w, r, projectUUID, uuid.UUID(presetId), "board_filter_preset_delete"); !ok {
 		return
 	}
 
-	if err := h.store.DeleteBoardFilterPreset(r.Context(), projectUUID, ownerID, uuid.UUID(presetId)); handleDeleteError(w, r, err, "board filter preset", "board_filter_preset_delete") {
+	if err := h.store.DeleteBoardFilterPreset(r.Context(), projectUUID, uuid.UUID(presetId)); handleDeleteError(w, r, err, "board filter preset", "board_filter_preset_delete") {
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// requireEditableBoardFilterPreset loads a preset visible to the caller and
+// checks that they own it or administer the project.
+func (h *API) requireEditableBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectID, presetID uuid.UUID, logCode string) (store.BoardFilterPreset, bool) {
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return store.BoardFilterPreset{}, false
+	}
+	preset, err := h.store.GetBoardFilterPreset(r.Context(), projectID, userID, presetID)
+	if handleDBError(w, r, err, "board filter preset", logCode) {
+		return store.BoardFilterPreset{}, false
+	}
+	if preset.OwnerID != userID && !h.requireProjectRole(w, r, projectID, roleAdmin) {
+		return store.BoardFilterPreset{}, false
+	}
+	return preset, true
+}
+
 func (h *API) GetSharedBoardFilterPreset(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, token string) {
 	projectUUID := uuid.UUID(projectId)
 	if !h.requireProjectAccess(w, r, projectUUID) {
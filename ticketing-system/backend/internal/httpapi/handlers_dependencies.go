@@ -3,6 +3,7 @@ package httpapi
 import (
 	"context"
 	"errors"
+	"log"
 	"net/http"
 
 	"ticketing-system/backend/internal/store"
@@ -79,6 +80,10 @@ func (h *API) CreateTicketDependency(w http.ResponseWriter, r *http.Request, id
 		writeError(w, http.StatusBadRequest, "invalid_dependency", "invalid dependency input")
 		return
 	}
+	if errors.Is(err, store.ErrDuplicateOfDuplicate) {
+		writeError(w, http.StatusConflict, "duplicate_of_duplicate", "a ticket cannot be marked as a duplicate of a duplicate")
+		return
+	}
 	if err != nil && err.Error() == "dependency already exists" {
 		writeError(w, http.StatusConflict, "dependency_exists", "dependency already exists")
 		return
@@ -87,9 +92,65 @@ func (h *API) CreateTicketDependency(w http.ResponseWriter, r *http.Request, id
 		return
 	}
 
+	switch req.RelationType {
+	case DuplicateOf:
+		h.closeDuplicateTicket(r, ticket)
+	case DuplicatedBy:
+		h.closeDuplicateTicket(r, related)
+	}
+
 	writeJSON(w, http.StatusCreated, h.mapTicketDependencyWithRelatedTicket(r.Context(), created))
 }
 
+// findDuplicateTickets lists likely duplicates for a response. Lookup
+// failures are logged and leave the list out rather than failing the request.
+func (h *API) findDuplicateTickets(ctx context.Context, projectID uuid.UUID, title, description string, excludeID *uuid.UUID) *[]DuplicateCandidate {
+	candidates, err := h.store.FindDuplicateTickets(ctx, projectID, title, description, excludeID)
+	if err != nil {
+		log.Printf("ticket_duplicates_error project=%s error=%s", projectID, err.Error())
+		return nil
+	}
+	mapped := mapSlice(candidates, mapDuplicateCandidate)
+	return &mapped
+}
+
+// closeDuplicateTicket moves a ticket that was just marked as a duplicate
+// into the project's first closed state, with the same side effects as a
+// manual update. Failures are logged; the relation is kept either way.
+func (h *API) closeDuplicateTicket(r *http.Request, duplicate store.Ticket) {
+	if duplicate.StateClosed {
+		return
+	}
+	ctx := r.Context()
+	states, err := h.store.ListWorkflowStates(ctx, duplicate.ProjectID)
+	if err != nil {
+		log.Printf("ticket_duplicate_close_error ticket=%s error=%s", duplicate.ID, err.Error())
+		return
+	}
+	var closedStateID *uuid.UUID
+	for _, state := range states {
+		if state.IsClosed {
+			closedStateID = &state.ID
+			break
+		}
+	}
+	if closedStateID == nil {
+		return
+	}
+
+	after, err := h.store.UpdateTicket(ctx, duplicate.ID, store.TicketUpdateInput{StateID: closedStateID})
+	if err != nil {
+		log.Printf("ticket_duplicate_close_error ticket=%s error=%s", duplicate.ID, err.Error())
+		return
+	}
+	if actorID, actorName, ok := currentActor(r); ok {
+		h.recordTicketActivities(ctx, duplicate, after, actorID, actorName)
+		h.notifyAssigneeTicketUpdate(r, duplicate, after, actorID, actorName)
+	}
+	h.autoStopTimers(ctx, duplicate, after)
+	h.publishTicketUpdated(ctx, duplicate, after)
+}
+
 func (h *API) DeleteTicketDependency(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, dependencyId openapi_types.UUID) {
 	ticketID := uuid.UUID(id)
 	ticket, err := h.store.GetTicket(r.Context(), ticketID)
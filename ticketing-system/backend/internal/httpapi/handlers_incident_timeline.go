@@ -0,0 +1,345 @@
+package httpapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *API) CreateIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	incident, ok := h.loadIncidentForEdit(w, r, uuid.UUID(id))
+	if !ok {
+		return
+	}
+	req, ok := decodeJSON[IncidentTimelineAnnotationCreateRequest](w, r, "incident_annotation_create")
+	if !ok {
+		return
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		writeError(w, http.StatusBadRequest, "invalid_annotation", "title is required")
+		return
+	}
+	now := time.Now().UTC()
+	occurredAt := now
+	if req.OccurredAt != nil {
+		// Allow for clock skew between the client and the server.
+		if req.OccurredAt.After(now.Add(time.Minute)) {
+			writeError(w, http.StatusBadRequest, "invalid_annotation", "occurredAt must not be in the future")
+			return
+		}
+		occurredAt = req.OccurredAt.UTC()
+	}
+
+	input := store.IncidentTimelineEntryCreateInput{
+		Kind:       store.IncidentTimelineAnnotation,
+		OccurredAt: occurredAt,
+		Title:      title,
+	}
+	if req.Body != nil && strings.TrimSpace(*req.Body) != "" {
+		input.Body = req.Body
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.AuthorID = &actorID
+	}
+	entry, err := h.store.CreateIncidentTimelineEntry(r.Context(), incident.ID, input)
+	if handleDBErrorWithCode(w, r, err, "annotation", "incident_annotation_create", "incident_annotation_create_failed") {
+		return
+	}
+	h.publishProjectLiveEvent(incident.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "incident.annotated",
+		"id":     incident.ID.String(),
+	})
+	writeJSON(w, http.StatusCreated, mapIncidentTimelineEntry(entry))
+}
+
+func (h *API) DeleteIncidentTimelineAnnotation(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, entryId openapi_types.UUID) {
+	ticketID := uuid.UUID(id)
+	ticket, err := h.store.GetTicket(r.Context(), ticketID)
+	if handleDBError(w, r, err, "ticket", "ticket_load") {
+		return
+	}
+	if !h.requireProjectRole(w, r, ticket.ProjectID, roleContributor) {
+		return
+	}
+	err = h.store.DeleteIncidentTimelineAnnotation(r.Context(), ticketID, uuid.UUID(entryId))
+	if handleDeleteError(w, r, err, "annotation", "incident_annotation_delete") {
+		return
+	}
+	h.publishProjectLiveEvent(ticket.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "incident.annotated",
+		"id":     ticket.ID.String(),
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) GetProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	// The settings include the ingestion token.
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+	settings, err := h.store.GetIncidentAlertSettings(r.Context(), projectUUID)
+	if handleListError(w, r, err, "incident alert settings", "incident_alert_settings_get") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIncidentAlertSettings(settings))
+}
+
+func (h *API) UpdateProjectIncidentAlertSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+	req, ok := decodeJSON[IncidentAlertSettingsUpdateRequest](w, r, "incident_alert_settings_update")
+	if !ok {
+		return
+	}
+	current, err := h.store.GetIncidentAlertSettings(r.Context(), projectUUID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "incident_alert_settings_error", "unable to load incident alert settings")
+		return
+	}
+
+	input := store.IncidentAlertSettingsUpdateInput{
+		Enabled:         current.Enabled,
+		StoryID:         current.StoryID,
+		DefaultSeverity: current.DefaultSeverity,
+		RotateToken:     req.RotateToken != nil && *req.RotateToken,
+	}
+	if req.Enabled != nil {
+		input.Enabled = *req.Enabled
+	}
+	if req.StoryId != nil {
+		story, err := h.store.GetStory(r.Context(), uuid.UUID(*req.StoryId))
+		if err != nil || story.ProjectID != projectUUID {
+			writeError(w, http.StatusBadRequest, "invalid_incident_alert_settings", "storyId does not reference a story in this project")
+			return
+		}
+		input.StoryID = &story.ID
+	}
+	if req.DefaultSeverity != nil {
+		switch *req.DefaultSeverity {
+		case Sev1, Sev2, Sev3, Sev4:
+			severity := string(*req.DefaultSeverity)
+			input.DefaultSeverity = &severity
+		default:
+			writeError(w, http.StatusBadRequest, "invalid_incident_alert_settings", "defaultSeverity must be sev1, sev2, sev3 or sev4")
+			return
+		}
+	}
+	if input.Enabled && input.StoryID == nil {
+		writeError(w, http.StatusBadRequest, "invalid_incident_alert_settings", "storyId is required to enable alert ingestion")
+		return
+	}
+
+	settings, err := h.store.UpdateIncidentAlertSettings(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "incident alert settings", "incident_alert_settings_update", "incident_alert_settings_update_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIncidentAlertSettings(settings))
+}
+
+// IngestIncidentAlerts is called by Alertmanager without a session; the
+// token in the path identifies the project.
+func (h *API) IngestIncidentAlerts(w http.ResponseWriter, r *http.Request, token string, params IngestIncidentAlertsParams) {
+	ctx := r.Context()
+	settings, err := h.store.GetIncidentAlertSettingsByToken(ctx, token)
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "not_found", "alert integration not found")
+		return
+	}
+	if handleDBError(w, r, err, "alert integration", "incident_alert_settings_get") {
+		return
+	}
+	req, ok := decodeJSON[AlertmanagerWebhook](w, r, "incident_alert_ingest")
+	if !ok {
+		return
+	}
+	groupKey := strings.TrimSpace(req.GroupKey)
+	if groupKey == "" || len(req.Alerts) == 0 {
+		writeError(w, http.StatusBadRequest, "invalid_alert_payload", "groupKey and alerts are required")
+		return
+	}
+
+	resp := IncidentAlertIngestResponse{}
+	var incident store.Ticket
+	if params.TicketId != nil {
+		incident, err = h.store.GetTicket(ctx, uuid.UUID(*params.TicketId))
+		if err == nil && incident.ProjectID != settings.ProjectID {
+			err = pgx.ErrNoRows
+		}
+		if handleDBError(w, r, err, "ticket", "ticket_load") {
+			return
+		}
+		if !incident.IncidentEnabled {
+			writeError(w, http.StatusConflict, "not_an_incident", "ticket is not an incident")
+			return
+		}
+	} else {
+		ticketID, err := h.store.FindIncidentForAlertGroup(ctx, settings.ProjectID, groupKey)
+		if err == nil {
+			incident, err = h.store.GetTicket(ctx, ticketID)
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			if !hasFiringAlert(req.Alerts) {
+				writeJSON(w, http.StatusOK, resp)
+				return
+			}
+			if settings.StoryID == nil {
+				writeError(w, http.StatusConflict, "alert_story_not_configured", "no story is configured for incidents declared from alerts")
+				return
+			}
+			incident, resp.Declared, err = h.declareIncidentFromAlerts(r, settings, groupKey, req)
+			if handleDBErrorWithCode(w, r, err, "ticket", "incident_alert_declare", "ticket_create_failed") {
+				return
+			}
+		} else if handleDBError(w, r, err, "ticket", "ticket_load") {
+			return
+		}
+	}
+
+	for _, alert := range req.Alerts {
+		_, err := h.store.CreateIncidentTimelineEntry(ctx, incident.ID, alertTimelineEntry(alert, groupKey))
+		if errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if handleDBErrorWithCode(w, r, err, "alert", "incident_alert_record", "incident_alert_record_failed") {
+			return
+		}
+		resp.Recorded++
+	}
+	ticketID := toOpenapiUUID(incident.ID)
+	resp.TicketId = &ticketID
+	if resp.Recorded > 0 {
+		h.publishProjectLiveEvent(incident.ProjectID, projectEventActivityChanged, map[string]any{
+			"reason": "incident.alerted",
+			"id":     incident.ID.String(),
+		})
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// declareIncidentFromAlerts creates an incident ticket for an alert group
+// that has no open incident yet. If a concurrent notification declared one
+// first, that incident is returned and declared is false.
+func (h *API) declareIncidentFromAlerts(r *http.Request, settings store.IncidentAlertSettings, groupKey string, req AlertmanagerWebhook) (store.Ticket, bool, error) {
+	ctx := r.Context()
+	labels := derefStringMap(req.CommonLabels)
+	annotations := derefStringMap(req.CommonAnnotations)
+
+	title := firstNonEmpty(annotations["summary"], labels["alertname"], derefStringMap(req.GroupLabels)["alertname"], "Alert")
+	description := annotations["description"]
+	if url := derefString(req.ExternalURL); url != "" {
+		description = strings.TrimSpace(description + "\n\nAlertmanager: " + url)
+	}
+	severity := store.AlertSeverity(labels["severity"])
+	if severity == nil {
+		severity = settings.DefaultSeverity
+	}
+
+	ticket, declared, err := h.store.DeclareIncidentForAlertGroup(ctx, settings.ProjectID, groupKey, store.TicketCreateInput{
+		Title:            title,
+		Description:      description,
+		StoryID:          *settings.StoryID,
+		IncidentEnabled:  true,
+		IncidentSeverity: severity,
+	})
+	if err != nil || !declared {
+		return ticket, false, err
+	}
+	ticket = h.assignOnCallCommander(r, store.Ticket{}, ticket)
+
+	h.dispatchTicketWebhook(ctx, ticket.ProjectID, ticket.ID, "ticket.created", map[string]any{"ticket": mapTicket(ticket)})
+	h.recordIncidentChanges(r, store.Ticket{}, ticket)
+	h.publishProjectLiveEvent(ticket.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.runAutomations(ctx, store.AutomationTriggerTicketCreated, ticket)
+	return ticket, true, nil
+}
+
+// alertTimelineEntry records one alert of a notification. A firing alert is
+// placed at its start, a resolved one at its end.
+func alertTimelineEntry(alert AlertmanagerAlert, groupKey string) store.IncidentTimelineEntryCreateInput {
+	labels := derefStringMap(alert.Labels)
+	annotations := derefStringMap(alert.Annotations)
+	status := store.AlertStatusFiring
+	occurredAt := alert.StartsAt
+	if alert.Status == AlertmanagerAlertStatusResolved {
+		status = store.AlertStatusResolved
+		occurredAt = alert.EndsAt
+	}
+	at := time.Now().UTC()
+	if occurredAt != nil && !occurredAt.IsZero() {
+		at = occurredAt.UTC()
+	}
+
+	var body []string
+	for _, key := range []string{"summary", "description"} {
+		if value := strings.TrimSpace(annotations[key]); value != "" {
+			body = append(body, value)
+		}
+	}
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels))
+		for key, value := range labels {
+			pairs = append(pairs, key+"="+value)
+		}
+		sort.Strings(pairs)
+		body = append(body, "Labels: "+strings.Join(pairs, ", "))
+	}
+	if url := derefString(alert.GeneratorURL); url != "" {
+		body = append(body, "Source: "+url)
+	}
+
+	input := store.IncidentTimelineEntryCreateInput{
+		Kind:          store.IncidentTimelineAlert,
+		OccurredAt:    at,
+		Title:         fmt.Sprintf("[%s] %s", strings.ToUpper(status), firstNonEmpty(labels["alertname"], "alert")),
+		AlertStatus:   &status,
+		AlertGroupKey: &groupKey,
+	}
+	if len(body) > 0 {
+		text := strings.Join(body, "\n")
+		input.Body = &text
+	}
+	if alert.Fingerprint != nil && *alert.Fingerprint != "" {
+		input.AlertFingerprint = alert.Fingerprint
+	}
+	return input
+}
+
+func hasFiringAlert(alerts []AlertmanagerAlert) bool {
+	for _, alert := range alerts {
+		if alert.Status == AlertmanagerAlertStatusFiring {
+			return true
+		}
+	}
+	return false
+}
+
+func derefStringMap(value *map[string]string) map[string]string {
+	if value == nil {
+		return map[string]string{}
+	}
+	return *value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package httpapi
+
+import (
+	"errors"
+	"log"
+	"net/http"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+var errInvalidIncidentTransition = errors.New("incident status can only move forward")
+
+// validateIncidentStatusChange checks a requested status against the
+// ticket's. A status needs an incident, and statuses only move forward.
+func validateIncidentStatusChange(current store.Ticket, enabled *bool, status string) error {
+	if store.IncidentStatusRank(status) < 0 {
+		return errors.New("invalid incident status")
+	}
+	incident := current.IncidentEnabled
+	if enabled != nil {
+		incident = *enabled
+	}
+	if !incident {
+		return errors.New("incidentStatus requires incidentEnabled")
+	}
+	if current.IncidentStatus != nil && store.IncidentStatusRank(status) < store.IncidentStatusRank(*current.IncidentStatus) {
+		return errInvalidIncidentTransition
+	}
+	return nil
+}
+
+// recordIncidentChanges keeps the severity history and sends the incident
+// webhooks for a ticket that changed from before to after. before is the
+// zero Ticket for a ticket that was just created.
+func (h *API) recordIncidentChanges(r *http.Request, before, after store.Ticket) {
+	if !after.IncidentEnabled {
+		return
+	}
+	ctx := r.Context()
+	declared := !before.IncidentEnabled
+	response := mapTicket(after)
+	if declared {
+		h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "incident.declared", map[string]any{"ticket": response})
+	}
+
+	fromSeverity := before.IncidentSeverity
+	if declared {
+		fromSeverity = nil
+	}
+	if derefString(fromSeverity) != derefString(after.IncidentSeverity) {
+		var actorID *uuid.UUID
+		if id, _, ok := currentActor(r); ok {
+			actorID = &id
+		}
+		change, err := h.store.CreateIncidentSeverityChange(ctx, after.ID, fromSeverity, after.IncidentSeverity, actorID)
+		if err != nil {
+			log.Printf("incident_severity_change_error ticket=%s error=%s", after.ID, err.Error())
+		} else if !declared && change.Escalated() {
+			h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "incident.escalated", map[string]any{
+				"ticket":       response,
+				"fromSeverity": derefString(change.FromSeverity),
+				"toSeverity":   derefString(change.ToSeverity),
+			})
+		}
+	}
+
+	resolved := store.IncidentStatusResolved
+	if derefString(after.IncidentStatus) == resolved && (declared || derefString(before.IncidentStatus) != resolved) {
+		h.dispatchTicketWebhook(ctx, after.ProjectID, after.ID, "incident.resolved", map[string]any{"ticket": response})
+	}
+}
+
+func (h *API) ListTicketIncidentSeverityHistory(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	ticketID := uuid.UUID(id)
+	ticket, err := h.store.GetTicket(r.Context(), ticketID)
+	if handleDBError(w, r, err, "ticket", "ticket_load") {
+		return
+	}
+	if !h.requireProjectAccess(w, r, ticket.ProjectID) {
+		return
+	}
+	changes, err := h.store.ListIncidentSeverityChanges(r.Context(), ticketID)
+	if handleListError(w, r, err, "incident severity changes", "incident_severity_history") {
+		return
+	}
+	writeJSON(w, http.StatusOK, IncidentSeverityChangeListResponse{Items: mapSlice(changes, mapIncidentSeverityChange)})
+}
+
+func (h *API) GetProjectIncidentMetrics(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectIncidentMetricsParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	from, to, ok := parseReportingRange(params.From, params.To)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid_date_range", "`to` must be on or after `from`")
+		return
+	}
+
+	report, err := h.store.GetIncidentMetrics(r.Context(), projectUUID, from, to)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load incident metrics")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIncidentMetricsReport(report))
+}
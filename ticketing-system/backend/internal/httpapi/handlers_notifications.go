@@ -1,6 +1,7 @@
 package httpapi
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -248,6 +249,30 @@ func (h *API) notifyAssignment(r *http.Request, before, after store.Ticket, acto
 	h.publishUserNotificationEvents(r.Context(), after.ProjectID, *after.AssigneeID)
 }
 
+// notifyBackgroundAssignment tells a ticket's assignee about an assignment
+// made outside a request, such as by a scheduler or an automation rule.
+func (h *API) notifyBackgroundAssignment(ctx context.Context, ticket store.Ticket, message string) error {
+	if ticket.AssigneeID == nil {
+		return nil
+	}
+	prefs, err := h.store.GetNotificationPreferences(ctx, *ticket.AssigneeID)
+	if err != nil || !prefs.AssignmentEnabled {
+		return nil
+	}
+	_, err = h.store.CreateNotification(ctx, store.NotificationCreateInput{
+		ProjectID: ticket.ProjectID,
+		UserID:    *ticket.AssigneeID,
+		TicketID:  ticket.ID,
+		Type:      "assignment",
+		Message:   message,
+	})
+	if err != nil {
+		return err
+	}
+	h.publishUserNotificationEvents(ctx, ticket.ProjectID, *ticket.AssigneeID)
+	return nil
+}
+
 func (h *API) notifyAssigneeTicketUpdate(r *http.Request, before, after store.Ticket, actorID uuid.UUID, actorName string) {
 	if after.AssigneeID == nil || *after.AssigneeID == actorID {
 		return
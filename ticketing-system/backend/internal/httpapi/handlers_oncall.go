@@ -0,0 +1,273 @@
+package httpapi
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *API) ListProjectOnCallSchedules(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	schedules, err := h.store.ListOnCallSchedulesForProject(r.Context(), projectUUID)
+	if handleListError(w, r, err, "on-call schedules", "oncall_schedule_list") {
+		return
+	}
+	h.writeOnCallSchedules(w, r, schedules)
+}
+
+func (h *API) CreateProjectOnCallSchedule(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+	h.createOnCallSchedule(w, r, store.OnCallScheduleInput{ProjectID: &projectUUID})
+}
+
+func (h *API) ListGroupOnCallSchedules(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID) {
+	schedules, err := h.store.ListOnCallSchedulesForGroup(r.Context(), uuid.UUID(groupId))
+	if handleListError(w, r, err, "on-call schedules", "oncall_schedule_list") {
+		return
+	}
+	h.writeOnCallSchedules(w, r, schedules)
+}
+
+func (h *API) CreateGroupOnCallSchedule(w http.ResponseWriter, r *http.Request, groupId openapi_types.UUID) {
+	if !requireAdmin(w, r) {
+		return
+	}
+	groupUUID := uuid.UUID(groupId)
+	if _, err := h.store.GetGroup(r.Context(), groupUUID); handleDBError(w, r, err, "group", "group_load") {
+		return
+	}
+	h.createOnCallSchedule(w, r, store.OnCallScheduleInput{GroupID: &groupUUID})
+}
+
+func (h *API) GetOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleViewer)
+	if !ok {
+		return
+	}
+	overrides, err := h.store.ListOnCallOverrides(r.Context(), sched.ID, time.Now().UTC())
+	if handleListError(w, r, err, "on-call overrides", "oncall_override_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapOnCallSchedule(sched, overrides))
+}
+
+func (h *API) UpdateOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleAdmin)
+	if !ok {
+		return
+	}
+	req, ok := decodeJSON[OnCallScheduleRequest](w, r, "oncall_schedule_update")
+	if !ok {
+		return
+	}
+	updated, err := h.store.UpdateOnCallSchedule(r.Context(), sched.ID, onCallScheduleInput(store.OnCallScheduleInput{}, req))
+	if handleDBErrorWithCode(w, r, err, "on-call schedule", "oncall_schedule_update", "invalid_oncall_schedule") {
+		return
+	}
+	overrides, err := h.store.ListOnCallOverrides(r.Context(), updated.ID, time.Now().UTC())
+	if handleListError(w, r, err, "on-call overrides", "oncall_override_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapOnCallSchedule(updated, overrides))
+}
+
+func (h *API) DeleteOnCallSchedule(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleAdmin)
+	if !ok {
+		return
+	}
+	if handleDeleteError(w, r, h.store.DeleteOnCallSchedule(r.Context(), sched.ID), "on-call schedule", "oncall_schedule_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) CreateOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleContributor)
+	if !ok {
+		return
+	}
+	req, ok := decodeJSON[OnCallOverrideCreateRequest](w, r, "oncall_override_create")
+	if !ok {
+		return
+	}
+	input := store.OnCallOverrideCreateInput{
+		UserID:   uuid.UUID(req.UserId),
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.CreatedBy = &actorID
+	}
+	override, err := h.store.CreateOnCallOverride(r.Context(), sched.ID, input)
+	if handleDBErrorWithCode(w, r, err, "user", "oncall_override_create", "invalid_oncall_override") {
+		return
+	}
+	writeJSON(w, http.StatusCreated, mapOnCallOverride(override))
+}
+
+func (h *API) DeleteOnCallOverride(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, overrideId openapi_types.UUID) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleContributor)
+	if !ok {
+		return
+	}
+	err := h.store.DeleteOnCallOverride(r.Context(), sched.ID, uuid.UUID(overrideId))
+	if handleDeleteError(w, r, err, "on-call override", "oncall_override_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) GetOnCallScheduleShift(w http.ResponseWriter, r *http.Request, scheduleId openapi_types.UUID, params GetOnCallScheduleShiftParams) {
+	sched, ok := h.loadOnCallSchedule(w, r, uuid.UUID(scheduleId), roleViewer)
+	if !ok {
+		return
+	}
+	shift, found, err := h.store.GetOnCallShift(r.Context(), sched, onCallTime(params.At))
+	if handleDBError(w, r, err, "on-call shift", "oncall_shift_load") {
+		return
+	}
+	if !found {
+		writeError(w, http.StatusNotFound, "nobody_on_call", "nobody is on call at that time")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapOnCallShift(shift))
+}
+
+func (h *API) GetProjectOnCall(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectOnCallParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	shifts, err := h.store.ListProjectOnCall(r.Context(), projectUUID, onCallTime(params.At))
+	if handleListError(w, r, err, "on-call shifts", "oncall_shift_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, OnCallShiftListResponse{Items: mapSlice(shifts, mapOnCallShift)})
+}
+
+// loadOnCallSchedule loads a schedule and checks the caller may act on it.
+// Project schedules need minRole on the project, where roleViewer means
+// any project access. Group schedules can be read by anyone and changed by
+// admins only.
+func (h *API) loadOnCallSchedule(w http.ResponseWriter, r *http.Request, id uuid.UUID, minRole string) (store.OnCallSchedule, bool) {
+	sched, err := h.store.GetOnCallSchedule(r.Context(), id)
+	if handleDBError(w, r, err, "on-call schedule", "oncall_schedule_load") {
+		return store.OnCallSchedule{}, false
+	}
+	switch {
+	case sched.ProjectID == nil:
+		if minRole != roleViewer && !requireAdmin(w, r) {
+			return store.OnCallSchedule{}, false
+		}
+	case minRole == roleViewer:
+		if !h.requireProjectAccess(w, r, *sched.ProjectID) {
+			return store.OnCallSchedule{}, false
+		}
+	default:
+		if !h.requireProjectRole(w, r, *sched.ProjectID, minRole) {
+			return store.OnCallSchedule{}, false
+		}
+	}
+	return sched, true
+}
+
+func (h *API) createOnCallSchedule(w http.ResponseWriter, r *http.Request, owner store.OnCallScheduleInput) {
+	req, ok := decodeJSON[OnCallScheduleRequest](w, r, "oncall_schedule_create")
+	if !ok {
+		return
+	}
+	sched, err := h.store.CreateOnCallSchedule(r.Context(), onCallScheduleInput(owner, req))
+	if handleDBErrorWithCode(w, r, err, "on-call schedule", "oncall_schedule_create", "invalid_oncall_schedule") {
+		return
+	}
+	writeJSON(w, http.StatusCreated, mapOnCallSchedule(sched, nil))
+}
+
+func (h *API) writeOnCallSchedules(w http.ResponseWriter, r *http.Request, schedules []store.OnCallSchedule) {
+	now := time.Now().UTC()
+	items := make([]OnCallSchedule, 0, len(schedules))
+	for _, sched := range schedules {
+		overrides, err := h.store.ListOnCallOverrides(r.Context(), sched.ID, now)
+		if handleListError(w, r, err, "on-call overrides", "oncall_override_list") {
+			return
+		}
+		items = append(items, mapOnCallSchedule(sched, overrides))
+	}
+	writeJSON(w, http.StatusOK, OnCallScheduleListResponse{Items: items})
+}
+
+func onCallScheduleInput(input store.OnCallScheduleInput, req OnCallScheduleRequest) store.OnCallScheduleInput {
+	input.Name = req.Name
+	input.Timezone = derefString(req.Timezone)
+	input.Layers = mapSlice(req.Layers, func(layer OnCallLayerRequest) store.OnCallLayerInput {
+		return store.OnCallLayerInput{
+			Name:         derefString(layer.Name),
+			RotationKind: string(layer.RotationKind),
+			Rotation:     layer.Rotation,
+			StartsAt:     layer.StartsAt,
+			EndsAt:       layer.EndsAt,
+			ParticipantIDs: mapSlice(layer.ParticipantIds, func(id openapi_types.UUID) uuid.UUID {
+				return uuid.UUID(id)
+			}),
+		}
+	})
+	return input
+}
+
+func onCallTime(at *time.Time) time.Time {
+	if at == nil {
+		return time.Now().UTC()
+	}
+	return at.UTC()
+}
+
+// assignOnCallCommander makes the first on-call user of the project the
+// commander of a newly declared incident that has none, and pages them.
+// Failures are logged and leave the ticket as it was.
+func (h *API) assignOnCallCommander(r *http.Request, before, after store.Ticket) store.Ticket {
+	if !after.IncidentEnabled || before.IncidentEnabled || after.IncidentCommanderID != nil {
+		return after
+	}
+	ctx := r.Context()
+	shifts, err := h.store.ListProjectOnCall(ctx, after.ProjectID, time.Now().UTC())
+	if err != nil {
+		logRequestError(r, "oncall_commander_lookup_failed", err)
+		return after
+	}
+	if len(shifts) == 0 {
+		return after
+	}
+	commander := shifts[0]
+	ticket, err := h.store.UpdateTicket(ctx, after.ID, store.TicketUpdateInput{IncidentCommanderID: &commander.UserID})
+	if err != nil {
+		logRequestError(r, "oncall_commander_assign_failed", err)
+		return after
+	}
+
+	// Paging is not subject to notification preferences.
+	_, err = h.store.CreateNotification(ctx, store.NotificationCreateInput{
+		ProjectID: ticket.ProjectID,
+		UserID:    commander.UserID,
+		TicketID:  ticket.ID,
+		Type:      "incident_commander",
+		Message:   fmt.Sprintf("You are on call for %s and are commander of incident %s", commander.ScheduleName, ticket.Key),
+	})
+	if err != nil {
+		logRequestError(r, "notification_incident_commander_create_failed", err)
+		return ticket
+	}
+	h.publishUserNotificationEvents(ctx, ticket.ProjectID, commander.UserID)
+	return ticket
+}
@@ -0,0 +1,167 @@
+package httpapi
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// loadIncidentForEdit loads an incident ticket the caller is adding to. It
+// writes the error response and returns false if the caller is not a
+// contributor on the ticket's project or the ticket is not an incident.
+func (h *API) loadIncidentForEdit(w http.ResponseWriter, r *http.Request, ticketID uuid.UUID) (store.Ticket, bool) {
+	ticket, err := h.store.GetTicket(r.Context(), ticketID)
+	if handleDBError(w, r, err, "ticket", "ticket_load") {
+		return store.Ticket{}, false
+	}
+	if !h.requireProjectRole(w, r, ticket.ProjectID, roleContributor) {
+		return store.Ticket{}, false
+	}
+	if !ticket.IncidentEnabled {
+		writeError(w, http.StatusConflict, "not_an_incident", "ticket is not an incident")
+		return store.Ticket{}, false
+	}
+	return ticket, true
+}
+
+func (h *API) GetTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	ticketID := uuid.UUID(id)
+	ticket, err := h.store.GetTicket(r.Context(), ticketID)
+	if handleDBError(w, r, err, "ticket", "ticket_load") {
+		return
+	}
+	if !h.requireProjectAccess(w, r, ticket.ProjectID) {
+		return
+	}
+	postmortem, err := h.store.GetIncidentPostmortem(r.Context(), ticketID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "postmortem_not_found", "postmortem not found")
+		return
+	}
+	if handleDBError(w, r, err, "postmortem", "postmortem_load") {
+		return
+	}
+	actionItems, err := h.store.ListPostmortemActionItems(r.Context(), ticketID)
+	if handleListError(w, r, err, "postmortem action items", "postmortem_action_item_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIncidentPostmortem(postmortem, actionItems))
+}
+
+func (h *API) UpdateTicketPostmortem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	ticketID := uuid.UUID(id)
+	if _, ok := h.loadIncidentForEdit(w, r, ticketID); !ok {
+		return
+	}
+	req, ok := decodeJSON[IncidentPostmortemUpdateRequest](w, r, "postmortem_update")
+	if !ok {
+		return
+	}
+
+	input := store.IncidentPostmortemUpdateInput{
+		Summary:             req.Summary,
+		Impact:              req.Impact,
+		RootCause:           req.RootCause,
+		ContributingFactors: req.ContributingFactors,
+		Lessons:             req.Lessons,
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.UpdatedBy = &actorID
+	}
+	postmortem, err := h.store.SaveIncidentPostmortem(r.Context(), ticketID, input)
+	if handleDBError(w, r, err, "postmortem", "postmortem_update") {
+		return
+	}
+	actionItems, err := h.store.ListPostmortemActionItems(r.Context(), ticketID)
+	if handleListError(w, r, err, "postmortem action items", "postmortem_action_item_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapIncidentPostmortem(postmortem, actionItems))
+}
+
+func (h *API) CreatePostmortemActionItem(w http.ResponseWriter, r *http.Request, id openapi_types.UUID) {
+	incident, ok := h.loadIncidentForEdit(w, r, uuid.UUID(id))
+	if !ok {
+		return
+	}
+	req, ok := decodeJSON[PostmortemActionItemCreateRequest](w, r, "postmortem_action_item_create")
+	if !ok {
+		return
+	}
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		writeError(w, http.StatusBadRequest, "invalid_ticket", "title is required")
+		return
+	}
+
+	input := store.TicketCreateInput{
+		Title:       title,
+		Description: derefString(req.Description),
+		StoryID:     incident.StoryID,
+		AssigneeID:  parseOpenapiUUIDPtr(req.AssigneeId),
+	}
+	if req.Priority != nil {
+		input.Priority = string(*req.Priority)
+	}
+	var createdBy *uuid.UUID
+	actorID, actorName, hasActor := currentActor(r)
+	if hasActor {
+		createdBy = &actorID
+	}
+	ctx := r.Context()
+	ticket, item, err := h.store.CreatePostmortemActionItem(ctx, incident.ProjectID, incident.ID, input, createdBy)
+	if handleDBErrorWithCode(w, r, err, "postmortem action item", "postmortem_action_item_create", "postmortem_action_item_create_failed") {
+		return
+	}
+
+	if hasActor {
+		h.notifyAssignment(r, store.Ticket{}, ticket, actorID, actorName)
+	}
+	h.dispatchTicketWebhook(ctx, ticket.ProjectID, ticket.ID, "ticket.created", map[string]any{"ticket": mapTicket(ticket)})
+	h.publishProjectLiveEvent(ticket.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.publishProjectLiveEvent(ticket.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.runAutomations(ctx, store.AutomationTriggerTicketCreated, ticket)
+
+	writeJSON(w, http.StatusCreated, mapPostmortemActionItem(item))
+}
+
+func (h *API) ListPostmortemActionItems(w http.ResponseWriter, r *http.Request, params ListPostmortemActionItemsParams) {
+	var projectIDs []uuid.UUID
+	if params.ProjectId != nil {
+		projectID := uuid.UUID(*params.ProjectId)
+		if !h.requireProjectAccess(w, r, projectID) {
+			return
+		}
+		projectIDs = []uuid.UUID{projectID}
+	} else {
+		accessible, err := h.projectIDsForCurrentUser(r.Context())
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "project_access_failed", "unable to verify project access")
+			return
+		}
+		for projectID := range accessible {
+			projectIDs = append(projectIDs, projectID)
+		}
+	}
+
+	includeClosed := params.IncludeClosed != nil && *params.IncludeClosed
+	limit := 200
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	items, err := h.store.ListPostmortemActionItemsForProjects(r.Context(), projectIDs, includeClosed, limit)
+	if handleListError(w, r, err, "postmortem action items", "postmortem_action_item_report") {
+		return
+	}
+	writeJSON(w, http.StatusOK, PostmortemActionItemListResponse{Items: mapSlice(items, mapPostmortemActionItem)})
+}
@@ -0,0 +1,290 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	webhookEventPresetMatchesChanged = "preset.matches_changed"
+	notificationTypePresetMatch      = "preset_match"
+
+	presetSubscriptionBatchSize = 50
+	// maxPresetMatchNotifications caps in-app notifications per evaluation so a
+	// broad preset cannot flood a subscriber's inbox.
+	maxPresetMatchNotifications = 20
+	// maxPresetSubscriptionMatches bounds the match set a subscription tracks.
+	// Presets matching more are not diffed, since a truncated set would report
+	// tickets at the cut-off as added or removed; the subscriber is told once
+	// instead, and tracking restarts from a new baseline when the preset
+	// narrows again.
+	maxPresetSubscriptionMatches = 1000
+)
+
+func (h *API) GetBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.store.GetBoardFilterPreset(r.Context(), projectUUID, userID, uuid.UUID(presetId)); handleDBError(w, r, err, "board filter preset", "board_filter_preset_subscription_get") {
+		return
+	}
+	sub, err := h.store.GetBoardFilterPresetSubscription(r.Context(), uuid.UUID(presetId), userID)
+	if handleDBError(w, r, err, "board filter preset subscription", "board_filter_preset_subscription_get") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapBoardFilterPresetSubscription(sub))
+}
+
+func (h *API) UpsertBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	req, ok := decodeJSON[boardFilterPresetSubscriptionRequest](w, r, "board_filter_preset_subscription_upsert")
+	if !ok {
+		return
+	}
+	input := store.BoardFilterPresetSubscriptionInput{
+		IntervalMinutes: derefInt(req.IntervalMinutes, 60),
+		NotifyInApp:     derefBool(req.NotifyInApp, true),
+		SendWebhook:     derefBool(req.SendWebhook, false),
+	}
+	if input.IntervalMinutes < 5 || input.IntervalMinutes > 10080 {
+		writeError(w, http.StatusBadRequest, "invalid_subscription", "intervalMinutes must be between 5 and 10080")
+		return
+	}
+	if !input.NotifyInApp && !input.SendWebhook {
+		writeError(w, http.StatusBadRequest, "invalid_subscription", "enable notifyInApp or sendWebhook")
+		return
+	}
+	if input.SendWebhook && !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	if _, err := h.store.GetBoardFilterPreset(r.Context(), projectUUID, userID, uuid.UUID(presetId)); handleDBError(w, r, err, "board filter preset", "board_filter_preset_subscription_upsert") {
+		return
+	}
+	sub, err := h.store.UpsertBoardFilterPresetSubscription(r.Context(), uuid.UUID(presetId), userID, input)
+	if handleDBErrorWithCode(w, r, err, "board filter preset subscription", "board_filter_preset_subscription_upsert", "board_filter_preset_subscription_upsert_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapBoardFilterPresetSubscription(sub))
+}
+
+func (h *API) DeleteBoardFilterPresetSubscription(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, presetId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.store.GetBoardFilterPreset(r.Context(), projectUUID, userID, uuid.UUID(presetId)); handleDBError(w, r, err, "board filter preset", "board_filter_preset_subscription_delete") {
+		return
+	}
+	if err := h.store.DeleteBoardFilterPresetSubscription(r.Context(), uuid.UUID(presetId), userID); handleDeleteError(w, r, err, "board filter preset subscription", "board_filter_preset_subscription_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunPresetSubscriptionScheduler evaluates due preset subscriptions every
+// interval until ctx is cancelled. Claiming uses row locks, so several
+// replicas can run it concurrently.
+func (h *API) RunPresetSubscriptionScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := h.EvaluatePresetSubscriptions(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("scheduler_error job=preset_subscriptions error=%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EvaluatePresetSubscriptions claims and evaluates one batch of due
+// subscriptions.
+func (h *API) EvaluatePresetSubscriptions(ctx context.Context) error {
+	subs, err := h.store.ClaimDuePresetSubscriptions(ctx, presetSubscriptionBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		if err := h.evaluatePresetSubscription(ctx, sub); err != nil {
+			log.Printf("scheduler_error job=preset_subscriptions subscription=%s error=%s", sub.ID, err.Error())
+		}
+	}
+	return nil
+}
+
+// evaluatePresetSubscription diffs a subscription's matches against its last
+// run and delivers the changes. The subscription's watermark only advances
+// once the new matches are recorded, so a failed run is retried when its
+// lease expires.
+func (h *API) evaluatePresetSubscription(ctx context.Context, sub store.DuePresetSubscription) error {
+	role, err := h.store.GetProjectRoleForUser(ctx, sub.ProjectID, sub.UserID)
+	if err != nil {
+		return err
+	}
+	if role == "" {
+		// The subscriber lost access; keep the subscription but stay quiet.
+		return h.store.MarkPresetSubscriptionEvaluated(ctx, sub.ID)
+	}
+	if _, err := h.store.GetBoardFilterPreset(ctx, sub.ProjectID, sub.UserID, sub.PresetID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			// The preset is no longer shared with the subscriber.
+			return h.store.MarkPresetSubscriptionEvaluated(ctx, sub.ID)
+		}
+		return err
+	}
+
+	current, err := h.store.ListBoardFilterMatches(ctx, sub.ProjectID, sub.Filters, maxPresetSubscriptionMatches+1)
+	if err != nil {
+		return err
+	}
+	if len(current) > maxPresetSubscriptionMatches {
+		if err := h.store.RecordPresetSubscriptionMatches(ctx, sub.ID, nil, true); err != nil {
+			return err
+		}
+		if !sub.MatchesTruncated {
+			h.deliverPresetMatchesTruncated(ctx, sub, current[0])
+		}
+		return nil
+	}
+	if err := h.store.RecordPresetSubscriptionMatches(ctx, sub.ID, current, false); err != nil {
+		return err
+	}
+	if sub.LastMatches == nil {
+		// First run, or the first since the preset matched too many tickets,
+		// records the baseline only.
+		return nil
+	}
+
+	added, removed := diffBoardFilterMatches(sub.LastMatches, current)
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	if sub.NotifyInApp && len(added) > 0 {
+		notified := 0
+		for _, match := range added {
+			if notified == maxPresetMatchNotifications {
+				break
+			}
+			_, err := h.store.CreateNotification(ctx, store.NotificationCreateInput{
+				ProjectID: sub.ProjectID,
+				UserID:    sub.UserID,
+				TicketID:  match.ID,
+				Type:      notificationTypePresetMatch,
+				Message:   fmt.Sprintf("%s now matches %q", match.Key, sub.PresetName),
+			})
+			if err != nil {
+				log.Printf("scheduler_error job=preset_subscriptions subscription=%s error=%s", sub.ID, err.Error())
+				continue
+			}
+			notified++
+		}
+		if notified > 0 {
+			h.publishUserNotificationEvents(ctx, sub.ProjectID, sub.UserID)
+		}
+	}
+
+	if sub.SendWebhook && h.webhooks != nil {
+		h.webhooks.Dispatch(ctx, sub.ProjectID, webhookEventPresetMatchesChanged, map[string]any{
+			"presetId":     sub.PresetID.String(),
+			"presetName":   sub.PresetName,
+			"subscriberId": sub.UserID.String(),
+			"added":        added,
+			"removed":      removed,
+		})
+	}
+	return nil
+}
+
+// deliverPresetMatchesTruncated tells the subscriber the preset now matches
+// more tickets than are tracked. Notifications need a ticket, so the in-app
+// one points at the first match.
+func (h *API) deliverPresetMatchesTruncated(ctx context.Context, sub store.DuePresetSubscription, first store.BoardFilterMatch) {
+	if sub.NotifyInApp {
+		_, err := h.store.CreateNotification(ctx, store.NotificationCreateInput{
+			ProjectID: sub.ProjectID,
+			UserID:    sub.UserID,
+			TicketID:  first.ID,
+			Type:      notificationTypePresetMatch,
+			Message:   fmt.Sprintf("%q matches more than %d tickets; changes are not tracked until it matches fewer", sub.PresetName, maxPresetSubscriptionMatches),
+		})
+		if err != nil {
+			log.Printf("scheduler_error job=preset_subscriptions subscription=%s error=%s", sub.ID, err.Error())
+		} else {
+			h.publishUserNotificationEvents(ctx, sub.ProjectID, sub.UserID)
+		}
+	}
+
+	if sub.SendWebhook && h.webhooks != nil {
+		h.webhooks.Dispatch(ctx, sub.ProjectID, webhookEventPresetMatchesChanged, map[string]any{
+			"presetId":     sub.PresetID.String(),
+			"presetName":   sub.PresetName,
+			"subscriberId": sub.UserID.String(),
+			"added":        []store.BoardFilterMatch{},
+			"removed":      []store.BoardFilterMatch{},
+			"truncated":    true,
+			"limit":        maxPresetSubscriptionMatches,
+		})
+	}
+}
+
+// diffBoardFilterMatches returns tickets present only in current (added) and
+// only in previous (removed), preserving input order.
+func diffBoardFilterMatches(previous, current []store.BoardFilterMatch) ([]store.BoardFilterMatch, []store.BoardFilterMatch) {
+	before := make(map[uuid.UUID]struct{}, len(previous))
+	for _, match := range previous {
+		before[match.ID] = struct{}{}
+	}
+	after := make(map[uuid.UUID]struct{}, len(current))
+	for _, match := range current {
+		after[match.ID] = struct{}{}
+	}
+
+	added := []store.BoardFilterMatch{}
+	for _, match := range current {
+		if _, ok := before[match.ID]; !ok {
+			added = append(added, match)
+		}
+	}
+	removed := []store.BoardFilterMatch{}
+	for _, match := range previous {
+		if _, ok := after[match.ID]; !ok {
+			removed = append(removed, match)
+		}
+	}
+	return added, removed
+}
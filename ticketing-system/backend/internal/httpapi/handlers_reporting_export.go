@@ -0,0 +1,270 @@
+package httpapi
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+	"ticketing-system/backend/internal/xlsx"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// exportFlushRows is how many rows a streamed export writes between flushes.
+const exportFlushRows = 500
+
+var reportingTicketDetailHeader = []string{
+	"key", "title", "type", "priority", "state", "assignee", "created_at", "closed_at",
+	"cycle_time_hours", "story_points", "time_estimate_minutes", "logged_minutes",
+}
+
+// exportStream buffers the start of a streamed export so that a failure
+// before anything reaches the client can still become an error response.
+type exportStream struct {
+	w       http.ResponseWriter
+	buf     *bufio.Writer
+	started bool
+}
+
+func newExportStream(w http.ResponseWriter) *exportStream {
+	stream := &exportStream{w: w}
+	stream.buf = bufio.NewWriterSize(exportStreamSink{stream}, 32*1024)
+	return stream
+}
+
+func (s *exportStream) Write(p []byte) (int, error) {
+	return s.buf.Write(p)
+}
+
+func (s *exportStream) Flush() error {
+	if err := s.buf.Flush(); err != nil {
+		return err
+	}
+	if flusher, ok := s.w.(http.Flusher); ok && s.started {
+		flusher.Flush()
+	}
+	return nil
+}
+
+type exportStreamSink struct {
+	stream *exportStream
+}
+
+func (s exportStreamSink) Write(p []byte) (int, error) {
+	if !s.stream.started {
+		s.stream.started = true
+		s.stream.w.WriteHeader(http.StatusOK)
+	}
+	return s.stream.w.Write(p)
+}
+
+// exportReportingTicketDetails streams one row per ticket in the requested
+// format, flushing as it goes so large projects are never held in memory.
+func (h *API) exportReportingTicketDetails(w http.ResponseWriter, r *http.Request, projectID uuid.UUID, from, to time.Time, format string) {
+	filename := fmt.Sprintf("project-tickets-%s-to-%s.%s", from.Format("2006-01-02"), to.Format("2006-01-02"), format)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	stream := newExportStream(w)
+	rows := 0
+	flushEvery := func(before func()) error {
+		rows++
+		if rows%exportFlushRows != 0 {
+			return nil
+		}
+		if before != nil {
+			before()
+		}
+		return stream.Flush()
+	}
+
+	var err error
+	switch format {
+	case "csv":
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		writer := csv.NewWriter(stream)
+		err = writer.Write(reportingTicketDetailHeader)
+		if err == nil {
+			err = h.store.StreamReportingTicketDetails(r.Context(), projectID, from, to, func(item store.ReportingTicketDetail) error {
+				if err := writer.Write(reportingTicketDetailRecord(item)); err != nil {
+					return err
+				}
+				return flushEvery(writer.Flush)
+			})
+		}
+		writer.Flush()
+		if err == nil {
+			err = writer.Error()
+		}
+	case "xlsx":
+		w.Header().Set("Content-Type", xlsxContentType)
+		writer := xlsx.NewWriter(stream)
+		err = writer.AddSheet("Tickets")
+		if err == nil {
+			err = writer.WriteRow(stringsToAny(reportingTicketDetailHeader)...)
+		}
+		if err == nil {
+			err = h.store.StreamReportingTicketDetails(r.Context(), projectID, from, to, func(item store.ReportingTicketDetail) error {
+				if err := writer.WriteRow(reportingTicketDetailCells(item)...); err != nil {
+					return err
+				}
+				return flushEvery(nil)
+			})
+		}
+		if err == nil {
+			err = writer.Close()
+		}
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		head, _ := json.Marshal(map[string]any{
+			"generatedAt": time.Now().UTC(),
+			"from":        openapi_types.Date{Time: from},
+			"to":          openapi_types.Date{Time: to},
+		})
+		// Splice the streamed ticket array into the header object.
+		_, err = stream.Write(append(head[:len(head)-1], []byte(`,"tickets":[`)...))
+		if err == nil {
+			err = h.store.StreamReportingTicketDetails(r.Context(), projectID, from, to, func(item store.ReportingTicketDetail) error {
+				encoded, err := json.Marshal(mapReportingTicketDetail(item))
+				if err != nil {
+					return err
+				}
+				if rows > 0 {
+					encoded = append([]byte(","), encoded...)
+				}
+				if _, err := stream.Write(encoded); err != nil {
+					return err
+				}
+				return flushEvery(nil)
+			})
+		}
+		if err == nil {
+			_, err = stream.Write([]byte("]}\n"))
+		}
+	}
+
+	if err == nil {
+		err = stream.Flush()
+	}
+	if err == nil {
+		return
+	}
+	if !stream.started {
+		w.Header().Del("Content-Disposition")
+		writeError(w, http.StatusInternalServerError, "reporting_export_error", "Failed to export reporting tickets")
+		return
+	}
+	log.Printf("reporting_export_error project=%s format=%s rows=%d error=%s", projectID, format, rows, err.Error())
+}
+
+func reportingTicketDetailRecord(item store.ReportingTicketDetail) []string {
+	record := make([]string, 0, len(reportingTicketDetailHeader))
+	for _, cell := range reportingTicketDetailCells(item) {
+		switch v := cell.(type) {
+		case nil:
+			record = append(record, "")
+		case time.Time:
+			record = append(record, v.UTC().Format(time.RFC3339))
+		case float64:
+			record = append(record, strconv.FormatFloat(v, 'f', 2, 64))
+		default:
+			record = append(record, fmt.Sprint(v))
+		}
+	}
+	return record
+}
+
+// reportingTicketDetailCells lists a ticket's export columns in header
+// order, with nil for missing values.
+func reportingTicketDetailCells(item store.ReportingTicketDetail) []any {
+	cells := []any{item.Key, item.Title, item.Type, item.Priority, item.State, nil, item.CreatedAt, nil, nil, nil, nil, item.LoggedMinutes}
+	if item.AssigneeName != nil {
+		cells[5] = *item.AssigneeName
+	}
+	if item.ClosedAt != nil {
+		cells[7] = *item.ClosedAt
+	}
+	if item.CycleTimeHours != nil {
+		cells[8] = *item.CycleTimeHours
+	}
+	if item.StoryPoints != nil {
+		cells[9] = *item.StoryPoints
+	}
+	if item.TimeEstimate != nil {
+		cells[10] = *item.TimeEstimate
+	}
+	return cells
+}
+
+func renderProjectReportingXLSX(report store.ProjectReportingSummary) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := xlsx.NewWriter(buf)
+	if err := writer.AddSheet("Summary"); err != nil {
+		return nil, err
+	}
+	rows := [][]any{
+		{"from", report.From.Format("2006-01-02")},
+		{"to", report.To.Format("2006-01-02")},
+		{"average_cycle_time_hours", report.AverageCycleTimeHours},
+	}
+	for _, row := range rows {
+		if err := writer.WriteRow(row...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.AddSheet("Throughput"); err != nil {
+		return nil, err
+	}
+	if err := writer.WriteRow("date", "throughput"); err != nil {
+		return nil, err
+	}
+	for _, point := range report.ThroughputByDay {
+		if err := writer.WriteRow(point.Date.Format("2006-01-02"), point.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.AddSheet("Open by state"); err != nil {
+		return nil, err
+	}
+	header := []any{"date"}
+	if len(report.OpenByState) > 0 {
+		for _, count := range report.OpenByState[0].Counts {
+			header = append(header, count.Label)
+		}
+	}
+	if err := writer.WriteRow(header...); err != nil {
+		return nil, err
+	}
+	for _, point := range report.OpenByState {
+		row := []any{point.Date.Format("2006-01-02")}
+		for _, count := range point.Counts {
+			row = append(row, count.Value)
+		}
+		if err := writer.WriteRow(row...); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func stringsToAny(values []string) []any {
+	out := make([]any, len(values))
+	for i, value := range values {
+		out[i] = value
+	}
+	return out
+}
@@ -1,6 +1,7 @@
 package httpapi
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -66,6 +67,157 @@ func (h *API) CreateProjectSprint(w http.ResponseWriter, r *http.Request, projec
 	writeJSON(w, http.StatusCreated, mapSprint(sprint))
 }
 
+func (h *API) GetProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	sprint, err := h.store.GetSprint(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleDBError(w, r, err, "sprint", "sprint_get") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprint(sprint))
+}
+
+func (h *API) UpdateProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[sprintUpdateRequest](w, r, "sprint_update")
+	if !ok {
+		return
+	}
+	input := store.SprintUpdateInput{
+		Name:      req.Name,
+		ClearGoal: derefBool(req.ClearGoal, false),
+	}
+	if req.Goal != nil {
+		input.Goal = nullableString(*req.Goal)
+		input.ClearGoal = input.ClearGoal || input.Goal == nil
+	}
+	if req.StartDate != nil {
+		value := req.StartDate.Time
+		input.StartDate = &value
+	}
+	if req.EndDate != nil {
+		value := req.EndDate.Time
+		input.EndDate = &value
+	}
+
+	sprint, err := h.store.UpdateSprint(r.Context(), projectUUID, uuid.UUID(sprintId), input)
+	if handleSprintStatusError(w, r, err, "sprint_update") {
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "sprint", "sprint_update", "sprint_update_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprint(sprint))
+}
+
+func (h *API) DeleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	err := h.store.DeleteSprint(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleSprintStatusError(w, r, err, "sprint_delete") {
+		return
+	}
+	if handleDeleteError(w, r, err, "sprint", "sprint_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) StartProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	sprint, err := h.store.StartSprint(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleSprintStatusError(w, r, err, "sprint_start") {
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "sprint", "sprint_start", "sprint_start_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprint(sprint))
+}
+
+func (h *API) CompleteProjectSprint(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[sprintCompleteRequest](w, r, "sprint_complete")
+	if !ok {
+		return
+	}
+	input := store.SprintCompleteInput{
+		CarryOver:      string(req.CarryOver),
+		TargetSprintID: parseOpenapiUUIDPtr(req.TargetSprintId),
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.CompletedBy = &actorID
+	}
+
+	completion, err := h.store.CompleteSprint(r.Context(), projectUUID, uuid.UUID(sprintId), input)
+	if handleSprintStatusError(w, r, err, "sprint_complete") {
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "sprint", "sprint_complete", "sprint_complete_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprintCompletion(completion))
+}
+
+func (h *API) GetProjectSprintCompletion(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	completion, err := h.store.GetSprintCompletion(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleDBError(w, r, err, "sprint completion", "sprint_completion_get") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprintCompletion(completion))
+}
+
+// handleSprintStatusError reports lifecycle conflicts (a second active sprint,
+// editing a completed sprint) as 409s.
+func handleSprintStatusError(w http.ResponseWriter, r *http.Request, err error, logCode string) bool {
+	switch {
+	case errors.Is(err, store.ErrSprintActiveExists):
+		logRequestError(r, logCode+"_conflict", err)
+		writeError(w, http.StatusConflict, "sprint_active_exists", "project already has an active sprint")
+		return true
+	case errors.Is(err, store.ErrSprintStatusConflict):
+		logRequestError(r, logCode+"_conflict", err)
+		writeError(w, http.StatusConflict, "sprint_status_conflict", err.Error())
+		return true
+	}
+	return false
+}
+
 func (h *API) ListProjectCapacitySettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
 	projectUUID := uuid.UUID(projectId)
 	if !h.requireProjectAccess(w, r, projectUUID) {
@@ -99,6 +251,9 @@ func (h *API) ReplaceProjectCapacitySettings(w http.ResponseWriter, r *http.Requ
 			Label:    strings.TrimSpace(item.Label),
 			Capacity: item.Capacity,
 		}
+		if item.Unit != nil {
+			input.Unit = string(*item.Unit)
+		}
 		if item.UserId != nil {
 			value := uuid.UUID(*item.UserId)
 			input.UserID = &value
@@ -131,6 +286,9 @@ func (h *API) AddSprintTickets(w http.ResponseWriter, r *http.Request, projectId
 	}
 
 	sprint, err := h.store.AddSprintTickets(r.Context(), projectUUID, uuid.UUID(sprintId), ticketIDs)
+	if handleSprintStatusError(w, r, err, "sprint_tickets_add") {
+		return
+	}
 	if handleDBErrorWithCode(w, r, err, "sprint tickets", "sprint_tickets_add", "sprint_tickets_add_failed") {
 		return
 	}
@@ -156,6 +314,9 @@ func (h *API) RemoveSprintTickets(w http.ResponseWriter, r *http.Request, projec
 	}
 
 	sprint, err := h.store.RemoveSprintTickets(r.Context(), projectUUID, uuid.UUID(sprintId), ticketIDs)
+	if handleSprintStatusError(w, r, err, "sprint_tickets_remove") {
+		return
+	}
 	if handleDBErrorWithCode(w, r, err, "sprint tickets", "sprint_tickets_remove", "sprint_tickets_remove_failed") {
 		return
 	}
@@ -168,19 +329,131 @@ func (h *API) GetProjectSprintForecast(w http.ResponseWriter, r *http.Request, p
 		return
 	}
 
-	var sprintID *uuid.UUID
-	if params.SprintId != nil {
-		value := uuid.UUID(*params.SprintId)
-		sprintID = &value
+	input := store.SprintForecastInput{
+		SprintID:   parseOpenapiUUIDPtr(params.SprintId),
+		Iterations: 250,
 	}
-	iterations := 250
 	if params.Iterations != nil {
-		iterations = *params.Iterations
+		input.Iterations = *params.Iterations
+	}
+	if params.Unit != nil {
+		input.Unit = string(*params.Unit)
+		if _, err := store.NormalizeForecastUnit(input.Unit); err != nil {
+			writeError(w, http.StatusBadRequest, "invalid_unit", err.Error())
+			return
+		}
 	}
 
-	summary, err := h.store.GetSprintForecastSummary(r.Context(), projectUUID, sprintID, iterations)
+	summary, err := h.store.GetSprintForecastSummary(r.Context(), projectUUID, input)
 	if handleListError(w, r, err, "sprint forecast", "sprint_forecast") {
 		return
 	}
 	writeJSON(w, http.StatusOK, mapSprintForecastSummary(summary))
 }
+
+func (h *API) WhatIfProjectSprintForecast(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	req, ok := decodeJSON[sprintForecastWhatIfRequest](w, r, "sprint_forecast_what_if")
+	if !ok {
+		return
+	}
+	input := store.SprintForecastInput{
+		SprintID: parseOpenapiUUIDPtr(req.SprintId),
+		Tickets:  make([]store.ForecastTicket, 0, len(req.Tickets)),
+	}
+	if req.Iterations != nil {
+		input.Iterations = *req.Iterations
+	}
+	if req.Unit != nil {
+		input.Unit = string(*req.Unit)
+	}
+	for _, item := range req.Tickets {
+		input.Tickets = append(input.Tickets, store.ForecastTicket{
+			TicketID:     parseOpenapiUUIDPtr(item.TicketId),
+			AssigneeID:   parseOpenapiUUIDPtr(item.AssigneeId),
+			StoryPoints:  item.StoryPoints,
+			TimeEstimate: item.TimeEstimate,
+		})
+	}
+
+	summary, err := h.store.GetSprintForecastSummary(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "sprint forecast", "sprint_forecast_what_if", "sprint_forecast_what_if_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprintForecastSummary(summary))
+}
+
+func (h *API) ListSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	items, err := h.store.ListSprintAbsences(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleListError(w, r, err, "sprint absences", "sprint_absences_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, sprintAbsencesResponse{Items: mapSlice(items, mapSprintAbsence)})
+}
+
+func (h *API) ReplaceSprintAbsences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[sprintAbsencesReplaceRequest](w, r, "sprint_absences_replace")
+	if !ok {
+		return
+	}
+	inputs := make([]store.SprintAbsenceInput, 0, len(req.Items))
+	for _, item := range req.Items {
+		inputs = append(inputs, store.SprintAbsenceInput{
+			UserID: uuid.UUID(item.UserId),
+			Days:   item.Days,
+			Note:   nullableString(derefString(item.Note)),
+		})
+	}
+	items, err := h.store.ReplaceSprintAbsences(r.Context(), projectUUID, uuid.UUID(sprintId), inputs)
+	if handleDBErrorWithCode(w, r, err, "sprint absences", "sprint_absences_replace", "sprint_absences_replace_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, sprintAbsencesResponse{Items: mapSlice(items, mapSprintAbsence)})
+}
+
+func (h *API) GetProjectSprintBurndown(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, sprintId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	burndown, err := h.store.GetSprintBurndown(r.Context(), projectUUID, uuid.UUID(sprintId))
+	if handleDBError(w, r, err, "sprint burndown", "sprint_burndown") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprintBurndown(burndown))
+}
+
+func (h *API) GetProjectSprintVelocity(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectSprintVelocityParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	limit := 10
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	history, err := h.store.ListSprintVelocity(r.Context(), projectUUID, limit)
+	if handleListError(w, r, err, "sprint velocity", "sprint_velocity") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapSprintVelocityHistory(history))
+}
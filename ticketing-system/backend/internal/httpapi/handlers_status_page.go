@@ -0,0 +1,265 @@
+package httpapi
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// statusFeedLimit is how many incidents a status feed lists.
+const statusFeedLimit = 50
+
+func (h *API) GetProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	settings, err := h.store.GetStatusPageSettings(r.Context(), projectUUID)
+	if handleListError(w, r, err, "status page settings", "status_page_settings_get") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapStatusPageSettings(settings))
+}
+
+func (h *API) UpdateProjectStatusPageSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+	req, ok := decodeJSON[StatusPageSettingsUpdateRequest](w, r, "status_page_settings_update")
+	if !ok {
+		return
+	}
+	current, err := h.store.GetStatusPageSettings(r.Context(), projectUUID)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "status_page_settings_error", "unable to load status page settings")
+		return
+	}
+
+	input := store.StatusPageSettingsUpdateInput{
+		Enabled: current.Enabled,
+		Slug:    current.Slug,
+		Title:   current.Title,
+	}
+	if req.Enabled != nil {
+		input.Enabled = *req.Enabled
+	}
+	if req.Slug != nil {
+		input.Slug = req.Slug
+	}
+	if req.Title != nil {
+		input.Title = req.Title
+	}
+	settings, err := h.store.UpdateStatusPageSettings(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "project", "status_page_settings_update", "invalid_status_page_settings") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapStatusPageSettings(settings))
+}
+
+func (h *API) GetStatusFeed(w http.ResponseWriter, r *http.Request, slug string) {
+	settings, incidents, ok := h.loadStatusFeed(w, r, slug)
+	if !ok {
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	writeJSON(w, http.StatusOK, StatusFeed{
+		Title:     settings.DisplayTitle(),
+		Status:    StatusPageStatus(store.StatusPageStatus(incidents)),
+		UpdatedAt: statusFeedUpdatedAt(settings, incidents),
+		Incidents: mapSlice(incidents, mapStatusFeedIncident),
+	})
+}
+
+func (h *API) GetStatusFeedAtom(w http.ResponseWriter, r *http.Request, slug string) {
+	settings, incidents, ok := h.loadStatusFeed(w, r, slug)
+	if !ok {
+		return
+	}
+	self := h.feedURL(r)
+	feed := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		ID:      "urn:uuid:" + settings.ProjectID.String(),
+		Title:   settings.DisplayTitle(),
+		Updated: statusFeedUpdatedAt(settings, incidents).Format(time.RFC3339),
+		Links:   []atomLink{{Rel: "self", Href: self}, {Rel: "alternate", Href: strings.TrimSuffix(self, "/feed.atom")}},
+	}
+	for _, incident := range incidents {
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:        "urn:uuid:" + incident.ID.String(),
+			Title:     statusEntryTitle(incident),
+			Published: incident.StartedAt.UTC().Format(time.RFC3339),
+			Updated:   incident.UpdatedAt.UTC().Format(time.RFC3339),
+			Content:   atomText{Type: "text", Body: statusEntryBody(incident)},
+		})
+	}
+	writeStatusXML(w, r, "application/atom+xml; charset=utf-8", feed)
+}
+
+func (h *API) GetStatusFeedRss(w http.ResponseWriter, r *http.Request, slug string) {
+	settings, incidents, ok := h.loadStatusFeed(w, r, slug)
+	if !ok {
+		return
+	}
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         settings.DisplayTitle(),
+			Link:          strings.TrimSuffix(h.feedURL(r), "/feed.rss"),
+			Description:   "Incidents affecting " + settings.DisplayTitle(),
+			LastBuildDate: statusFeedUpdatedAt(settings, incidents).Format(time.RFC1123Z),
+		},
+	}
+	for _, incident := range incidents {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			GUID:        rssGUID{IsPermaLink: "false", Value: "urn:uuid:" + incident.ID.String()},
+			Title:       statusEntryTitle(incident),
+			Description: statusEntryBody(incident),
+			PubDate:     incident.UpdatedAt.UTC().Format(time.RFC1123Z),
+		})
+	}
+	writeStatusXML(w, r, "application/rss+xml; charset=utf-8", feed)
+}
+
+// loadStatusFeed resolves a public status page and its incidents. It writes
+// the error response and returns false when the slug is unknown or the page
+// is disabled.
+func (h *API) loadStatusFeed(w http.ResponseWriter, r *http.Request, slug string) (store.StatusPageSettings, []store.StatusPageIncident, bool) {
+	settings, err := h.store.GetStatusPageBySlug(r.Context(), slug)
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "not_found", "status page not found")
+		return store.StatusPageSettings{}, nil, false
+	}
+	if handleDBError(w, r, err, "status page", "status_page_load") {
+		return store.StatusPageSettings{}, nil, false
+	}
+	incidents, err := h.store.ListStatusPageIncidents(r.Context(), settings.ProjectID, statusFeedLimit)
+	if handleListError(w, r, err, "status page incidents", "status_page_incident_list") {
+		return store.StatusPageSettings{}, nil, false
+	}
+	return settings, incidents, true
+}
+
+func statusFeedUpdatedAt(settings store.StatusPageSettings, incidents []store.StatusPageIncident) time.Time {
+	updated := settings.UpdatedAt
+	for _, incident := range incidents {
+		if incident.UpdatedAt.After(updated) {
+			updated = incident.UpdatedAt
+		}
+	}
+	return updated.UTC()
+}
+
+func statusEntryTitle(incident store.StatusPageIncident) string {
+	if incident.Status == nil {
+		return incident.Title
+	}
+	return fmt.Sprintf("%s (%s)", incident.Title, *incident.Status)
+}
+
+// statusEntryBody renders an incident as plain text for feed readers: its
+// status, severity and impact, then the public updates, newest first.
+func statusEntryBody(incident store.StatusPageIncident) string {
+	var b strings.Builder
+	if incident.Status != nil {
+		b.WriteString("Status: " + *incident.Status + "\n")
+	}
+	if incident.Severity != nil {
+		b.WriteString("Severity: " + *incident.Severity + "\n")
+	}
+	if incident.Impact != nil && *incident.Impact != "" {
+		b.WriteString("Impact: " + *incident.Impact + "\n")
+	}
+	b.WriteString("Started: " + incident.StartedAt.UTC().Format(time.RFC3339) + "\n")
+	if incident.ResolvedAt != nil {
+		b.WriteString("Resolved: " + incident.ResolvedAt.UTC().Format(time.RFC3339) + "\n")
+	}
+	for _, update := range incident.Updates {
+		b.WriteString("\n" + update.CreatedAt.UTC().Format(time.RFC3339) + "\n" + update.Message + "\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// feedURL returns the public URL of a feed request. It is built from the
+// configured base URL, never from the Host or X-Forwarded-* headers, because
+// the response is cached publicly.
+func (h *API) feedURL(r *http.Request) string {
+	return h.publicBaseURL + r.URL.Path
+}
+
+func writeStatusXML(w http.ResponseWriter, r *http.Request, contentType string, feed any) {
+	body, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		logRequestError(r, "status_feed_encode_failed", err)
+		writeError(w, http.StatusInternalServerError, "status_feed_encode_failed", "failed to encode status feed")
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "public, max-age=60")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(xml.Header))
+	_, _ = w.Write(body)
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID        string   `xml:"id"`
+	Title     string   `xml:"title"`
+	Published string   `xml:"published"`
+	Updated   string   `xml:"updated"`
+	Content   atomText `xml:"content"`
+}
+
+type atomText struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	GUID        rssGUID `xml:"guid"`
+	Title       string  `xml:"title"`
+	Description string  `xml:"description"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink string `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
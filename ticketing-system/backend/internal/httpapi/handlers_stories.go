@@ -175,9 +175,10 @@ func (h *API) AddTicketComment(w http.ResponseWriter, r *http.Request, id openap
 	}
 
 	comment, err := h.store.CreateComment(r.Context(), ticketID, store.CommentCreateInput{
-		AuthorID:   authorID,
+		AuthorID:   &authorID,
 		AuthorName: user.Name,
 		Message:    req.Message,
+		Public:     req.Public != nil && *req.Public,
 	})
 	if handleDBErrorWithCode(w, r, err, "comment", "comment_create", "comment_create_failed") {
 		return
@@ -185,10 +186,34 @@ func (h *API) AddTicketComment(w http.ResponseWriter, r *http.Request, id openap
 
 	h.notifyAssigneeComment(r, ticket, authorID, user.Name)
 	h.notifyMentions(r, ticket.ProjectID, ticket, authorID, user.Name, req.Message)
+	h.runAutomations(r.Context(), store.AutomationTriggerCommentCreated, ticket)
 
 	writeJSON(w, http.StatusCreated, mapComment(comment))
 }
 
+// UpdateTicketComment publishes a comment on the status page or withdraws
+// it.
+func (h *API) UpdateTicketComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID) {
+	ticketID := uuid.UUID(id)
+	ticket, err := h.store.GetTicket(r.Context(), ticketID)
+	if handleDBError(w, r, err, "ticket", "ticket_load") {
+		return
+	}
+	if !h.requireProjectRole(w, r, ticket.ProjectID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[ticketCommentUpdateRequest](w, r, "comment_update")
+	if !ok {
+		return
+	}
+	comment, err := h.store.SetCommentPublic(r.Context(), ticketID, uuid.UUID(commentId), req.Public)
+	if handleDBErrorWithCode(w, r, err, "comment", "comment_update", "comment_update_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapComment(comment))
+}
+
 func (h *API) DeleteTicketComment(w http.ResponseWriter, r *http.Request, id openapi_types.UUID, commentId openapi_types.UUID) {
 	ticketID := uuid.UUID(id)
 	ticket, err := h.store.GetTicket(r.Context(), ticketID)
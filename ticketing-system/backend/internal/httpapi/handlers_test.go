@@ -1,12 +1,18 @@
 package httpapi
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/csv"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -26,9 +32,11 @@ type fakeStore struct {
 	listErr error
 	states  []store.WorkflowState
 
-	listTickets      []store.Ticket
-	listTicketsErr   error
-	listTicketsTotal int
+	listTickets       []store.Ticket
+	listTicketsErr    error
+	listTicketsTotal  int
+	listTicketsNext   *string
+	listTicketsCursor *string
 
 	boardTickets    []store.Ticket
 	boardTicketsErr error
@@ -40,6 +48,7 @@ type fakeStore struct {
 	ticketDependency            store.TicketDependency
 	ticketDependencyErr         error
 	createTicketDependencyInput store.TicketDependencyCreateInput
+	subtaskDependencyInputs     []store.TicketDependencyCreateInput
 	deleteTicketDependencyErr   error
 	ticketDependencyGraph       store.TicketDependencyGraph
 	ticketDependencyGraphErr    error
@@ -47,31 +56,55 @@ type fakeStore struct {
 	createTicket    store.Ticket
 	createTicketErr error
 	createInput     store.TicketCreateInput
+	createInputs    []store.TicketCreateInput
 
 	updateTicket    store.Ticket
 	updateTicketErr error
 	updateInput     store.TicketUpdateInput
+	// applyTicketUpdates makes UpdateTicket apply its input to getTicket and
+	// return it, so chained updates observe each other.
+	applyTicketUpdates bool
 
 	deleteTicketErr error
 
-	webhooks                   []store.Webhook
-	webhookErr                 error
-	getWebhook                 store.Webhook
-	getWebhookErr              error
-	createWebhook              store.Webhook
-	createWebhookErr           error
-	updateWebhook              store.Webhook
-	updateWebhookErr           error
-	deleteWebhookErr           error
-	boardFilterPresets         []store.BoardFilterPreset
-	boardFilterPresetErr       error
-	createBoardFilterPreset    store.BoardFilterPreset
-	createBoardFilterPresetErr error
-	updateBoardFilterPreset    store.BoardFilterPreset
-	updateBoardFilterPresetErr error
-	deleteBoardFilterPresetErr error
-	sharedBoardFilterPreset    store.BoardFilterPreset
-	sharedBoardFilterPresetErr error
+	webhooks                     []store.Webhook
+	webhookErr                   error
+	getWebhook                   store.Webhook
+	getWebhookErr                error
+	createWebhook                store.Webhook
+	createWebhookErr             error
+	updateWebhook                store.Webhook
+	updateWebhookErr             error
+	deleteWebhookErr             error
+	boardFilterPresets           []store.BoardFilterPreset
+	boardFilterPresetErr         error
+	createBoardFilterPreset      store.BoardFilterPreset
+	createBoardFilterPresetErr   error
+	updateBoardFilterPreset      store.BoardFilterPreset
+	updateBoardFilterPresetErr   error
+	deleteBoardFilterPresetErr   error
+	sharedBoardFilterPreset      store.BoardFilterPreset
+	sharedBoardFilterPresetErr   error
+	boardFilterPresetOwner       *uuid.UUID
+	getBoardFilterPresetErr      error
+	duePresetSubscriptions       []store.DuePresetSubscription
+	boardFilterMatches           []store.BoardFilterMatch
+	recordedPresetMatches        map[uuid.UUID][]store.BoardFilterMatch
+	recordedPresetTruncated      bool
+	recordPresetMatchesErr       error
+	evaluatedPresetSubscriptions []uuid.UUID
+	ticketTemplates              []store.TicketTemplate
+	dueTicketRecurrences         []store.TicketRecurrence
+	recurrenceAssignee           *uuid.UUID
+	activeSprintID               *uuid.UUID
+	addedSprintTicketIDs         []uuid.UUID
+	completedRecurrenceRuns      map[uuid.UUID]uuid.UUID
+	createCommentInputs          []store.CommentCreateInput
+	recurrenceAlreadyRan         bool
+	failedRecurrenceRuns         map[uuid.UUID]string
+	automationRules              []store.AutomationRule
+	automationRuns               []store.AutomationRunCreateInput
+	completedAutomationRules     map[uuid.UUID]*time.Time
 
 	replaceErr    error
 	replaceResult []store.WorkflowState
@@ -131,6 +164,7 @@ type fakeStore struct {
 	createComment    store.Comment
 	createCommentErr error
 	deleteCommentErr error
+	commentPublic    map[uuid.UUID]bool
 
 	webhookDeliveries    []store.WebhookDelivery
 	webhookDeliveriesErr error
@@ -141,19 +175,66 @@ type fakeStore struct {
 	sprintsErr                 error
 	sprint                     store.Sprint
 	sprintErr                  error
+	sprintUpdateInput          *store.SprintUpdateInput
+	sprintCompleteInput        *store.SprintCompleteInput
+	sprintCompletion           store.SprintCompletion
+	sprintBurndown             store.SprintBurndown
+	sprintVelocity             store.SprintVelocityHistory
 	capacitySettings           []store.CapacitySetting
 	capacitySettingsErr        error
 	replacedCapacityInputs     []store.CapacitySettingInput
 	sprintForecastSummary      store.SprintForecastSummary
 	sprintForecastSummaryErr   error
+	sprintForecastInput        *store.SprintForecastInput
+	sprintAbsences             []store.SprintAbsence
+	replacedSprintAbsences     []store.SprintAbsenceInput
 	aiTriageSettings           store.AiTriageSettings
 	aiTriageSettingsErr        error
 	aiTriageSuggestion         store.AiTriageSuggestion
 	aiTriageSuggestionErr      error
+	aiTriageSuggestionInputs   []store.AiTriageSuggestionCreateInput
+	aiTriageTrainingTickets    []store.AiTriageTrainingTicket
+	aiTriageFeedback           []store.AiTriageFeedback
+	aiTriageModel              *store.AiTriageModel
+	aiTriageModelsDue          []uuid.UUID
+	aiTriageAccuracyField      *string
+	duplicateCandidates        []store.DuplicateCandidate
 	aiTriageDecision           store.AiTriageSuggestionDecision
 	aiTriageDecisionErr        error
+	aiTriageDecisionInputs     []store.AiTriageSuggestionDecisionCreateInput
+	aiTriageAutoApplication    *store.AiTriageAutoApplication
+	incidentSeverityChanges    []store.IncidentSeverityChange
+	incidentMetricsRecords     []store.IncidentMetricsRecord
+	postmortem                 *store.IncidentPostmortem
+	postmortemInput            store.IncidentPostmortemUpdateInput
+	postmortemActionItems      []store.PostmortemActionItem
+	postmortemReportProjectIDs []uuid.UUID
+	postmortemReportClosed     bool
+	timelineEntries            []store.IncidentTimelineEntry
+	timelineEntryInputs        []store.IncidentTimelineEntryCreateInput
+	alertGroupTicketID         *uuid.UUID
+	alertGroupDeclaredTicketID *uuid.UUID
+	incidentAlertSettings      store.IncidentAlertSettings
+	incidentAlertSettingsInput store.IncidentAlertSettingsUpdateInput
+	onCallSchedule             *store.OnCallSchedule
+	onCallShifts               []store.OnCallShift
+	onCallOverrideInput        store.OnCallOverrideCreateInput
+	statusPage                 *store.StatusPageSettings
+	statusPageInput            store.StatusPageSettingsUpdateInput
+	statusPageIncidents        []store.StatusPageIncident
+	activities                 []store.ActivityCreateInput
 	projectReportingSummary    store.ProjectReportingSummary
 	projectReportingSummaryErr error
+	reportingFilter            store.ReportingFilter
+	agingWip                   store.AgingWipReport
+	reportingTicketDetails     []store.ReportingTicketDetail
+	reportingTicketDetailsErr  error
+	timeEntryErr               error
+	timesheet                  store.Timesheet
+	timeReportGroupBy          string
+	timerErr                   error
+	idleTimers                 []store.TimeEntryTimer
+	autoStoppedTicketIDs       []uuid.UUID
 
 	notifications                  []store.Notification
 	notificationsErr               error
@@ -362,12 +443,27 @@ func (f *fakeStore) ListComments(ctx context.Context, ticketID uuid.UUID) ([]sto
 }
 
 func (f *fakeStore) CreateComment(ctx context.Context, ticketID uuid.UUID, input store.CommentCreateInput) (store.Comment, error) {
+	f.createCommentInputs = append(f.createCommentInputs, input)
 	if f.createCommentErr != nil {
 		return store.Comment{}, f.createCommentErr
 	}
 	return f.createComment, nil
 }
 
+func (f *fakeStore) SetCommentPublic(ctx context.Context, ticketID, commentID uuid.UUID, public bool) (store.Comment, error) {
+	for _, comment := range f.comments {
+		if comment.ID == commentID && comment.TicketID == ticketID {
+			if f.commentPublic == nil {
+				f.commentPublic = map[uuid.UUID]bool{}
+			}
+			f.commentPublic[commentID] = public
+			comment.Public = public
+			return comment, nil
+		}
+	}
+	return store.Comment{}, pgx.ErrNoRows
+}
+
 func (f *fakeStore) DeleteComment(ctx context.Context, id uuid.UUID) error {
 	return f.deleteCommentErr
 }
@@ -421,6 +517,7 @@ func (f *fakeStore) GetSprint(ctx context.Context, projectID, sprintID uuid.UUID
 }
 
 func (f *fakeStore) AddSprintTickets(ctx context.Context, projectID, sprintID uuid.UUID, ticketIDs []uuid.UUID) (store.Sprint, error) {
+	f.addedSprintTicketIDs = append(f.addedSprintTicketIDs, ticketIDs...)
 	if f.sprintErr != nil {
 		return store.Sprint{}, f.sprintErr
 	}
@@ -434,6 +531,54 @@ func (f *fakeStore) RemoveSprintTickets(ctx context.Context, projectID, sprintID
 	return f.sprint, nil
 }
 
+func (f *fakeStore) UpdateSprint(ctx context.Context, projectID, sprintID uuid.UUID, input store.SprintUpdateInput) (store.Sprint, error) {
+	f.sprintUpdateInput = &input
+	if f.sprintErr != nil {
+		return store.Sprint{}, f.sprintErr
+	}
+	return f.sprint, nil
+}
+
+func (f *fakeStore) DeleteSprint(ctx context.Context, projectID, sprintID uuid.UUID) error {
+	return f.sprintErr
+}
+
+func (f *fakeStore) StartSprint(ctx context.Context, projectID, sprintID uuid.UUID) (store.Sprint, error) {
+	if f.sprintErr != nil {
+		return store.Sprint{}, f.sprintErr
+	}
+	return f.sprint, nil
+}
+
+func (f *fakeStore) CompleteSprint(ctx context.Context, projectID, sprintID uuid.UUID, input store.SprintCompleteInput) (store.SprintCompletion, error) {
+	f.sprintCompleteInput = &input
+	if f.sprintErr != nil {
+		return store.SprintCompletion{}, f.sprintErr
+	}
+	return f.sprintCompletion, nil
+}
+
+func (f *fakeStore) GetSprintCompletion(ctx context.Context, projectID, sprintID uuid.UUID) (store.SprintCompletion, error) {
+	if f.sprintErr != nil {
+		return store.SprintCompletion{}, f.sprintErr
+	}
+	return f.sprintCompletion, nil
+}
+
+func (f *fakeStore) GetSprintBurndown(ctx context.Context, projectID, sprintID uuid.UUID) (store.SprintBurndown, error) {
+	if f.sprintErr != nil {
+		return store.SprintBurndown{}, f.sprintErr
+	}
+	return f.sprintBurndown, nil
+}
+
+func (f *fakeStore) ListSprintVelocity(ctx context.Context, projectID uuid.UUID, limit int) (store.SprintVelocityHistory, error) {
+	if f.sprintsErr != nil {
+		return store.SprintVelocityHistory{}, f.sprintsErr
+	}
+	return f.sprintVelocity, nil
+}
+
 func (f *fakeStore) ListCapacitySettings(ctx context.Context, projectID uuid.UUID) ([]store.CapacitySetting, error) {
 	if f.capacitySettingsErr != nil {
 		return nil, f.capacitySettingsErr
@@ -449,13 +594,29 @@ func (f *fakeStore) ReplaceCapacitySettings(ctx context.Context, projectID uuid.
 	return f.capacitySettings, nil
 }
 
-func (f *fakeStore) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, sprintID *uuid.UUID, iterations int) (store.SprintForecastSummary, error) {
+func (f *fakeStore) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, input store.SprintForecastInput) (store.SprintForecastSummary, error) {
+	f.sprintForecastInput = &input
 	if f.sprintForecastSummaryErr != nil {
 		return store.SprintForecastSummary{}, f.sprintForecastSummaryErr
 	}
 	return f.sprintForecastSummary, nil
 }
 
+func (f *fakeStore) ListSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID) ([]store.SprintAbsence, error) {
+	if f.sprintErr != nil {
+		return nil, f.sprintErr
+	}
+	return f.sprintAbsences, nil
+}
+
+func (f *fakeStore) ReplaceSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID, inputs []store.SprintAbsenceInput) ([]store.SprintAbsence, error) {
+	f.replacedSprintAbsences = inputs
+	if f.sprintErr != nil {
+		return nil, f.sprintErr
+	}
+	return f.sprintAbsences, nil
+}
+
 func (f *fakeStore) GetAiTriageSettings(ctx context.Context, projectID uuid.UUID) (store.AiTriageSettings, error) {
 	if f.aiTriageSettingsErr != nil {
 		return store.AiTriageSettings{}, f.aiTriageSettingsErr
@@ -463,14 +624,16 @@ func (f *fakeStore) GetAiTriageSettings(ctx context.Context, projectID uuid.UUID
 	return f.aiTriageSettings, nil
 }
 
-func (f *fakeStore) UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, enabled bool) (store.AiTriageSettings, error) {
+func (f *fakeStore) UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, settings store.AiTriageSettings) (store.AiTriageSettings, error) {
 	if f.aiTriageSettingsErr != nil {
 		return store.AiTriageSettings{}, f.aiTriageSettingsErr
 	}
-	return store.AiTriageSettings{Enabled: enabled}, nil
+	f.aiTriageSettings = settings
+	return settings, nil
 }
 
 func (f *fakeStore) CreateAiTriageSuggestion(ctx context.Context, projectID uuid.UUID, input store.AiTriageSuggestionCreateInput) (store.AiTriageSuggestion, error) {
+	f.aiTriageSuggestionInputs = append(f.aiTriageSuggestionInputs, input)
 	if f.aiTriageSuggestionErr != nil {
 		return store.AiTriageSuggestion{}, f.aiTriageSuggestionErr
 	}
@@ -485,12 +648,302 @@ func (f *fakeStore) GetAiTriageSuggestion(ctx context.Context, projectID, sugges
 }
 
 func (f *fakeStore) CreateAiTriageSuggestionDecision(ctx context.Context, projectID, suggestionID uuid.UUID, input store.AiTriageSuggestionDecisionCreateInput) (store.AiTriageSuggestionDecision, error) {
+	f.aiTriageDecisionInputs = append(f.aiTriageDecisionInputs, input)
 	if f.aiTriageDecisionErr != nil {
 		return store.AiTriageSuggestionDecision{}, f.aiTriageDecisionErr
 	}
 	return f.aiTriageDecision, nil
 }
 
+func (f *fakeStore) ListAiTriageTrainingTickets(ctx context.Context, projectID uuid.UUID, limit int) ([]store.AiTriageTrainingTicket, error) {
+	return f.aiTriageTrainingTickets, nil
+}
+
+func (f *fakeStore) ListAiTriageFeedback(ctx context.Context, projectID uuid.UUID, limit int) ([]store.AiTriageFeedback, error) {
+	return f.aiTriageFeedback, nil
+}
+
+func (f *fakeStore) GetAiTriageModel(ctx context.Context, projectID uuid.UUID) (store.AiTriageModel, error) {
+	if f.aiTriageModel == nil {
+		return store.AiTriageModel{}, pgx.ErrNoRows
+	}
+	return *f.aiTriageModel, nil
+}
+
+func (f *fakeStore) GetAiTriageModelTrainedAt(ctx context.Context, projectID uuid.UUID) (time.Time, error) {
+	if f.aiTriageModel == nil {
+		return time.Time{}, pgx.ErrNoRows
+	}
+	return f.aiTriageModel.TrainedAt, nil
+}
+
+func (f *fakeStore) SaveAiTriageModel(ctx context.Context, projectID uuid.UUID, model json.RawMessage, exampleCount, feedbackCount int) (store.AiTriageModel, error) {
+	f.aiTriageModel = &store.AiTriageModel{
+		ProjectID:     projectID,
+		Model:         model,
+		ExampleCount:  exampleCount,
+		FeedbackCount: feedbackCount,
+		TrainedAt:     time.Now(),
+	}
+	return *f.aiTriageModel, nil
+}
+
+func (f *fakeStore) ClaimDueAiTriageModels(ctx context.Context, maxAge time.Duration, limit int) ([]uuid.UUID, error) {
+	return f.aiTriageModelsDue, nil
+}
+
+func (f *fakeStore) GetAiTriageAccuracy(ctx context.Context, projectID uuid.UUID, from, to time.Time, field *string) (store.AiTriageAccuracyReport, error) {
+	f.aiTriageAccuracyField = field
+	return store.BuildAiTriageAccuracy(from, to, nil), nil
+}
+
+func (f *fakeStore) CreateAiTriageAutoApplication(ctx context.Context, projectID, ticketID, suggestionID uuid.UUID, fields []store.AiTriageAppliedField) (store.AiTriageAutoApplication, error) {
+	f.aiTriageAutoApplication = &store.AiTriageAutoApplication{
+		TicketID:     ticketID,
+		ProjectID:    projectID,
+		SuggestionID: suggestionID,
+		Fields:       fields,
+		AppliedAt:    time.Now(),
+	}
+	return *f.aiTriageAutoApplication, nil
+}
+
+func (f *fakeStore) GetAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID) (store.AiTriageAutoApplication, error) {
+	if f.aiTriageAutoApplication == nil {
+		return store.AiTriageAutoApplication{}, pgx.ErrNoRows
+	}
+	return *f.aiTriageAutoApplication, nil
+}
+
+func (f *fakeStore) RevertAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID, restore func(store.AiTriageAutoApplication, store.Ticket) (store.TicketUpdateInput, bool)) (store.AiTriageAutoApplication, store.Ticket, store.Ticket, error) {
+	if f.aiTriageAutoApplication == nil || f.aiTriageAutoApplication.RevertedAt != nil {
+		return store.AiTriageAutoApplication{}, store.Ticket{}, store.Ticket{}, pgx.ErrNoRows
+	}
+	before := f.getTicket
+	after := before
+	if update, ok := restore(*f.aiTriageAutoApplication, before); ok {
+		var err error
+		if after, err = f.UpdateTicket(ctx, ticketID, update); err != nil {
+			return store.AiTriageAutoApplication{}, store.Ticket{}, store.Ticket{}, err
+		}
+	}
+	now := time.Now()
+	f.aiTriageAutoApplication.RevertedAt = &now
+	return *f.aiTriageAutoApplication, before, after, nil
+}
+
+func (f *fakeStore) CreateIncidentSeverityChange(ctx context.Context, ticketID uuid.UUID, from, to *string, actorID *uuid.UUID) (store.IncidentSeverityChange, error) {
+	change := store.IncidentSeverityChange{ID: uuid.New(), TicketID: ticketID, FromSeverity: from, ToSeverity: to, ActorID: actorID, CreatedAt: time.Now()}
+	f.incidentSeverityChanges = append(f.incidentSeverityChanges, change)
+	return change, nil
+}
+
+func (f *fakeStore) ListIncidentSeverityChanges(ctx context.Context, ticketID uuid.UUID) ([]store.IncidentSeverityChange, error) {
+	return f.incidentSeverityChanges, nil
+}
+
+func (f *fakeStore) ListIncidentTimelineEntries(ctx context.Context, ticketID uuid.UUID) ([]store.IncidentTimelineEntry, error) {
+	return f.timelineEntries, nil
+}
+
+// CreateIncidentTimelineEntry treats an alert state seen before as a
+// duplicate, like the unique index does.
+func (f *fakeStore) CreateIncidentTimelineEntry(ctx context.Context, ticketID uuid.UUID, input store.IncidentTimelineEntryCreateInput) (store.IncidentTimelineEntry, error) {
+	for _, seen := range f.timelineEntryInputs {
+		if input.AlertFingerprint != nil && seen.AlertFingerprint != nil && *seen.AlertFingerprint == *input.AlertFingerprint &&
+			*seen.AlertStatus == *input.AlertStatus && seen.OccurredAt.Equal(input.OccurredAt) {
+			return store.IncidentTimelineEntry{}, pgx.ErrNoRows
+		}
+	}
+	f.timelineEntryInputs = append(f.timelineEntryInputs, input)
+	entry := store.IncidentTimelineEntry{
+		ID:          uuid.New(),
+		TicketID:    ticketID,
+		Kind:        input.Kind,
+		OccurredAt:  input.OccurredAt,
+		Title:       input.Title,
+		Body:        input.Body,
+		AuthorID:    input.AuthorID,
+		AlertStatus: input.AlertStatus,
+	}
+	f.timelineEntries = append(f.timelineEntries, entry)
+	return entry, nil
+}
+
+func (f *fakeStore) DeleteIncidentTimelineAnnotation(ctx context.Context, ticketID, entryID uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeStore) FindIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string) (uuid.UUID, error) {
+	if f.alertGroupTicketID == nil {
+		return uuid.Nil, pgx.ErrNoRows
+	}
+	return *f.alertGroupTicketID, nil
+}
+
+func (f *fakeStore) DeclareIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string, input store.TicketCreateInput) (store.Ticket, bool, error) {
+	if f.alertGroupDeclaredTicketID != nil {
+		return store.Ticket{ID: *f.alertGroupDeclaredTicketID, ProjectID: projectID, IncidentEnabled: true}, false, nil
+	}
+	ticket, err := f.CreateTicket(ctx, projectID, input)
+	return ticket, err == nil, err
+}
+
+func (f *fakeStore) GetIncidentAlertSettings(ctx context.Context, projectID uuid.UUID) (store.IncidentAlertSettings, error) {
+	return f.incidentAlertSettings, nil
+}
+
+func (f *fakeStore) GetIncidentAlertSettingsByToken(ctx context.Context, token string) (store.IncidentAlertSettings, error) {
+	if f.incidentAlertSettings.Token == nil || *f.incidentAlertSettings.Token != token || !f.incidentAlertSettings.Enabled {
+		return store.IncidentAlertSettings{}, pgx.ErrNoRows
+	}
+	return f.incidentAlertSettings, nil
+}
+
+func (f *fakeStore) UpdateIncidentAlertSettings(ctx context.Context, projectID uuid.UUID, input store.IncidentAlertSettingsUpdateInput) (store.IncidentAlertSettings, error) {
+	f.incidentAlertSettingsInput = input
+	return store.IncidentAlertSettings{ProjectID: projectID, Enabled: input.Enabled, StoryID: input.StoryID, DefaultSeverity: input.DefaultSeverity}, nil
+}
+
+func (f *fakeStore) GetStatusPageSettings(ctx context.Context, projectID uuid.UUID) (store.StatusPageSettings, error) {
+	if f.statusPage == nil {
+		return store.StatusPageSettings{ProjectID: projectID}, nil
+	}
+	return *f.statusPage, nil
+}
+
+func (f *fakeStore) GetStatusPageBySlug(ctx context.Context, slug string) (store.StatusPageSettings, error) {
+	if f.statusPage == nil || !f.statusPage.Enabled || f.statusPage.Slug == nil || *f.statusPage.Slug != slug {
+		return store.StatusPageSettings{}, pgx.ErrNoRows
+	}
+	return *f.statusPage, nil
+}
+
+func (f *fakeStore) UpdateStatusPageSettings(ctx context.Context, projectID uuid.UUID, input store.StatusPageSettingsUpdateInput) (store.StatusPageSettings, error) {
+	f.statusPageInput = input
+	return store.StatusPageSettings{ProjectID: projectID, Enabled: input.Enabled, Slug: input.Slug, Title: input.Title}, nil
+}
+
+func (f *fakeStore) ListStatusPageIncidents(ctx context.Context, projectID uuid.UUID, limit int) ([]store.StatusPageIncident, error) {
+	return f.statusPageIncidents, nil
+}
+
+func (f *fakeStore) GetOnCallSchedule(ctx context.Context, id uuid.UUID) (store.OnCallSchedule, error) {
+	if f.onCallSchedule == nil || f.onCallSchedule.ID != id {
+		return store.OnCallSchedule{}, pgx.ErrNoRows
+	}
+	return *f.onCallSchedule, nil
+}
+
+func (f *fakeStore) ListOnCallSchedulesForProject(ctx context.Context, projectID uuid.UUID) ([]store.OnCallSchedule, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) ListOnCallSchedulesForGroup(ctx context.Context, groupID uuid.UUID) ([]store.OnCallSchedule, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) CreateOnCallSchedule(ctx context.Context, input store.OnCallScheduleInput) (store.OnCallSchedule, error) {
+	return store.OnCallSchedule{ID: uuid.New(), ProjectID: input.ProjectID, GroupID: input.GroupID, Name: input.Name, Timezone: input.Timezone}, nil
+}
+
+func (f *fakeStore) UpdateOnCallSchedule(ctx context.Context, id uuid.UUID, input store.OnCallScheduleInput) (store.OnCallSchedule, error) {
+	return store.OnCallSchedule{ID: id, Name: input.Name, Timezone: input.Timezone}, nil
+}
+
+func (f *fakeStore) DeleteOnCallSchedule(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeStore) ListOnCallOverrides(ctx context.Context, scheduleID uuid.UUID, since time.Time) ([]store.OnCallOverride, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) CreateOnCallOverride(ctx context.Context, scheduleID uuid.UUID, input store.OnCallOverrideCreateInput) (store.OnCallOverride, error) {
+	f.onCallOverrideInput = input
+	return store.OnCallOverride{ID: uuid.New(), ScheduleID: scheduleID, UserID: input.UserID, StartsAt: input.StartsAt, EndsAt: input.EndsAt, CreatedBy: input.CreatedBy}, nil
+}
+
+func (f *fakeStore) DeleteOnCallOverride(ctx context.Context, scheduleID, overrideID uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeStore) GetOnCallShift(ctx context.Context, sched store.OnCallSchedule, at time.Time) (store.OnCallShift, bool, error) {
+	if len(f.onCallShifts) == 0 {
+		return store.OnCallShift{}, false, nil
+	}
+	return f.onCallShifts[0], true, nil
+}
+
+func (f *fakeStore) ListProjectOnCall(ctx context.Context, projectID uuid.UUID, at time.Time) ([]store.OnCallShift, error) {
+	return f.onCallShifts, nil
+}
+
+func (f *fakeStore) GetIncidentPostmortem(ctx context.Context, ticketID uuid.UUID) (store.IncidentPostmortem, error) {
+	if f.postmortem == nil {
+		return store.IncidentPostmortem{}, pgx.ErrNoRows
+	}
+	return *f.postmortem, nil
+}
+
+func (f *fakeStore) SaveIncidentPostmortem(ctx context.Context, ticketID uuid.UUID, input store.IncidentPostmortemUpdateInput) (store.IncidentPostmortem, error) {
+	f.postmortemInput = input
+	postmortem := store.IncidentPostmortem{TicketID: ticketID}
+	if f.postmortem != nil {
+		postmortem = *f.postmortem
+	}
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{input.Summary, &postmortem.Summary},
+		{input.Impact, &postmortem.Impact},
+		{input.RootCause, &postmortem.RootCause},
+		{input.ContributingFactors, &postmortem.ContributingFactors},
+		{input.Lessons, &postmortem.Lessons},
+	} {
+		if field.value != nil {
+			*field.target = *field.value
+		}
+	}
+	f.postmortem = &postmortem
+	return postmortem, nil
+}
+
+func (f *fakeStore) CreatePostmortemActionItem(ctx context.Context, projectID, incidentTicketID uuid.UUID, input store.TicketCreateInput, createdBy *uuid.UUID) (store.Ticket, store.PostmortemActionItem, error) {
+	ticket, err := f.CreateTicket(ctx, projectID, input)
+	if err != nil {
+		return store.Ticket{}, store.PostmortemActionItem{}, err
+	}
+	f.createTicketDependencyInput = store.TicketDependencyCreateInput{
+		TicketID:        ticket.ID,
+		RelatedTicketID: incidentTicketID,
+		RelationType:    store.DependencyRelationRelated,
+		CreatedBy:       createdBy,
+	}
+	item := store.PostmortemActionItem{IncidentTicketID: incidentTicketID, TicketID: ticket.ID, Priority: "medium"}
+	f.postmortemActionItems = append(f.postmortemActionItems, item)
+	return ticket, item, nil
+}
+
+func (f *fakeStore) ListPostmortemActionItems(ctx context.Context, incidentTicketID uuid.UUID) ([]store.PostmortemActionItem, error) {
+	return f.postmortemActionItems, nil
+}
+
+func (f *fakeStore) ListPostmortemActionItemsForProjects(ctx context.Context, projectIDs []uuid.UUID, includeClosed bool, limit int) ([]store.PostmortemActionItem, error) {
+	f.postmortemReportProjectIDs = projectIDs
+	f.postmortemReportClosed = includeClosed
+	return f.postmortemActionItems, nil
+}
+
+func (f *fakeStore) GetIncidentMetrics(ctx context.Context, projectID uuid.UUID, from, to time.Time) (store.IncidentMetricsReport, error) {
+	return store.BuildIncidentMetrics(from, to, f.incidentMetricsRecords), nil
+}
+
+func (f *fakeStore) FindDuplicateTickets(ctx context.Context, projectID uuid.UUID, title, description string, excludeID *uuid.UUID) ([]store.DuplicateCandidate, error) {
+	return f.duplicateCandidates, nil
+}
+
 func (f *fakeStore) GetProjectReportingSummary(ctx context.Context, projectID uuid.UUID, from, to time.Time) (store.ProjectReportingSummary, error) {
 	if f.projectReportingSummaryErr != nil {
 		return store.ProjectReportingSummary{}, f.projectReportingSummaryErr
@@ -498,6 +951,33 @@ func (f *fakeStore) GetProjectReportingSummary(ctx context.Context, projectID uu
 	return f.projectReportingSummary, nil
 }
 
+func (f *fakeStore) GetProjectCumulativeFlow(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter store.ReportingFilter) (store.CumulativeFlowReport, error) {
+	f.reportingFilter = filter
+	return store.CumulativeFlowReport{From: from, To: to}, nil
+}
+
+func (f *fakeStore) GetProjectLeadTimeDistribution(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter store.ReportingFilter) (store.LeadTimeReport, error) {
+	f.reportingFilter = filter
+	return store.LeadTimeReport{From: from, To: to}, nil
+}
+
+func (f *fakeStore) StreamReportingTicketDetails(ctx context.Context, projectID uuid.UUID, from, to time.Time, fn func(store.ReportingTicketDetail) error) error {
+	if f.reportingTicketDetailsErr != nil {
+		return f.reportingTicketDetailsErr
+	}
+	for _, item := range f.reportingTicketDetails {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeStore) GetProjectAgingWip(ctx context.Context, projectID uuid.UUID, filter store.ReportingFilter) (store.AgingWipReport, error) {
+	f.reportingFilter = filter
+	return f.agingWip, nil
+}
+
 func (f *fakeStore) ListActivities(ctx context.Context, ticketID uuid.UUID) ([]store.Activity, error) {
 	return nil, nil
 }
@@ -507,6 +987,7 @@ func (f *fakeStore) ListProjectActivities(ctx context.Context, projectID uuid.UU
 }
 
 func (f *fakeStore) CreateActivity(ctx context.Context, ticketID uuid.UUID, input store.ActivityCreateInput) error {
+	f.activities = append(f.activities, input)
 	return nil
 }
 
@@ -587,6 +1068,18 @@ func (f *fakeStore) ListTickets(ctx context.Context, filter store.TicketFilter)
 	return f.listTickets, f.listTicketsTotal, nil
 }
 
+func (f *fakeStore) ListTicketsAfter(ctx context.Context, filter store.TicketFilter, cursor string) ([]store.Ticket, *string, error) {
+	f.listTicketsCursor = &cursor
+	if f.listTicketsErr != nil {
+		return nil, nil, f.listTicketsErr
+	}
+	return f.listTickets, f.listTicketsNext, nil
+}
+
+func (f *fakeStore) ListTicketChanges(ctx context.Context, projectID uuid.UUID, since time.Time, cursor string, limit int) (store.TicketChanges, error) {
+	return store.TicketChanges{}, nil
+}
+
 func (f *fakeStore) ListTicketsForBoard(ctx context.Context, projectID uuid.UUID) ([]store.Ticket, error) {
 	if f.boardTicketsErr != nil {
 		return nil, f.boardTicketsErr
@@ -634,106 +1127,411 @@ func (f *fakeStore) GetTicketDependencyGraph(ctx context.Context, projectID uuid
 	return f.ticketDependencyGraph, nil
 }
 
-func (f *fakeStore) CreateTicket(ctx context.Context, projectID uuid.UUID, input store.TicketCreateInput) (store.Ticket, error) {
-	f.createInput = input
-	if f.createTicketErr != nil {
-		return store.Ticket{}, f.createTicketErr
-	}
-	return f.createTicket, nil
+func (f *fakeStore) ListTicketTemplates(ctx context.Context, projectID uuid.UUID) ([]store.TicketTemplate, error) {
+	return f.ticketTemplates, nil
 }
 
-func (f *fakeStore) UpdateTicket(ctx context.Context, id uuid.UUID, input store.TicketUpdateInput) (store.Ticket, error) {
-	f.updateInput = input
-	if f.updateTicketErr != nil {
-		return store.Ticket{}, f.updateTicketErr
+func (f *fakeStore) GetTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) (store.TicketTemplate, error) {
+	for _, tmpl := range f.ticketTemplates {
+		if tmpl.ID == templateID {
+			return tmpl, nil
+		}
 	}
-	return f.updateTicket, nil
+	return store.TicketTemplate{}, pgx.ErrNoRows
 }
 
-func (f *fakeStore) DeleteTicket(ctx context.Context, id uuid.UUID) error {
-	return f.deleteTicketErr
+func (f *fakeStore) CreateTicketTemplate(ctx context.Context, projectID uuid.UUID, input store.TicketTemplateCreateInput) (store.TicketTemplate, error) {
+	return store.TicketTemplate{ID: uuid.New(), ProjectID: projectID, Name: input.Name, TitlePattern: input.TitlePattern}, nil
 }
 
-func (f *fakeStore) ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]store.Webhook, error) {
-	if f.webhookErr != nil {
-		return nil, f.webhookErr
-	}
-	return f.webhooks, nil
+func (f *fakeStore) UpdateTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID, input store.TicketTemplateUpdateInput) (store.TicketTemplate, error) {
+	return f.GetTicketTemplate(ctx, projectID, templateID)
 }
 
-func (f *fakeStore) GetWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (store.Webhook, error) {
-	if f.getWebhookErr != nil {
-		return store.Webhook{}, f.getWebhookErr
-	}
-	return f.getWebhook, nil
+func (f *fakeStore) DeleteTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) error {
+	return nil
 }
 
-func (f *fakeStore) CreateWebhook(ctx context.Context, projectID uuid.UUID, input store.WebhookCreateInput) (store.Webhook, error) {
-	if f.createWebhookErr != nil {
-		return store.Webhook{}, f.createWebhookErr
-	}
-	return f.createWebhook, nil
+func (f *fakeStore) ListTicketRecurrences(ctx context.Context, projectID uuid.UUID) ([]store.TicketRecurrence, error) {
+	return nil, nil
 }
 
-func (f *fakeStore) UpdateWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID, input store.WebhookUpdateInput) (store.Webhook, error) {
-	if f.updateWebhookErr != nil {
-		return store.Webhook{}, f.updateWebhookErr
-	}
-	return f.updateWebhook, nil
+func (f *fakeStore) GetTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) (store.TicketRecurrence, error) {
+	return store.TicketRecurrence{}, pgx.ErrNoRows
 }
 
-func (f *fakeStore) DeleteWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error {
-	return f.deleteWebhookErr
+func (f *fakeStore) CreateTicketRecurrence(ctx context.Context, projectID uuid.UUID, input store.TicketRecurrenceCreateInput) (store.TicketRecurrence, error) {
+	return store.TicketRecurrence{ID: uuid.New(), ProjectID: projectID, Name: input.Name, Enabled: input.Enabled}, nil
 }
 
-func (f *fakeStore) ListBoardFilterPresets(ctx context.Context, projectID, ownerID uuid.UUID) ([]store.BoardFilterPreset, error) {
-	if f.boardFilterPresetErr != nil {
-		return nil, f.boardFilterPresetErr
-	}
-	return f.boardFilterPresets, nil
+func (f *fakeStore) UpdateTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID, input store.TicketRecurrenceUpdateInput) (store.TicketRecurrence, error) {
+	return store.TicketRecurrence{}, pgx.ErrNoRows
 }
 
-func (f *fakeStore) CreateBoardFilterPreset(ctx context.Context, projectID, ownerID uuid.UUID, input store.BoardFilterPresetCreateInput) (store.BoardFilterPreset, error) {
-	if f.createBoardFilterPresetErr != nil {
-		return store.BoardFilterPreset{}, f.createBoardFilterPresetErr
+func (f *fakeStore) DeleteTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeStore) ClaimDueTicketRecurrences(ctx context.Context, limit int) ([]store.TicketRecurrence, error) {
+	due := f.dueTicketRecurrences
+	f.dueTicketRecurrences = nil
+	return due, nil
+}
+
+func (f *fakeStore) CreateRecurringTicket(ctx context.Context, rec store.TicketRecurrence, input store.TicketCreateInput, subtasks []store.TicketCreateInput, nextRunAt *time.Time) (store.Ticket, []store.Ticket, error) {
+	if f.recurrenceAlreadyRan {
+		return store.Ticket{}, nil, store.ErrTicketRecurrenceAlreadyRan
 	}
-	return f.createBoardFilterPreset, nil
+	parent, children, err := f.CreateTicketWithSubtasks(ctx, rec.ProjectID, input, subtasks, rec.CreatedBy)
+	if err != nil {
+		return store.Ticket{}, nil, err
+	}
+	if f.completedRecurrenceRuns == nil {
+		f.completedRecurrenceRuns = map[uuid.UUID]uuid.UUID{}
+	}
+	f.completedRecurrenceRuns[rec.ID] = parent.ID
+	return parent, children, nil
 }
 
-func (f *fakeStore) UpdateBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID, input store.BoardFilterPresetUpdateInput) (store.BoardFilterPreset, error) {
-	if f.updateBoardFilterPresetErr != nil {
-		return store.BoardFilterPreset{}, f.updateBoardFilterPresetErr
+func (f *fakeStore) FailTicketRecurrenceRun(ctx context.Context, recurrenceID uuid.UUID, message string) error {
+	if f.failedRecurrenceRuns == nil {
+		f.failedRecurrenceRuns = map[uuid.UUID]string{}
 	}
-	return f.updateBoardFilterPreset, nil
+	f.failedRecurrenceRuns[recurrenceID] = message
+	return nil
 }
 
-func (f *fakeStore) DeleteBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID) error {
-	return f.deleteBoardFilterPresetErr
+func (f *fakeStore) NextRecurrenceAssignee(ctx context.Context, groupID uuid.UUID, previous *uuid.UUID) (*uuid.UUID, error) {
+	return f.recurrenceAssignee, nil
 }
 
-func (f *fakeStore) GetSharedBoardFilterPreset(ctx context.Context, projectID uuid.UUID, token string) (store.BoardFilterPreset, error) {
-	if f.sharedBoardFilterPresetErr != nil {
-		return store.BoardFilterPreset{}, f.sharedBoardFilterPresetErr
+func (f *fakeStore) ActiveSprintID(ctx context.Context, projectID uuid.UUID, on time.Time) (*uuid.UUID, error) {
+	return f.activeSprintID, nil
+}
+
+func (f *fakeStore) ListAutomationRules(ctx context.Context, projectID uuid.UUID) ([]store.AutomationRule, error) {
+	return f.automationRules, nil
+}
+
+func (f *fakeStore) ListAutomationRulesForTrigger(ctx context.Context, projectID uuid.UUID, trigger string) ([]store.AutomationRule, error) {
+	out := []store.AutomationRule{}
+	for _, rule := range f.automationRules {
+		if rule.Enabled && rule.Trigger == trigger {
+			out = append(out, rule)
+		}
 	}
-	return f.sharedBoardFilterPreset, nil
+	return out, nil
 }
 
-func (f *fakeStore) ListTimeEntries(ctx context.Context, ticketID uuid.UUID) ([]store.TimeEntry, int, error) {
-	return nil, 0, nil
+func (f *fakeStore) GetAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) (store.AutomationRule, error) {
+	for _, rule := range f.automationRules {
+		if rule.ID == ruleID {
+			return rule, nil
+		}
+	}
+	return store.AutomationRule{}, pgx.ErrNoRows
 }
 
-func (f *fakeStore) CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input store.TimeEntryCreateInput) (store.TimeEntry, error) {
-	return store.TimeEntry{}, nil
+func (f *fakeStore) CreateAutomationRule(ctx context.Context, projectID uuid.UUID, input store.AutomationRuleCreateInput) (store.AutomationRule, error) {
+	return store.AutomationRule{}, nil
 }
 
-func (f *fakeStore) DeleteTimeEntry(ctx context.Context, id uuid.UUID) error {
+func (f *fakeStore) UpdateAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID, input store.AutomationRuleUpdateInput) (store.AutomationRule, error) {
+	return store.AutomationRule{}, nil
+}
+
+func (f *fakeStore) DeleteAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) error {
 	return nil
 }
 
-type fakeAuth struct {
-	loginUser  auth.User
-	loginToken auth.TokenSet
-	loginErr   error
+func (f *fakeStore) ClaimDueAutomationRules(ctx context.Context, limit int) ([]store.AutomationRule, error) {
+	out := []store.AutomationRule{}
+	for _, rule := range f.automationRules {
+		if rule.Enabled && rule.Trigger == store.AutomationTriggerSchedule {
+			out = append(out, rule)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeStore) CompleteScheduledAutomationRule(ctx context.Context, ruleID uuid.UUID, nextRunAt *time.Time) error {
+	if f.completedAutomationRules == nil {
+		f.completedAutomationRules = map[uuid.UUID]*time.Time{}
+	}
+	f.completedAutomationRules[ruleID] = nextRunAt
+	return nil
+}
+
+func (f *fakeStore) CreateAutomationRun(ctx context.Context, input store.AutomationRunCreateInput) (store.AutomationRun, error) {
+	f.automationRuns = append(f.automationRuns, input)
+	return store.AutomationRun{}, nil
+}
+
+func (f *fakeStore) ListAutomationRuns(ctx context.Context, filter store.AutomationRunFilter) ([]store.AutomationRun, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) CreateTicket(ctx context.Context, projectID uuid.UUID, input store.TicketCreateInput) (store.Ticket, error) {
+	f.createInput = input
+	f.createInputs = append(f.createInputs, input)
+	if f.createTicketErr != nil {
+		return store.Ticket{}, f.createTicketErr
+	}
+	return f.createTicket, nil
+}
+
+func (f *fakeStore) CreateTicketWithSubtasks(ctx context.Context, projectID uuid.UUID, input store.TicketCreateInput, subtasks []store.TicketCreateInput, createdBy *uuid.UUID) (store.Ticket, []store.Ticket, error) {
+	parent, err := f.CreateTicket(ctx, projectID, input)
+	if err != nil {
+		return store.Ticket{}, nil, err
+	}
+	children := make([]store.Ticket, 0, len(subtasks))
+	for _, subtask := range subtasks {
+		f.createInputs = append(f.createInputs, subtask)
+		child := store.Ticket{ID: uuid.New(), ProjectID: projectID, StoryID: subtask.StoryID, Title: subtask.Title}
+		children = append(children, child)
+		f.subtaskDependencyInputs = append(f.subtaskDependencyInputs, store.TicketDependencyCreateInput{
+			TicketID:        child.ID,
+			RelatedTicketID: parent.ID,
+			RelationType:    store.DependencyRelationBlocks,
+			CreatedBy:       createdBy,
+		})
+	}
+	return parent, children, nil
+}
+
+func (f *fakeStore) UpdateTicket(ctx context.Context, id uuid.UUID, input store.TicketUpdateInput) (store.Ticket, error) {
+	f.updateInput = input
+	if f.updateTicketErr != nil {
+		return store.Ticket{}, f.updateTicketErr
+	}
+	if f.applyTicketUpdates {
+		if input.StateID != nil {
+			f.getTicket.StateID = *input.StateID
+		}
+		if input.AssigneeID != nil {
+			f.getTicket.AssigneeID = input.AssigneeID
+		} else if input.ClearAssignee {
+			f.getTicket.AssigneeID = nil
+		}
+		if input.Priority != nil {
+			f.getTicket.Priority = *input.Priority
+		}
+		if input.Type != nil {
+			f.getTicket.Type = *input.Type
+		}
+		return f.getTicket, nil
+	}
+	return f.updateTicket, nil
+}
+
+func (f *fakeStore) DeleteTicket(ctx context.Context, id uuid.UUID) error {
+	return f.deleteTicketErr
+}
+
+func (f *fakeStore) ListWebhooks(ctx context.Context, projectID uuid.UUID) ([]store.Webhook, error) {
+	if f.webhookErr != nil {
+		return nil, f.webhookErr
+	}
+	return f.webhooks, nil
+}
+
+func (f *fakeStore) GetWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) (store.Webhook, error) {
+	if f.getWebhookErr != nil {
+		return store.Webhook{}, f.getWebhookErr
+	}
+	return f.getWebhook, nil
+}
+
+func (f *fakeStore) CreateWebhook(ctx context.Context, projectID uuid.UUID, input store.WebhookCreateInput) (store.Webhook, error) {
+	if f.createWebhookErr != nil {
+		return store.Webhook{}, f.createWebhookErr
+	}
+	return f.createWebhook, nil
+}
+
+func (f *fakeStore) UpdateWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID, input store.WebhookUpdateInput) (store.Webhook, error) {
+	if f.updateWebhookErr != nil {
+		return store.Webhook{}, f.updateWebhookErr
+	}
+	return f.updateWebhook, nil
+}
+
+func (f *fakeStore) DeleteWebhook(ctx context.Context, projectID uuid.UUID, id uuid.UUID) error {
+	return f.deleteWebhookErr
+}
+
+func (f *fakeStore) ListBoardFilterPresets(ctx context.Context, projectID, ownerID uuid.UUID) ([]store.BoardFilterPreset, error) {
+	if f.boardFilterPresetErr != nil {
+		return nil, f.boardFilterPresetErr
+	}
+	return f.boardFilterPresets, nil
+}
+
+func (f *fakeStore) CreateBoardFilterPreset(ctx context.Context, projectID, ownerID uuid.UUID, input store.BoardFilterPresetCreateInput) (store.BoardFilterPreset, error) {
+	if f.createBoardFilterPresetErr != nil {
+		return store.BoardFilterPreset{}, f.createBoardFilterPresetErr
+	}
+	return f.createBoardFilterPreset, nil
+}
+
+func (f *fakeStore) UpdateBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID, input store.BoardFilterPresetUpdateInput) (store.BoardFilterPreset, error) {
+	if f.updateBoardFilterPresetErr != nil {
+		return store.BoardFilterPreset{}, f.updateBoardFilterPresetErr
+	}
+	return f.updateBoardFilterPreset, nil
+}
+
+func (f *fakeStore) DeleteBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID) error {
+	return f.deleteBoardFilterPresetErr
+}
+
+func (f *fakeStore) GetBoardFilterPreset(ctx context.Context, projectID, userID, presetID uuid.UUID) (store.BoardFilterPreset, error) {
+	if f.getBoardFilterPresetErr != nil {
+		return store.BoardFilterPreset{}, f.getBoardFilterPresetErr
+	}
+	ownerID := userID
+	if f.boardFilterPresetOwner != nil {
+		ownerID = *f.boardFilterPresetOwner
+	}
+	return store.BoardFilterPreset{ID: presetID, ProjectID: projectID, OwnerID: ownerID}, nil
+}
+
+func (f *fakeStore) GetBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) (store.BoardFilterPresetSubscription, error) {
+	return store.BoardFilterPresetSubscription{}, pgx.ErrNoRows
+}
+
+func (f *fakeStore) UpsertBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID, input store.BoardFilterPresetSubscriptionInput) (store.BoardFilterPresetSubscription, error) {
+	return store.BoardFilterPresetSubscription{
+		ID:              uuid.New(),
+		PresetID:        presetID,
+		UserID:          userID,
+		IntervalMinutes: input.IntervalMinutes,
+		NotifyInApp:     input.NotifyInApp,
+		SendWebhook:     input.SendWebhook,
+	}, nil
+}
+
+func (f *fakeStore) DeleteBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) error {
+	return nil
+}
+
+func (f *fakeStore) ClaimDuePresetSubscriptions(ctx context.Context, limit int) ([]store.DuePresetSubscription, error) {
+	due := f.duePresetSubscriptions
+	f.duePresetSubscriptions = nil
+	return due, nil
+}
+
+func (f *fakeStore) RecordPresetSubscriptionMatches(ctx context.Context, subscriptionID uuid.UUID, matches []store.BoardFilterMatch, truncated bool) error {
+	if f.recordPresetMatchesErr != nil {
+		return f.recordPresetMatchesErr
+	}
+	if f.recordedPresetMatches == nil {
+		f.recordedPresetMatches = map[uuid.UUID][]store.BoardFilterMatch{}
+	}
+	f.recordedPresetMatches[subscriptionID] = matches
+	f.recordedPresetTruncated = truncated
+	f.evaluatedPresetSubscriptions = append(f.evaluatedPresetSubscriptions, subscriptionID)
+	return nil
+}
+
+func (f *fakeStore) MarkPresetSubscriptionEvaluated(ctx context.Context, subscriptionID uuid.UUID) error {
+	f.evaluatedPresetSubscriptions = append(f.evaluatedPresetSubscriptions, subscriptionID)
+	return nil
+}
+
+func (f *fakeStore) ListBoardFilterMatches(ctx context.Context, projectID uuid.UUID, filter store.BoardFilter, limit int) ([]store.BoardFilterMatch, error) {
+	if len(f.boardFilterMatches) > limit {
+		return f.boardFilterMatches[:limit], nil
+	}
+	return f.boardFilterMatches, nil
+}
+
+func (f *fakeStore) GetSharedBoardFilterPreset(ctx context.Context, projectID uuid.UUID, token string) (store.BoardFilterPreset, error) {
+	if f.sharedBoardFilterPresetErr != nil {
+		return store.BoardFilterPreset{}, f.sharedBoardFilterPresetErr
+	}
+	return f.sharedBoardFilterPreset, nil
+}
+
+func (f *fakeStore) ListTimeEntries(ctx context.Context, ticketID uuid.UUID) ([]store.TimeEntry, int, error) {
+	return nil, 0, nil
+}
+
+func (f *fakeStore) CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input store.TimeEntryCreateInput) (store.TimeEntry, error) {
+	return store.TimeEntry{}, f.timeEntryErr
+}
+
+func (f *fakeStore) DeleteTimeEntry(ctx context.Context, id uuid.UUID) error {
+	return f.timeEntryErr
+}
+
+func (f *fakeStore) GetTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) (store.Timesheet, error) {
+	return f.timesheet, nil
+}
+
+func (f *fakeStore) ApproveTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time, approvedBy *uuid.UUID, approvedByName string) (store.TimesheetApproval, error) {
+	return store.TimesheetApproval{ProjectID: projectID, UserID: userID, WeekStart: store.TimesheetWeekStart(day), ApprovedBy: approvedBy, ApprovedByName: approvedByName}, nil
+}
+
+func (f *fakeStore) ReopenTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) error {
+	return nil
+}
+
+func (f *fakeStore) GetTimeReport(ctx context.Context, projectID uuid.UUID, from, to time.Time, groupBy string) (store.TimeReport, error) {
+	f.timeReportGroupBy = groupBy
+	return store.TimeReport{From: from, To: to, GroupBy: groupBy}, nil
+}
+
+func (f *fakeStore) GetTimeVariance(ctx context.Context, projectID uuid.UUID, storyID *uuid.UUID) (store.TimeVarianceReport, error) {
+	return store.TimeVarianceReport{}, nil
+}
+
+func (f *fakeStore) ListTimeEntryTimers(ctx context.Context, projectID, userID uuid.UUID) ([]store.TimeEntryTimer, error) {
+	return nil, nil
+}
+
+func (f *fakeStore) StartTimeEntryTimer(ctx context.Context, projectID, ticketID uuid.UUID, input store.TimeEntryTimerStartInput) (store.TimeEntryTimer, error) {
+	if f.timerErr != nil {
+		return store.TimeEntryTimer{}, f.timerErr
+	}
+	return store.TimeEntryTimer{ProjectID: projectID, TicketID: ticketID, UserID: input.UserID, UserName: input.UserName, Description: input.Description, StartedAt: time.Now().UTC()}, nil
+}
+
+func (f *fakeStore) StopTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID, description *string) (store.TimeEntry, error) {
+	if f.timerErr != nil {
+		return store.TimeEntry{}, f.timerErr
+	}
+	return store.TimeEntry{TicketID: ticketID, UserID: userID, Minutes: 1, Description: description}, nil
+}
+
+func (f *fakeStore) DiscardTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID) error {
+	return f.timerErr
+}
+
+func (f *fakeStore) AutoStopTimeEntryTimers(ctx context.Context, ticketID uuid.UUID) ([]store.TimeEntry, error) {
+	f.autoStoppedTicketIDs = append(f.autoStoppedTicketIDs, ticketID)
+	return nil, nil
+}
+
+func (f *fakeStore) ClaimIdleTimeEntryTimers(ctx context.Context, limit int) ([]store.TimeEntryTimer, error) {
+	idle := f.idleTimers
+	f.idleTimers = nil
+	return idle, nil
+}
+
+func (f *fakeStore) GetTimeTrackingSettings(ctx context.Context, projectID uuid.UUID) (store.TimeTrackingSettings, error) {
+	return store.TimeTrackingSettings{AutoStopOnClose: true, IdleTimerHours: store.DefaultIdleTimerHours}, nil
+}
+
+func (f *fakeStore) UpdateTimeTrackingSettings(ctx context.Context, projectID uuid.UUID, input store.TimeTrackingSettingsUpdateInput) (store.TimeTrackingSettings, error) {
+	return store.TimeTrackingSettings{AutoStopOnClose: derefBool(input.AutoStopOnClose, true), IdleTimerHours: store.DefaultIdleTimerHours}, nil
+}
+
+type fakeAuth struct {
+	loginUser  auth.User
+	loginToken auth.TokenSet
+	loginErr   error
 	verifyUser auth.User
 	verifyErr  error
 	listUsers  []auth.User
@@ -1155,60 +1953,316 @@ func TestGetBoard(t *testing.T) {
 	}
 }
 
-func TestCreateTicket(t *testing.T) {
-	t.Run("invalid json", func(t *testing.T) {
-		h := newHandlerWith(&fakeStore{})
-		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader("{"))
+func TestListTicketsPagination(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	tickets := []store.Ticket{
+		{ID: uuid.New(), Key: "TIC-1", StateOrder: 1, Position: 1},
+		{ID: uuid.New(), Key: "TIC-2", StateOrder: 1, Position: 2},
+	}
+
+	t.Run("offset mode returns total and a cursor to continue", func(t *testing.T) {
+		fs := &fakeStore{listTickets: tickets, listTicketsTotal: 5}
+		h := newHandlerWith(fs)
+		limit := 2
+		req := newTestRequest(http.MethodGet, "/tickets", nil)
 		rec := httptest.NewRecorder()
 
-		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+		h.ListTickets(rec, req, projectID, ListTicketsParams{Limit: &limit})
 
-		if rec.Code != http.StatusBadRequest {
-			t.Fatalf("expected status 400, got %d", rec.Code)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var resp ticketListResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Total == nil || *resp.Total != 5 {
+			t.Fatalf("expected total 5, got %v", resp.Total)
+		}
+		if resp.NextCursor == nil || *resp.NextCursor != store.TicketCursor(tickets[1]) {
+			t.Fatalf("expected next cursor after last ticket, got %v", resp.NextCursor)
+		}
+		if fs.listTicketsCursor != nil {
+			t.Fatalf("expected offset listing, got cursor listing")
 		}
 	})
 
-	t.Run("missing title", func(t *testing.T) {
-		h := newHandlerWith(&fakeStore{})
-		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"description":"x"}`))
+	t.Run("cursor mode does not count tickets", func(t *testing.T) {
+		next := "next-page"
+		fs := &fakeStore{listTickets: tickets, listTicketsTotal: 5, listTicketsNext: &next}
+		h := newHandlerWith(fs)
+		cursor := ""
+		req := newTestRequest(http.MethodGet, "/tickets", nil)
 		rec := httptest.NewRecorder()
 
-		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+		h.ListTickets(rec, req, projectID, ListTicketsParams{Cursor: &cursor})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if fs.listTicketsCursor == nil {
+			t.Fatalf("expected cursor listing")
+		}
+		if strings.Contains(rec.Body.String(), `"total"`) {
+			t.Fatalf("expected no total, got %s", rec.Body.String())
+		}
+		if !strings.Contains(rec.Body.String(), `"nextCursor":"next-page"`) {
+			t.Fatalf("expected next cursor, got %s", rec.Body.String())
+		}
+	})
+
+	t.Run("invalid cursor is rejected", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{listTicketsErr: store.ErrInvalidCursor})
+		cursor := "garbage"
+		req := newTestRequest(http.MethodGet, "/tickets", nil)
+		rec := httptest.NewRecorder()
+
+		h.ListTickets(rec, req, projectID, ListTicketsParams{Cursor: &cursor})
 
 		if rec.Code != http.StatusBadRequest {
 			t.Fatalf("expected status 400, got %d", rec.Code)
 		}
 	})
+}
 
-	t.Run("success", func(t *testing.T) {
-		id := uuid.New()
-		stateID := uuid.New()
-		fs := &fakeStore{createTicket: store.Ticket{ID: id, Key: "TIC-2000", Number: 2000, Title: "Hello", Description: "", StateID: stateID, StateName: "Backlog", StateOrder: 1, Priority: "medium", Position: 1, CreatedAt: time.Now().UTC(), UpdatedAt: time.Now().UTC()}}
+func TestEvaluatePresetSubscriptions(t *testing.T) {
+	projectID := uuid.New()
+	kept := store.BoardFilterMatch{ID: uuid.New(), Key: "TIC-1", Title: "Kept"}
+	gone := store.BoardFilterMatch{ID: uuid.New(), Key: "TIC-2", Title: "Gone"}
+	fresh := store.BoardFilterMatch{ID: uuid.New(), Key: "TIC-3", Title: "Fresh"}
+
+	t.Run("first run only records a baseline", func(t *testing.T) {
+		sub := store.DuePresetSubscription{ID: uuid.New(), ProjectID: projectID, UserID: uuid.New(), NotifyInApp: true, SendWebhook: true}
+		fs := &fakeStore{duePresetSubscriptions: []store.DuePresetSubscription{sub}, boardFilterMatches: []store.BoardFilterMatch{kept}}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
+		}
+		if len(fs.recordedPresetMatches[sub.ID]) != 1 {
+			t.Fatalf("expected baseline to be recorded, got %v", fs.recordedPresetMatches)
+		}
+		if len(fs.createNotificationInputs) != 0 || len(dispatcher.events) != 0 {
+			t.Fatalf("expected no deliveries on baseline run")
+		}
+	})
+
+	t.Run("changes notify and fire webhook", func(t *testing.T) {
+		sub := store.DuePresetSubscription{
+			ID:          uuid.New(),
+			ProjectID:   projectID,
+			UserID:      uuid.New(),
+			PresetName:  "Urgent bugs",
+			NotifyInApp: true,
+			SendWebhook: true,
+			LastMatches: []store.BoardFilterMatch{kept, gone},
+		}
+		fs := &fakeStore{duePresetSubscriptions: []store.DuePresetSubscription{sub}, boardFilterMatches: []store.BoardFilterMatch{kept, fresh}}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
+		}
+		if len(fs.createNotificationInputs) != 1 {
+			t.Fatalf("expected 1 notification, got %d", len(fs.createNotificationInputs))
+		}
+		notification := fs.createNotificationInputs[0]
+		if notification.TicketID != fresh.ID || notification.Type != "preset_match" || notification.UserID != sub.UserID {
+			t.Fatalf("unexpected notification %+v", notification)
+		}
+		if len(dispatcher.events) != 1 || dispatcher.events[0] != "preset.matches_changed" {
+			t.Fatalf("expected preset.matches_changed webhook, got %v", dispatcher.events)
+		}
+		payload, ok := dispatcher.data[0].(map[string]any)
+		if !ok {
+			t.Fatalf("unexpected payload type %T", dispatcher.data[0])
+		}
+		added := payload["added"].([]store.BoardFilterMatch)
+		removed := payload["removed"].([]store.BoardFilterMatch)
+		if len(added) != 1 || added[0].ID != fresh.ID || len(removed) != 1 || removed[0].ID != gone.ID {
+			t.Fatalf("unexpected diff added=%v removed=%v", added, removed)
+		}
+	})
+
+	t.Run("preset no longer visible stays quiet", func(t *testing.T) {
+		sub := store.DuePresetSubscription{ID: uuid.New(), ProjectID: projectID, UserID: uuid.New(), NotifyInApp: true, LastMatches: []store.BoardFilterMatch{kept}}
+		fs := &fakeStore{duePresetSubscriptions: []store.DuePresetSubscription{sub}, boardFilterMatches: []store.BoardFilterMatch{kept, fresh}, getBoardFilterPresetErr: pgx.ErrNoRows}
 		h := newHandlerWith(fs)
-		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Hello"}`))
-		rec := httptest.NewRecorder()
 
-		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
+		}
+		if len(fs.createNotificationInputs) != 0 || fs.recordedPresetMatches != nil {
+			t.Fatalf("expected no evaluation for a hidden preset")
+		}
+		if len(fs.evaluatedPresetSubscriptions) != 1 {
+			t.Fatalf("expected the subscription to be marked evaluated, got %v", fs.evaluatedPresetSubscriptions)
+		}
+	})
 
-		if rec.Code != http.StatusCreated {
-			t.Fatalf("expected status 201, got %d", rec.Code)
+	t.Run("too many matches is reported once", func(t *testing.T) {
+		many := make([]store.BoardFilterMatch, maxPresetSubscriptionMatches+1)
+		for i := range many {
+			many[i] = store.BoardFilterMatch{ID: uuid.New(), Key: fmt.Sprintf("TIC-%d", i)}
 		}
-		if fs.createInput.Title != "Hello" {
-			t.Fatalf("expected title passed, got %q", fs.createInput.Title)
+		sub := store.DuePresetSubscription{ID: uuid.New(), ProjectID: projectID, UserID: uuid.New(), NotifyInApp: true, SendWebhook: true, LastMatches: []store.BoardFilterMatch{kept}}
+		fs := &fakeStore{duePresetSubscriptions: []store.DuePresetSubscription{sub}, boardFilterMatches: many}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
+		}
+		if !fs.recordedPresetTruncated || fs.recordedPresetMatches[sub.ID] != nil {
+			t.Fatalf("expected a truncated evaluation to be recorded, got %v", fs.recordedPresetMatches)
+		}
+		if len(fs.createNotificationInputs) != 1 || !strings.Contains(fs.createNotificationInputs[0].Message, "more than 1000") {
+			t.Fatalf("expected one truncation notification, got %+v", fs.createNotificationInputs)
+		}
+		if payload, ok := dispatcher.data[0].(map[string]any); !ok || payload["truncated"] != true {
+			t.Fatalf("expected a truncated webhook, got %v", dispatcher.data)
 		}
 
-		var resp ticketResponse
-		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
-			t.Fatalf("decode response: %v", err)
+		sub.LastMatches, sub.MatchesTruncated = nil, true
+		fs.duePresetSubscriptions = []store.DuePresetSubscription{sub}
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
 		}
-		if resp.Key != "TIC-2000" {
-			t.Fatalf("expected key, got %q", resp.Key)
+		if len(fs.createNotificationInputs) != 1 || len(dispatcher.events) != 1 {
+			t.Fatalf("expected no repeat delivery while still truncated")
 		}
 	})
 
-	t.Run("creates assignment notification for assigned user", func(t *testing.T) {
-		id := uuid.New()
-		stateID := uuid.New()
+	t.Run("failed recording does not advance the watermark", func(t *testing.T) {
+		sub := store.DuePresetSubscription{ID: uuid.New(), ProjectID: projectID, UserID: uuid.New(), NotifyInApp: true, LastMatches: []store.BoardFilterMatch{kept}}
+		fs := &fakeStore{duePresetSubscriptions: []store.DuePresetSubscription{sub}, boardFilterMatches: []store.BoardFilterMatch{kept, fresh}, recordPresetMatchesErr: errors.New("boom")}
+		h := newHandlerWith(fs)
+
+		if err := h.EvaluatePresetSubscriptions(context.Background()); err != nil {
+			t.Fatalf("evaluate: %v", err)
+		}
+		if len(fs.evaluatedPresetSubscriptions) != 0 || len(fs.createNotificationInputs) != 0 {
+			t.Fatalf("expected nothing delivered or marked evaluated, got %v", fs.evaluatedPresetSubscriptions)
+		}
+	})
+}
+
+func TestBoardFilterPresetPermissions(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	presetID := openapiUUID("33333333-3333-3333-3333-333333333333")
+	otherOwner := uuid.New()
+	regularUser := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	cases := []struct {
+		name       string
+		role       string
+		owner      uuid.UUID
+		body       string
+		wantStatus int
+	}{
+		{name: "owner can rename", role: "contributor", owner: regularUser, body: `{"name":"Mine"}`, wantStatus: http.StatusOK},
+		{name: "owner can share with project", role: "viewer", owner: regularUser, body: `{"visibility":"project"}`, wantStatus: http.StatusOK},
+		{name: "contributor cannot edit shared preset of another user", role: "contributor", owner: otherOwner, body: `{"name":"Theirs"}`, wantStatus: http.StatusForbidden},
+		{name: "project admin can edit preset of another user", role: "admin", owner: otherOwner, body: `{"name":"Theirs"}`, wantStatus: http.StatusOK},
+		{name: "owner cannot pin without admin role", role: "contributor", owner: regularUser, body: `{"pinned":true}`, wantStatus: http.StatusForbidden},
+		{name: "project admin can set default", role: "admin", owner: otherOwner, body: `{"isDefault":true}`, wantStatus: http.StatusOK},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			owner := tc.owner
+			fs := &fakeStore{
+				projectRoleForUser:     tc.role,
+				projectIDsForUser:      []uuid.UUID{uuid.UUID(projectID)},
+				boardFilterPresetOwner: &owner,
+			}
+			h := newHandlerWith(fs)
+			req := newTestRequestAsUser(http.MethodPatch, "/board-filters", strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+
+			h.UpdateBoardFilterPreset(rec, req, projectID, presetID)
+
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("expected status %d, got %d: %s", tc.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	t.Run("contributor cannot delete preset of another user", func(t *testing.T) {
+		owner := otherOwner
+		fs := &fakeStore{
+			projectRoleForUser:     "contributor",
+			projectIDsForUser:      []uuid.UUID{uuid.UUID(projectID)},
+			boardFilterPresetOwner: &owner,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodDelete, "/board-filters", nil)
+		rec := httptest.NewRecorder()
+
+		h.DeleteBoardFilterPreset(rec, req, projectID, presetID)
+
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected status 403, got %d", rec.Code)
+		}
+	})
+}
+
+func TestCreateTicket(t *testing.T) {
+	t.Run("invalid json", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{})
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader("{"))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("missing title", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{})
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"description":"x"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("success", func(t *testing.T) {
+		id := uuid.New()
+		stateID := uuid.New()
+		fs := &fakeStore{createTicket: store.Ticket{ID: id, Key: "TIC-2000", Number: 2000, Title: "Hello", Description: "", StateID: stateID, StateName: "Backlog", StateOrder: 1, Priority: "medium", Position: 1, CreatedAt: time.Now().UTC(), UpdatedAt: time.Now().UTC()}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Hello"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+		if fs.createInput.Title != "Hello" {
+			t.Fatalf("expected title passed, got %q", fs.createInput.Title)
+		}
+
+		var resp ticketResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Key != "TIC-2000" {
+			t.Fatalf("expected key, got %q", resp.Key)
+		}
+	})
+
+	t.Run("creates assignment notification for assigned user", func(t *testing.T) {
+		id := uuid.New()
+		stateID := uuid.New()
 		projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
 		assigneeID := openapiUUID("33333333-3333-3333-3333-333333333333")
 		fs := &fakeStore{
@@ -1258,6 +2312,110 @@ func TestCreateTicket(t *testing.T) {
 			t.Fatalf("expected ticket id %s, got %s", id, created.TicketID)
 		}
 	})
+
+	t.Run("applies template and creates subtasks", func(t *testing.T) {
+		parentID := uuid.New()
+		storyID := uuid.New()
+		templateID := uuid.New()
+		bug := "bug"
+		high := "high"
+		points := 3
+		fs := &fakeStore{
+			getProject:   store.Project{Key: "OPS"},
+			createTicket: store.Ticket{ID: parentID, StoryID: storyID, Title: "[OPS] Disk full"},
+			ticketTemplates: []store.TicketTemplate{{
+				ID:           templateID,
+				Name:         "Bug report",
+				TitlePattern: "[{project}] {title}",
+				Description:  "Steps to reproduce:",
+				Type:         &bug,
+				Priority:     &high,
+				StoryPoints:  &points,
+				Subtasks: []store.TicketTemplateSubtask{
+					{Title: "Reproduce {title}"},
+					{Title: "Write regression test"},
+				},
+			}},
+		}
+		h := newHandlerWith(fs)
+		body := fmt.Sprintf(`{"title":"Disk full","priority":"urgent","storyId":%q,"templateId":%q}`, storyID, templateID)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if len(fs.createInputs) != 3 {
+			t.Fatalf("expected parent and 2 subtasks, got %d creates", len(fs.createInputs))
+		}
+		parent := fs.createInputs[0]
+		if parent.Title != "[OPS] Disk full" || parent.Description != "Steps to reproduce:" {
+			t.Fatalf("unexpected templated parent: %+v", parent)
+		}
+		if parent.Type != "bug" || parent.Priority != "urgent" {
+			t.Fatalf("expected template type and request priority, got %q/%q", parent.Type, parent.Priority)
+		}
+		if parent.StoryPoints == nil || *parent.StoryPoints != 3 {
+			t.Fatalf("expected template story points, got %v", parent.StoryPoints)
+		}
+		if fs.createInputs[1].Title != "Reproduce Disk full" || fs.createInputs[1].StoryID != storyID {
+			t.Fatalf("unexpected subtask input: %+v", fs.createInputs[1])
+		}
+		if len(fs.subtaskDependencyInputs) != 2 {
+			t.Fatalf("expected each subtask to block the parent, got %+v", fs.subtaskDependencyInputs)
+		}
+		for _, link := range fs.subtaskDependencyInputs {
+			if link.RelatedTicketID != parentID || link.RelationType != store.DependencyRelationBlocks || link.CreatedBy == nil {
+				t.Fatalf("expected subtask to block parent, got %+v", link)
+			}
+		}
+	})
+
+	t.Run("failed create leaves no subtasks", func(t *testing.T) {
+		storyID := uuid.New()
+		templateID := uuid.New()
+		fs := &fakeStore{
+			getProject:      store.Project{Key: "OPS"},
+			createTicketErr: errors.New("title required"),
+			ticketTemplates: []store.TicketTemplate{{
+				ID:           templateID,
+				TitlePattern: "{title}",
+				Subtasks:     []store.TicketTemplateSubtask{{Title: "Reproduce"}},
+			}},
+		}
+		h := newHandlerWith(fs)
+		body := fmt.Sprintf(`{"title":"Disk full","storyId":%q,"templateId":%q}`, storyID, templateID)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if len(fs.createInputs) != 1 {
+			t.Fatalf("expected only the parent attempt, got %d creates", len(fs.createInputs))
+		}
+	})
+
+	t.Run("unknown template", func(t *testing.T) {
+		fs := &fakeStore{}
+		h := newHandlerWith(fs)
+		body := fmt.Sprintf(`{"title":"Hello","templateId":%q}`, uuid.New())
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, openapiUUID("11111111-1111-1111-1111-111111111111"))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+		if len(fs.createInputs) != 0 {
+			t.Fatalf("expected no ticket created, got %d", len(fs.createInputs))
+		}
+	})
 }
 
 func TestUpdateTicketNotFound(t *testing.T) {
@@ -1336,6 +2494,66 @@ func TestUpdateTicketNotifiesAssigneeOnStateTypePriorityChange(t *testing.T) {
 	}
 }
 
+func TestUpdateTicketCommentVisibility(t *testing.T) {
+	projectID := uuid.New()
+	ticketID := uuid.New()
+	comment := store.Comment{ID: uuid.New(), TicketID: ticketID, AuthorName: "Regular User", Message: "Fix deployed", CreatedAt: time.Now().UTC()}
+	newStore := func(role string) *fakeStore {
+		return &fakeStore{
+			getTicket:          store.Ticket{ID: ticketID, ProjectID: projectID, Key: "OPS-4"},
+			comments:           []store.Comment{comment},
+			projectRoleForUser: role,
+		}
+	}
+
+	t.Run("contributor publishes a comment", func(t *testing.T) {
+		fs := newStore("contributor")
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/tickets/"+ticketID.String()+"/comments/"+comment.ID.String(), strings.NewReader(`{"public":true}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicketComment(rec, req, toOpenapiUUID(ticketID), toOpenapiUUID(comment.ID))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp ticketCommentResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if !resp.Public || !fs.commentPublic[comment.ID] {
+			t.Fatalf("expected the comment to be public, got %+v", resp)
+		}
+	})
+
+	t.Run("viewer cannot change visibility", func(t *testing.T) {
+		fs := newStore("viewer")
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/tickets/"+ticketID.String()+"/comments/"+comment.ID.String(), strings.NewReader(`{"public":true}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicketComment(rec, req, toOpenapiUUID(ticketID), toOpenapiUUID(comment.ID))
+
+		if rec.Code != http.StatusForbidden || len(fs.commentPublic) != 0 {
+			t.Fatalf("expected status 403 and no change, got %d", rec.Code)
+		}
+	})
+
+	t.Run("comment on another ticket is not found", func(t *testing.T) {
+		fs := newStore("contributor")
+		h := newHandlerWith(fs)
+		other := uuid.New()
+		req := newTestRequestAsUser(http.MethodPatch, "/tickets/"+ticketID.String()+"/comments/"+other.String(), strings.NewReader(`{"public":false}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicketComment(rec, req, toOpenapiUUID(ticketID), toOpenapiUUID(other))
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", rec.Code)
+		}
+	})
+}
+
 func TestAddTicketCommentNotifiesAssignee(t *testing.T) {
 	projectID := uuid.New()
 	ticketID := uuid.New()
@@ -1351,7 +2569,7 @@ func TestAddTicketCommentNotifiesAssignee(t *testing.T) {
 		createComment: store.Comment{
 			ID:         uuid.New(),
 			TicketID:   ticketID,
-			AuthorID:   uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+			AuthorID:   func() *uuid.UUID { v := uuid.MustParse("22222222-2222-2222-2222-222222222222"); return &v }(),
 			AuthorName: "Regular User",
 			Message:    "Please review",
 			CreatedAt:  time.Now().UTC(),
@@ -1638,166 +2856,2294 @@ func TestSprintPlannerHandlers(t *testing.T) {
 		}
 	})
 
-	t.Run("list capacity settings", func(t *testing.T) {
+	t.Run("start sprint conflicts with active sprint", func(t *testing.T) {
 		fs := &fakeStore{
-			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
-			capacitySettings: []store.CapacitySetting{
-				{
-					ID:        uuid.New(),
-					ProjectID: uuid.UUID(projectID),
-					Scope:     "team",
-					Label:     "Team",
-					Capacity:  12,
-					CreatedAt: now,
-					UpdatedAt: now,
-				},
-			},
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			sprintErr:          store.ErrSprintActiveExists,
 		}
 		h := newHandlerWith(fs)
-		req := newTestRequestAsUser(http.MethodGet, "/capacity-settings", nil)
+		req := newTestRequestAsUser(http.MethodPost, "/sprints/"+sprintID.String()+"/start", nil)
 		rec := httptest.NewRecorder()
 
-		h.ListProjectCapacitySettings(rec, req, projectID)
+		h.StartProjectSprint(rec, req, projectID, openapiUUID(sprintID.String()))
 
-		if rec.Code != http.StatusOK {
-			t.Fatalf("expected status 200, got %d", rec.Code)
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
 		}
 	})
 
-	t.Run("get sprint forecast", func(t *testing.T) {
+	t.Run("update sprint clears empty goal", func(t *testing.T) {
 		fs := &fakeStore{
-			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
-			sprintForecastSummary: store.SprintForecastSummary{
-				CommittedTickets:    10,
-				Capacity:            8,
-				ProjectedCompletion: 9,
-				OverCapacityDelta:   2,
-				Confidence:          0.55,
-				Iterations:          500,
-			},
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			sprint:             store.Sprint{ID: sprintID, ProjectID: uuid.UUID(projectID), Name: "Sprint 1", Status: store.SprintStatusPlanned},
 		}
 		h := newHandlerWith(fs)
-		params := GetProjectSprintForecastParams{Iterations: intPtr(500)}
-		req := newTestRequestAsUser(http.MethodGet, "/sprint-forecast", nil)
+		req := newTestRequestAsUser(http.MethodPatch, "/sprints/"+sprintID.String(), strings.NewReader(`{"name":"Sprint 1b","goal":"  "}`))
 		rec := httptest.NewRecorder()
 
-		h.GetProjectSprintForecast(rec, req, projectID, params)
+		h.UpdateProjectSprint(rec, req, projectID, openapiUUID(sprintID.String()))
 
 		if rec.Code != http.StatusOK {
 			t.Fatalf("expected status 200, got %d", rec.Code)
 		}
+		if fs.sprintUpdateInput == nil || !fs.sprintUpdateInput.ClearGoal || fs.sprintUpdateInput.Goal != nil {
+			t.Fatalf("expected goal to be cleared, got %+v", fs.sprintUpdateInput)
+		}
 	})
-}
 
-func TestAiTriageHandlers(t *testing.T) {
-	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
-	stateID := uuid.New()
-	actorID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
-
-	t.Run("create suggestion when enabled", func(t *testing.T) {
+	t.Run("complete sprint carries over to next sprint", func(t *testing.T) {
+		nextID := uuid.New()
 		fs := &fakeStore{
 			projectRoleForUser: "contributor",
 			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
-			aiTriageSettings:   store.AiTriageSettings{Enabled: true},
-			states: []store.WorkflowState{
-				{ID: stateID, Name: "Backlog", IsDefault: true},
-			},
-			aiTriageSuggestion: store.AiTriageSuggestion{
-				ID:                 uuid.New(),
+			sprintCompletion: store.SprintCompletion{
+				SprintID:           sprintID,
 				ProjectID:          uuid.UUID(projectID),
-				Summary:            "Summary",
-				Priority:           "high",
-				StateID:            stateID,
-				ConfidenceSummary:  0.8,
-				ConfidencePriority: 0.7,
-				ConfidenceState:    0.9,
-				ConfidenceAssignee: 0.2,
-				PromptVersion:      "triage-v1",
-				Model:              "heuristic-local-v1",
-				CreatedAt:          time.Now().UTC(),
+				CarryOver:          store.SprintCarryOverNextSprint,
+				CarryOverSprintID:  &nextID,
+				CommittedTickets:   5,
+				CompletedTickets:   3,
+				CarriedOverTickets: 2,
+				CommittedPoints:    13,
+				CompletedPoints:    8,
+				CreatedAt:          now,
 			},
 		}
 		h := newHandlerWith(fs)
-		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"prod error","description":"critical fail"}`))
+		body := `{"carryOver":"next_sprint","targetSprintId":"` + nextID.String() + `"}`
+		req := newTestRequestAsUser(http.MethodPost, "/sprints/"+sprintID.String()+"/complete", strings.NewReader(body))
 		rec := httptest.NewRecorder()
 
-		h.CreateAiTriageSuggestion(rec, req, projectID)
+		h.CompleteProjectSprint(rec, req, projectID, openapiUUID(sprintID.String()))
 
-		if rec.Code != http.StatusCreated {
-			t.Fatalf("expected status 201, got %d", rec.Code)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if fs.sprintCompleteInput == nil || fs.sprintCompleteInput.TargetSprintID == nil || *fs.sprintCompleteInput.TargetSprintID != nextID {
+			t.Fatalf("expected target sprint %s, got %+v", nextID, fs.sprintCompleteInput)
+		}
+		if fs.sprintCompleteInput.CompletedBy == nil {
+			t.Fatalf("expected completing user to be recorded")
+		}
+		var resp sprintCompletionResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.CarriedOverTickets != 2 || resp.CompletedPoints != 8 {
+			t.Fatalf("unexpected completion snapshot %+v", resp)
 		}
 	})
 
-	t.Run("decision recorded field-by-field", func(t *testing.T) {
-		suggestionID := uuid.New()
+	t.Run("delete active sprint conflicts", func(t *testing.T) {
 		fs := &fakeStore{
 			projectRoleForUser: "contributor",
 			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
-			aiTriageSettings:   store.AiTriageSettings{Enabled: true},
-			aiTriageSuggestion: store.AiTriageSuggestion{
-				ID:        suggestionID,
-				ProjectID: uuid.UUID(projectID),
-			},
-			aiTriageDecision: store.AiTriageSuggestionDecision{
-				ID:             uuid.New(),
-				SuggestionID:   suggestionID,
-				ProjectID:      uuid.UUID(projectID),
-				ActorID:        actorID,
-				AcceptedFields: []string{"priority", "summary"},
-				RejectedFields: []string{"assignee"},
-				CreatedAt:      time.Now().UTC(),
-			},
+			sprintErr:          fmt.Errorf("%w: complete the active sprint before deleting it", store.ErrSprintStatusConflict),
 		}
 		h := newHandlerWith(fs)
-		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions/"+suggestionID.String()+"/decision", strings.NewReader(`{"acceptedFields":["summary","priority"],"rejectedFields":["assignee"]}`))
+		req := newTestRequestAsUser(http.MethodDelete, "/sprints/"+sprintID.String(), nil)
 		rec := httptest.NewRecorder()
 
-		h.RecordAiTriageSuggestionDecision(rec, req, projectID, openapiUUID(suggestionID.String()))
+		h.DeleteProjectSprint(rec, req, projectID, openapiUUID(sprintID.String()))
 
-		if rec.Code != http.StatusCreated {
-			t.Fatalf("expected status 201, got %d", rec.Code)
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
 		}
 	})
-}
-
-func TestStreamProjectEventsAccess(t *testing.T) {
-	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
 
-	t.Run("missing session returns unauthorized", func(t *testing.T) {
-		h := newHandlerWith(&fakeStore{})
-		req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
-		rec := httptest.NewRecorder()
+	t.Run("get sprint burndown", func(t *testing.T) {
+		scope, done := 4, 1
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			sprintBurndown: store.SprintBurndown{
+				SprintID:            sprintID,
+				StartDate:           start.Time,
+				EndDate:             end.Time,
+				InitialScopeTickets: 4,
+				Points: []store.SprintBurndownPoint{
+					{Date: start.Time, ScopeTickets: &scope, DoneTickets: &done, IdealTickets: 4},
+					{Date: end.Time},
+				},
+				ScopeChanges: []store.SprintScopeChange{
+					{Date: start.Time, At: now, TicketID: uuid.New(), Key: "OPS-9", Action: store.SprintScopeAdded},
+				},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/sprints/"+sprintID.String()+"/burndown", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetProjectSprintBurndown(rec, req, projectID, openapiUUID(sprintID.String()))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		var resp sprintBurndownResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Points) != 2 || resp.Points[1].ScopeTickets != nil || len(resp.ScopeChanges) != 1 {
+			t.Fatalf("unexpected burndown %+v", resp)
+		}
+	})
+
+	t.Run("list capacity settings", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			capacitySettings: []store.CapacitySetting{
+				{
+					ID:        uuid.New(),
+					ProjectID: uuid.UUID(projectID),
+					Scope:     "team",
+					Label:     "Team",
+					Capacity:  12,
+					CreatedAt: now,
+					UpdatedAt: now,
+				},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/capacity-settings", nil)
+		rec := httptest.NewRecorder()
+
+		h.ListProjectCapacitySettings(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+	})
+
+	t.Run("what-if forecast passes hypothetical tickets", func(t *testing.T) {
+		ticketID := uuid.New()
+		assigneeID := uuid.New()
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			sprintForecastSummary: store.SprintForecastSummary{
+				Unit:      store.ForecastUnitPoints,
+				Committed: 8,
+				Capacity:  5,
+				Allocations: []store.CapacityAllocation{
+					{UserID: assigneeID, Name: "Alice", Capacity: 5, Committed: 8, OverAllocated: true, Delta: 3},
+				},
+				Warnings: []string{"Alice is over-allocated by 3 points (8 committed, 5 capacity)"},
+			},
+		}
+		h := newHandlerWith(fs)
+		body := `{"unit":"points","tickets":[{"ticketId":"` + ticketID.String() + `","assigneeId":"` + assigneeID.String() + `"},{"storyPoints":5}]}`
+		req := newTestRequestAsUser(http.MethodPost, "/sprint-forecast/what-if", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.WhatIfProjectSprintForecast(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		input := fs.sprintForecastInput
+		if input == nil || input.Unit != store.ForecastUnitPoints || len(input.Tickets) != 2 {
+			t.Fatalf("unexpected forecast input %+v", input)
+		}
+		if input.Tickets[0].TicketID == nil || *input.Tickets[0].TicketID != ticketID || *input.Tickets[0].AssigneeID != assigneeID {
+			t.Fatalf("expected existing ticket override, got %+v", input.Tickets[0])
+		}
+		if input.Tickets[1].TicketID != nil || *input.Tickets[1].StoryPoints != 5 {
+			t.Fatalf("expected hypothetical ticket, got %+v", input.Tickets[1])
+		}
+		var resp sprintForecastSummaryResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Allocations) != 1 || !resp.Allocations[0].OverAllocated || len(resp.Warnings) != 1 {
+			t.Fatalf("unexpected forecast response %+v", resp)
+		}
+	})
+
+	t.Run("forecast rejects unknown unit", func(t *testing.T) {
+		fs := &fakeStore{projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)}}
+		h := newHandlerWith(fs)
+		unit := CapacityUnit("days")
+		req := newTestRequestAsUser(http.MethodGet, "/sprint-forecast", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetProjectSprintForecast(rec, req, projectID, GetProjectSprintForecastParams{Unit: &unit})
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("get sprint forecast", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			sprintForecastSummary: store.SprintForecastSummary{
+				CommittedTickets:    10,
+				Capacity:            8,
+				ProjectedCompletion: 9,
+				OverCapacityDelta:   2,
+				Confidence:          0.55,
+				Iterations:          500,
+			},
+		}
+		h := newHandlerWith(fs)
+		params := GetProjectSprintForecastParams{Iterations: intPtr(500)}
+		req := newTestRequestAsUser(http.MethodGet, "/sprint-forecast", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetProjectSprintForecast(rec, req, projectID, params)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+	})
+}
+
+func TestAiTriageHandlers(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	stateID := uuid.New()
+	actorID := uuid.MustParse("22222222-2222-2222-2222-222222222222")
+
+	t.Run("create suggestion when enabled", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   store.AiTriageSettings{Enabled: true},
+			states: []store.WorkflowState{
+				{ID: stateID, Name: "Backlog", IsDefault: true},
+			},
+			aiTriageSuggestion: store.AiTriageSuggestion{
+				ID:                 uuid.New(),
+				ProjectID:          uuid.UUID(projectID),
+				Summary:            "Summary",
+				Priority:           "high",
+				StateID:            stateID,
+				ConfidenceSummary:  0.8,
+				ConfidencePriority: 0.7,
+				ConfidenceState:    0.9,
+				ConfidenceAssignee: 0.2,
+				PromptVersion:      "triage-v1",
+				Model:              "heuristic-local-v1",
+				CreatedAt:          time.Now().UTC(),
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"prod error","description":"critical fail"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateAiTriageSuggestion(rec, req, projectID)
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+	})
+
+	t.Run("decision recorded field-by-field", func(t *testing.T) {
+		suggestionID := uuid.New()
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   store.AiTriageSettings{Enabled: true},
+			aiTriageSuggestion: store.AiTriageSuggestion{
+				ID:        suggestionID,
+				ProjectID: uuid.UUID(projectID),
+			},
+			aiTriageDecision: store.AiTriageSuggestionDecision{
+				ID:             uuid.New(),
+				SuggestionID:   suggestionID,
+				ProjectID:      uuid.UUID(projectID),
+				ActorID:        actorID,
+				AcceptedFields: []string{"priority", "summary"},
+				RejectedFields: []string{"assignee"},
+				CreatedAt:      time.Now().UTC(),
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions/"+suggestionID.String()+"/decision", strings.NewReader(`{"acceptedFields":["summary","priority"],"rejectedFields":["assignee"]}`))
+		rec := httptest.NewRecorder()
+
+		h.RecordAiTriageSuggestionDecision(rec, req, projectID, openapiUUID(suggestionID.String()))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+	})
+}
+
+func TestAiTriageProviders(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	backlogID := uuid.New()
+	triagedID := uuid.New()
+	states := []store.WorkflowState{
+		{ID: backlogID, Name: "Backlog", IsDefault: true},
+		{ID: triagedID, Name: "Triaged"},
+	}
+	httpSettings := func(endpoint string) store.AiTriageSettings {
+		settings := store.DefaultAiTriageSettings()
+		settings.Enabled = true
+		settings.Provider = store.AiTriageProviderHTTP
+		settings.EndpointURL = &endpoint
+		model := "local-model"
+		settings.Model = &model
+		return settings
+	}
+
+	t.Run("http provider uses the model answer", func(t *testing.T) {
+		var got chatCompletionRequest
+		stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/v1/chat/completions" || r.Header.Get("Authorization") != "Bearer secret" {
+				t.Errorf("unexpected request %s auth=%q", r.URL.Path, r.Header.Get("Authorization"))
+			}
+			_ = json.NewDecoder(r.Body).Decode(&got)
+			answer := "```json\n" + `{"summary":"Checkout fails","priority":"URGENT","state":"triaged","assignee":null,"type":"bug","confidence":{"priority":0.97,"state":1.4}}` + "\n```"
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"model":   "local-model-q4",
+				"choices": []map[string]any{{"message": map[string]string{"role": "assistant", "content": answer}}},
+			})
+		}))
+		defer stub.Close()
+
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   httpSettings(stub.URL + "/v1"),
+			states:             states,
+		}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{TriageAPIKey: "secret", TriageAllowedOrigins: []string{stub.URL}})
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"checkout is down"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateAiTriageSuggestion(rec, req, projectID)
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if got.Model != "local-model" || len(got.Messages) != 2 || got.Messages[0].Content != aiTriagePrompts["triage-v1"] {
+			t.Fatalf("unexpected provider request %+v", got)
+		}
+		input := fs.aiTriageSuggestionInputs[0]
+		if input.Summary != "Checkout fails" || input.Priority != "urgent" || input.StateID != triagedID || input.Type == nil || *input.Type != "bug" {
+			t.Fatalf("unexpected suggestion %+v", input)
+		}
+		if input.ConfidencePriority != 0.97 || input.ConfidenceState != 1 || input.ConfidenceSummary != 0.5 {
+			t.Fatalf("unexpected confidences %+v", input)
+		}
+		if input.Model != "local-model-q4" || input.PromptVersion != "triage-v1" {
+			t.Fatalf("unexpected model %q prompt %q", input.Model, input.PromptVersion)
+		}
+	})
+
+	t.Run("http provider only sends the server key to allowed origins", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			endpoint   string
+			allowed    []string
+			projectKey *string
+			want       string
+		}{
+			"origin not allowed":    {endpoint: "https://models.example.com/v1", want: ""},
+			"origin allowed":        {endpoint: "https://models.example.com/v1", allowed: []string{"https://models.example.com"}, want: "secret"},
+			"bare host means https": {endpoint: "https://Models.Example.com:443/v1", allowed: []string{"models.example.com"}, want: "secret"},
+			"scheme mismatch":       {endpoint: "http://models.example.com/v1", allowed: []string{"https://models.example.com"}, want: ""},
+			"port mismatch":         {endpoint: "https://models.example.com:8443/v1", allowed: []string{"https://models.example.com"}, want: ""},
+			"project key preferred": {endpoint: "https://models.example.com/v1", allowed: []string{"https://models.example.com"}, projectKey: nullableString("project-key"), want: "project-key"},
+			"project key only":      {endpoint: "https://models.example.com/v1", projectKey: nullableString("project-key"), want: "project-key"},
+		} {
+			settings := httpSettings(tc.endpoint)
+			settings.APIKey = tc.projectKey
+			h := NewHandler(&fakeStore{}, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{TriageAPIKey: "secret", TriageAllowedOrigins: tc.allowed})
+
+			if got := h.triageEndpointAPIKey(settings); got != tc.want {
+				t.Fatalf("%s: expected key %q, got %q", name, tc.want, got)
+			}
+		}
+	})
+
+	t.Run("untrusted endpoints must be public https", func(t *testing.T) {
+		var called bool
+		stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			called = true
+		}))
+		defer stub.Close()
+
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   httpSettings(stub.URL),
+			states:             states,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"prod outage"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateAiTriageSuggestion(rec, req, projectID)
+
+		if rec.Code != http.StatusCreated || called {
+			t.Fatalf("expected a heuristic suggestion without calling the endpoint, got %d called=%v", rec.Code, called)
+		}
+		if input := fs.aiTriageSuggestionInputs[0]; input.Model != aiTriageModel {
+			t.Fatalf("expected heuristic suggestion, got %+v", input)
+		}
+		if _, err := h.triagePublicClient.Get(stub.URL); err == nil || !strings.Contains(err.Error(), "not public") || called {
+			t.Fatalf("expected the public client to refuse a loopback address, got err=%v called=%v", err, called)
+		}
+	})
+
+	t.Run("failing http provider falls back to heuristics", func(t *testing.T) {
+		stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer stub.Close()
+
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   httpSettings(stub.URL),
+			states:             states,
+		}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{TriageAllowedOrigins: []string{stub.URL}})
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"prod outage"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateAiTriageSuggestion(rec, req, projectID)
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d", rec.Code)
+		}
+		input := fs.aiTriageSuggestionInputs[0]
+		if input.Model != aiTriageModel || input.Priority != "urgent" || input.StateID != backlogID {
+			t.Fatalf("expected heuristic suggestion, got %+v", input)
+		}
+	})
+
+	t.Run("http provider requires an endpoint", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   store.DefaultAiTriageSettings(),
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"provider":"http"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectAiTriageSettings(rec, req, projectID)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("settings update keeps omitted fields", func(t *testing.T) {
+		current := httpSettings("http://localhost:11434/v1")
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   current,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"timeoutMs":2500}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectAiTriageSettings(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		saved := fs.aiTriageSettings
+		if saved.TimeoutMS != 2500 || saved.Provider != store.AiTriageProviderHTTP || saved.EndpointURL == nil || *saved.EndpointURL != "http://localhost:11434/v1" {
+			t.Fatalf("unexpected saved settings %+v", saved)
+		}
+	})
+
+	t.Run("settings never return the api key", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   httpSettings("http://localhost:11434/v1"),
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"apiKey":" project-key "}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectAiTriageSettings(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.aiTriageSettings.APIKey == nil || *fs.aiTriageSettings.APIKey != "project-key" {
+			t.Fatalf("expected the api key to be saved, got %+v", fs.aiTriageSettings.APIKey)
+		}
+		if strings.Contains(rec.Body.String(), "project-key") || !strings.Contains(rec.Body.String(), `"apiKeySet":true`) {
+			t.Fatalf("expected only apiKeySet in the response, got %s", rec.Body.String())
+		}
+
+		rec = httptest.NewRecorder()
+		h.GetProjectAiTriageSettings(rec, newTestRequestAsUser(http.MethodGet, "/ai-triage/settings", nil), projectID)
+		if strings.Contains(rec.Body.String(), "project-key") || !strings.Contains(rec.Body.String(), `"apiKeySet":true`) {
+			t.Fatalf("expected only apiKeySet in the response, got %s", rec.Body.String())
+		}
+	})
+
+	t.Run("settings reject plain http to untrusted origins", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   store.DefaultAiTriageSettings(),
+		}
+		for origins, want := range map[string]int{"": http.StatusBadRequest, "http://169.254.169.254": http.StatusOK} {
+			h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{TriageAllowedOrigins: strings.Fields(origins)})
+			req := newTestRequestAsUser(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"provider":"http","endpointUrl":"http://169.254.169.254/latest"}`))
+			rec := httptest.NewRecorder()
+
+			h.UpdateProjectAiTriageSettings(rec, req, projectID)
+
+			if rec.Code != want {
+				t.Fatalf("origins %q: expected status %d, got %d: %s", origins, want, rec.Code, rec.Body.String())
+			}
+		}
+	})
+
+	t.Run("changing the endpoint clears the api key", func(t *testing.T) {
+		current := httpSettings("http://localhost:11434/v1")
+		current.APIKey = nullableString("project-key")
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:   current,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"endpointUrl":"https://models.example.com/v1"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectAiTriageSettings(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.aiTriageSettings.APIKey != nil {
+			t.Fatalf("expected the api key to be cleared, got %q", *fs.aiTriageSettings.APIKey)
+		}
+	})
+}
+
+func TestTicketDuplicates(t *testing.T) {
+	projectID := uuid.New()
+
+	t.Run("create returns likely duplicates", func(t *testing.T) {
+		original := uuid.New()
+		fs := &fakeStore{
+			createTicket:        store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-12", Title: "Checkout is down"},
+			duplicateCandidates: []store.DuplicateCandidate{{TicketID: original, Key: "OPS-9", Title: "Checkout down", StateName: "Backlog", Score: 0.81}},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout is down"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp ticketResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.DuplicateCandidates == nil || len(*resp.DuplicateCandidates) != 1 || (*resp.DuplicateCandidates)[0].Key != "OPS-9" {
+			t.Fatalf("unexpected duplicates %+v", resp.DuplicateCandidates)
+		}
+	})
+
+	t.Run("marking a duplicate closes it", func(t *testing.T) {
+		openState := store.WorkflowState{ID: uuid.New(), Name: "Backlog", IsDefault: true}
+		doneState := store.WorkflowState{ID: uuid.New(), Name: "Done", IsClosed: true}
+		duplicate := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-12", StateID: openState.ID}
+		original := uuid.New()
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{projectID},
+			getTicket:          duplicate,
+			applyTicketUpdates: true,
+			states:             []store.WorkflowState{openState, doneState},
+			ticketDependency:   store.TicketDependency{ID: uuid.New(), ProjectID: projectID, TicketID: duplicate.ID, RelatedTicketID: original, RelationType: "duplicate_of"},
+		}
+		h := newHandlerWith(fs)
+		body := fmt.Sprintf(`{"relatedTicketId":%q,"relationType":"duplicate_of"}`, original)
+		req := newTestRequestAsUser(http.MethodPost, "/dependencies", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicketDependency(rec, req, toOpenapiUUID(duplicate.ID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.createTicketDependencyInput.RelationType != "duplicate_of" {
+			t.Fatalf("unexpected relation %+v", fs.createTicketDependencyInput)
+		}
+		if fs.updateInput.StateID == nil || *fs.updateInput.StateID != doneState.ID {
+			t.Fatalf("expected the duplicate to move to %s, got %+v", doneState.ID, fs.updateInput.StateID)
+		}
+	})
+
+	t.Run("duplicate of a duplicate is rejected", func(t *testing.T) {
+		ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID}
+		fs := &fakeStore{
+			projectRoleForUser:  "contributor",
+			projectIDsForUser:   []uuid.UUID{projectID},
+			getTicket:           ticket,
+			ticketDependencyErr: store.ErrDuplicateOfDuplicate,
+		}
+		h := newHandlerWith(fs)
+		body := fmt.Sprintf(`{"relatedTicketId":%q,"relationType":"duplicate_of"}`, uuid.New())
+		req := newTestRequestAsUser(http.MethodPost, "/dependencies", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicketDependency(rec, req, toOpenapiUUID(ticket.ID))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+		if fs.updateInput.StateID != nil {
+			t.Fatal("expected no state change")
+		}
+	})
+}
+
+func TestGetAiTriageAccuracy(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	fs := &fakeStore{
+		projectRoleForUser: "viewer",
+		projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+	}
+	h := newHandlerWith(fs)
+
+	req := newTestRequestAsUser(http.MethodGet, "/ai-triage/accuracy?from=2026-03-01&to=2026-03-07&field=priority", nil)
+	rec := httptest.NewRecorder()
+	field := AiTriageField("priority")
+	from := openapi_types.Date{Time: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	to := openapi_types.Date{Time: time.Date(2026, 3, 7, 0, 0, 0, 0, time.UTC)}
+
+	h.GetAiTriageAccuracy(rec, req, projectID, GetAiTriageAccuracyParams{From: &from, To: &to, Field: &field})
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if fs.aiTriageAccuracyField == nil || *fs.aiTriageAccuracyField != "priority" {
+		t.Fatalf("expected field filter to reach the store, got %v", fs.aiTriageAccuracyField)
+	}
+	var report AiTriageAccuracyReport
+	if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(report.Points) != 7 || len(report.Calibration) != 10 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+
+	invalid := AiTriageField("mood")
+	rec = httptest.NewRecorder()
+	h.GetAiTriageAccuracy(rec, req, projectID, GetAiTriageAccuracyParams{Field: &invalid})
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400 for unknown field, got %d", rec.Code)
+	}
+}
+
+func TestAiTriageLearnedProvider(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	backlogID := uuid.New()
+	billingOwner := uuid.New()
+	authOwner := uuid.New()
+	var tickets []store.AiTriageTrainingTicket
+	for i := 0; i < 8; i++ {
+		tickets = append(tickets,
+			store.AiTriageTrainingTicket{Title: fmt.Sprintf("Invoice totals wrong in billing run %d", i), Description: "billing crash", Priority: "urgent", Type: "bug", AssigneeID: &billingOwner},
+			store.AiTriageTrainingTicket{Title: fmt.Sprintf("Add SSO option to login page %d", i), Description: "auth improvement", Priority: "low", Type: "feature", AssigneeID: &authOwner},
+		)
+	}
+
+	t.Run("train reports per-field status", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser:      "admin",
+			projectIDsForUser:       []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageTrainingTickets: tickets,
+			aiTriageFeedback: []store.AiTriageFeedback{
+				{Title: "Billing invoice export", Priority: "urgent", RejectedFields: []string{"priority"}},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/model/train", nil)
+		rec := httptest.NewRecorder()
+
+		h.TrainAiTriageModel(rec, req, projectID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var status AiTriageModelStatus
+		if err := json.NewDecoder(rec.Body).Decode(&status); err != nil {
+			t.Fatalf("decode: %v", err)
+		}
+		if !status.Trained || status.ExampleCount != 16 || status.FeedbackCount != 1 || len(status.Fields) != 3 {
+			t.Fatalf("unexpected status %+v", status)
+		}
+		if priority := status.Fields[0]; priority.Field != AiTriageModelFieldFieldPriority || priority.Observations != 17 {
+			t.Fatalf("expected the rejection to count against priority calibration, got %+v", priority)
+		}
+	})
+
+	t.Run("learned provider predicts from the trained model", func(t *testing.T) {
+		settings := store.DefaultAiTriageSettings()
+		settings.Enabled = true
+		settings.Provider = store.AiTriageProviderLearned
+		fs := &fakeStore{
+			projectRoleForUser:      "contributor",
+			projectIDsForUser:       []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageSettings:        settings,
+			aiTriageTrainingTickets: tickets,
+			states:                  []store.WorkflowState{{ID: backlogID, Name: "Backlog", IsDefault: true}},
+		}
+		h := newHandlerWith(fs)
+		if err := h.TrainTriageModels(context.Background()); err != nil {
+			t.Fatalf("train: %v", err)
+		}
+		if fs.aiTriageModel != nil {
+			t.Fatal("expected no training for projects that are not due")
+		}
+		fs.aiTriageModelsDue = []uuid.UUID{uuid.UUID(projectID)}
+		if err := h.TrainTriageModels(context.Background()); err != nil || fs.aiTriageModel == nil {
+			t.Fatalf("expected a trained model, err=%v", err)
+		}
+
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"billing invoice is wrong"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateAiTriageSuggestion(rec, req, projectID)
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		input := fs.aiTriageSuggestionInputs[0]
+		if input.Model != learnedTriageModel || input.Priority != "urgent" || input.Type == nil || *input.Type != "bug" {
+			t.Fatalf("unexpected suggestion %+v", input)
+		}
+		if input.AssigneeID == nil || *input.AssigneeID != billingOwner || input.ConfidenceAssignee <= 0.5 {
+			t.Fatalf("unexpected assignee %v confidence %f", input.AssigneeID, input.ConfidenceAssignee)
+		}
+
+		// The decoded model is reused until the project is retrained.
+		fs.aiTriageModel.Model = []byte("not json")
+		suggest := func() store.AiTriageSuggestionCreateInput {
+			req := newTestRequestAsUser(http.MethodPost, "/ai-triage/suggestions", strings.NewReader(`{"title":"billing invoice is wrong"}`))
+			rec := httptest.NewRecorder()
+			h.CreateAiTriageSuggestion(rec, req, projectID)
+			if rec.Code != http.StatusCreated {
+				t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+			}
+			return fs.aiTriageSuggestionInputs[len(fs.aiTriageSuggestionInputs)-1]
+		}
+		if input := suggest(); input.Model != learnedTriageModel {
+			t.Fatalf("expected the cached model, got %q", input.Model)
+		}
+		fs.aiTriageModel.TrainedAt = fs.aiTriageModel.TrainedAt.Add(time.Hour)
+		if input := suggest(); input.Model == learnedTriageModel {
+			t.Fatalf("expected a retrained model to be reloaded")
+		}
+	})
+
+	t.Run("too few closed tickets", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser:      "admin",
+			projectIDsForUser:       []uuid.UUID{uuid.UUID(projectID)},
+			aiTriageTrainingTickets: tickets[:4],
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/ai-triage/model/train", nil)
+		rec := httptest.NewRecorder()
+
+		h.TrainAiTriageModel(rec, req, projectID)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+}
+
+func TestFlowReportingHandlers(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	assigneeID := uuid.New()
+
+	t.Run("aging wip passes filters", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			agingWip: store.AgingWipReport{
+				GeneratedAt: time.Now().UTC(),
+				States:      []store.AgingWipState{},
+				Items:       []store.AgingWipItem{{TicketID: uuid.New(), Key: "OPS-1", Type: "bug", Priority: "high", StateAgeHours: 30}},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/aging-wip", nil)
+		rec := httptest.NewRecorder()
+		bug := Bug
+		assignee := openapi_types.UUID(assigneeID)
+
+		h.GetProjectAgingWip(rec, req, projectID, GetProjectAgingWipParams{Type: &bug, AssigneeId: &assignee})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if fs.reportingFilter.Type == nil || *fs.reportingFilter.Type != "bug" {
+			t.Fatalf("expected type filter bug, got %v", fs.reportingFilter.Type)
+		}
+		if fs.reportingFilter.AssigneeID == nil || *fs.reportingFilter.AssigneeID != assigneeID {
+			t.Fatalf("expected assignee filter to be passed through")
+		}
+		var resp AgingWipReport
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Items) != 1 || resp.Items[0].StateAgeHours != 30 {
+			t.Fatalf("unexpected items %+v", resp.Items)
+		}
+	})
+
+	t.Run("ticket detail export streams csv", func(t *testing.T) {
+		assignee := "Ada"
+		closedAt := time.Date(2026, 3, 4, 10, 0, 0, 0, time.UTC)
+		cycle := 26.5
+		points := 3
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			reportingTicketDetails: []store.ReportingTicketDetail{
+				{Key: "OPS-1", Title: "Fix login", Type: "bug", Priority: "high", State: "Done", AssigneeName: &assignee, CreatedAt: closedAt.Add(-48 * time.Hour), ClosedAt: &closedAt, CycleTimeHours: &cycle, StoryPoints: &points, LoggedMinutes: 90},
+				{Key: "OPS-2", Title: "Add export", Type: "feature", Priority: "medium", State: "To Do", CreatedAt: closedAt},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/export", nil)
+		rec := httptest.NewRecorder()
+		format := ExportProjectReportingSnapshotParamsFormatCsv
+		detail := true
+
+		h.ExportProjectReportingSnapshot(rec, req, projectID, ExportProjectReportingSnapshotParams{Format: &format, Detail: &detail})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("parse csv: %v", err)
+		}
+		if len(records) != 3 || records[0][0] != "key" {
+			t.Fatalf("expected header and two rows, got %v", records)
+		}
+		want := []string{"OPS-1", "Fix login", "bug", "high", "Done", "Ada", "2026-03-02T10:00:00Z", "2026-03-04T10:00:00Z", "26.50", "3", "", "90"}
+		for i, value := range want {
+			if records[1][i] != value {
+				t.Fatalf("column %s: expected %q, got %q", records[0][i], value, records[1][i])
+			}
+		}
+		if records[2][7] != "" || records[2][11] != "0" {
+			t.Fatalf("expected open ticket to have no close time, got %v", records[2])
+		}
+	})
+
+	t.Run("ticket detail export streams json", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			reportingTicketDetails: []store.ReportingTicketDetail{
+				{Key: "OPS-1", Type: "bug", Priority: "high", State: "Done"},
+				{Key: "OPS-2", Type: "feature", Priority: "low", State: "To Do"},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/export", nil)
+		rec := httptest.NewRecorder()
+		detail := true
+
+		h.ExportProjectReportingSnapshot(rec, req, projectID, ExportProjectReportingSnapshotParams{Detail: &detail})
+
+		var resp ProjectReportingTicketExportJson
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Tickets) != 2 || resp.Tickets[1].Key != "OPS-2" {
+			t.Fatalf("unexpected tickets %+v", resp.Tickets)
+		}
+	})
+
+	t.Run("ticket detail export reports early failures", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser:         []uuid.UUID{uuid.UUID(projectID)},
+			reportingTicketDetailsErr: errors.New("boom"),
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/export", nil)
+		rec := httptest.NewRecorder()
+		format := ExportProjectReportingSnapshotParamsFormatXlsx
+		detail := true
+
+		h.ExportProjectReportingSnapshot(rec, req, projectID, ExportProjectReportingSnapshotParams{Format: &format, Detail: &detail})
+
+		if rec.Code != http.StatusInternalServerError {
+			t.Fatalf("expected status 500, got %d", rec.Code)
+		}
+		if rec.Header().Get("Content-Disposition") != "" {
+			t.Fatalf("expected no attachment header on error")
+		}
+	})
+
+	t.Run("summary export renders xlsx", func(t *testing.T) {
+		fs := &fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+			projectReportingSummary: store.ProjectReportingSummary{
+				ThroughputByDay: []store.DateValuePoint{{Date: time.Now().UTC(), Value: 2}},
+			},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/export", nil)
+		rec := httptest.NewRecorder()
+		format := ExportProjectReportingSnapshotParamsFormatXlsx
+
+		h.ExportProjectReportingSnapshot(rec, req, projectID, ExportProjectReportingSnapshotParams{Format: &format})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if rec.Header().Get("Content-Type") != xlsxContentType {
+			t.Fatalf("unexpected content type %q", rec.Header().Get("Content-Type"))
+		}
+		if _, err := zip.NewReader(bytes.NewReader(rec.Body.Bytes()), int64(rec.Body.Len())); err != nil {
+			t.Fatalf("expected xlsx zip: %v", err)
+		}
+	})
+
+	t.Run("export rejects unknown format", func(t *testing.T) {
+		fs := &fakeStore{projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)}}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/export", nil)
+		rec := httptest.NewRecorder()
+		format := ExportProjectReportingSnapshotParamsFormat("pdf")
+
+		h.ExportProjectReportingSnapshot(rec, req, projectID, ExportProjectReportingSnapshotParams{Format: &format})
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("lead time rejects unknown priority", func(t *testing.T) {
+		fs := &fakeStore{projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)}}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/reporting/lead-time", nil)
+		rec := httptest.NewRecorder()
+		priority := TicketPriority("critical")
+
+		h.GetProjectLeadTimeDistribution(rec, req, projectID, GetProjectLeadTimeDistributionParams{Priority: &priority})
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestTimesheetHandlers(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	ticketID := openapiUUID("22222222-2222-2222-2222-222222222222")
+
+	t.Run("locked week rejects new time entries", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			timeEntryErr:       store.ErrTimePeriodLocked,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/time-entries", strings.NewReader(`{"minutes":30}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicketTimeEntry(rec, req, projectID, ticketID)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("locked week rejects deletes", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			timeEntryErr:       store.ErrTimePeriodLocked,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodDelete, "/time-entries/x", nil)
+		rec := httptest.NewRecorder()
+
+		h.DeleteTicketTimeEntry(rec, req, projectID, ticketID, openapiUUID(uuid.NewString()))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("approval requires admin", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+		}
+		h := newHandlerWith(fs)
+		body := `{"userId":"` + uuid.NewString() + `","week":"2026-03-04"}`
+		req := newTestRequestAsUser(http.MethodPut, "/timesheets/approval", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.ApproveProjectTimesheet(rec, req, projectID)
+
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected status 403, got %d", rec.Code)
+		}
+	})
+
+	t.Run("timesheet exports csv", func(t *testing.T) {
+		weekStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		sheet := store.BuildTimesheet(weekStart, []store.TimesheetEntry{
+			{TicketID: uuid.UUID(ticketID), Key: "OPS-1", Title: "Fix login", LoggedAt: weekStart.AddDate(0, 0, 1), Minutes: 45},
+		})
+		sheet.UserName = "Ada Lovelace"
+		fs := &fakeStore{projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)}, timesheet: sheet}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/timesheets", nil)
+		rec := httptest.NewRecorder()
+		format := GetProjectTimesheetParamsFormatCsv
+
+		h.GetProjectTimesheet(rec, req, projectID, GetProjectTimesheetParams{Format: &format})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if !strings.Contains(rec.Header().Get("Content-Disposition"), "timesheet-ada-lovelace-2026-03-02.csv") {
+			t.Fatalf("unexpected disposition %q", rec.Header().Get("Content-Disposition"))
+		}
+		records, err := csv.NewReader(rec.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("parse csv: %v", err)
+		}
+		if len(records) != 3 || records[0][3] != "2026-03-03" || records[1][3] != "45" || records[2][9] != "45" {
+			t.Fatalf("unexpected timesheet csv %v", records)
+		}
+	})
+
+	t.Run("time report rejects unknown grouping", func(t *testing.T) {
+		fs := &fakeStore{projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)}}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodGet, "/time-report", nil)
+		rec := httptest.NewRecorder()
+		groupBy := TimeReportGroupBy("label")
+
+		h.GetProjectTimeReport(rec, req, projectID, GetProjectTimeReportParams{GroupBy: &groupBy})
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestTimeEntryTimerHandlers(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+	ticketID := openapiUUID("22222222-2222-2222-2222-222222222222")
+
+	t.Run("starting a running timer conflicts", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			timerErr:           store.ErrTimerRunning,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/timer", strings.NewReader(`{}`))
+		rec := httptest.NewRecorder()
+
+		h.StartTicketTimer(rec, req, projectID, ticketID)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("stopping into a locked week conflicts", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			timerErr:           store.ErrTimePeriodLocked,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/timer/stop", strings.NewReader(`{}`))
+		rec := httptest.NewRecorder()
+
+		h.StopTicketTimer(rec, req, projectID, ticketID)
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("stopping a missing timer is not found", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "contributor",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+			timerErr:           pgx.ErrNoRows,
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPost, "/timer/stop", strings.NewReader(`{}`))
+		rec := httptest.NewRecorder()
+
+		h.StopTicketTimer(rec, req, projectID, ticketID)
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("settings reject out of range idle hours", func(t *testing.T) {
+		fs := &fakeStore{
+			projectRoleForUser: "admin",
+			projectIDsForUser:  []uuid.UUID{uuid.UUID(projectID)},
+		}
+		h := newHandlerWith(fs)
+		req := newTestRequestAsUser(http.MethodPatch, "/time-tracking-settings", strings.NewReader(`{"idleTimerHours":0}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTimeTrackingSettings(rec, req, projectID)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+
+	t.Run("state change auto-stops timers", func(t *testing.T) {
+		ticket := store.Ticket{ID: uuid.UUID(ticketID), ProjectID: uuid.UUID(projectID), Key: "OPS-1", StateID: uuid.New()}
+		closed := ticket
+		closed.StateID = uuid.New()
+		fs := &fakeStore{getTicket: ticket, updateTicket: closed}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPatch, "/tickets/"+ticket.ID.String(), strings.NewReader(`{"stateId":"`+closed.StateID.String()+`"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicket(rec, req, ticketID)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d", rec.Code)
+		}
+		if len(fs.autoStoppedTicketIDs) != 1 || fs.autoStoppedTicketIDs[0] != ticket.ID {
+			t.Fatalf("expected timers auto-stopped for %s, got %v", ticket.ID, fs.autoStoppedTicketIDs)
+		}
+	})
+
+	t.Run("idle timers notify their owner", func(t *testing.T) {
+		timer := store.TimeEntryTimer{
+			ID:        uuid.New(),
+			ProjectID: uuid.UUID(projectID),
+			TicketID:  uuid.UUID(ticketID),
+			TicketKey: "OPS-1",
+			UserID:    uuid.New(),
+			StartedAt: time.Now().Add(-9 * time.Hour),
+		}
+		fs := &fakeStore{idleTimers: []store.TimeEntryTimer{timer}}
+		h := newHandlerWith(fs)
+
+		if err := h.NotifyIdleTimers(context.Background()); err != nil {
+			t.Fatalf("notify idle timers: %v", err)
+		}
+
+		if len(fs.createNotificationInputs) != 1 {
+			t.Fatalf("expected 1 notification, got %d", len(fs.createNotificationInputs))
+		}
+		created := fs.createNotificationInputs[0]
+		if created.Type != "timer_idle" || created.UserID != timer.UserID || !strings.Contains(created.Message, "OPS-1 has been running for 9 hours") {
+			t.Fatalf("unexpected notification %+v", created)
+		}
+	})
+}
+
+func TestRunDueTicketRecurrences(t *testing.T) {
+	projectID := uuid.New()
+	templateID := uuid.New()
+	tmpl := store.TicketTemplate{ID: templateID, ProjectID: projectID, TitlePattern: "{title} {date}"}
+
+	t.Run("creates assigned ticket in active sprint", func(t *testing.T) {
+		assigneeID := uuid.New()
+		sprintID := uuid.New()
+		ticketID := uuid.New()
+		groupID := uuid.New()
+		rec := store.TicketRecurrence{
+			ID:                uuid.New(),
+			ProjectID:         projectID,
+			Name:              "Cert rotation",
+			TemplateID:        templateID,
+			StoryID:           uuid.New(),
+			Title:             "Rotate certs",
+			ScheduleKind:      "cron",
+			Schedule:          "0 9 1 * *",
+			Timezone:          "UTC",
+			AssigneeGroupID:   &groupID,
+			AddToActiveSprint: true,
+			Enabled:           true,
+		}
+		fs := &fakeStore{
+			getProject:           store.Project{Key: "OPS"},
+			ticketTemplates:      []store.TicketTemplate{tmpl},
+			dueTicketRecurrences: []store.TicketRecurrence{rec},
+			recurrenceAssignee:   &assigneeID,
+			activeSprintID:       &sprintID,
+			createTicket:         store.Ticket{ID: ticketID, ProjectID: projectID, Key: "OPS-7", AssigneeID: &assigneeID},
+		}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		if err := h.RunDueTicketRecurrences(context.Background()); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		if len(fs.createInputs) != 1 {
+			t.Fatalf("expected 1 ticket, got %d", len(fs.createInputs))
+		}
+		input := fs.createInputs[0]
+		if !strings.HasPrefix(input.Title, "Rotate certs ") || input.StoryID != rec.StoryID {
+			t.Fatalf("unexpected ticket input %+v", input)
+		}
+		if input.AssigneeID == nil || *input.AssigneeID != assigneeID {
+			t.Fatalf("expected round-robin assignee, got %v", input.AssigneeID)
+		}
+		if len(fs.addedSprintTicketIDs) != 1 || fs.addedSprintTicketIDs[0] != ticketID {
+			t.Fatalf("expected ticket added to active sprint, got %v", fs.addedSprintTicketIDs)
+		}
+		if fs.completedRecurrenceRuns[rec.ID] != ticketID {
+			t.Fatalf("expected run recorded, got %v", fs.completedRecurrenceRuns)
+		}
+		if len(fs.createNotificationInputs) != 1 || fs.createNotificationInputs[0].UserID != assigneeID {
+			t.Fatalf("expected assignment notification, got %v", fs.createNotificationInputs)
+		}
+		if len(dispatcher.events) != 1 || dispatcher.events[0] != "ticket.created" {
+			t.Fatalf("expected ticket.created webhook, got %v", dispatcher.events)
+		}
+	})
+
+	t.Run("missing template records failure", func(t *testing.T) {
+		rec := store.TicketRecurrence{ID: uuid.New(), ProjectID: projectID, TemplateID: uuid.New(), Title: "x"}
+		fs := &fakeStore{dueTicketRecurrences: []store.TicketRecurrence{rec}}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{})
+
+		if err := h.RunDueTicketRecurrences(context.Background()); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		if len(fs.createInputs) != 0 {
+			t.Fatalf("expected no ticket, got %d", len(fs.createInputs))
+		}
+		if fs.failedRecurrenceRuns[rec.ID] == "" {
+			t.Fatalf("expected failure recorded, got %v", fs.failedRecurrenceRuns)
+		}
+	})
+
+	t.Run("unreadable schedule fails the run without ending it", func(t *testing.T) {
+		rec := store.TicketRecurrence{ID: uuid.New(), ProjectID: projectID, TemplateID: templateID, Title: "Rotate certs", ScheduleKind: "cron", Schedule: "not a cron", Timezone: "UTC", Enabled: true}
+		fs := &fakeStore{
+			getProject:           store.Project{Key: "OPS"},
+			ticketTemplates:      []store.TicketTemplate{tmpl},
+			dueTicketRecurrences: []store.TicketRecurrence{rec},
+		}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{})
+
+		if err := h.RunDueTicketRecurrences(context.Background()); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		if len(fs.createInputs) != 0 || len(fs.completedRecurrenceRuns) != 0 {
+			t.Fatalf("expected the run to stop before creating a ticket, got %d creates", len(fs.createInputs))
+		}
+		if !strings.HasPrefix(fs.failedRecurrenceRuns[rec.ID], "next run:") {
+			t.Fatalf("expected the schedule error recorded, got %v", fs.failedRecurrenceRuns)
+		}
+	})
+
+	t.Run("occurrence completed elsewhere is skipped", func(t *testing.T) {
+		rec := store.TicketRecurrence{ID: uuid.New(), ProjectID: projectID, TemplateID: templateID, Title: "Rotate certs", ScheduleKind: "cron", Schedule: "0 9 1 * *", Timezone: "UTC"}
+		fs := &fakeStore{
+			getProject:           store.Project{Key: "OPS"},
+			ticketTemplates:      []store.TicketTemplate{tmpl},
+			dueTicketRecurrences: []store.TicketRecurrence{rec},
+			recurrenceAlreadyRan: true,
+		}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		if err := h.RunDueTicketRecurrences(context.Background()); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		if len(fs.failedRecurrenceRuns) != 0 || len(dispatcher.events) != 0 {
+			t.Fatalf("expected a silent skip, got failures %v events %v", fs.failedRecurrenceRuns, dispatcher.events)
+		}
+	})
+}
+
+func TestRunAutomations(t *testing.T) {
+	projectID := uuid.New()
+	openState := uuid.New()
+
+	t.Run("runs matching rules and logs the run", func(t *testing.T) {
+		assigneeID := uuid.New()
+		high := "high"
+		ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-1", Title: "Disk full", StateID: openState, Priority: "high"}
+		matching := store.AutomationRule{
+			ID:         uuid.New(),
+			ProjectID:  projectID,
+			Name:       "Escalate",
+			Trigger:    store.AutomationTriggerTicketCreated,
+			Conditions: store.BoardFilter{Priority: &high},
+			Enabled:    true,
+			Actions: []store.AutomationAction{
+				{Type: store.AutomationActionAssign, UserID: &assigneeID},
+				{Type: store.AutomationActionNotify, Message: "{key} escalated by {rule}"},
+				{Type: store.AutomationActionCallWebhook},
+			},
+		}
+		low := "low"
+		other := store.AutomationRule{
+			ID:         uuid.New(),
+			ProjectID:  projectID,
+			Trigger:    store.AutomationTriggerTicketCreated,
+			Conditions: store.BoardFilter{Priority: &low},
+			Enabled:    true,
+			Actions:    []store.AutomationAction{{Type: store.AutomationActionCallWebhook}},
+		}
+		fs := &fakeStore{getTicket: ticket, applyTicketUpdates: true, automationRules: []store.AutomationRule{matching, other}}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		h.runAutomations(context.Background(), store.AutomationTriggerTicketCreated, ticket)
+
+		if fs.updateInput.AssigneeID == nil || *fs.updateInput.AssigneeID != assigneeID {
+			t.Fatalf("expected assignment, got %+v", fs.updateInput)
+		}
+		var automationNotes []store.NotificationCreateInput
+		for _, n := range fs.createNotificationInputs {
+			if n.Type == notificationTypeAutomation {
+				automationNotes = append(automationNotes, n)
+			}
+		}
+		if len(automationNotes) != 1 || automationNotes[0].UserID != assigneeID || automationNotes[0].Message != "OPS-1 escalated by Escalate" {
+			t.Fatalf("expected notification to new assignee, got %+v", automationNotes)
+		}
+		if !slices.Contains(dispatcher.events, webhookEventAutomationTriggered) {
+			t.Fatalf("expected automation webhook, got %v", dispatcher.events)
+		}
+		if len(fs.automationRuns) != 1 {
+			t.Fatalf("expected 1 logged run, got %+v", fs.automationRuns)
+		}
+		run := fs.automationRuns[0]
+		if run.RuleID != matching.ID || run.Status != store.AutomationRunSucceeded || len(run.Results) != 3 {
+			t.Fatalf("unexpected run %+v", run)
+		}
+	})
+
+	t.Run("failed action skips the rest", func(t *testing.T) {
+		ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID, StateID: openState}
+		target := uuid.New()
+		rule := store.AutomationRule{
+			ID:        uuid.New(),
+			ProjectID: projectID,
+			Trigger:   store.AutomationTriggerCommentCreated,
+			Enabled:   true,
+			Actions: []store.AutomationAction{
+				{Type: store.AutomationActionMoveState, StateID: &target},
+				{Type: store.AutomationActionCallWebhook},
+			},
+		}
+		fs := &fakeStore{getTicket: ticket, updateTicketErr: errors.New("boom"), automationRules: []store.AutomationRule{rule}}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+
+		h.runAutomations(context.Background(), store.AutomationTriggerCommentCreated, ticket)
+
+		if len(fs.automationRuns) != 1 || fs.automationRuns[0].Status != store.AutomationRunFailed || fs.automationRuns[0].Error == nil {
+			t.Fatalf("expected failed run, got %+v", fs.automationRuns)
+		}
+		results := fs.automationRuns[0].Results
+		if len(results) != 2 || results[0].Status != store.AutomationRunFailed || results[1].Status != store.AutomationRunSkipped {
+			t.Fatalf("unexpected results %+v", results)
+		}
+		if len(dispatcher.events) != 0 {
+			t.Fatalf("expected no webhook after failure, got %v", dispatcher.events)
+		}
+	})
+
+	t.Run("loop protection", func(t *testing.T) {
+		// Each rule moves the ticket to its own state, so every action
+		// re-triggers every rule.
+		ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID, StateID: openState}
+		var rules []store.AutomationRule
+		for i := 0; i < maxAutomationDepth+1; i++ {
+			stateID := uuid.New()
+			rules = append(rules, store.AutomationRule{
+				ID:        uuid.New(),
+				ProjectID: projectID,
+				Name:      fmt.Sprintf("rule %d", i),
+				Trigger:   store.AutomationTriggerTicketUpdated,
+				Enabled:   true,
+				Actions:   []store.AutomationAction{{Type: store.AutomationActionMoveState, StateID: &stateID}},
+			})
+		}
+		fs := &fakeStore{getTicket: ticket, applyTicketUpdates: true, automationRules: rules}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{})
+
+		h.runAutomations(context.Background(), store.AutomationTriggerTicketUpdated, ticket)
+
+		var loops, depthLimited int
+		for _, run := range fs.automationRuns {
+			if run.Depth > maxAutomationDepth {
+				t.Fatalf("run exceeded depth limit: %+v", run)
+			}
+			if run.Status != store.AutomationRunSkipped {
+				continue
+			}
+			switch {
+			case strings.HasPrefix(*run.Error, "loop detected"):
+				loops++
+			case strings.Contains(*run.Error, "depth limit"):
+				depthLimited++
+			}
+		}
+		if loops == 0 || depthLimited == 0 {
+			t.Fatalf("expected loop and depth skips, got %d and %d in %d runs", loops, depthLimited, len(fs.automationRuns))
+		}
+	})
+
+	t.Run("scheduled rule acts on matching tickets", func(t *testing.T) {
+		ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-2", StateID: openState}
+		kind, expr := "cron", "0 9 * * *"
+		rule := store.AutomationRule{
+			ID:           uuid.New(),
+			ProjectID:    projectID,
+			Name:         "Daily nudge",
+			Trigger:      store.AutomationTriggerSchedule,
+			ScheduleKind: &kind,
+			Schedule:     &expr,
+			Timezone:     "UTC",
+			Enabled:      true,
+			Actions:      []store.AutomationAction{{Type: store.AutomationActionAddComment, Message: "Still open: {key}"}},
+		}
+		fs := &fakeStore{
+			getTicket:          ticket,
+			boardFilterMatches: []store.BoardFilterMatch{{ID: ticket.ID, Key: ticket.Key}},
+			automationRules:    []store.AutomationRule{rule},
+		}
+		h := NewHandler(fs, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{})
+
+		if err := h.RunDueAutomationRules(context.Background()); err != nil {
+			t.Fatalf("run: %v", err)
+		}
+		if len(fs.automationRuns) != 1 || fs.automationRuns[0].Trigger != store.AutomationTriggerSchedule || fs.automationRuns[0].Status != store.AutomationRunSucceeded {
+			t.Fatalf("unexpected runs %+v", fs.automationRuns)
+		}
+		next, ok := fs.completedAutomationRules[rule.ID]
+		if !ok || next == nil || next.Hour() != 9 {
+			t.Fatalf("expected next run at 09:00, got %v", next)
+		}
+		if len(fs.createCommentInputs) != 1 {
+			t.Fatalf("expected 1 comment, got %d", len(fs.createCommentInputs))
+		}
+		if comment := fs.createCommentInputs[0]; comment.AuthorID != nil || comment.AuthorName != "Automation: Daily nudge" {
+			t.Fatalf("expected an automation comment without author, got %+v", comment)
+		}
+	})
+}
+
+func TestSuggestTemplate(t *testing.T) {
+	incident := store.TicketTemplate{ID: uuid.New(), Name: "Incident", Keywords: []string{"outage", "down"}}
+	release := store.TicketTemplate{ID: uuid.New(), Name: "Release", Keywords: []string{"deploy"}}
+	templates := []store.TicketTemplate{release, incident}
+
+	description := "API is down for all customers"
+	id, confidence := suggestTemplate(templates, "Checkout outage", &description)
+	if id == nil || *id != incident.ID {
+		t.Fatalf("expected incident template, got %v", id)
+	}
+	if confidence != 0.8 {
+		t.Fatalf("expected confidence 0.8, got %v", confidence)
+	}
+
+	if id, confidence := suggestTemplate(templates, "Update docs", nil); id != nil || confidence != 0 {
+		t.Fatalf("expected no suggestion, got %v (%v)", id, confidence)
+	}
+}
+
+func TestStreamProjectEventsAccess(t *testing.T) {
+	projectID := openapiUUID("11111111-1111-1111-1111-111111111111")
+
+	t.Run("missing session returns unauthorized", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{})
+		req := httptest.NewRequest(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
+		rec := httptest.NewRecorder()
+
+		h.StreamProjectEvents(rec, req, projectID)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status 401, got %d", rec.Code)
+		}
+	})
+
+	t.Run("no project access returns forbidden", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{})
+		req := newTestRequestAsUser(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
+		rec := httptest.NewRecorder()
+
+		h.StreamProjectEvents(rec, req, projectID)
+
+		if rec.Code != http.StatusForbidden {
+			t.Fatalf("expected status 403, got %d", rec.Code)
+		}
+	})
+
+	t.Run("no websocket upgrade returns upgrade required", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{
+			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
+		})
+		req := newTestRequestAsUser(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
+		rec := httptest.NewRecorder()
+
+		h.StreamProjectEvents(rec, req, projectID)
+
+		if rec.Code != http.StatusUpgradeRequired {
+			t.Fatalf("expected status 426, got %d", rec.Code)
+		}
+	})
+}
+
+func TestAiTriageAutoApply(t *testing.T) {
+	projectID := uuid.New()
+	backlog := store.WorkflowState{ID: uuid.New(), Name: "Backlog", IsDefault: true}
+	ticket := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-7", Title: "Checkout crashes on submit", StateID: backlog.ID, StateName: backlog.Name, Priority: "medium", Type: "feature"}
+	settings := store.DefaultAiTriageSettings()
+	settings.Enabled = true
+	settings.AutoApply = true
+	settings.AutoApplyThresholds = map[string]float32{"priority": 0.9, "type": 0.7, "assignee": 0.5}
+	bug := "bug"
+	fs := &fakeStore{
+		states:             []store.WorkflowState{backlog},
+		aiTriageSettings:   settings,
+		createTicket:       ticket,
+		getTicket:          ticket,
+		applyTicketUpdates: true,
+		aiTriageSuggestion: store.AiTriageSuggestion{
+			ID:                 uuid.New(),
+			ProjectID:          projectID,
+			Priority:           "urgent",
+			StateID:            backlog.ID,
+			Type:               &bug,
+			ConfidencePriority: 0.92,
+			ConfidenceState:    0.85,
+			ConfidenceType:     0.76,
+		},
+	}
+	h := newHandlerWith(fs)
+
+	t.Run("applies confident fields on create", func(t *testing.T) {
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout crashes on submit"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp ticketResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Priority != Urgent || resp.Type != Bug {
+			t.Fatalf("expected urgent bug, got %s %s", resp.Priority, resp.Type)
+		}
+		if fs.aiTriageAutoApplication == nil || len(fs.aiTriageAutoApplication.Fields) != 2 {
+			t.Fatalf("expected two applied fields, got %+v", fs.aiTriageAutoApplication)
+		}
+		if len(fs.aiTriageDecisionInputs) != 1 || strings.Join(fs.aiTriageDecisionInputs[0].AcceptedFields, ",") != "priority,type" || !fs.aiTriageDecisionInputs[0].AutoApplied {
+			t.Fatalf("expected priority and type auto-applied, got %+v", fs.aiTriageDecisionInputs)
+		}
+		if len(fs.activities) != 2 || fs.activities[0].Action != "ai_triage_applied" || *fs.activities[0].NewValue != "urgent" {
+			t.Fatalf("unexpected activities %+v", fs.activities)
+		}
+	})
+
+	t.Run("failed revert can be retried", func(t *testing.T) {
+		fs.updateTicketErr = errors.New("boom")
+		defer func() { fs.updateTicketErr = nil }()
+		req := newTestRequest(http.MethodPost, "/revert", nil)
+		rec := httptest.NewRecorder()
+
+		h.RevertAiTriageAutoApplication(rec, req, toOpenapiUUID(projectID), toOpenapiUUID(ticket.ID))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.aiTriageAutoApplication.RevertedAt != nil {
+			t.Fatalf("expected application left unreverted")
+		}
+	})
+
+	t.Run("revert restores untouched fields", func(t *testing.T) {
+		// Someone changed the type by hand after creation.
+		fs.getTicket.Type = "feature"
+		fs.activities = nil
+		req := newTestRequest(http.MethodPost, "/revert", nil)
+		rec := httptest.NewRecorder()
+
+		h.RevertAiTriageAutoApplication(rec, req, toOpenapiUUID(projectID), toOpenapiUUID(ticket.ID))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.updateInput.Priority == nil || *fs.updateInput.Priority != "medium" || fs.updateInput.Type != nil {
+			t.Fatalf("expected only priority restored, got %+v", fs.updateInput)
+		}
+		last := fs.aiTriageDecisionInputs[len(fs.aiTriageDecisionInputs)-1]
+		if strings.Join(last.RejectedFields, ",") != "priority,type" || len(last.AcceptedFields) != 0 || last.AutoApplied {
+			t.Fatalf("expected applied fields rejected, got %+v", last)
+		}
+		if len(fs.activities) != 1 || fs.activities[0].Action != "ai_triage_reverted" {
+			t.Fatalf("unexpected activities %+v", fs.activities)
+		}
+	})
+
+	t.Run("second revert conflicts", func(t *testing.T) {
+		req := newTestRequest(http.MethodPost, "/revert", nil)
+		rec := httptest.NewRecorder()
+
+		h.RevertAiTriageAutoApplication(rec, req, toOpenapiUUID(projectID), toOpenapiUUID(ticket.ID))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("fields set by the creator are kept", func(t *testing.T) {
+		update, applied := autoTriageUpdate(settings.AutoApplyThresholds, store.TicketCreateInput{Priority: "low"}, ticket, fs.aiTriageSuggestion)
+		if update.Priority != nil || len(applied) != 1 || applied[0].Field != "type" {
+			t.Fatalf("expected only type applied, got %+v", applied)
+		}
+	})
+
+	t.Run("rejects out of range thresholds", func(t *testing.T) {
+		req := newTestRequest(http.MethodPatch, "/ai-triage/settings", strings.NewReader(`{"autoApply":true,"autoApplyThresholds":{"priority":1.5}}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectAiTriageSettings(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestIncidentLifecycle(t *testing.T) {
+	projectID := uuid.New()
+	sev3, sev1 := "sev3", "sev1"
+	detected := store.IncidentStatusDetected
+	incident := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-3", IncidentEnabled: true, IncidentSeverity: &sev3, IncidentStatus: &detected}
+
+	t.Run("declaring an incident records its severity", func(t *testing.T) {
+		fs := &fakeStore{createTicket: incident}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout down","incidentEnabled":true,"incidentSeverity":"sev3"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if !slices.Contains(dispatcher.events, "incident.declared") || slices.Contains(dispatcher.events, "incident.escalated") {
+			t.Fatalf("unexpected webhook events %v", dispatcher.events)
+		}
+		if len(fs.incidentSeverityChanges) != 1 || fs.incidentSeverityChanges[0].FromSeverity != nil || *fs.incidentSeverityChanges[0].ToSeverity != "sev3" {
+			t.Fatalf("unexpected severity history %+v", fs.incidentSeverityChanges)
+		}
+	})
+
+	t.Run("escalating and resolving send webhooks", func(t *testing.T) {
+		resolved := store.IncidentStatusResolved
+		updated := incident
+		updated.IncidentSeverity = &sev1
+		updated.IncidentStatus = &resolved
+		fs := &fakeStore{getTicket: incident, updateTicket: updated}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		req := newTestRequest(http.MethodPatch, "/tickets", strings.NewReader(`{"incidentSeverity":"sev1","incidentStatus":"resolved"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicket(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.updateInput.IncidentStatus == nil || *fs.updateInput.IncidentStatus != "resolved" {
+			t.Fatalf("expected status update, got %+v", fs.updateInput)
+		}
+		if !slices.Contains(dispatcher.events, "incident.escalated") || !slices.Contains(dispatcher.events, "incident.resolved") {
+			t.Fatalf("unexpected webhook events %v", dispatcher.events)
+		}
+		if len(fs.incidentSeverityChanges) != 1 || !fs.incidentSeverityChanges[0].Escalated() {
+			t.Fatalf("unexpected severity history %+v", fs.incidentSeverityChanges)
+		}
+	})
+
+	t.Run("status cannot move backwards", func(t *testing.T) {
+		mitigated := store.IncidentStatusMitigated
+		current := incident
+		current.IncidentStatus = &mitigated
+		h := newHandlerWith(&fakeStore{getTicket: current})
+		req := newTestRequest(http.MethodPatch, "/tickets", strings.NewReader(`{"incidentStatus":"acknowledged"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicket(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("status requires an incident", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{getTicket: store.Ticket{ID: incident.ID, ProjectID: projectID}})
+		req := newTestRequest(http.MethodPatch, "/tickets", strings.NewReader(`{"incidentStatus":"acknowledged"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicket(rec, req, toOpenapiUUID(incident.ID))
 
-		h.StreamProjectEvents(rec, req, projectID)
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
 
-		if rec.Code != http.StatusUnauthorized {
-			t.Fatalf("expected status 401, got %d", rec.Code)
+	t.Run("metrics", func(t *testing.T) {
+		detectedAt := time.Date(2026, 5, 4, 10, 0, 0, 0, time.UTC)
+		acknowledgedAt := detectedAt.Add(30 * time.Minute)
+		resolvedAt := detectedAt.Add(4 * time.Hour)
+		fs := &fakeStore{incidentMetricsRecords: []store.IncidentMetricsRecord{
+			{Severity: &sev1, DetectedAt: detectedAt, AcknowledgedAt: &acknowledgedAt, ResolvedAt: &resolvedAt},
+			{Severity: &sev3, DetectedAt: detectedAt},
+		}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodGet, "/reporting/incidents", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetProjectIncidentMetrics(rec, req, toOpenapiUUID(projectID), GetProjectIncidentMetricsParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var report IncidentMetricsReport
+		if err := json.NewDecoder(rec.Body).Decode(&report); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if report.Overall.Declared != 2 || report.Overall.Open != 1 || report.Overall.TimeToAcknowledge.AverageHours != 0.5 || report.Overall.TimeToResolve.AverageHours != 4 {
+			t.Fatalf("unexpected overall metrics %+v", report.Overall)
+		}
+		if len(report.BySeverity) != 2 || *report.BySeverity[0].Severity != Sev1 {
+			t.Fatalf("unexpected severity breakdown %+v", report.BySeverity)
 		}
 	})
+}
 
-	t.Run("no project access returns forbidden", func(t *testing.T) {
+func TestIncidentPostmortems(t *testing.T) {
+	projectID := uuid.New()
+	storyID := uuid.New()
+	incident := store.Ticket{ID: uuid.New(), ProjectID: projectID, StoryID: storyID, Key: "OPS-3", Title: "Checkout down", IncidentEnabled: true}
+
+	t.Run("get before save is not found", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{getTicket: incident})
+		req := newTestRequest(http.MethodGet, "/tickets/postmortem", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetTicketPostmortem(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("save keeps omitted sections", func(t *testing.T) {
+		fs := &fakeStore{getTicket: incident, postmortem: &store.IncidentPostmortem{TicketID: incident.ID, Summary: "Checkout failed for 2h"}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPut, "/tickets/postmortem", strings.NewReader(`{"rootCause":"Expired certificate"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicketPostmortem(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp IncidentPostmortem
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Summary != "Checkout failed for 2h" || resp.RootCause != "Expired certificate" {
+			t.Fatalf("unexpected postmortem %+v", resp)
+		}
+		if fs.postmortemInput.Summary != nil || fs.postmortemInput.UpdatedBy == nil {
+			t.Fatalf("unexpected save input %+v", fs.postmortemInput)
+		}
+	})
+
+	t.Run("requires an incident", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{getTicket: store.Ticket{ID: incident.ID, ProjectID: projectID}})
+		req := newTestRequest(http.MethodPut, "/tickets/postmortem", strings.NewReader(`{"summary":"x"}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateTicketPostmortem(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusConflict {
+			t.Fatalf("expected status 409, got %d", rec.Code)
+		}
+	})
+
+	t.Run("action item creates a linked ticket", func(t *testing.T) {
+		assigneeID := uuid.New()
+		action := store.Ticket{ID: uuid.New(), ProjectID: projectID, StoryID: storyID, Key: "OPS-4", Title: "Alert on certificate expiry"}
+		fs := &fakeStore{getTicket: incident, createTicket: action}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		body := fmt.Sprintf(`{"title":"Alert on certificate expiry","assigneeId":%q,"priority":"high"}`, assigneeID)
+		req := newTestRequest(http.MethodPost, "/tickets/postmortem/action-items", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreatePostmortemActionItem(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.createInput.StoryID != storyID || fs.createInput.AssigneeID == nil || *fs.createInput.AssigneeID != assigneeID || fs.createInput.Priority != "high" {
+			t.Fatalf("unexpected ticket input %+v", fs.createInput)
+		}
+		link := fs.createTicketDependencyInput
+		if link.TicketID != action.ID || link.RelatedTicketID != incident.ID || link.RelationType != store.DependencyRelationRelated {
+			t.Fatalf("unexpected link %+v", link)
+		}
+		if len(fs.postmortemActionItems) != 1 || fs.postmortemActionItems[0].TicketID != action.ID {
+			t.Fatalf("unexpected action items %+v", fs.postmortemActionItems)
+		}
+		if !slices.Contains(dispatcher.events, "ticket.created") {
+			t.Fatalf("unexpected webhook events %v", dispatcher.events)
+		}
+	})
+
+	t.Run("failed action item create reports the error", func(t *testing.T) {
+		fs := &fakeStore{getTicket: incident, createTicketErr: errors.New("title required")}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		req := newTestRequest(http.MethodPost, "/tickets/postmortem/action-items", strings.NewReader(`{"title":"Alert on certificate expiry"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreatePostmortemActionItem(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if len(fs.postmortemActionItems) != 0 || len(dispatcher.events) != 0 {
+			t.Fatalf("expected nothing recorded or announced, got %+v %v", fs.postmortemActionItems, dispatcher.events)
+		}
+	})
+
+	t.Run("report covers the caller's projects", func(t *testing.T) {
+		other := uuid.New()
+		fs := &fakeStore{projects: []store.Project{{ID: projectID}, {ID: other}}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodGet, "/postmortem-action-items", nil)
+		rec := httptest.NewRecorder()
+
+		h.ListPostmortemActionItems(rec, req, ListPostmortemActionItemsParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if len(fs.postmortemReportProjectIDs) != 2 || fs.postmortemReportClosed {
+			t.Fatalf("unexpected report filter %v closed=%t", fs.postmortemReportProjectIDs, fs.postmortemReportClosed)
+		}
+	})
+}
+
+func TestIncidentTimelineAnnotations(t *testing.T) {
+	projectID := uuid.New()
+	incident := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-3", IncidentEnabled: true}
+
+	t.Run("annotation keeps its timestamp in the timeline", func(t *testing.T) {
+		fs := &fakeStore{getTicket: incident}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets/incident-timeline", strings.NewReader(`{"title":"rolled back deploy","occurredAt":"2026-05-04T10:42:00Z"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateIncidentTimelineAnnotation(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		input := fs.timelineEntryInputs[0]
+		if input.Kind != store.IncidentTimelineAnnotation || !input.OccurredAt.Equal(time.Date(2026, 5, 4, 10, 42, 0, 0, time.UTC)) || input.AuthorID == nil {
+			t.Fatalf("unexpected annotation input %+v", input)
+		}
+
+		req = newTestRequest(http.MethodGet, "/tickets/incident-timeline", nil)
+		rec = httptest.NewRecorder()
+		h.ListTicketIncidentTimeline(rec, req, toOpenapiUUID(incident.ID))
+
+		var resp IncidentTimelineResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Items) != 1 || resp.Items[0].Type != IncidentTimelineItemTypeAnnotation || resp.Items[0].Title != "rolled back deploy" {
+			t.Fatalf("unexpected timeline %+v", resp.Items)
+		}
+	})
+
+	t.Run("rejects future timestamps", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{getTicket: incident})
+		body := fmt.Sprintf(`{"title":"later","occurredAt":%q}`, time.Now().Add(time.Hour).Format(time.RFC3339))
+		req := newTestRequest(http.MethodPost, "/tickets/incident-timeline", strings.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		h.CreateIncidentTimelineAnnotation(rec, req, toOpenapiUUID(incident.ID))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestIngestIncidentAlerts(t *testing.T) {
+	projectID := uuid.New()
+	storyID := uuid.New()
+	token := "alert-token"
+	settings := store.IncidentAlertSettings{ProjectID: projectID, Enabled: true, Token: &token, StoryID: &storyID}
+	payload := func(status string) string {
+		return fmt.Sprintf(`{"version":"4","groupKey":"{}:{alertname=\"HighErrorRate\"}","status":%q,
+			"commonLabels":{"alertname":"HighErrorRate","severity":"critical"},
+			"commonAnnotations":{"summary":"Checkout error rate above 5%%"},
+			"alerts":[{"status":%q,"labels":{"alertname":"HighErrorRate","severity":"critical"},
+				"startsAt":"2026-05-04T10:00:00Z","endsAt":"2026-05-04T11:00:00Z","fingerprint":"abc123"}]}`, status, status)
+	}
+
+	t.Run("unknown token", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{incidentAlertSettings: settings})
+		req := httptest.NewRequest(http.MethodPost, "/incident-alerts/wrong", strings.NewReader(payload("firing")))
+		rec := httptest.NewRecorder()
+
+		h.IngestIncidentAlerts(rec, req, "wrong", IngestIncidentAlertsParams{})
+
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("firing alert declares an incident once", func(t *testing.T) {
+		declared := store.Ticket{ID: uuid.New(), ProjectID: projectID, StoryID: storyID, Key: "OPS-9", IncidentEnabled: true}
+		fs := &fakeStore{incidentAlertSettings: settings, createTicket: declared}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		req := httptest.NewRequest(http.MethodPost, "/incident-alerts/"+token, strings.NewReader(payload("firing")))
+		rec := httptest.NewRecorder()
+
+		h.IngestIncidentAlerts(rec, req, token, IngestIncidentAlertsParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp IncidentAlertIngestResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if !resp.Declared || resp.Recorded != 1 || resp.TicketId == nil || uuid.UUID(*resp.TicketId) != declared.ID {
+			t.Fatalf("unexpected response %+v", resp)
+		}
+		input := fs.createInput
+		if input.Title != "Checkout error rate above 5%" || !input.IncidentEnabled || derefString(input.IncidentSeverity) != "sev1" || input.StoryID != storyID {
+			t.Fatalf("unexpected incident input %+v", input)
+		}
+		if !slices.Contains(dispatcher.events, "incident.declared") {
+			t.Fatalf("unexpected webhook events %v", dispatcher.events)
+		}
+
+		// Alertmanager repeats the notification until the alert resolves.
+		fs.alertGroupTicketID = &declared.ID
+		fs.getTicket = declared
+		req = httptest.NewRequest(http.MethodPost, "/incident-alerts/"+token, strings.NewReader(payload("firing")))
+		rec = httptest.NewRecorder()
+		h.IngestIncidentAlerts(rec, req, token, IngestIncidentAlertsParams{})
+
+		resp = IncidentAlertIngestResponse{}
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Declared || resp.Recorded != 0 || len(fs.createInputs) != 1 {
+			t.Fatalf("expected repeat to record nothing, got %+v", resp)
+		}
+
+		req = httptest.NewRequest(http.MethodPost, "/incident-alerts/"+token, strings.NewReader(payload("resolved")))
+		rec = httptest.NewRecorder()
+		h.IngestIncidentAlerts(rec, req, token, IngestIncidentAlertsParams{})
+
+		last := fs.timelineEntryInputs[len(fs.timelineEntryInputs)-1]
+		if rec.Code != http.StatusOK || len(fs.timelineEntryInputs) != 2 || *last.AlertStatus != store.AlertStatusResolved || last.OccurredAt.Hour() != 11 {
+			t.Fatalf("unexpected resolved entry %+v (status %d)", last, rec.Code)
+		}
+	})
+
+	t.Run("concurrent declaration reuses the incident", func(t *testing.T) {
+		existing := uuid.New()
+		fs := &fakeStore{incidentAlertSettings: settings, alertGroupDeclaredTicketID: &existing}
+		dispatcher := &fakeWebhookDispatcher{}
+		h := NewHandler(fs, &fakeAuth{}, dispatcher, HandlerOptions{})
+		req := httptest.NewRequest(http.MethodPost, "/incident-alerts/"+token, strings.NewReader(payload("firing")))
+		rec := httptest.NewRecorder()
+
+		h.IngestIncidentAlerts(rec, req, token, IngestIncidentAlertsParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp IncidentAlertIngestResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Declared || resp.Recorded != 1 || resp.TicketId == nil || uuid.UUID(*resp.TicketId) != existing {
+			t.Fatalf("unexpected response %+v", resp)
+		}
+		if len(fs.createInputs) != 0 || len(dispatcher.events) != 0 {
+			t.Fatalf("expected no second incident, got inputs %v events %v", fs.createInputs, dispatcher.events)
+		}
+	})
+
+	t.Run("resolved alert without incident is ignored", func(t *testing.T) {
+		fs := &fakeStore{incidentAlertSettings: settings}
+		h := newHandlerWith(fs)
+		req := httptest.NewRequest(http.MethodPost, "/incident-alerts/"+token, strings.NewReader(payload("resolved")))
+		rec := httptest.NewRecorder()
+
+		h.IngestIncidentAlerts(rec, req, token, IngestIncidentAlertsParams{})
+
+		if rec.Code != http.StatusOK || len(fs.createInputs) != 0 || len(fs.timelineEntryInputs) != 0 {
+			t.Fatalf("expected nothing recorded, got status %d", rec.Code)
+		}
+	})
+
+	t.Run("enabling requires a story", func(t *testing.T) {
 		h := newHandlerWith(&fakeStore{})
-		req := newTestRequestAsUser(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
+		req := newTestRequest(http.MethodPatch, "/incident-alerts/settings", strings.NewReader(`{"enabled":true}`))
 		rec := httptest.NewRecorder()
 
-		h.StreamProjectEvents(rec, req, projectID)
+		h.UpdateProjectIncidentAlertSettings(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status 400, got %d", rec.Code)
+		}
+	})
+}
+
+func TestOnCall(t *testing.T) {
+	projectID := uuid.New()
+	userID := uuid.New()
+	shift := store.OnCallShift{ScheduleID: uuid.New(), ScheduleName: "Primary", UserID: userID, UserName: "Ada", StartsAt: time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)}
+
+	t.Run("declaring an incident makes the on-call user commander", func(t *testing.T) {
+		incident := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-3", IncidentEnabled: true}
+		commanded := incident
+		commanded.IncidentCommanderID = &userID
+		fs := &fakeStore{createTicket: incident, updateTicket: commanded, onCallShifts: []store.OnCallShift{shift}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout down","incidentEnabled":true}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.updateInput.IncidentCommanderID == nil || *fs.updateInput.IncidentCommanderID != userID {
+			t.Fatalf("expected commander update, got %+v", fs.updateInput)
+		}
+		if len(fs.createNotificationInputs) != 1 || fs.createNotificationInputs[0].Type != "incident_commander" || fs.createNotificationInputs[0].UserID != userID {
+			t.Fatalf("unexpected notifications %+v", fs.createNotificationInputs)
+		}
+		var resp ticketResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.IncidentCommanderId == nil || uuid.UUID(*resp.IncidentCommanderId) != userID {
+			t.Fatalf("expected commander in response, got %+v", resp.IncidentCommanderId)
+		}
+	})
+
+	t.Run("an explicit commander is kept", func(t *testing.T) {
+		other := uuid.New()
+		incident := store.Ticket{ID: uuid.New(), ProjectID: projectID, Key: "OPS-4", IncidentEnabled: true, IncidentCommanderID: &other}
+		fs := &fakeStore{createTicket: incident, onCallShifts: []store.OnCallShift{shift}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout down","incidentEnabled":true}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated {
+			t.Fatalf("expected status 201, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if fs.updateInput.IncidentCommanderID != nil || len(fs.createNotificationInputs) != 0 {
+			t.Fatalf("expected no commander assignment, got %+v", fs.updateInput)
+		}
+	})
+
+	t.Run("project on call lists current shifts", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{onCallShifts: []store.OnCallShift{shift}})
+		req := newTestRequest(http.MethodGet, "/projects/oncall", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetProjectOnCall(rec, req, toOpenapiUUID(projectID), GetProjectOnCallParams{})
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp OnCallShiftListResponse
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if len(resp.Items) != 1 || resp.Items[0].User.Name != "Ada" || resp.Items[0].ScheduleName != "Primary" {
+			t.Fatalf("unexpected shifts %+v", resp.Items)
+		}
+	})
+
+	t.Run("group schedules are changed by admins only", func(t *testing.T) {
+		groupID := uuid.New()
+		sched := store.OnCallSchedule{ID: uuid.New(), GroupID: &groupID, Name: "Platform"}
+		h := newHandlerWith(&fakeStore{onCallSchedule: &sched})
+		req := newTestRequestAsUser(http.MethodPost, "/oncall-schedules/overrides", strings.NewReader(`{"userId":"`+userID.String()+`","startsAt":"2026-05-04T09:00:00Z","endsAt":"2026-05-04T17:00:00Z"}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateOnCallOverride(rec, req, toOpenapiUUID(sched.ID))
 
 		if rec.Code != http.StatusForbidden {
 			t.Fatalf("expected status 403, got %d", rec.Code)
 		}
 	})
+}
 
-	t.Run("no websocket upgrade returns upgrade required", func(t *testing.T) {
-		h := newHandlerWith(&fakeStore{
-			projectIDsForUser: []uuid.UUID{uuid.UUID(projectID)},
-		})
-		req := newTestRequestAsUser(http.MethodGet, "/projects/"+projectID.String()+"/events/ws", nil)
+func TestStatusFeed(t *testing.T) {
+	projectID := uuid.New()
+	slug := "acme"
+	sev2, impact, mitigated := "sev2", "Checkout is slow for some customers", store.IncidentStatusMitigated
+	started := time.Date(2026, 5, 4, 10, 0, 0, 0, time.UTC)
+	incident := store.StatusPageIncident{
+		ID:        uuid.New(),
+		Title:     "Slow checkout",
+		Severity:  &sev2,
+		Impact:    &impact,
+		Status:    &mitigated,
+		StartedAt: started,
+		UpdatedAt: started.Add(time.Hour),
+		Updates:   []store.StatusPageUpdate{{ID: uuid.New(), Message: "A fix has been deployed.", CreatedAt: started.Add(time.Hour)}},
+	}
+	settings := store.StatusPageSettings{ProjectID: projectID, Enabled: true, Slug: &slug, ProjectName: "Acme", UpdatedAt: started}
+
+	t.Run("unknown slug", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{statusPage: &settings})
+		req := httptest.NewRequest(http.MethodGet, "/status/other", nil)
 		rec := httptest.NewRecorder()
 
-		h.StreamProjectEvents(rec, req, projectID)
+		h.GetStatusFeed(rec, req, "other")
 
-		if rec.Code != http.StatusUpgradeRequired {
-			t.Fatalf("expected status 426, got %d", rec.Code)
+		if rec.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404, got %d", rec.Code)
+		}
+	})
+
+	t.Run("json feed", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{statusPage: &settings, statusPageIncidents: []store.StatusPageIncident{incident}})
+		req := httptest.NewRequest(http.MethodGet, "/status/acme", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetStatusFeed(rec, req, slug)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		var resp StatusFeed
+		if err := json.NewDecoder(rec.Body).Decode(&resp); err != nil {
+			t.Fatalf("decode response: %v", err)
+		}
+		if resp.Title != "Acme" || resp.Status != Degraded || !resp.UpdatedAt.Equal(incident.UpdatedAt) {
+			t.Fatalf("unexpected feed %+v", resp)
+		}
+		if len(resp.Incidents) != 1 || resp.Incidents[0].Impact == nil || *resp.Incidents[0].Impact != impact || len(resp.Incidents[0].Updates) != 1 {
+			t.Fatalf("unexpected incidents %+v", resp.Incidents)
+		}
+	})
+
+	t.Run("atom feed", func(t *testing.T) {
+		h := NewHandler(&fakeStore{statusPage: &settings, statusPageIncidents: []store.StatusPageIncident{incident}}, &fakeAuth{}, &fakeWebhookDispatcher{}, HandlerOptions{PublicBaseURL: "https://status.example.com/"})
+		req := httptest.NewRequest(http.MethodGet, "http://evil.example/status/acme/feed.atom", nil)
+		req.Header.Set("X-Forwarded-Proto", "gopher")
+		rec := httptest.NewRecorder()
+
+		h.GetStatusFeedAtom(rec, req, slug)
+
+		if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/atom+xml") {
+			t.Fatalf("unexpected response %d %q", rec.Code, rec.Header().Get("Content-Type"))
+		}
+		var feed atomFeed
+		if err := xml.Unmarshal(rec.Body.Bytes(), &feed); err != nil {
+			t.Fatalf("decode feed: %v", err)
+		}
+		if len(feed.Links) != 2 || feed.Links[0].Href != "https://status.example.com/status/acme/feed.atom" || feed.Links[1].Href != "https://status.example.com/status/acme" {
+			t.Fatalf("unexpected links %+v", feed.Links)
+		}
+		if len(feed.Entries) != 1 || feed.Entries[0].ID != "urn:uuid:"+incident.ID.String() || !strings.Contains(feed.Entries[0].Content.Body, "A fix has been deployed.") {
+			t.Fatalf("unexpected entries %+v", feed.Entries)
+		}
+	})
+
+	t.Run("rss feed", func(t *testing.T) {
+		h := newHandlerWith(&fakeStore{statusPage: &settings, statusPageIncidents: []store.StatusPageIncident{incident}})
+		req := httptest.NewRequest(http.MethodGet, "http://evil.example/status/acme/feed.rss", nil)
+		rec := httptest.NewRecorder()
+
+		h.GetStatusFeedRss(rec, req, slug)
+
+		var feed rssFeed
+		if err := xml.Unmarshal(rec.Body.Bytes(), &feed); err != nil {
+			t.Fatalf("decode feed: %v", err)
+		}
+		if feed.Channel.Link != "/status/acme" {
+			t.Fatalf("expected a relative link without a base URL, got %q", feed.Channel.Link)
+		}
+		if len(feed.Channel.Items) != 1 || feed.Channel.Items[0].Title != "Slow checkout (mitigated)" {
+			t.Fatalf("unexpected items %+v", feed.Channel.Items)
+		}
+	})
+
+	t.Run("settings update keeps omitted fields", func(t *testing.T) {
+		title := "Acme Status"
+		current := store.StatusPageSettings{ProjectID: projectID, Slug: &slug, Title: &title}
+		fs := &fakeStore{statusPage: &current}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPatch, "/projects/status-page", strings.NewReader(`{"enabled":true}`))
+		rec := httptest.NewRecorder()
+
+		h.UpdateProjectStatusPageSettings(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+		if !fs.statusPageInput.Enabled || fs.statusPageInput.Slug == nil || *fs.statusPageInput.Slug != slug || fs.statusPageInput.Title == nil {
+			t.Fatalf("unexpected settings input %+v", fs.statusPageInput)
+		}
+	})
+
+	t.Run("ticket create passes the customer-visible flag", func(t *testing.T) {
+		fs := &fakeStore{createTicket: store.Ticket{ID: uuid.New(), ProjectID: projectID, IncidentEnabled: true, IncidentCustomerVisible: true}}
+		h := newHandlerWith(fs)
+		req := newTestRequest(http.MethodPost, "/tickets", strings.NewReader(`{"title":"Checkout down","incidentEnabled":true,"incidentCustomerVisible":true}`))
+		rec := httptest.NewRecorder()
+
+		h.CreateTicket(rec, req, toOpenapiUUID(projectID))
+
+		if rec.Code != http.StatusCreated || !fs.createInput.IncidentCustomerVisible {
+			t.Fatalf("expected customer-visible incident, got %d %+v", rec.Code, fs.createInput)
 		}
 	})
 }
@@ -0,0 +1,240 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const ticketRecurrenceBatchSize = 20
+
+func (h *API) ListTicketRecurrences(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	items, err := h.store.ListTicketRecurrences(r.Context(), projectUUID)
+	if handleListError(w, r, err, "ticket recurrences", "ticket_recurrence_list") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ticketRecurrenceListResponse{Items: mapSlice(items, mapTicketRecurrence)})
+}
+
+func (h *API) GetTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	rec, err := h.store.GetTicketRecurrence(r.Context(), projectUUID, uuid.UUID(recurrenceId))
+	if handleDBError(w, r, err, "ticket recurrence", "ticket_recurrence_get") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTicketRecurrence(rec))
+}
+
+func (h *API) CreateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[ticketRecurrenceCreateRequest](w, r, "ticket_recurrence_create")
+	if !ok {
+		return
+	}
+
+	input := store.TicketRecurrenceCreateInput{
+		Name:              req.Name,
+		TemplateID:        uuid.UUID(req.TemplateId),
+		StoryID:           uuid.UUID(req.StoryId),
+		Title:             req.Title,
+		ScheduleKind:      string(req.ScheduleKind),
+		Schedule:          req.Schedule,
+		Timezone:          derefString(req.Timezone),
+		StartsAt:          req.StartsAt,
+		AssigneeGroupID:   parseOpenapiUUIDPtr(req.AssigneeGroupId),
+		AddToActiveSprint: derefBool(req.AddToActiveSprint, false),
+		Enabled:           derefBool(req.Enabled, true),
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.CreatedBy = &actorID
+	}
+
+	rec, err := h.store.CreateTicketRecurrence(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "ticket recurrence", "ticket_recurrence_create", "ticket_recurrence_create_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapTicketRecurrence(rec))
+}
+
+func (h *API) UpdateTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[ticketRecurrenceUpdateRequest](w, r, "ticket_recurrence_update")
+	if !ok {
+		return
+	}
+
+	input := store.TicketRecurrenceUpdateInput{
+		Name:               req.Name,
+		TemplateID:         parseOpenapiUUIDPtr(req.TemplateId),
+		StoryID:            parseOpenapiUUIDPtr(req.StoryId),
+		Title:              req.Title,
+		ScheduleKind:       mapStringPtr(req.ScheduleKind, func(v TicketRecurrenceScheduleKind) string { return string(v) }),
+		Schedule:           req.Schedule,
+		Timezone:           req.Timezone,
+		StartsAt:           req.StartsAt,
+		AssigneeGroupID:    parseOpenapiUUIDPtr(req.AssigneeGroupId),
+		ClearAssigneeGroup: derefBool(req.ClearAssigneeGroup, false),
+		AddToActiveSprint:  req.AddToActiveSprint,
+		Enabled:            req.Enabled,
+	}
+
+	rec, err := h.store.UpdateTicketRecurrence(r.Context(), projectUUID, uuid.UUID(recurrenceId), input)
+	if handleDBErrorWithCode(w, r, err, "ticket recurrence", "ticket_recurrence_update", "ticket_recurrence_update_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTicketRecurrence(rec))
+}
+
+func (h *API) DeleteTicketRecurrence(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, recurrenceId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	if err := h.store.DeleteTicketRecurrence(r.Context(), projectUUID, uuid.UUID(recurrenceId)); handleDeleteError(w, r, err, "ticket recurrence", "ticket_recurrence_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunTicketRecurrenceScheduler creates tickets for due recurrences every
+// interval until ctx is cancelled. Recurrences are leased before they run, so
+// several replicas can run it concurrently.
+func (h *API) RunTicketRecurrenceScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := h.RunDueTicketRecurrences(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("scheduler_error job=ticket_recurrences error=%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunDueTicketRecurrences claims one batch of due recurrences and creates a
+// ticket for each. A run that fails keeps its lease and is retried once the
+// lease expires.
+func (h *API) RunDueTicketRecurrences(ctx context.Context) error {
+	recs, err := h.store.ClaimDueTicketRecurrences(ctx, ticketRecurrenceBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, rec := range recs {
+		if err := h.runTicketRecurrence(ctx, rec, time.Now().UTC()); err != nil {
+			log.Printf("scheduler_error job=ticket_recurrences recurrence=%s error=%s", rec.ID, err.Error())
+			if failErr := h.store.FailTicketRecurrenceRun(ctx, rec.ID, err.Error()); failErr != nil {
+				log.Printf("scheduler_error job=ticket_recurrences recurrence=%s error=%s", rec.ID, failErr.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func (h *API) runTicketRecurrence(ctx context.Context, rec store.TicketRecurrence, now time.Time) error {
+	tmpl, projectKey, err := h.loadTicketTemplate(ctx, rec.ProjectID, rec.TemplateID)
+	if err != nil {
+		return fmt.Errorf("load template: %w", err)
+	}
+	// The schedule was validated when saved, so this only fails if schedule
+	// parsing has changed since. Fail the run rather than end the
+	// recurrence: next_run_at is kept and the error shows on the recurrence.
+	next, err := store.NextTicketRecurrenceRun(rec, now)
+	if err != nil {
+		return fmt.Errorf("next run: %w", err)
+	}
+
+	input := store.TicketCreateInput{StoryID: rec.StoryID}
+	applyTicketTemplate(&input, ticketCreateRequest{Title: rec.Title}, tmpl, projectKey, now)
+	if rec.AssigneeGroupID != nil {
+		input.AssigneeID, err = h.store.NextRecurrenceAssignee(ctx, *rec.AssigneeGroupID, rec.LastAssigneeID)
+		if err != nil {
+			return fmt.Errorf("pick assignee: %w", err)
+		}
+	}
+
+	subtaskInputs := templateSubtaskInputs(rec.StoryID, tmpl, rec.Title, projectKey, now)
+	ticket, subtasks, err := h.store.CreateRecurringTicket(ctx, rec, input, subtaskInputs, next)
+	if errors.Is(err, store.ErrTicketRecurrenceAlreadyRan) {
+		log.Printf("scheduler_skip job=ticket_recurrences recurrence=%s reason=already_ran", rec.ID)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("create ticket: %w", err)
+	}
+
+	// The ticket and the completed run are committed together, so follow-up
+	// failures are only logged.
+	h.announceTemplateSubtasks(ctx, rec.ProjectID, subtasks)
+	if rec.AddToActiveSprint {
+		h.addRecurringTicketToActiveSprint(ctx, rec, ticket, now)
+	}
+	if ticket.AssigneeID != nil {
+		h.notifyRecurringAssignment(ctx, rec, ticket)
+	}
+	h.dispatchTicketWebhook(ctx, rec.ProjectID, ticket.ID, "ticket.created", map[string]any{
+		"ticket":       mapTicket(ticket),
+		"recurrenceId": rec.ID,
+	})
+	h.publishProjectLiveEvent(rec.ProjectID, projectEventBoardRefresh, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.publishProjectLiveEvent(rec.ProjectID, projectEventActivityChanged, map[string]any{
+		"reason": "ticket.created",
+	})
+	h.runAutomations(ctx, store.AutomationTriggerTicketCreated, ticket)
+	return nil
+}
+
+func (h *API) addRecurringTicketToActiveSprint(ctx context.Context, rec store.TicketRecurrence, ticket store.Ticket, now time.Time) {
+	sprintID, err := h.store.ActiveSprintID(ctx, rec.ProjectID, now)
+	if err != nil {
+		log.Printf("scheduler_error job=ticket_recurrences recurrence=%s error=%s", rec.ID, err.Error())
+		return
+	}
+	if sprintID == nil {
+		return
+	}
+	if _, err := h.store.AddSprintTickets(ctx, rec.ProjectID, *sprintID, []uuid.UUID{ticket.ID}); err != nil {
+		log.Printf("scheduler_error job=ticket_recurrences recurrence=%s error=%s", rec.ID, err.Error())
+	}
+}
+
+func (h *API) notifyRecurringAssignment(ctx context.Context, rec store.TicketRecurrence, ticket store.Ticket) {
+	message := fmt.Sprintf("Recurring ticket %s (%s) was assigned to you", ticket.Key, rec.Name)
+	if err := h.notifyBackgroundAssignment(ctx, ticket, message); err != nil {
+		log.Printf("scheduler_error job=ticket_recurrences recurrence=%s error=%s", rec.ID, err.Error())
+	}
+}
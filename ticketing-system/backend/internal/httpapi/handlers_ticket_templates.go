@@ -0,0 +1,207 @@
+package httpapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func (h *API) ListTicketTemplates(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	items, err := h.store.ListTicketTemplates(r.Context(), projectUUID)
+	if handleListError(w, r, err, "ticket templates", "ticket_template_list") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, ticketTemplateListResponse{Items: mapSlice(items, mapTicketTemplate)})
+}
+
+func (h *API) GetTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	tmpl, err := h.store.GetTicketTemplate(r.Context(), projectUUID, uuid.UUID(templateId))
+	if handleDBError(w, r, err, "ticket template", "ticket_template_get") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTicketTemplate(tmpl))
+}
+
+func (h *API) CreateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[ticketTemplateCreateRequest](w, r, "ticket_template_create")
+	if !ok {
+		return
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "invalid_ticket_template", "name is required")
+		return
+	}
+
+	input := store.TicketTemplateCreateInput{
+		Name:             name,
+		TitlePattern:     derefString(req.TitlePattern),
+		Description:      derefString(req.Description),
+		Type:             mapStringPtr(req.Type, func(v TicketType) string { return string(v) }),
+		Priority:         mapStringPtr(req.Priority, func(v TicketPriority) string { return string(v) }),
+		StoryPoints:      req.StoryPoints,
+		TimeEstimate:     req.TimeEstimate,
+		IncidentEnabled:  derefBool(req.IncidentEnabled, false),
+		IncidentSeverity: mapStringPtr(req.IncidentSeverity, func(v TicketIncidentSeverity) string { return string(v) }),
+	}
+	if req.Keywords != nil {
+		input.Keywords = *req.Keywords
+	}
+	if req.Subtasks != nil {
+		input.Subtasks = mapSlice(*req.Subtasks, mapStoreTicketTemplateSubtask)
+	}
+	if actorID, _, ok := currentActor(r); ok {
+		input.CreatedBy = &actorID
+	}
+
+	tmpl, err := h.store.CreateTicketTemplate(r.Context(), projectUUID, input)
+	if handleDBErrorWithCode(w, r, err, "ticket template", "ticket_template_create", "ticket_template_create_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, mapTicketTemplate(tmpl))
+}
+
+func (h *API) UpdateTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[ticketTemplateUpdateRequest](w, r, "ticket_template_update")
+	if !ok {
+		return
+	}
+
+	input := store.TicketTemplateUpdateInput{
+		Name:             req.Name,
+		TitlePattern:     req.TitlePattern,
+		Description:      req.Description,
+		Type:             mapStringPtr(req.Type, func(v TicketType) string { return string(v) }),
+		Priority:         mapStringPtr(req.Priority, func(v TicketPriority) string { return string(v) }),
+		StoryPoints:      req.StoryPoints,
+		TimeEstimate:     req.TimeEstimate,
+		IncidentEnabled:  req.IncidentEnabled,
+		IncidentSeverity: mapStringPtr(req.IncidentSeverity, func(v TicketIncidentSeverity) string { return string(v) }),
+		Keywords:         req.Keywords,
+	}
+	if req.Subtasks != nil {
+		subtasks := mapSlice(*req.Subtasks, mapStoreTicketTemplateSubtask)
+		input.Subtasks = &subtasks
+	}
+
+	tmpl, err := h.store.UpdateTicketTemplate(r.Context(), projectUUID, uuid.UUID(templateId), input)
+	if handleDBErrorWithCode(w, r, err, "ticket template", "ticket_template_update", "ticket_template_update_failed") {
+		return
+	}
+
+	writeJSON(w, http.StatusOK, mapTicketTemplate(tmpl))
+}
+
+func (h *API) DeleteTicketTemplate(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, templateId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	if err := h.store.DeleteTicketTemplate(r.Context(), projectUUID, uuid.UUID(templateId)); handleDeleteError(w, r, err, "ticket template", "ticket_template_delete") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// applyTicketTemplate fills the fields a create request left unset from tmpl
+// and renders the template title pattern around the requested title.
+func applyTicketTemplate(input *store.TicketCreateInput, req ticketCreateRequest, tmpl store.TicketTemplate, projectKey string, now time.Time) {
+	input.Title = store.RenderTicketTemplateTitle(tmpl.TitlePattern, req.Title, projectKey, now)
+	if strings.TrimSpace(input.Description) == "" {
+		input.Description = tmpl.Description
+	}
+	if req.Type == nil && tmpl.Type != nil {
+		input.Type = *tmpl.Type
+	}
+	if req.Priority == nil && tmpl.Priority != nil {
+		input.Priority = *tmpl.Priority
+	}
+	if req.StoryPoints == nil {
+		input.StoryPoints = tmpl.StoryPoints
+	}
+	if req.TimeEstimate == nil {
+		input.TimeEstimate = tmpl.TimeEstimate
+	}
+	if req.IncidentEnabled == nil {
+		input.IncidentEnabled = tmpl.IncidentEnabled
+	}
+	if req.IncidentSeverity == nil {
+		input.IncidentSeverity = tmpl.IncidentSeverity
+	}
+}
+
+// templateSubtaskInputs builds the create inputs for the template's subtasks
+// in the parent's story. Subtasks carry the template's title, description,
+// type, priority and story points only.
+func templateSubtaskInputs(storyID uuid.UUID, tmpl store.TicketTemplate, requestTitle, projectKey string, now time.Time) []store.TicketCreateInput {
+	inputs := make([]store.TicketCreateInput, 0, len(tmpl.Subtasks))
+	for _, subtask := range tmpl.Subtasks {
+		input := store.TicketCreateInput{
+			Title:       store.RenderTicketTemplateTitle(subtask.Title, requestTitle, projectKey, now),
+			Description: subtask.Description,
+			StoryID:     storyID,
+			StoryPoints: subtask.StoryPoints,
+		}
+		if subtask.Type != nil {
+			input.Type = *subtask.Type
+		}
+		if subtask.Priority != nil {
+			input.Priority = *subtask.Priority
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+// announceTemplateSubtasks fires the creation side effects for subtasks that
+// were created together with their parent.
+func (h *API) announceTemplateSubtasks(ctx context.Context, projectID uuid.UUID, subtasks []store.Ticket) {
+	for _, child := range subtasks {
+		h.dispatchTicketWebhook(ctx, projectID, child.ID, "ticket.created", map[string]any{"ticket": mapTicket(child)})
+		h.runAutomations(ctx, store.AutomationTriggerTicketCreated, child)
+	}
+}
+
+// loadTicketTemplate resolves a template for ticket creation. It returns the
+// template and the project key used for title placeholders.
+func (h *API) loadTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) (store.TicketTemplate, string, error) {
+	tmpl, err := h.store.GetTicketTemplate(ctx, projectID, templateID)
+	if err != nil {
+		return store.TicketTemplate{}, "", err
+	}
+	project, err := h.store.GetProject(ctx, projectID)
+	if err != nil {
+		return store.TicketTemplate{}, "", err
+	}
+	return tmpl, project.Key, nil
+}
@@ -1,7 +1,14 @@
 package httpapi
 
 import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"ticketing-system/backend/internal/store"
 
@@ -67,6 +74,9 @@ func (h *API) CreateTicketTimeEntry(w http.ResponseWriter, r *http.Request, proj
 	}
 
 	entry, err := h.store.CreateTimeEntry(r.Context(), ticketUUID, input)
+	if handleTimePeriodLocked(w, r, err, "time_entry_create") {
+		return
+	}
 	if handleDBErrorWithCode(w, r, err, "time_entry", "time_entry_create", "time_entry_create_failed") {
 		return
 	}
@@ -81,8 +91,176 @@ func (h *API) DeleteTicketTimeEntry(w http.ResponseWriter, r *http.Request, proj
 	}
 
 	entryUUID := uuid.UUID(timeEntryId)
-	if err := h.store.DeleteTimeEntry(r.Context(), entryUUID); handleDeleteError(w, r, err, "time_entry", "time_entry_delete") {
+	err := h.store.DeleteTimeEntry(r.Context(), entryUUID)
+	if handleTimePeriodLocked(w, r, err, "time_entry_delete") {
+		return
+	}
+	if handleDeleteError(w, r, err, "time_entry", "time_entry_delete") {
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
 }
+
+func (h *API) GetProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimesheetParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	userID, _, ok := currentActor(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing session")
+		return
+	}
+	if params.UserId != nil {
+		userID = uuid.UUID(*params.UserId)
+	}
+	day := time.Now().UTC()
+	if params.Week != nil {
+		day = params.Week.Time
+	}
+
+	sheet, err := h.store.GetTimesheet(r.Context(), projectUUID, userID, day)
+	if handleDBError(w, r, err, "user", "timesheet_get") {
+		return
+	}
+
+	if params.Format != nil && strings.ToLower(string(*params.Format)) == "csv" {
+		content, err := renderTimesheetCSV(sheet)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "timesheet_export_error", "Failed to render timesheet")
+			return
+		}
+		filename := fmt.Sprintf("timesheet-%s-%s.csv", strings.ReplaceAll(strings.ToLower(sheet.UserName), " ", "-"), sheet.WeekStart.Format("2006-01-02"))
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(content)
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimesheet(sheet))
+}
+
+func (h *API) ApproveProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	req, ok := decodeJSON[TimesheetApprovalRequest](w, r, "timesheet_approve")
+	if !ok {
+		return
+	}
+	actorID, actorName, ok := currentActor(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing session")
+		return
+	}
+
+	approval, err := h.store.ApproveTimesheet(r.Context(), projectUUID, uuid.UUID(req.UserId), req.Week.Time, &actorID, actorName)
+	if handleDBErrorWithCode(w, r, err, "user", "timesheet_approve", "timesheet_approve_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimesheetApproval(approval))
+}
+
+func (h *API) ReopenProjectTimesheet(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params ReopenProjectTimesheetParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	err := h.store.ReopenTimesheet(r.Context(), projectUUID, uuid.UUID(params.UserId), params.Week.Time)
+	if handleDeleteError(w, r, err, "timesheet_approval", "timesheet_reopen") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) GetProjectTimeReport(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeReportParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	from, to, ok := parseReportingRange(params.From, params.To)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "invalid_date_range", "`to` must be on or after `from`")
+		return
+	}
+	groupBy := ""
+	if params.GroupBy != nil {
+		groupBy = string(*params.GroupBy)
+	}
+	groupBy, err := store.NormalizeTimeReportGroup(groupBy)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_group_by", err.Error())
+		return
+	}
+
+	report, err := h.store.GetTimeReport(r.Context(), projectUUID, from, to, groupBy)
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load time report")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimeReport(report))
+}
+
+func (h *API) GetProjectTimeVariance(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, params GetProjectTimeVarianceParams) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	report, err := h.store.GetTimeVariance(r.Context(), projectUUID, parseOpenapiUUIDPtr(params.StoryId))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "reporting_error", "Failed to load time variance")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimeVarianceReport(report))
+}
+
+func handleTimePeriodLocked(w http.ResponseWriter, r *http.Request, err error, logCode string) bool {
+	if !errors.Is(err, store.ErrTimePeriodLocked) {
+		return false
+	}
+	logRequestError(r, logCode+"_locked", err)
+	writeError(w, http.StatusConflict, "time_period_locked", "the timesheet for this week has been approved")
+	return true
+}
+
+func renderTimesheetCSV(sheet store.Timesheet) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+	header := []string{"key", "title"}
+	for _, day := range sheet.Days {
+		header = append(header, day.Format("2006-01-02"))
+	}
+	header = append(header, "total_minutes")
+	if err := writer.Write(header); err != nil {
+		return nil, err
+	}
+	for _, row := range sheet.Rows {
+		record := []string{row.Key, row.Title}
+		for _, minutes := range row.MinutesByDay {
+			record = append(record, strconv.Itoa(minutes))
+		}
+		record = append(record, strconv.Itoa(row.TotalMinutes))
+		if err := writer.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	totals := []string{"", "total"}
+	for _, minutes := range sheet.DailyTotals {
+		totals = append(totals, strconv.Itoa(minutes))
+	}
+	totals = append(totals, strconv.Itoa(sheet.TotalMinutes))
+	if err := writer.Write(totals); err != nil {
+		return nil, err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
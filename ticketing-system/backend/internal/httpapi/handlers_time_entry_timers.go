@@ -0,0 +1,209 @@
+package httpapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	notificationTypeTimerIdle = "timer_idle"
+
+	idleTimerBatchSize = 50
+)
+
+func (h *API) ListProjectTimers(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	timers, err := h.store.ListTimeEntryTimers(r.Context(), projectUUID, userID)
+	if handleListError(w, r, err, "timers", "timer_list") {
+		return
+	}
+	writeJSON(w, http.StatusOK, TimeEntryTimerListResponse{Items: mapSlice(timers, mapTimeEntryTimer)})
+}
+
+func (h *API) StartTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[TimeEntryTimerStartRequest](w, r, "timer_start")
+	if !ok {
+		return
+	}
+	userID, userName, ok := currentActor(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing session")
+		return
+	}
+
+	timer, err := h.store.StartTimeEntryTimer(r.Context(), projectUUID, uuid.UUID(ticketId), store.TimeEntryTimerStartInput{
+		UserID:      userID,
+		UserName:    userName,
+		Description: req.Description,
+	})
+	if errors.Is(err, store.ErrTimerRunning) {
+		writeError(w, http.StatusConflict, "timer_running", "a timer is already running on this ticket")
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "ticket", "timer_start", "timer_start_failed") {
+		return
+	}
+	writeJSON(w, http.StatusCreated, mapTimeEntryTimer(timer))
+}
+
+func (h *API) StopTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+
+	req, ok := decodeJSON[TimeEntryTimerStopRequest](w, r, "timer_stop")
+	if !ok {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.store.StopTimeEntryTimer(r.Context(), projectUUID, uuid.UUID(ticketId), userID, req.Description)
+	if handleTimePeriodLocked(w, r, err, "timer_stop") {
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "timer", "timer_stop", "timer_stop_failed") {
+		return
+	}
+	writeJSON(w, http.StatusCreated, mapTimeEntry(entry))
+}
+
+func (h *API) DiscardTicketTimer(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+	userID, ok := currentUserID(w, r)
+	if !ok {
+		return
+	}
+
+	err := h.store.DiscardTimeEntryTimer(r.Context(), projectUUID, uuid.UUID(ticketId), userID)
+	if handleDeleteError(w, r, err, "timer", "timer_discard") {
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *API) GetTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+
+	settings, err := h.store.GetTimeTrackingSettings(r.Context(), projectUUID)
+	if handleDBError(w, r, err, "time tracking settings", "time_tracking_settings_get") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimeTrackingSettings(settings))
+}
+
+func (h *API) UpdateTimeTrackingSettings(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+
+	req, ok := decodeJSON[TimeTrackingSettingsUpdateRequest](w, r, "time_tracking_settings_update")
+	if !ok {
+		return
+	}
+	if req.IdleTimerHours != nil && (*req.IdleTimerHours < 1 || *req.IdleTimerHours > 168) {
+		writeError(w, http.StatusBadRequest, "invalid_time_tracking_settings", "idleTimerHours must be between 1 and 168")
+		return
+	}
+
+	settings, err := h.store.UpdateTimeTrackingSettings(r.Context(), projectUUID, store.TimeTrackingSettingsUpdateInput{
+		AutoStopOnClose: req.AutoStopOnClose,
+		IdleTimerHours:  req.IdleTimerHours,
+	})
+	if handleDBErrorWithCode(w, r, err, "time tracking settings", "time_tracking_settings_update", "time_tracking_settings_update_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapTimeTrackingSettings(settings))
+}
+
+// autoStopTimers logs and clears running timers when a ticket has just moved
+// into a closed state. Failures are logged rather than failing the update.
+func (h *API) autoStopTimers(ctx context.Context, before, after store.Ticket) {
+	if before.StateID == after.StateID {
+		return
+	}
+	entries, err := h.store.AutoStopTimeEntryTimers(ctx, after.ID)
+	if err != nil {
+		log.Printf("timer_auto_stop_error ticket=%s error=%s", after.ID, err.Error())
+	}
+	if len(entries) > 0 {
+		h.publishProjectLiveEvent(after.ProjectID, projectEventActivityChanged, map[string]any{
+			"reason": "timer.stopped",
+			"id":     after.ID.String(),
+		})
+	}
+}
+
+// RunIdleTimerScheduler notifies owners of timers left running past their
+// project's idle threshold, every interval until ctx is cancelled.
+func (h *API) RunIdleTimerScheduler(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := h.NotifyIdleTimers(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("scheduler_error job=idle_timers error=%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// NotifyIdleTimers claims one batch of idle timers and sends each owner an
+// in-app notification. A timer is only ever reported once.
+func (h *API) NotifyIdleTimers(ctx context.Context) error {
+	timers, err := h.store.ClaimIdleTimeEntryTimers(ctx, idleTimerBatchSize)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	for _, timer := range timers {
+		hours := int(now.Sub(timer.StartedAt).Hours())
+		if _, err := h.store.CreateNotification(ctx, store.NotificationCreateInput{
+			ProjectID: timer.ProjectID,
+			UserID:    timer.UserID,
+			TicketID:  timer.TicketID,
+			Type:      notificationTypeTimerIdle,
+			Message:   fmt.Sprintf("Your timer on %s has been running for %d hours", timer.TicketKey, hours),
+		}); err != nil {
+			log.Printf("scheduler_error job=idle_timers timer=%s error=%s", timer.ID, err.Error())
+			continue
+		}
+		h.publishUserNotificationEvents(ctx, timer.ProjectID, timer.UserID)
+	}
+	return nil
+}
@@ -1,6 +1,8 @@
 package httpapi
 
 import (
+	"time"
+
 	"ticketing-system/backend/internal/store"
 
 	"github.com/google/uuid"
@@ -11,6 +13,14 @@ func toOpenapiUUID(id uuid.UUID) openapi_types.UUID {
 	return openapi_types.UUID(id)
 }
 
+func toOpenapiUUIDPtr(id *uuid.UUID) *openapi_types.UUID {
+	if id == nil {
+		return nil
+	}
+	value := toOpenapiUUID(*id)
+	return &value
+}
+
 func mapWorkflowStates(states []store.WorkflowState, projectID openapi_types.UUID) []workflowState {
 	out := make([]workflowState, 0, len(states))
 	for _, state := range states {
@@ -62,6 +72,13 @@ func mapTicket(ticket store.Ticket) ticketResponse {
 	if ticket.IncidentImpact != nil && *ticket.IncidentImpact != "" {
 		incidentImpact = ticket.IncidentImpact
 	}
+	incidentScribeID, incidentScribe := mapOptionalUser(ticket.IncidentScribeID, ticket.IncidentScribeName)
+	incidentCommsLeadID, incidentCommsLead := mapOptionalUser(ticket.IncidentCommsLeadID, ticket.IncidentCommsLeadName)
+	var incidentStatus *IncidentStatus
+	if ticket.IncidentStatus != nil {
+		status := IncidentStatus(*ticket.IncidentStatus)
+		incidentStatus = &status
+	}
 
 	state := workflowState{
 		Id:        toOpenapiUUID(ticket.StateID),
@@ -84,34 +101,249 @@ func mapTicket(ticket store.Ticket) ticketResponse {
 	}
 
 	return ticketResponse{
-		Id:                  toOpenapiUUID(ticket.ID),
-		Key:                 TicketKey(ticket.Key),
-		Number:              ticket.Number,
-		Type:                TicketType(ticket.Type),
-		ProjectId:           projectID,
-		ProjectKey:          projectKey,
-		StoryId:             storyOapiID,
-		Story:               story,
-		Title:               ticket.Title,
-		Description:         description,
-		StateId:             toOpenapiUUID(ticket.StateID),
-		State:               &state,
-		AssigneeId:          assigneeID,
-		Assignee:            assignee,
-		Priority:            TicketPriority(ticket.Priority),
-		IncidentEnabled:     ticket.IncidentEnabled,
-		IncidentSeverity:    incidentSeverity,
-		IncidentImpact:      incidentImpact,
-		IncidentCommanderId: incidentCommanderID,
-		IncidentCommander:   incidentCommander,
-		StoryPoints:         ticket.StoryPoints,
-		TimeEstimate:        ticket.TimeEstimate,
-		TimeLogged:          &ticket.TimeLogged,
-		Position:            float32(ticket.Position),
-		BlockedByCount:      ticket.BlockedByCount,
-		IsBlocked:           ticket.IsBlocked,
-		CreatedAt:           ticket.CreatedAt,
-		UpdatedAt:           ticket.UpdatedAt,
+		Id:                      toOpenapiUUID(ticket.ID),
+		Key:                     TicketKey(ticket.Key),
+		Number:                  ticket.Number,
+		Type:                    TicketType(ticket.Type),
+		ProjectId:               projectID,
+		ProjectKey:              projectKey,
+		StoryId:                 storyOapiID,
+		Story:                   story,
+		Title:                   ticket.Title,
+		Description:             description,
+		StateId:                 toOpenapiUUID(ticket.StateID),
+		State:                   &state,
+		AssigneeId:              assigneeID,
+		Assignee:                assignee,
+		Priority:                TicketPriority(ticket.Priority),
+		IncidentEnabled:         ticket.IncidentEnabled,
+		IncidentCustomerVisible: ticket.IncidentCustomerVisible,
+		IncidentSeverity:        incidentSeverity,
+		IncidentImpact:          incidentImpact,
+		IncidentCommanderId:     incidentCommanderID,
+		IncidentCommander:       incidentCommander,
+		IncidentScribeId:        incidentScribeID,
+		IncidentScribe:          incidentScribe,
+		IncidentCommsLeadId:     incidentCommsLeadID,
+		IncidentCommsLead:       incidentCommsLead,
+		IncidentStatus:          incidentStatus,
+		IncidentDetectedAt:      ticket.IncidentDetectedAt,
+		IncidentAcknowledgedAt:  ticket.IncidentAcknowledgedAt,
+		IncidentMitigatedAt:     ticket.IncidentMitigatedAt,
+		IncidentResolvedAt:      ticket.IncidentResolvedAt,
+		StoryPoints:             ticket.StoryPoints,
+		TimeEstimate:            ticket.TimeEstimate,
+		TimeLogged:              &ticket.TimeLogged,
+		Position:                float32(ticket.Position),
+		BlockedByCount:          ticket.BlockedByCount,
+		IsBlocked:               ticket.IsBlocked,
+		CreatedAt:               ticket.CreatedAt,
+		UpdatedAt:               ticket.UpdatedAt,
+	}
+}
+
+// mapOptionalUser maps a nullable user reference. The summary is left out
+// when the name did not resolve.
+func mapOptionalUser(id *uuid.UUID, name *string) (*openapi_types.UUID, *userSummary) {
+	if id == nil {
+		return nil, nil
+	}
+	value := toOpenapiUUID(*id)
+	if name == nil {
+		return &value, nil
+	}
+	return &value, &userSummary{Id: value, Name: *name}
+}
+
+func mapIncidentTimelineEntry(item store.IncidentTimelineEntry) IncidentTimelineItem {
+	itemType := IncidentTimelineItemTypeAnnotation
+	if item.Kind == store.IncidentTimelineAlert {
+		itemType = IncidentTimelineItemTypeAlert
+	}
+	return IncidentTimelineItem{
+		Id:        item.ID.String(),
+		TicketId:  toOpenapiUUID(item.TicketID),
+		Type:      itemType,
+		Title:     item.Title,
+		Body:      item.Body,
+		CreatedAt: item.OccurredAt,
+	}
+}
+
+func mapIncidentAlertSettings(item store.IncidentAlertSettings) IncidentAlertSettings {
+	out := IncidentAlertSettings{
+		Enabled:         item.Enabled,
+		Token:           item.Token,
+		DefaultSeverity: mapIncidentSeverity(item.DefaultSeverity),
+	}
+	if item.StoryID != nil {
+		storyID := toOpenapiUUID(*item.StoryID)
+		out.StoryId = &storyID
+	}
+	return out
+}
+
+func mapIncidentPostmortem(item store.IncidentPostmortem, actionItems []store.PostmortemActionItem) IncidentPostmortem {
+	out := IncidentPostmortem{
+		TicketId:            toOpenapiUUID(item.TicketID),
+		Summary:             item.Summary,
+		Impact:              item.Impact,
+		RootCause:           item.RootCause,
+		ContributingFactors: item.ContributingFactors,
+		Lessons:             item.Lessons,
+		ActionItems:         mapSlice(actionItems, mapPostmortemActionItem),
+		CreatedAt:           item.CreatedAt,
+		UpdatedAt:           item.UpdatedAt,
+	}
+	_, out.UpdatedBy = mapOptionalUser(item.UpdatedBy, item.UpdatedByName)
+	return out
+}
+
+func mapPostmortemActionItem(item store.PostmortemActionItem) PostmortemActionItem {
+	out := PostmortemActionItem{
+		IncidentTicketId: toOpenapiUUID(item.IncidentTicketID),
+		IncidentKey:      item.IncidentKey,
+		IncidentTitle:    item.IncidentTitle,
+		TicketId:         toOpenapiUUID(item.TicketID),
+		ProjectId:        toOpenapiUUID(item.ProjectID),
+		ProjectKey:       item.ProjectKey,
+		Key:              item.Key,
+		Title:            item.Title,
+		Priority:         TicketPriority(item.Priority),
+		StateName:        item.StateName,
+		IsClosed:         item.StateClosed,
+		CreatedAt:        item.CreatedAt,
+	}
+	_, out.Assignee = mapOptionalUser(item.AssigneeID, item.AssigneeName)
+	return out
+}
+
+func mapIncidentSeverityChange(item store.IncidentSeverityChange) IncidentSeverityChange {
+	out := IncidentSeverityChange{
+		Id:           toOpenapiUUID(item.ID),
+		TicketId:     toOpenapiUUID(item.TicketID),
+		FromSeverity: mapIncidentSeverity(item.FromSeverity),
+		ToSeverity:   mapIncidentSeverity(item.ToSeverity),
+		Escalated:    item.Escalated(),
+		CreatedAt:    item.CreatedAt,
+	}
+	if item.ActorID != nil && item.ActorName != nil {
+		out.Actor = &userSummary{Id: toOpenapiUUID(*item.ActorID), Name: *item.ActorName}
+	}
+	return out
+}
+
+func mapIncidentSeverity(value *string) *TicketIncidentSeverity {
+	if value == nil {
+		return nil
+	}
+	severity := TicketIncidentSeverity(*value)
+	return &severity
+}
+
+func mapIncidentMetricsReport(report store.IncidentMetricsReport) IncidentMetricsReport {
+	return IncidentMetricsReport{
+		From:    openapi_types.Date{Time: report.From},
+		To:      openapi_types.Date{Time: report.To},
+		Overall: mapIncidentMetrics(report.IncidentMetrics),
+		BySeverity: mapSlice(report.BySeverity, func(item store.IncidentSeverityMetrics) IncidentSeverityMetrics {
+			metrics := mapIncidentMetrics(item.IncidentMetrics)
+			return IncidentSeverityMetrics{
+				Severity:          mapIncidentSeverity(item.Severity),
+				Declared:          metrics.Declared,
+				Open:              metrics.Open,
+				TimeToAcknowledge: metrics.TimeToAcknowledge,
+				TimeToResolve:     metrics.TimeToResolve,
+			}
+		}),
+	}
+}
+
+func mapIncidentMetrics(item store.IncidentMetrics) IncidentMetrics {
+	return IncidentMetrics{
+		Declared:          item.Declared,
+		Open:              item.Open,
+		TimeToAcknowledge: mapDurationDistribution(item.TimeToAcknowledge),
+		TimeToResolve:     mapDurationDistribution(item.TimeToResolve),
+	}
+}
+
+func mapStatusPageSettings(item store.StatusPageSettings) StatusPageSettings {
+	return StatusPageSettings{
+		Enabled: item.Enabled,
+		Slug:    item.Slug,
+		Title:   item.DisplayTitle(),
+	}
+}
+
+func mapStatusFeedIncident(item store.StatusPageIncident) StatusFeedIncident {
+	out := StatusFeedIncident{
+		Id:          toOpenapiUUID(item.ID),
+		Title:       item.Title,
+		Severity:    mapIncidentSeverity(item.Severity),
+		Impact:      item.Impact,
+		StartedAt:   item.StartedAt,
+		MitigatedAt: item.MitigatedAt,
+		ResolvedAt:  item.ResolvedAt,
+		UpdatedAt:   item.UpdatedAt,
+		Updates: mapSlice(item.Updates, func(update store.StatusPageUpdate) StatusFeedUpdate {
+			return StatusFeedUpdate{Id: toOpenapiUUID(update.ID), Message: update.Message, CreatedAt: update.CreatedAt}
+		}),
+	}
+	if item.Status != nil {
+		status := IncidentStatus(*item.Status)
+		out.Status = &status
+	}
+	return out
+}
+
+func mapOnCallSchedule(item store.OnCallSchedule, overrides []store.OnCallOverride) OnCallSchedule {
+	return OnCallSchedule{
+		Id:        toOpenapiUUID(item.ID),
+		ProjectId: toOpenapiUUIDPtr(item.ProjectID),
+		GroupId:   toOpenapiUUIDPtr(item.GroupID),
+		Name:      item.Name,
+		Timezone:  item.Timezone,
+		Layers:    mapSlice(item.Layers, mapOnCallLayer),
+		Overrides: mapSlice(overrides, mapOnCallOverride),
+		CreatedAt: item.CreatedAt,
+		UpdatedAt: item.UpdatedAt,
+	}
+}
+
+func mapOnCallLayer(item store.OnCallLayer) OnCallLayer {
+	return OnCallLayer{
+		Id:             toOpenapiUUID(item.ID),
+		Name:           item.Name,
+		RotationKind:   TicketRecurrenceScheduleKind(item.RotationKind),
+		Rotation:       item.Rotation,
+		StartsAt:       item.StartsAt,
+		EndsAt:         item.EndsAt,
+		ParticipantIds: mapSlice(item.ParticipantIDs, toOpenapiUUID),
+	}
+}
+
+func mapOnCallOverride(item store.OnCallOverride) OnCallOverride {
+	return OnCallOverride{
+		Id:         toOpenapiUUID(item.ID),
+		ScheduleId: toOpenapiUUID(item.ScheduleID),
+		User:       userSummary{Id: toOpenapiUUID(item.UserID), Name: item.UserName},
+		StartsAt:   item.StartsAt,
+		EndsAt:     item.EndsAt,
+		CreatedAt:  item.CreatedAt,
+	}
+}
+
+func mapOnCallShift(item store.OnCallShift) OnCallShift {
+	return OnCallShift{
+		ScheduleId:   toOpenapiUUID(item.ScheduleID),
+		ScheduleName: item.ScheduleName,
+		User:         userSummary{Id: toOpenapiUUID(item.UserID), Name: item.UserName},
+		LayerId:      toOpenapiUUIDPtr(item.LayerID),
+		LayerName:    item.LayerName,
+		OverrideId:   toOpenapiUUIDPtr(item.OverrideID),
+		StartsAt:     item.StartsAt,
+		EndsAt:       item.EndsAt,
 	}
 }
 
@@ -222,9 +454,10 @@ func mapComment(comment store.Comment) ticketCommentResponse {
 	return ticketCommentResponse{
 		Id:         toOpenapiUUID(comment.ID),
 		TicketId:   toOpenapiUUID(comment.TicketID),
-		AuthorId:   toOpenapiUUID(comment.AuthorID),
+		AuthorId:   toOpenapiUUIDPtr(comment.AuthorID),
 		AuthorName: comment.AuthorName,
 		Message:    comment.Message,
+		Public:     comment.Public,
 		CreatedAt:  comment.CreatedAt,
 	}
 }
@@ -314,6 +547,9 @@ func mapSprint(item store.Sprint) sprintResponse {
 		Name:             item.Name,
 		StartDate:        openapi_types.Date{Time: item.StartDate},
 		EndDate:          openapi_types.Date{Time: item.EndDate},
+		Status:           SprintStatus(item.Status),
+		StartedAt:        item.StartedAt,
+		CompletedAt:      item.CompletedAt,
 		TicketIds:        make([]openapi_types.UUID, 0, len(item.TicketIDs)),
 		CommittedTickets: item.CommittedTickets,
 		CreatedAt:        item.CreatedAt,
@@ -328,6 +564,34 @@ func mapSprint(item store.Sprint) sprintResponse {
 	return out
 }
 
+func mapSprintCompletion(item store.SprintCompletion) sprintCompletionResponse {
+	return sprintCompletionResponse{
+		SprintId:           toOpenapiUUID(item.SprintID),
+		ProjectId:          toOpenapiUUID(item.ProjectID),
+		CarryOver:          SprintCarryOver(item.CarryOver),
+		CarryOverSprintId:  toOpenapiUUIDPtr(item.CarryOverSprintID),
+		CommittedTickets:   item.CommittedTickets,
+		CompletedTickets:   item.CompletedTickets,
+		CarriedOverTickets: item.CarriedOverTickets,
+		CommittedPoints:    item.CommittedPoints,
+		CompletedPoints:    item.CompletedPoints,
+		CompletedBy:        toOpenapiUUIDPtr(item.CompletedBy),
+		CreatedAt:          item.CreatedAt,
+		Tickets:            mapSlice(item.Tickets, mapSprintCompletionTicket),
+	}
+}
+
+func mapSprintCompletionTicket(item store.SprintCompletionTicket) sprintCompletionTicketResponse {
+	return sprintCompletionTicketResponse{
+		TicketId:    toOpenapiUUID(item.TicketID),
+		Key:         item.Key,
+		Title:       item.Title,
+		StateName:   item.StateName,
+		StoryPoints: item.StoryPoints,
+		Done:        item.Done,
+	}
+}
+
 func mapCapacitySetting(item store.CapacitySetting) capacitySettingResponse {
 	out := capacitySettingResponse{
 		Id:        toOpenapiUUID(item.ID),
@@ -335,6 +599,7 @@ func mapCapacitySetting(item store.CapacitySetting) capacitySettingResponse {
 		Scope:     CapacitySettingScope(item.Scope),
 		Label:     item.Label,
 		Capacity:  item.Capacity,
+		Unit:      CapacityUnit(item.Unit),
 		CreatedAt: item.CreatedAt,
 		UpdatedAt: item.UpdatedAt,
 	}
@@ -347,12 +612,25 @@ func mapCapacitySetting(item store.CapacitySetting) capacitySettingResponse {
 
 func mapSprintForecastSummary(item store.SprintForecastSummary) sprintForecastSummaryResponse {
 	out := sprintForecastSummaryResponse{
-		CommittedTickets:    item.CommittedTickets,
-		Capacity:            item.Capacity,
-		ProjectedCompletion: item.ProjectedCompletion,
-		OverCapacityDelta:   item.OverCapacityDelta,
-		Confidence:          float32(item.Confidence),
-		Iterations:          item.Iterations,
+		Unit:                   CapacityUnit(item.Unit),
+		CommittedTickets:       item.CommittedTickets,
+		Committed:              item.Committed,
+		Capacity:               item.Capacity,
+		ProjectedCompletion:    item.ProjectedCompletion,
+		OverCapacityDelta:      item.OverCapacityDelta,
+		Confidence:             float32(item.Confidence),
+		Iterations:             item.Iterations,
+		CommittedPoints:        item.CommittedPoints,
+		VelocitySprints:        item.VelocitySprints,
+		AverageVelocityTickets: item.AverageVelocityTickets,
+		AverageVelocityPoints:  item.AverageVelocityPoints,
+		ProjectedPoints:        item.ProjectedPoints,
+		PointsConfidence:       item.PointsConfidence,
+		Allocations:            mapSlice(item.Allocations, mapCapacityAllocation),
+		Warnings:               item.Warnings,
+	}
+	if out.Warnings == nil {
+		out.Warnings = []string{}
 	}
 	if item.Sprint != nil {
 		mapped := mapSprint(*item.Sprint)
@@ -361,10 +639,192 @@ func mapSprintForecastSummary(item store.SprintForecastSummary) sprintForecastSu
 	return out
 }
 
+func mapCapacityAllocation(item store.CapacityAllocation) capacityAllocationResponse {
+	return capacityAllocationResponse{
+		UserId:        toOpenapiUUID(item.UserID),
+		Name:          item.Name,
+		BaseCapacity:  item.BaseCapacity,
+		AbsenceDays:   item.AbsenceDays,
+		Capacity:      item.Capacity,
+		Committed:     item.Committed,
+		OverAllocated: item.OverAllocated,
+		Delta:         item.Delta,
+	}
+}
+
+func mapSprintAbsence(item store.SprintAbsence) sprintAbsenceResponse {
+	return sprintAbsenceResponse{
+		Id:        toOpenapiUUID(item.ID),
+		SprintId:  toOpenapiUUID(item.SprintID),
+		UserId:    toOpenapiUUID(item.UserID),
+		UserName:  item.UserName,
+		Days:      item.Days,
+		Note:      item.Note,
+		CreatedAt: item.CreatedAt,
+	}
+}
+
+func mapSprintBurndown(item store.SprintBurndown) sprintBurndownResponse {
+	return sprintBurndownResponse{
+		SprintId:            toOpenapiUUID(item.SprintID),
+		StartDate:           openapi_types.Date{Time: item.StartDate},
+		EndDate:             openapi_types.Date{Time: item.EndDate},
+		InitialScopeTickets: item.InitialScopeTickets,
+		InitialScopePoints:  item.InitialScopePoints,
+		Points:              mapSlice(item.Points, mapSprintBurndownPoint),
+		ScopeChanges:        mapSlice(item.ScopeChanges, mapSprintScopeChange),
+	}
+}
+
+func mapSprintBurndownPoint(item store.SprintBurndownPoint) sprintBurndownPointResponse {
+	return sprintBurndownPointResponse{
+		Date:             openapi_types.Date{Time: item.Date},
+		ScopeTickets:     item.ScopeTickets,
+		ScopePoints:      item.ScopePoints,
+		DoneTickets:      item.DoneTickets,
+		DonePoints:       item.DonePoints,
+		RemainingTickets: item.RemainingTickets,
+		RemainingPoints:  item.RemainingPoints,
+		IdealTickets:     item.IdealTickets,
+		IdealPoints:      item.IdealPoints,
+	}
+}
+
+func mapSprintScopeChange(item store.SprintScopeChange) sprintScopeChangeResponse {
+	return sprintScopeChangeResponse{
+		Date:        openapi_types.Date{Time: item.Date},
+		At:          item.At,
+		TicketId:    toOpenapiUUID(item.TicketID),
+		Key:         item.Key,
+		Action:      SprintScopeChangeAction(item.Action),
+		StoryPoints: item.StoryPoints,
+	}
+}
+
+func mapSprintVelocityHistory(item store.SprintVelocityHistory) sprintVelocityHistoryResponse {
+	return sprintVelocityHistoryResponse{
+		Items:          mapSlice(item.Items, mapSprintVelocity),
+		AverageTickets: item.AverageTickets,
+		AveragePoints:  item.AveragePoints,
+	}
+}
+
+func mapSprintVelocity(item store.SprintVelocity) sprintVelocityResponse {
+	return sprintVelocityResponse{
+		SprintId:         toOpenapiUUID(item.SprintID),
+		Name:             item.Name,
+		StartDate:        openapi_types.Date{Time: item.StartDate},
+		EndDate:          openapi_types.Date{Time: item.EndDate},
+		CommittedTickets: item.CommittedTickets,
+		CompletedTickets: item.CompletedTickets,
+		CommittedPoints:  item.CommittedPoints,
+		CompletedPoints:  item.CompletedPoints,
+		CompletedAt:      item.CompletedAt,
+	}
+}
+
 func mapAiTriageSettings(item store.AiTriageSettings) aiTriageSettingsResponse {
 	return aiTriageSettingsResponse{
-		Enabled: item.Enabled,
+		Enabled:        item.Enabled,
+		Provider:       AiTriageProvider(item.Provider),
+		EndpointUrl:    item.EndpointURL,
+		ApiKeySet:      item.APIKey != nil,
+		Model:          item.Model,
+		TimeoutMs:      item.TimeoutMS,
+		PromptVersion:  item.PromptVersion,
+		PromptVersions: aiTriagePromptVersions(),
+		AutoApply:      item.AutoApply,
+		AutoApplyThresholds: AiTriageAutoApplyThresholds{
+			Priority: aiTriageThreshold(item.AutoApplyThresholds, "priority"),
+			State:    aiTriageThreshold(item.AutoApplyThresholds, "state"),
+			Assignee: aiTriageThreshold(item.AutoApplyThresholds, "assignee"),
+			Type:     aiTriageThreshold(item.AutoApplyThresholds, "type"),
+		},
+	}
+}
+
+func aiTriageThreshold(thresholds map[string]float32, field string) *float32 {
+	value, ok := thresholds[field]
+	if !ok {
+		return nil
+	}
+	return &value
+}
+
+func mapDuplicateCandidate(item store.DuplicateCandidate) DuplicateCandidate {
+	return DuplicateCandidate{
+		TicketId:  toOpenapiUUID(item.TicketID),
+		Key:       item.Key,
+		Title:     item.Title,
+		StateName: item.StateName,
+		Score:     item.Score,
+	}
+}
+
+func mapAiTriageAccuracyReport(report store.AiTriageAccuracyReport) AiTriageAccuracyReport {
+	return AiTriageAccuracyReport{
+		From:   openapi_types.Date{Time: report.From},
+		To:     openapi_types.Date{Time: report.To},
+		Fields: mapSlice(report.Fields, mapAiTriageFieldAcceptance),
+		Points: mapSlice(report.Points, func(point store.AiTriageAcceptancePoint) AiTriageAcceptancePoint {
+			return AiTriageAcceptancePoint{
+				Date:   openapi_types.Date{Time: point.Date},
+				Fields: mapSlice(point.Fields, mapAiTriageFieldAcceptance),
+			}
+		}),
+		Versions: mapSlice(report.Versions, func(item store.AiTriageVersionAccuracy) AiTriageVersionAccuracy {
+			return AiTriageVersionAccuracy{
+				PromptVersion:  item.PromptVersion,
+				Model:          item.Model,
+				Accepted:       item.Accepted,
+				Rejected:       item.Rejected,
+				AcceptanceRate: item.Rate(),
+			}
+		}),
+		Calibration: mapSlice(report.Calibration, func(item store.AiTriageCalibrationBucket) AiTriageCalibrationBucket {
+			return AiTriageCalibrationBucket{
+				MinConfidence:     item.MinConfidence,
+				MaxConfidence:     item.MaxConfidence,
+				AverageConfidence: item.AverageConfidence,
+				Accepted:          item.Accepted,
+				Rejected:          item.Rejected,
+				AcceptanceRate:    item.Rate(),
+			}
+		}),
+	}
+}
+
+func mapAiTriageFieldAcceptance(item store.AiTriageFieldAcceptance) AiTriageFieldAcceptance {
+	return AiTriageFieldAcceptance{
+		Field:          AiTriageField(item.Field),
+		Accepted:       item.Accepted,
+		Rejected:       item.Rejected,
+		AcceptanceRate: item.Rate(),
+	}
+}
+
+func mapAiTriageModelStatus(item store.AiTriageModel, models learnedTriageModels) AiTriageModelStatus {
+	trainedAt := item.TrainedAt
+	out := AiTriageModelStatus{
+		Trained:       true,
+		TrainedAt:     &trainedAt,
+		ExampleCount:  item.ExampleCount,
+		FeedbackCount: item.FeedbackCount,
+		Fields:        []AiTriageModelField{},
+	}
+	for _, field := range []AiTriageModelFieldField{AiTriageModelFieldFieldPriority, AiTriageModelFieldFieldType, AiTriageModelFieldFieldAssignee} {
+		model := models.field(string(field))
+		if model == nil {
+			continue
+		}
+		out.Fields = append(out.Fields, AiTriageModelField{
+			Field:        field,
+			Classes:      len(model.Classes),
+			Observations: model.Calibration.Observations(),
+			Accuracy:     float32(model.Calibration.Accuracy()),
+		})
 	}
+	return out
 }
 
 func mapAiTriageSuggestion(item store.AiTriageSuggestion) aiTriageSuggestionResponse {
@@ -381,6 +841,8 @@ func mapAiTriageSuggestion(item store.AiTriageSuggestion) aiTriageSuggestionResp
 			Priority: item.ConfidencePriority,
 			State:    item.ConfidenceState,
 			Assignee: item.ConfidenceAssignee,
+			Template: item.ConfidenceTemplate,
+			Type:     item.ConfidenceType,
 		},
 		CreatedAt: item.CreatedAt,
 	}
@@ -388,6 +850,14 @@ func mapAiTriageSuggestion(item store.AiTriageSuggestion) aiTriageSuggestionResp
 		value := toOpenapiUUID(*item.AssigneeID)
 		out.AssigneeId = &value
 	}
+	if item.TemplateID != nil {
+		value := toOpenapiUUID(*item.TemplateID)
+		out.TemplateId = &value
+	}
+	if item.Type != nil {
+		value := TicketType(*item.Type)
+		out.Type = &value
+	}
 	return out
 }
 
@@ -436,6 +906,90 @@ func mapProjectReportingSummary(report store.ProjectReportingSummary) ProjectRep
 	}
 }
 
+func mapCumulativeFlowReport(report store.CumulativeFlowReport) CumulativeFlowReport {
+	return CumulativeFlowReport{
+		From: openapi_types.Date{Time: report.From},
+		To:   openapi_types.Date{Time: report.To},
+		States: mapSlice(report.States, func(state store.WorkflowState) CumulativeFlowState {
+			return CumulativeFlowState{Id: toOpenapiUUID(state.ID), Name: state.Name, IsClosed: state.IsClosed}
+		}),
+		Points: mapSlice(report.Points, func(point store.StateOpenSeriesPoint) StateOpenPoint {
+			return StateOpenPoint{Date: openapi_types.Date{Time: point.Date}, Counts: mapStatCounts(point.Counts)}
+		}),
+	}
+}
+
+func mapDurationDistribution(item store.DurationDistribution) DurationDistribution {
+	return DurationDistribution{
+		Count:        item.Count,
+		AverageHours: item.AverageHours,
+		P50Hours:     item.P50Hours,
+		P85Hours:     item.P85Hours,
+		P95Hours:     item.P95Hours,
+		Histogram: mapSlice(item.Histogram, func(bucket store.DurationBucket) DurationHistogramBucket {
+			return DurationHistogramBucket{MinHours: bucket.MinHours, MaxHours: bucket.MaxHours, Count: bucket.Count}
+		}),
+	}
+}
+
+func mapLeadTimeReport(report store.LeadTimeReport) LeadTimeReport {
+	return LeadTimeReport{
+		From:      openapi_types.Date{Time: report.From},
+		To:        openapi_types.Date{Time: report.To},
+		LeadTime:  mapDurationDistribution(report.LeadTime),
+		CycleTime: mapDurationDistribution(report.CycleTime),
+	}
+}
+
+func mapAgingWipReport(report store.AgingWipReport) AgingWipReport {
+	return AgingWipReport{
+		GeneratedAt: report.GeneratedAt,
+		States: mapSlice(report.States, func(state store.AgingWipState) AgingWipState {
+			return AgingWipState{
+				StateId:     toOpenapiUUID(state.StateID),
+				StateName:   state.StateName,
+				Count:       state.Count,
+				P50AgeHours: state.P50AgeHours,
+				P85AgeHours: state.P85AgeHours,
+				MaxAgeHours: state.MaxAgeHours,
+			}
+		}),
+		Items: mapSlice(report.Items, func(item store.AgingWipItem) AgingWipItem {
+			return AgingWipItem{
+				TicketId:       toOpenapiUUID(item.TicketID),
+				Key:            item.Key,
+				Title:          item.Title,
+				Type:           TicketType(item.Type),
+				Priority:       TicketPriority(item.Priority),
+				StateId:        toOpenapiUUID(item.StateID),
+				StateName:      item.StateName,
+				AssigneeId:     toOpenapiUUIDPtr(item.AssigneeID),
+				AssigneeName:   item.AssigneeName,
+				EnteredStateAt: item.EnteredStateAt,
+				StateAgeHours:  item.StateAgeHours,
+				AgeHours:       item.AgeHours,
+			}
+		}),
+	}
+}
+
+func mapReportingTicketDetail(item store.ReportingTicketDetail) ReportingTicketDetail {
+	return ReportingTicketDetail{
+		Key:                 item.Key,
+		Title:               item.Title,
+		Type:                TicketType(item.Type),
+		Priority:            TicketPriority(item.Priority),
+		State:               item.State,
+		AssigneeName:        item.AssigneeName,
+		CreatedAt:           item.CreatedAt,
+		ClosedAt:            item.ClosedAt,
+		CycleTimeHours:      item.CycleTimeHours,
+		StoryPoints:         item.StoryPoints,
+		TimeEstimateMinutes: item.TimeEstimate,
+		LoggedMinutes:       item.LoggedMinutes,
+	}
+}
+
 func mapStatCounts(counts []store.StatCount) []statCountResponse {
 	out := make([]statCountResponse, 0, len(counts))
 	for _, sc := range counts {
@@ -519,11 +1073,111 @@ func mapBoardFilterPreset(preset store.BoardFilterPreset) boardFilterPresetRespo
 		Name:       preset.Name,
 		Filters:    mapBoardFilter(preset.Filters),
 		ShareToken: preset.ShareToken,
+		Visibility: BoardFilterPresetVisibility(preset.Visibility),
+		GroupIds:   mapSlice(preset.GroupIDs, toOpenapiUUID),
+		Pinned:     preset.Pinned,
+		IsDefault:  preset.IsDefault,
+		Position:   preset.Position,
 		CreatedAt:  preset.CreatedAt,
 		UpdatedAt:  preset.UpdatedAt,
 	}
 }
 
+func mapBoardFilterPresetSubscription(sub store.BoardFilterPresetSubscription) boardFilterPresetSubscriptionResponse {
+	return boardFilterPresetSubscriptionResponse{
+		Id:              toOpenapiUUID(sub.ID),
+		PresetId:        toOpenapiUUID(sub.PresetID),
+		UserId:          toOpenapiUUID(sub.UserID),
+		IntervalMinutes: sub.IntervalMinutes,
+		NotifyInApp:     sub.NotifyInApp,
+		SendWebhook:     sub.SendWebhook,
+		LastEvaluatedAt: sub.LastEvaluatedAt,
+		CreatedAt:       sub.CreatedAt,
+		UpdatedAt:       sub.UpdatedAt,
+	}
+}
+
+func mapTicketTemplate(tmpl store.TicketTemplate) ticketTemplateResponse {
+	return ticketTemplateResponse{
+		Id:               toOpenapiUUID(tmpl.ID),
+		ProjectId:        toOpenapiUUID(tmpl.ProjectID),
+		Name:             tmpl.Name,
+		TitlePattern:     tmpl.TitlePattern,
+		Description:      tmpl.Description,
+		Type:             mapEnumPtr[TicketType](tmpl.Type),
+		Priority:         mapEnumPtr[TicketPriority](tmpl.Priority),
+		StoryPoints:      tmpl.StoryPoints,
+		TimeEstimate:     tmpl.TimeEstimate,
+		IncidentEnabled:  tmpl.IncidentEnabled,
+		IncidentSeverity: mapEnumPtr[TicketIncidentSeverity](tmpl.IncidentSeverity),
+		Keywords:         tmpl.Keywords,
+		Subtasks:         mapSlice(tmpl.Subtasks, mapTicketTemplateSubtask),
+		CreatedAt:        tmpl.CreatedAt,
+		UpdatedAt:        tmpl.UpdatedAt,
+	}
+}
+
+func mapTicketTemplateSubtask(subtask store.TicketTemplateSubtask) TicketTemplateSubtask {
+	return TicketTemplateSubtask{
+		Title:       subtask.Title,
+		Description: nullableString(subtask.Description),
+		Type:        mapEnumPtr[TicketType](subtask.Type),
+		Priority:    mapEnumPtr[TicketPriority](subtask.Priority),
+		StoryPoints: subtask.StoryPoints,
+	}
+}
+
+func mapStoreTicketTemplateSubtask(subtask TicketTemplateSubtask) store.TicketTemplateSubtask {
+	return store.TicketTemplateSubtask{
+		Title:       subtask.Title,
+		Description: derefString(subtask.Description),
+		Type:        mapStringPtr(subtask.Type, func(v TicketType) string { return string(v) }),
+		Priority:    mapStringPtr(subtask.Priority, func(v TicketPriority) string { return string(v) }),
+		StoryPoints: subtask.StoryPoints,
+	}
+}
+
+func mapTicketRecurrence(rec store.TicketRecurrence) ticketRecurrenceResponse {
+	return ticketRecurrenceResponse{
+		Id:                toOpenapiUUID(rec.ID),
+		ProjectId:         toOpenapiUUID(rec.ProjectID),
+		Name:              rec.Name,
+		TemplateId:        toOpenapiUUID(rec.TemplateID),
+		StoryId:           toOpenapiUUID(rec.StoryID),
+		Title:             rec.Title,
+		ScheduleKind:      TicketRecurrenceScheduleKind(rec.ScheduleKind),
+		Schedule:          rec.Schedule,
+		Timezone:          rec.Timezone,
+		StartsAt:          rec.StartsAt,
+		AssigneeGroupId:   toOpenapiUUIDPtr(rec.AssigneeGroupID),
+		LastAssigneeId:    toOpenapiUUIDPtr(rec.LastAssigneeID),
+		AddToActiveSprint: rec.AddToActiveSprint,
+		Enabled:           rec.Enabled,
+		NextRunAt:         rec.NextRunAt,
+		LastRunAt:         rec.LastRunAt,
+		LastTicketId:      toOpenapiUUIDPtr(rec.LastTicketID),
+		LastError:         rec.LastError,
+		CreatedAt:         rec.CreatedAt,
+		UpdatedAt:         rec.UpdatedAt,
+	}
+}
+
+func mapEnumPtr[T ~string](value *string) *T {
+	if value == nil {
+		return nil
+	}
+	mapped := T(*value)
+	return &mapped
+}
+
+func mapTicketDeletion(d store.TicketDeletion) ticketDeletionResponse {
+	return ticketDeletionResponse{
+		Id:        toOpenapiUUID(d.TicketID),
+		Key:       d.Key,
+		DeletedAt: d.DeletedAt,
+	}
+}
+
 func mapNotification(n store.Notification) notificationResponse {
 	typ := NotificationType(n.Type)
 	return notificationResponse{
@@ -547,6 +1201,92 @@ func mapNotificationPreferences(p store.NotificationPreferences) notificationPre
 	}
 }
 
+func mapTimesheetApproval(item store.TimesheetApproval) TimesheetApproval {
+	return TimesheetApproval{
+		Id:             toOpenapiUUID(item.ID),
+		ProjectId:      toOpenapiUUID(item.ProjectID),
+		UserId:         toOpenapiUUID(item.UserID),
+		WeekStart:      openapi_types.Date{Time: item.WeekStart},
+		ApprovedBy:     toOpenapiUUIDPtr(item.ApprovedBy),
+		ApprovedByName: item.ApprovedByName,
+		ApprovedAt:     item.ApprovedAt,
+	}
+}
+
+func mapTimesheet(sheet store.Timesheet) Timesheet {
+	out := Timesheet{
+		ProjectId:    toOpenapiUUID(sheet.ProjectID),
+		UserId:       toOpenapiUUID(sheet.UserID),
+		UserName:     sheet.UserName,
+		WeekStart:    openapi_types.Date{Time: sheet.WeekStart},
+		Days:         mapSlice(sheet.Days, func(day time.Time) openapi_types.Date { return openapi_types.Date{Time: day} }),
+		DailyTotals:  sheet.DailyTotals,
+		TotalMinutes: sheet.TotalMinutes,
+		Locked:       sheet.Approval != nil,
+		Rows: mapSlice(sheet.Rows, func(row store.TimesheetRow) TimesheetRow {
+			return TimesheetRow{
+				TicketId:     toOpenapiUUID(row.TicketID),
+				Key:          row.Key,
+				Title:        row.Title,
+				MinutesByDay: row.MinutesByDay,
+				TotalMinutes: row.TotalMinutes,
+			}
+		}),
+	}
+	if sheet.Approval != nil {
+		approval := mapTimesheetApproval(*sheet.Approval)
+		out.Approval = &approval
+	}
+	return out
+}
+
+func mapTimeReport(report store.TimeReport) TimeReport {
+	return TimeReport{
+		From:         openapi_types.Date{Time: report.From},
+		To:           openapi_types.Date{Time: report.To},
+		GroupBy:      TimeReportGroupBy(report.GroupBy),
+		TotalMinutes: report.TotalMinutes,
+		Groups: mapSlice(report.Groups, func(group store.TimeReportGroup) TimeReportGroup {
+			return TimeReportGroup{
+				Key:         group.Key,
+				Label:       group.Label,
+				Minutes:     group.Minutes,
+				EntryCount:  group.EntryCount,
+				TicketCount: group.TicketCount,
+			}
+		}),
+	}
+}
+
+func mapTimeVarianceReport(report store.TimeVarianceReport) TimeVarianceReport {
+	return TimeVarianceReport{
+		Tickets: mapSlice(report.Tickets, func(item store.TicketTimeVariance) TicketTimeVariance {
+			return TicketTimeVariance{
+				TicketId:        toOpenapiUUID(item.TicketID),
+				Key:             item.Key,
+				Title:           item.Title,
+				StoryId:         toOpenapiUUID(item.StoryID),
+				EstimateMinutes: item.EstimateMinutes,
+				LoggedMinutes:   item.LoggedMinutes,
+				VarianceMinutes: item.VarianceMinutes,
+				VariancePercent: item.VariancePercent,
+			}
+		}),
+		Stories: mapSlice(report.Stories, func(item store.StoryTimeVariance) StoryTimeVariance {
+			return StoryTimeVariance{
+				StoryId:            toOpenapiUUID(item.StoryID),
+				Title:              item.Title,
+				TicketCount:        item.TicketCount,
+				UnestimatedTickets: item.UnestimatedTickets,
+				EstimateMinutes:    item.EstimateMinutes,
+				LoggedMinutes:      item.LoggedMinutes,
+				VarianceMinutes:    item.VarianceMinutes,
+				VariancePercent:    item.VariancePercent,
+			}
+		}),
+	}
+}
+
 func mapTimeEntry(entry store.TimeEntry) timeEntryResponse {
 	return timeEntryResponse{
 		Id:          toOpenapiUUID(entry.ID),
@@ -559,3 +1299,92 @@ func mapTimeEntry(entry store.TimeEntry) timeEntryResponse {
 		CreatedAt:   entry.CreatedAt,
 	}
 }
+
+func mapTimeEntryTimer(timer store.TimeEntryTimer) TimeEntryTimer {
+	return TimeEntryTimer{
+		Id:             toOpenapiUUID(timer.ID),
+		ProjectId:      toOpenapiUUID(timer.ProjectID),
+		TicketId:       toOpenapiUUID(timer.TicketID),
+		TicketKey:      timer.TicketKey,
+		TicketTitle:    timer.TicketTitle,
+		UserId:         toOpenapiUUID(timer.UserID),
+		UserName:       timer.UserName,
+		Description:    timer.Description,
+		StartedAt:      timer.StartedAt,
+		IdleNotifiedAt: timer.IdleNotifiedAt,
+	}
+}
+
+func mapTimeTrackingSettings(settings store.TimeTrackingSettings) TimeTrackingSettings {
+	return TimeTrackingSettings{
+		AutoStopOnClose: settings.AutoStopOnClose,
+		IdleTimerHours:  settings.IdleTimerHours,
+	}
+}
+
+func mapAutomationRule(rule store.AutomationRule) automationRuleResponse {
+	return automationRuleResponse{
+		Id:           toOpenapiUUID(rule.ID),
+		ProjectId:    toOpenapiUUID(rule.ProjectID),
+		Name:         rule.Name,
+		Trigger:      AutomationTrigger(rule.Trigger),
+		Conditions:   mapBoardFilter(rule.Conditions),
+		Actions:      mapSlice(rule.Actions, mapAutomationAction),
+		ScheduleKind: mapEnumPtr[TicketRecurrenceScheduleKind](rule.ScheduleKind),
+		Schedule:     rule.Schedule,
+		Timezone:     rule.Timezone,
+		Enabled:      rule.Enabled,
+		NextRunAt:    rule.NextRunAt,
+		LastRunAt:    rule.LastRunAt,
+		CreatedBy:    toOpenapiUUIDPtr(rule.CreatedBy),
+		CreatedAt:    rule.CreatedAt,
+		UpdatedAt:    rule.UpdatedAt,
+	}
+}
+
+func mapAutomationAction(action store.AutomationAction) automationAction {
+	return automationAction{
+		Type:         AutomationActionType(action.Type),
+		Field:        mapEnumPtr[AutomationActionField](nullableString(action.Field)),
+		Value:        nullableString(action.Value),
+		UserId:       toOpenapiUUIDPtr(action.UserID),
+		StateId:      toOpenapiUUIDPtr(action.StateID),
+		Message:      nullableString(action.Message),
+		Title:        nullableString(action.Title),
+		RelationType: mapEnumPtr[DependencyRelationType](nullableString(action.RelationType)),
+	}
+}
+
+func mapStoreAutomationAction(action automationAction) store.AutomationAction {
+	return store.AutomationAction{
+		Type:         string(action.Type),
+		Field:        derefString(mapStringPtr(action.Field, func(v AutomationActionField) string { return string(v) })),
+		Value:        derefString(action.Value),
+		UserID:       parseOpenapiUUIDPtr(action.UserId),
+		StateID:      parseOpenapiUUIDPtr(action.StateId),
+		Message:      derefString(action.Message),
+		Title:        derefString(action.Title),
+		RelationType: derefString(mapStringPtr(action.RelationType, func(v DependencyRelationType) string { return string(v) })),
+	}
+}
+
+func mapAutomationRun(run store.AutomationRun) automationRunResponse {
+	return automationRunResponse{
+		Id:        toOpenapiUUID(run.ID),
+		RuleId:    toOpenapiUUID(run.RuleID),
+		ProjectId: toOpenapiUUID(run.ProjectID),
+		TicketId:  toOpenapiUUIDPtr(run.TicketID),
+		Trigger:   AutomationTrigger(run.Trigger),
+		Status:    AutomationRunStatus(run.Status),
+		Depth:     run.Depth,
+		Results: mapSlice(run.Results, func(result store.AutomationActionResult) AutomationActionResult {
+			return AutomationActionResult{
+				Type:    AutomationActionType(result.Type),
+				Status:  AutomationRunStatus(result.Status),
+				Message: nullableString(result.Message),
+			}
+		}),
+		Error:     run.Error,
+		CreatedAt: run.CreatedAt,
+	}
+}
@@ -2,7 +2,12 @@ package httpapi
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
 	"net/http"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -18,8 +23,26 @@ const (
 	projectEventNotificationsChange = "notifications.changed"
 	projectEventBoardRefresh        = "board.refresh"
 	projectEventActivityChanged     = "activity.changed"
+	projectEventPresenceChanged     = "presence.changed"
+	projectEventPresenceConflict    = "presence.conflict"
 )
 
+const (
+	projectClientHeartbeat     = "heartbeat"
+	projectClientPresenceView  = "presence.view"
+	projectClientPresenceEdit  = "presence.edit"
+	projectClientPresenceLeave = "presence.leave"
+)
+
+const (
+	presenceModeViewing = "viewing"
+	presenceModeEditing = "editing"
+)
+
+// presenceTTL is how long a viewing/editing announcement stays valid without
+// a client heartbeat. Clients are expected to heartbeat well within this window.
+const presenceTTL = 60 * time.Second
+
 type projectLiveEvent struct {
 	Type      string             `json:"type"`
 	ProjectID openapi_types.UUID `json:"projectId"`
@@ -27,6 +50,21 @@ type projectLiveEvent struct {
 	Payload   map[string]any     `json:"payload,omitempty"`
 }
 
+type projectLiveClientMessage struct {
+	Type     string  `json:"type"`
+	TicketID *string `json:"ticketId,omitempty"`
+	Field    *string `json:"field,omitempty"`
+}
+
+type projectPresenceEntry struct {
+	UserID    uuid.UUID `json:"userId"`
+	UserName  string    `json:"userName"`
+	TicketID  uuid.UUID `json:"ticketId"`
+	Mode      string    `json:"mode"`
+	Field     *string   `json:"field,omitempty"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
 type projectLiveSubscriber struct {
 	userID uuid.UUID
 	ch     chan projectLiveEvent
@@ -35,11 +73,13 @@ type projectLiveSubscriber struct {
 type projectLiveHub struct {
 	mu          sync.RWMutex
 	subscribers map[uuid.UUID]map[*projectLiveSubscriber]struct{}
+	presence    map[uuid.UUID]map[*projectLiveSubscriber]projectPresenceEntry
 }
 
 func newProjectLiveHub() *projectLiveHub {
 	return &projectLiveHub{
 		subscribers: map[uuid.UUID]map[*projectLiveSubscriber]struct{}{},
+		presence:    map[uuid.UUID]map[*projectLiveSubscriber]projectPresenceEntry{},
 	}
 }
 
@@ -64,8 +104,13 @@ func (h *projectLiveHub) subscribe(projectID, userID uuid.UUID) (*projectLiveSub
 				delete(h.subscribers, projectID)
 			}
 		}
+		_, hadPresence := h.presence[projectID][sub]
+		h.removePresenceLocked(projectID, sub)
 		h.mu.Unlock()
 		close(sub.ch)
+		if hadPresence {
+			h.publishPresence(projectID)
+		}
 	}
 }
 
@@ -89,6 +134,181 @@ func (h *projectLiveHub) publish(projectID uuid.UUID, evt projectLiveEvent, user
 	}
 }
 
+// setPresence records what a subscriber is viewing or editing and returns the
+// entries of other users editing the same ticket field, if any.
+func (h *projectLiveHub) setPresence(projectID uuid.UUID, sub *projectLiveSubscriber, entry projectPresenceEntry, now time.Time) []projectPresenceEntry {
+	h.mu.Lock()
+	if _, ok := h.subscribers[projectID][sub]; !ok {
+		h.mu.Unlock()
+		return nil
+	}
+	if _, ok := h.presence[projectID]; !ok {
+		h.presence[projectID] = map[*projectLiveSubscriber]projectPresenceEntry{}
+	}
+	h.presence[projectID][sub] = entry
+
+	conflicts := []projectPresenceEntry{}
+	if entry.Mode == presenceModeEditing && entry.Field != nil {
+		for other, existing := range h.presence[projectID] {
+			if other == sub || existing.UserID == entry.UserID {
+				continue
+			}
+			if existing.Mode != presenceModeEditing || existing.TicketID != entry.TicketID || existing.Field == nil {
+				continue
+			}
+			if *existing.Field == *entry.Field && existing.ExpiresAt.After(now) {
+				conflicts = append(conflicts, existing)
+			}
+		}
+	}
+	h.mu.Unlock()
+
+	h.publishPresence(projectID)
+	return conflicts
+}
+
+// touchPresence extends the expiry of a subscriber's presence entry.
+func (h *projectLiveHub) touchPresence(projectID uuid.UUID, sub *projectLiveSubscriber, now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	entry, ok := h.presence[projectID][sub]
+	if !ok {
+		return
+	}
+	entry.ExpiresAt = now.Add(presenceTTL)
+	h.presence[projectID][sub] = entry
+}
+
+func (h *projectLiveHub) clearPresence(projectID uuid.UUID, sub *projectLiveSubscriber) {
+	h.mu.Lock()
+	_, ok := h.presence[projectID][sub]
+	h.removePresenceLocked(projectID, sub)
+	h.mu.Unlock()
+	if ok {
+		h.publishPresence(projectID)
+	}
+}
+
+// expirePresence drops entries that were not refreshed in time and
+// broadcasts the new snapshot when anything changed.
+func (h *projectLiveHub) expirePresence(projectID uuid.UUID, now time.Time) {
+	h.mu.Lock()
+	expired := false
+	for sub, entry := range h.presence[projectID] {
+		if !entry.ExpiresAt.After(now) {
+			h.removePresenceLocked(projectID, sub)
+			expired = true
+		}
+	}
+	h.mu.Unlock()
+	if expired {
+		h.publishPresence(projectID)
+	}
+}
+
+func (h *projectLiveHub) presenceSnapshot(projectID uuid.UUID) []projectPresenceEntry {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	items := make([]projectPresenceEntry, 0, len(h.presence[projectID]))
+	for _, entry := range h.presence[projectID] {
+		items = append(items, entry)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].TicketID != items[j].TicketID {
+			return items[i].TicketID.String() < items[j].TicketID.String()
+		}
+		if items[i].UserName != items[j].UserName {
+			return items[i].UserName < items[j].UserName
+		}
+		return items[i].Mode < items[j].Mode
+	})
+	return items
+}
+
+func (h *projectLiveHub) publishPresence(projectID uuid.UUID) {
+	h.publish(projectID, projectLiveEvent{
+		Type:      projectEventPresenceChanged,
+		ProjectID: openapi_types.UUID(projectID),
+		Timestamp: time.Now().UTC(),
+		Payload: map[string]any{
+			"entries": h.presenceSnapshot(projectID),
+		},
+	}, nil)
+}
+
+func (h *projectLiveHub) removePresenceLocked(projectID uuid.UUID, sub *projectLiveSubscriber) {
+	set, ok := h.presence[projectID]
+	if !ok {
+		return
+	}
+	delete(set, sub)
+	if len(set) == 0 {
+		delete(h.presence, projectID)
+	}
+}
+
+// handleClientMessage applies a presence message received on a project
+// WebSocket. Malformed messages are ignored so older clients keep working.
+func (h *projectLiveHub) handleClientMessage(projectID uuid.UUID, sub *projectLiveSubscriber, userName string, msg projectLiveClientMessage, now time.Time) {
+	switch msg.Type {
+	case projectClientHeartbeat:
+		h.touchPresence(projectID, sub, now)
+	case projectClientPresenceLeave:
+		h.clearPresence(projectID, sub)
+	case projectClientPresenceView, projectClientPresenceEdit:
+		if msg.TicketID == nil {
+			return
+		}
+		ticketID, err := uuid.Parse(strings.TrimSpace(*msg.TicketID))
+		if err != nil {
+			return
+		}
+		entry := projectPresenceEntry{
+			UserID:    sub.userID,
+			UserName:  userName,
+			TicketID:  ticketID,
+			Mode:      presenceModeViewing,
+			ExpiresAt: now.Add(presenceTTL),
+		}
+		if msg.Type == projectClientPresenceEdit {
+			if msg.Field == nil || strings.TrimSpace(*msg.Field) == "" {
+				return
+			}
+			field := strings.TrimSpace(*msg.Field)
+			entry.Mode = presenceModeEditing
+			entry.Field = &field
+		}
+		conflicts := h.setPresence(projectID, sub, entry, now)
+		if len(conflicts) == 0 {
+			return
+		}
+		h.sendTo(projectID, sub, projectLiveEvent{
+			Type:      projectEventPresenceConflict,
+			ProjectID: openapi_types.UUID(projectID),
+			Timestamp: now.UTC(),
+			Payload: map[string]any{
+				"ticketId": ticketID.String(),
+				"field":    *entry.Field,
+				"entries":  conflicts,
+			},
+		})
+	}
+}
+
+// sendTo delivers an event to a single subscriber if it is still connected.
+func (h *projectLiveHub) sendTo(projectID uuid.UUID, sub *projectLiveSubscriber, evt projectLiveEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if _, ok := h.subscribers[projectID][sub]; !ok {
+		return
+	}
+	select {
+	case sub.ch <- evt:
+	default:
+	}
+}
+
 func isWebSocketUpgradeRequest(r *http.Request) bool {
 	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") &&
 		strings.Contains(strings.ToLower(r.Header.Get("Connection")), "upgrade")
@@ -122,14 +342,34 @@ func (h *API) StreamProjectEvents(w http.ResponseWriter, r *http.Request, projec
 			heartbeat := time.NewTicker(20 * time.Second)
 			defer heartbeat.Stop()
 
+			if sendErr := websocket.JSON.Send(conn, projectLiveEvent{
+				Type:      projectEventPresenceChanged,
+				ProjectID: openapi_types.UUID(projectUUID),
+				Timestamp: time.Now().UTC(),
+				Payload: map[string]any{
+					"entries": h.live.presenceSnapshot(projectUUID),
+				},
+			}); sendErr != nil {
+				return
+			}
+
 			done := make(chan struct{})
 			go func() {
 				defer close(done)
 				for {
-					var msg any
+					var msg projectLiveClientMessage
 					if recvErr := websocket.JSON.Receive(conn, &msg); recvErr != nil {
+						if errors.Is(recvErr, io.EOF) || errors.Is(recvErr, net.ErrClosed) {
+							return
+						}
+						var syntaxErr *json.SyntaxError
+						var typeErr *json.UnmarshalTypeError
+						if errors.As(recvErr, &syntaxErr) || errors.As(recvErr, &typeErr) {
+							continue
+						}
 						return
 					}
+					h.live.handleClientMessage(projectUUID, sub, user.Name, msg, time.Now().UTC())
 				}
 			}()
 
@@ -141,6 +381,7 @@ func (h *API) StreamProjectEvents(w http.ResponseWriter, r *http.Request, projec
 				case <-done:
 					return
 				case <-heartbeat.C:
+					h.live.expirePresence(projectUUID, time.Now().UTC())
 					if sendErr := websocket.JSON.Send(conn, projectLiveEvent{
 						Type:      projectEventHeartbeat,
 						ProjectID: openapi_types.UUID(projectUUID),
@@ -65,3 +65,128 @@ func TestProjectLiveHubPublishesOnlyToTargetUser(t *testing.T) {
 		// expected
 	}
 }
+
+func TestProjectLiveHubPresenceBroadcastAndConflict(t *testing.T) {
+	hub := newProjectLiveHub()
+	projectID := uuid.New()
+	ticketID := uuid.New().String()
+	field := "description"
+	now := time.Now().UTC()
+
+	alice, unsubscribeAlice := hub.subscribe(projectID, uuid.New())
+	defer unsubscribeAlice()
+	bob, unsubscribeBob := hub.subscribe(projectID, uuid.New())
+	defer unsubscribeBob()
+
+	hub.handleClientMessage(projectID, alice, "Alice", projectLiveClientMessage{
+		Type:     projectClientPresenceEdit,
+		TicketID: &ticketID,
+		Field:    &field,
+	}, now)
+
+	got := <-bob.ch
+	if got.Type != projectEventPresenceChanged {
+		t.Fatalf("expected %q, got %q", projectEventPresenceChanged, got.Type)
+	}
+	entries, ok := got.Payload["entries"].([]projectPresenceEntry)
+	if !ok || len(entries) != 1 || entries[0].UserName != "Alice" || entries[0].Mode != presenceModeEditing {
+		t.Fatalf("unexpected presence payload: %#v", got.Payload)
+	}
+	<-alice.ch
+
+	hub.handleClientMessage(projectID, bob, "Bob", projectLiveClientMessage{
+		Type:     projectClientPresenceEdit,
+		TicketID: &ticketID,
+		Field:    &field,
+	}, now)
+
+	sawConflict := false
+	for i := 0; i < 2; i++ {
+		select {
+		case evt := <-bob.ch:
+			if evt.Type == projectEventPresenceConflict {
+				sawConflict = true
+				conflicts := evt.Payload["entries"].([]projectPresenceEntry)
+				if len(conflicts) != 1 || conflicts[0].UserName != "Alice" {
+					t.Fatalf("unexpected conflicts: %#v", conflicts)
+				}
+			}
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("expected presence events for bob")
+		}
+	}
+	if !sawConflict {
+		t.Fatalf("expected bob to receive a presence conflict")
+	}
+	select {
+	case evt := <-alice.ch:
+		if evt.Type != projectEventPresenceChanged {
+			t.Fatalf("expected alice to only see presence changes, got %q", evt.Type)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("expected alice to see bob's presence")
+	}
+}
+
+func TestProjectLiveHubPresenceExpiresWithoutHeartbeat(t *testing.T) {
+	hub := newProjectLiveHub()
+	projectID := uuid.New()
+	ticketID := uuid.New().String()
+	now := time.Now().UTC()
+
+	sub, unsubscribe := hub.subscribe(projectID, uuid.New())
+	defer unsubscribe()
+
+	hub.handleClientMessage(projectID, sub, "Alice", projectLiveClientMessage{
+		Type:     projectClientPresenceView,
+		TicketID: &ticketID,
+	}, now)
+	<-sub.ch
+
+	hub.handleClientMessage(projectID, sub, "Alice", projectLiveClientMessage{Type: projectClientHeartbeat}, now.Add(presenceTTL/2))
+	hub.expirePresence(projectID, now.Add(presenceTTL))
+	if got := len(hub.presenceSnapshot(projectID)); got != 1 {
+		t.Fatalf("expected heartbeat to keep presence alive, got %d entries", got)
+	}
+
+	hub.expirePresence(projectID, now.Add(2*presenceTTL))
+	if got := len(hub.presenceSnapshot(projectID)); got != 0 {
+		t.Fatalf("expected presence to expire, got %d entries", got)
+	}
+	select {
+	case evt := <-sub.ch:
+		if evt.Type != projectEventPresenceChanged {
+			t.Fatalf("expected %q, got %q", projectEventPresenceChanged, evt.Type)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("expected expiry to broadcast presence change")
+	}
+}
+
+func TestProjectLiveHubUnsubscribeClearsPresence(t *testing.T) {
+	hub := newProjectLiveHub()
+	projectID := uuid.New()
+	ticketID := uuid.New().String()
+
+	viewer, unsubscribeViewer := hub.subscribe(projectID, uuid.New())
+	watcher, unsubscribeWatcher := hub.subscribe(projectID, uuid.New())
+	defer unsubscribeWatcher()
+
+	hub.handleClientMessage(projectID, viewer, "Alice", projectLiveClientMessage{
+		Type:     projectClientPresenceView,
+		TicketID: &ticketID,
+	}, time.Now().UTC())
+	<-watcher.ch
+
+	unsubscribeViewer()
+
+	select {
+	case evt := <-watcher.ch:
+		entries := evt.Payload["entries"].([]projectPresenceEntry)
+		if len(entries) != 0 {
+			t.Fatalf("expected empty presence after disconnect, got %d", len(entries))
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatalf("expected disconnect to broadcast presence change")
+	}
+}
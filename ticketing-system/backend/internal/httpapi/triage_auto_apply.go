@@ -0,0 +1,274 @@
+package httpapi
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"sort"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// autoTriageTicket applies confident suggestions to a ticket that was just
+// created, when the project has opted in. Only fields the creator and the
+// template left unset are applied. The decision is recorded as auto-applied,
+// so it is kept out of training and the accuracy report. Failures are logged and leave the ticket as it was
+// created.
+func (h *API) autoTriageTicket(r *http.Request, input store.TicketCreateInput, ticket store.Ticket) store.Ticket {
+	ctx := r.Context()
+	settings, err := h.store.GetAiTriageSettings(ctx, ticket.ProjectID)
+	if err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+		return ticket
+	}
+	if !settings.Enabled || !settings.AutoApply || len(settings.AutoApplyThresholds) == 0 {
+		return ticket
+	}
+	actorID, actorName, ok := currentActor(r)
+	if !ok {
+		return ticket
+	}
+
+	var inputType *string
+	if input.Type != "" {
+		inputType = &input.Type
+	}
+	var description *string
+	if ticket.Description != "" {
+		description = &ticket.Description
+	}
+	suggestionInput, err := h.triageSuggestionInput(ctx, ticket.ProjectID, settings, actorID, ticket.Title, description, inputType)
+	if err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+		return ticket
+	}
+	suggestion, err := h.store.CreateAiTriageSuggestion(ctx, ticket.ProjectID, suggestionInput)
+	if err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+		return ticket
+	}
+
+	update, applied := autoTriageUpdate(settings.AutoApplyThresholds, input, ticket, suggestion)
+	if len(applied) == 0 {
+		return ticket
+	}
+	after, err := h.store.UpdateTicket(ctx, ticket.ID, update)
+	if err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+		return ticket
+	}
+	if _, err := h.store.CreateAiTriageAutoApplication(ctx, ticket.ProjectID, ticket.ID, suggestion.ID, applied); err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+	}
+	if _, err := h.store.CreateAiTriageSuggestionDecision(ctx, ticket.ProjectID, suggestion.ID, store.AiTriageSuggestionDecisionCreateInput{
+		ActorID:        actorID,
+		AcceptedFields: appliedFieldNames(applied),
+		AutoApplied:    true,
+	}); err != nil {
+		log.Printf("ai_triage_auto_apply_error ticket=%s error=%s", ticket.ID, err.Error())
+	}
+	h.recordTriageActivities(r, "ai_triage_applied", applied, ticket, after, actorID, actorName)
+	return after
+}
+
+// autoTriageUpdate picks the suggested fields that meet their threshold, are
+// unset on the create input and differ from what the ticket got by default.
+func autoTriageUpdate(thresholds map[string]float32, input store.TicketCreateInput, ticket store.Ticket, suggestion store.AiTriageSuggestion) (store.TicketUpdateInput, []store.AiTriageAppliedField) {
+	var update store.TicketUpdateInput
+	var applied []store.AiTriageAppliedField
+	meets := func(field string, confidence float32) bool {
+		threshold, ok := thresholds[field]
+		return ok && confidence >= threshold
+	}
+	apply := func(field string, to string) {
+		applied = append(applied, store.AiTriageAppliedField{Field: field, From: triageFieldValue(ticket, field), To: &to})
+	}
+
+	if input.Priority == "" && suggestion.Priority != "" && suggestion.Priority != ticket.Priority &&
+		meets("priority", suggestion.ConfidencePriority) {
+		priority := suggestion.Priority
+		update.Priority = &priority
+		apply("priority", priority)
+	}
+	if input.StateID == nil && suggestion.StateID != uuid.Nil && suggestion.StateID != ticket.StateID &&
+		meets("state", suggestion.ConfidenceState) {
+		stateID := suggestion.StateID
+		update.StateID = &stateID
+		apply("state", stateID.String())
+	}
+	if input.AssigneeID == nil && ticket.AssigneeID == nil && suggestion.AssigneeID != nil &&
+		meets("assignee", suggestion.ConfidenceAssignee) {
+		assigneeID := *suggestion.AssigneeID
+		update.AssigneeID = &assigneeID
+		apply("assignee", assigneeID.String())
+	}
+	if input.Type == "" && suggestion.Type != nil && *suggestion.Type != ticket.Type &&
+		meets("type", suggestion.ConfidenceType) {
+		ticketType := *suggestion.Type
+		update.Type = &ticketType
+		apply("type", ticketType)
+	}
+	return update, applied
+}
+
+func (h *API) RevertAiTriageAutoApplication(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID, ticketId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	ticketUUID := uuid.UUID(ticketId)
+	if !h.requireProjectRole(w, r, projectUUID, roleContributor) {
+		return
+	}
+	actorID, actorName, ok := currentActor(r)
+	if !ok {
+		writeError(w, http.StatusUnauthorized, "unauthorized", "missing session")
+		return
+	}
+
+	current, err := h.store.GetAiTriageAutoApplication(r.Context(), projectUUID, ticketUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusNotFound, "ai_triage_auto_application_not_found", "ai triage did not change this ticket")
+		return
+	}
+	if handleDBError(w, r, err, "ai triage auto application", "ai_triage_auto_application_load") {
+		return
+	}
+	if current.RevertedAt != nil {
+		writeError(w, http.StatusConflict, "ai_triage_already_reverted", "ai triage changes were already reverted")
+		return
+	}
+	// The store marks the revert and restores the fields together, so two
+	// concurrent reverts cannot both restore and a failed restore can be
+	// retried.
+	var restored []store.AiTriageAppliedField
+	application, before, after, err := h.store.RevertAiTriageAutoApplication(r.Context(), projectUUID, ticketUUID, func(application store.AiTriageAutoApplication, ticket store.Ticket) (store.TicketUpdateInput, bool) {
+		var update store.TicketUpdateInput
+		update, restored = revertTriageUpdate(application.Fields, ticket)
+		return update, len(restored) > 0
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeError(w, http.StatusConflict, "ai_triage_already_reverted", "ai triage changes were already reverted")
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "ticket", "ai_triage_revert", "ticket_update_failed") {
+		return
+	}
+	if _, err := h.store.CreateAiTriageSuggestionDecision(r.Context(), projectUUID, application.SuggestionID, store.AiTriageSuggestionDecisionCreateInput{
+		ActorID:        actorID,
+		RejectedFields: appliedFieldNames(application.Fields),
+	}); err != nil {
+		log.Printf("ai_triage_revert_error ticket=%s error=%s", ticketUUID, err.Error())
+	}
+
+	if len(restored) > 0 {
+		h.recordTriageActivities(r, "ai_triage_reverted", restored, before, after, actorID, actorName)
+		h.notifyAssignment(r, before, after, actorID, actorName)
+		h.notifyAssigneeTicketUpdate(r, before, after, actorID, actorName)
+		h.autoStopTimers(r.Context(), before, after)
+		h.publishTicketUpdated(r.Context(), before, after)
+	}
+	writeJSON(w, http.StatusOK, mapTicket(after))
+}
+
+// revertTriageUpdate restores the applied fields that still hold the value
+// auto-triage set. Fields someone changed since are left alone.
+func revertTriageUpdate(applied []store.AiTriageAppliedField, ticket store.Ticket) (store.TicketUpdateInput, []store.AiTriageAppliedField) {
+	var update store.TicketUpdateInput
+	var restored []store.AiTriageAppliedField
+	for _, field := range applied {
+		current := triageFieldValue(ticket, field.Field)
+		if current == nil || field.To == nil || *current != *field.To {
+			continue
+		}
+		from := derefString(field.From)
+		switch field.Field {
+		case "priority":
+			update.Priority = &from
+		case "type":
+			update.Type = &from
+		case "state":
+			stateID, err := uuid.Parse(from)
+			if err != nil {
+				continue
+			}
+			update.StateID = &stateID
+		case "assignee":
+			if field.From == nil {
+				update.ClearAssignee = true
+				break
+			}
+			assigneeID, err := uuid.Parse(from)
+			if err != nil {
+				continue
+			}
+			update.AssigneeID = &assigneeID
+		default:
+			continue
+		}
+		restored = append(restored, store.AiTriageAppliedField{Field: field.Field, From: field.To, To: field.From})
+	}
+	return update, restored
+}
+
+// triageFieldValue is a ticket field as stored in an auto application.
+func triageFieldValue(ticket store.Ticket, field string) *string {
+	var value string
+	switch field {
+	case "priority":
+		value = ticket.Priority
+	case "type":
+		value = ticket.Type
+	case "state":
+		value = ticket.StateID.String()
+	case "assignee":
+		if ticket.AssigneeID == nil {
+			return nil
+		}
+		value = ticket.AssigneeID.String()
+	default:
+		return nil
+	}
+	return &value
+}
+
+// triageFieldDisplay is a ticket field as shown in the activity log.
+func triageFieldDisplay(ticket store.Ticket, field string) string {
+	switch field {
+	case "state":
+		return ticket.StateName
+	case "assignee":
+		return derefString(ticket.AssigneeName)
+	}
+	return derefString(triageFieldValue(ticket, field))
+}
+
+// recordTriageActivities logs one activity per field changed by triage, so
+// the activity log tells these changes apart from manual edits.
+func (h *API) recordTriageActivities(r *http.Request, action string, fields []store.AiTriageAppliedField, before, after store.Ticket, actorID uuid.UUID, actorName string) {
+	for _, applied := range fields {
+		field := applied.Field
+		oldValue := triageFieldDisplay(before, field)
+		newValue := triageFieldDisplay(after, field)
+		if err := h.store.CreateActivity(r.Context(), after.ID, store.ActivityCreateInput{
+			ActorID:   actorID,
+			ActorName: actorName,
+			Action:    action,
+			Field:     &field,
+			OldValue:  &oldValue,
+			NewValue:  &newValue,
+		}); err != nil {
+			log.Printf("ai_triage_activity_error ticket=%s error=%s", after.ID, err.Error())
+		}
+	}
+}
+
+func appliedFieldNames(fields []store.AiTriageAppliedField) []string {
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		names = append(names, field.Field)
+	}
+	sort.Strings(names)
+	return names
+}
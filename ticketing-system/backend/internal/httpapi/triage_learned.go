@@ -0,0 +1,351 @@
+package httpapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+
+	"ticketing-system/backend/internal/classify"
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	learnedTriageModel = "naive-bayes-v1"
+
+	triageTrainingTicketLimit = 5000
+	triageFeedbackLimit       = 2000
+	// triageMinExamples is the fewest labelled examples a field needs before
+	// its classifier is trusted over the heuristics.
+	triageMinExamples      = 10
+	triageCalibrationFolds = 5
+	triageModelMaxAge      = 24 * time.Hour
+	// triageModelTrainBatch bounds the projects one trainer run claims; the
+	// rest are picked up by later runs.
+	triageModelTrainBatch = 20
+)
+
+var errNotEnoughTrainingData = errors.New("not enough closed tickets to train on")
+
+// learnedTriageModels are a project's classifiers as stored in
+// ai_triage_models. A nil classifier means the field had too little data.
+type learnedTriageModels struct {
+	Priority *classify.Classifier `json:"priority,omitempty"`
+	Type     *classify.Classifier `json:"type,omitempty"`
+	Assignee *classify.Classifier `json:"assignee,omitempty"`
+}
+
+// trainTriageModels fits one classifier per field on closed tickets. Fields
+// accepted in recorded decisions are added as training examples. Rejected
+// fields the new model would still suggest count against its calibration,
+// so confidence drops where people keep overriding it.
+func trainTriageModels(tickets []store.AiTriageTrainingTicket, feedback []store.AiTriageFeedback) (learnedTriageModels, error) {
+	var priority, ticketType, assignee []classify.Example
+	for _, ticket := range tickets {
+		text := ticket.Title + "\n" + ticket.Description
+		priority = append(priority, classify.Example{Text: text, Label: ticket.Priority})
+		ticketType = append(ticketType, classify.Example{Text: text, Label: ticket.Type})
+		if ticket.AssigneeID != nil {
+			assignee = append(assignee, classify.Example{Text: text, Label: ticket.AssigneeID.String()})
+		}
+	}
+	for _, item := range feedback {
+		text := feedbackText(item)
+		for _, field := range item.AcceptedFields {
+			if label := feedbackLabel(item, field); label != "" {
+				switch field {
+				case "priority":
+					priority = append(priority, classify.Example{Text: text, Label: label})
+				case "type":
+					ticketType = append(ticketType, classify.Example{Text: text, Label: label})
+				case "assignee":
+					assignee = append(assignee, classify.Example{Text: text, Label: label})
+				}
+			}
+		}
+	}
+
+	models := learnedTriageModels{
+		Priority: trainTriageField(priority),
+		Type:     trainTriageField(ticketType),
+		Assignee: trainTriageField(assignee),
+	}
+	if models.Priority == nil && models.Type == nil && models.Assignee == nil {
+		return learnedTriageModels{}, errNotEnoughTrainingData
+	}
+
+	for _, item := range feedback {
+		text := feedbackText(item)
+		for _, field := range item.RejectedFields {
+			model := models.field(field)
+			label := feedbackLabel(item, field)
+			if model == nil || label == "" {
+				continue
+			}
+			if predictions := model.Predict(text); len(predictions) > 0 && predictions[0].Label == label {
+				model.Calibration.Observe(predictions[0].Probability, false)
+			}
+		}
+	}
+	return models, nil
+}
+
+func trainTriageField(examples []classify.Example) *classify.Classifier {
+	if len(examples) < triageMinExamples {
+		return nil
+	}
+	return classify.TrainCalibrated(examples, triageCalibrationFolds)
+}
+
+func (m learnedTriageModels) field(name string) *classify.Classifier {
+	switch name {
+	case "priority":
+		return m.Priority
+	case "type":
+		return m.Type
+	case "assignee":
+		return m.Assignee
+	}
+	return nil
+}
+
+func feedbackText(item store.AiTriageFeedback) string {
+	return item.Title + "\n" + derefString(item.Description)
+}
+
+func feedbackLabel(item store.AiTriageFeedback, field string) string {
+	switch field {
+	case "priority":
+		return item.Priority
+	case "type":
+		return derefString(item.Type)
+	case "assignee":
+		if item.AssigneeID != nil {
+			return item.AssigneeID.String()
+		}
+	}
+	return ""
+}
+
+// learnedTriageProvider predicts priority, type and assignee with the
+// project's trained classifiers. Summary and state, and any field without
+// a classifier, come from the heuristics.
+type learnedTriageProvider struct {
+	models   learnedTriageModels
+	fallback heuristicTriageProvider
+	isMember func(ctx context.Context, projectID, userID uuid.UUID) bool
+}
+
+func (p learnedTriageProvider) Suggest(ctx context.Context, input TriageInput) (TriageSuggestion, error) {
+	out, err := p.fallback.Suggest(ctx, input)
+	if err != nil {
+		return TriageSuggestion{}, err
+	}
+	out.Model = learnedTriageModel
+	text := input.Title + "\n" + derefString(input.Description)
+
+	if prediction, ok := topPrediction(p.models.Priority, text, isTicketPriority); ok {
+		out.Priority = prediction.Label
+		out.PriorityConfidence = float32(p.models.Priority.Confidence(prediction.Probability))
+	}
+	// A type the reporter chose is kept.
+	if input.Type == nil || !isTicketType(*input.Type) {
+		if prediction, ok := topPrediction(p.models.Type, text, isTicketType); ok {
+			out.Type = prediction.Label
+			out.TypeConfidence = float32(p.models.Type.Confidence(prediction.Probability))
+		}
+	}
+	// An @mention of a member is a stronger signal than the model.
+	if out.AssigneeID == nil {
+		isMember := func(label string) bool {
+			id, err := uuid.Parse(label)
+			return err == nil && p.isMember(ctx, input.ProjectID, id)
+		}
+		if prediction, ok := topPrediction(p.models.Assignee, text, isMember); ok {
+			id := uuid.MustParse(prediction.Label)
+			out.AssigneeID = &id
+			out.AssigneeConfidence = float32(p.models.Assignee.Confidence(prediction.Probability))
+		}
+	}
+	return out, nil
+}
+
+// topPrediction returns the most likely label that passes valid.
+func topPrediction(model *classify.Classifier, text string, valid func(string) bool) (classify.Prediction, bool) {
+	for _, prediction := range model.Predict(text) {
+		if valid(prediction.Label) {
+			return prediction, true
+		}
+	}
+	return classify.Prediction{}, false
+}
+
+// loadLearnedTriageProvider loads a project's model, decoding it only when
+// it was retrained since it was last cached. Projects that have not been
+// trained yet get the heuristics.
+func (h *API) loadLearnedTriageProvider(ctx context.Context, projectID uuid.UUID, heuristic heuristicTriageProvider) TriageProvider {
+	trainedAt, err := h.store.GetAiTriageModelTrainedAt(ctx, projectID)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			log.Printf("ai_triage_model_error project=%s error=%s", projectID, err.Error())
+		}
+		return heuristic
+	}
+	models, ok := h.triageModels.get(projectID, trainedAt)
+	if !ok {
+		saved, err := h.store.GetAiTriageModel(ctx, projectID)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				log.Printf("ai_triage_model_error project=%s error=%s", projectID, err.Error())
+			}
+			return heuristic
+		}
+		if err := json.Unmarshal(saved.Model, &models); err != nil {
+			log.Printf("ai_triage_model_error project=%s error=%s", projectID, err.Error())
+			return heuristic
+		}
+		h.triageModels.put(projectID, saved.TrainedAt, models)
+	}
+	return learnedTriageProvider{models: models, fallback: heuristic, isMember: h.isProjectMember}
+}
+
+// triageModelCache keeps each project's decoded model along with when it was
+// trained. Classifiers are read-only once trained, so cached models are
+// shared between requests.
+type triageModelCache struct {
+	mu     sync.RWMutex
+	models map[uuid.UUID]cachedTriageModels
+}
+
+type cachedTriageModels struct {
+	trainedAt time.Time
+	models    learnedTriageModels
+}
+
+func newTriageModelCache() *triageModelCache {
+	return &triageModelCache{models: map[uuid.UUID]cachedTriageModels{}}
+}
+
+func (c *triageModelCache) get(projectID uuid.UUID, trainedAt time.Time) (learnedTriageModels, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cached, ok := c.models[projectID]
+	if !ok || !cached.trainedAt.Equal(trainedAt) {
+		return learnedTriageModels{}, false
+	}
+	return cached.models, true
+}
+
+func (c *triageModelCache) put(projectID uuid.UUID, trainedAt time.Time, models learnedTriageModels) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.models[projectID] = cachedTriageModels{trainedAt: trainedAt, models: models}
+}
+
+func (h *API) isProjectMember(ctx context.Context, projectID, userID uuid.UUID) bool {
+	role, err := h.store.GetProjectRoleForUser(ctx, projectID, userID)
+	return err == nil && role != ""
+}
+
+func (h *API) GetAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectAccess(w, r, projectUUID) {
+		return
+	}
+	saved, err := h.store.GetAiTriageModel(r.Context(), projectUUID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		writeJSON(w, http.StatusOK, AiTriageModelStatus{Fields: []AiTriageModelField{}})
+		return
+	}
+	if handleDBError(w, r, err, "ai triage model", "ai_triage_model_get") {
+		return
+	}
+	var models learnedTriageModels
+	if err := json.Unmarshal(saved.Model, &models); err != nil {
+		log.Printf("ai_triage_model_error project=%s error=%s", projectUUID, err.Error())
+		writeError(w, http.StatusInternalServerError, "ai_triage_model_error", "unable to read ai triage model")
+		return
+	}
+	writeJSON(w, http.StatusOK, mapAiTriageModelStatus(saved, models))
+}
+
+func (h *API) TrainAiTriageModel(w http.ResponseWriter, r *http.Request, projectId openapi_types.UUID) {
+	projectUUID := uuid.UUID(projectId)
+	if !h.requireProjectRole(w, r, projectUUID, roleAdmin) {
+		return
+	}
+	saved, models, err := h.trainTriageModel(r.Context(), projectUUID)
+	if errors.Is(err, errNotEnoughTrainingData) {
+		writeError(w, http.StatusConflict, "not_enough_training_data", err.Error())
+		return
+	}
+	if handleDBErrorWithCode(w, r, err, "ai triage model", "ai_triage_model_train", "ai_triage_model_train_failed") {
+		return
+	}
+	writeJSON(w, http.StatusOK, mapAiTriageModelStatus(saved, models))
+}
+
+func (h *API) trainTriageModel(ctx context.Context, projectID uuid.UUID) (store.AiTriageModel, learnedTriageModels, error) {
+	tickets, err := h.store.ListAiTriageTrainingTickets(ctx, projectID, triageTrainingTicketLimit)
+	if err != nil {
+		return store.AiTriageModel{}, learnedTriageModels{}, err
+	}
+	feedback, err := h.store.ListAiTriageFeedback(ctx, projectID, triageFeedbackLimit)
+	if err != nil {
+		return store.AiTriageModel{}, learnedTriageModels{}, err
+	}
+	models, err := trainTriageModels(tickets, feedback)
+	if err != nil {
+		return store.AiTriageModel{}, learnedTriageModels{}, err
+	}
+	payload, err := json.Marshal(models)
+	if err != nil {
+		return store.AiTriageModel{}, learnedTriageModels{}, err
+	}
+	saved, err := h.store.SaveAiTriageModel(ctx, projectID, payload, len(tickets), len(feedback))
+	return saved, models, err
+}
+
+// RunTriageModelTrainer retrains learned triage models that are due, every
+// interval until ctx is cancelled.
+func (h *API) RunTriageModelTrainer(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := h.TrainTriageModels(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("scheduler_error job=ai_triage_models error=%s", err.Error())
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// TrainTriageModels retrains the model of every project on the learned
+// provider whose model is missing or a day old. Projects without enough
+// closed tickets are skipped until they have more, and projects another
+// server is training are skipped.
+func (h *API) TrainTriageModels(ctx context.Context) error {
+	projectIDs, err := h.store.ClaimDueAiTriageModels(ctx, triageModelMaxAge, triageModelTrainBatch)
+	if err != nil {
+		return err
+	}
+	for _, projectID := range projectIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if _, _, err := h.trainTriageModel(ctx, projectID); err != nil && !errors.Is(err, errNotEnoughTrainingData) {
+			log.Printf("scheduler_error job=ai_triage_models project=%s error=%s", projectID, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,453 @@
+package httpapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"ticketing-system/backend/internal/store"
+
+	"github.com/google/uuid"
+)
+
+// TriageProvider suggests how a new ticket should be triaged. Confidences
+// are between 0 and 1.
+type TriageProvider interface {
+	Suggest(ctx context.Context, input TriageInput) (TriageSuggestion, error)
+}
+
+type TriageInput struct {
+	ProjectID     uuid.UUID
+	Title         string
+	Description   *string
+	Type          *string
+	States        []store.WorkflowState
+	PromptVersion string
+}
+
+type TriageSuggestion struct {
+	Summary            string
+	SummaryConfidence  float32
+	Priority           string
+	PriorityConfidence float32
+	StateID            uuid.UUID
+	StateConfidence    float32
+	AssigneeID         *uuid.UUID
+	AssigneeConfidence float32
+	Type               string
+	TypeConfidence     float32
+	Model              string
+}
+
+// memberResolver maps @handles or names to a member of the project.
+type memberResolver func(ctx context.Context, projectID uuid.UUID, handles []string) (*uuid.UUID, error)
+
+// maxTriageResponseBytes caps how much of a provider response is read.
+const maxTriageResponseBytes = 1 << 20
+
+// aiTriagePrompts are the system prompts for the http provider by version.
+// A published version must not change, so that decisions recorded against
+// it stay comparable; add a new version instead.
+var aiTriagePrompts = map[string]string{
+	"triage-v1": `You triage tickets for a software team.
+The user message is a JSON object with the ticket's title, description and
+optional type, and the allowed states, priorities and types.
+Reply with a single JSON object and nothing else, with these keys:
+  "summary": one sentence of at most 140 characters,
+  "priority": one of the allowed priorities,
+  "state": the name of one of the allowed states for a new ticket,
+  "assignee": the @handle or name of the person the ticket mentions as its owner, or null,
+  "type": one of the allowed types,
+  "confidence": an object with a number from 0 to 1 for each of summary, priority, state, assignee and type.`,
+}
+
+func aiTriagePromptVersions() []string {
+	versions := make([]string, 0, len(aiTriagePrompts))
+	for version := range aiTriagePrompts {
+		versions = append(versions, version)
+	}
+	sort.Strings(versions)
+	return versions
+}
+
+// triageProvider returns the provider configured for a project. Projects
+// without a usable http configuration or a trained model get the
+// heuristics.
+func (h *API) triageProvider(ctx context.Context, projectID uuid.UUID, settings store.AiTriageSettings) TriageProvider {
+	heuristic := heuristicTriageProvider{resolveMember: h.resolveProjectMember}
+	if settings.Provider == store.AiTriageProviderLearned {
+		return h.loadLearnedTriageProvider(ctx, projectID, heuristic)
+	}
+	if settings.Provider != store.AiTriageProviderHTTP || settings.EndpointURL == nil {
+		return heuristic
+	}
+	client := h.triageClient
+	if !h.triageEndpointTrusted(*settings.EndpointURL) {
+		if parsed, err := url.Parse(strings.TrimSpace(*settings.EndpointURL)); err != nil || parsed.Scheme != "https" {
+			return heuristic
+		}
+		client = h.triagePublicClient
+	}
+	return httpTriageProvider{
+		client:        client,
+		endpoint:      *settings.EndpointURL,
+		model:         derefString(settings.Model),
+		apiKey:        h.triageEndpointAPIKey(settings),
+		fallback:      heuristic,
+		resolveMember: h.resolveProjectMember,
+	}
+}
+
+// triageEndpointAPIKey picks the bearer token for a project's endpoint: the
+// project's own key if it has one, otherwise the server's key when the
+// endpoint's origin is on the operator's allowlist, otherwise none.
+func (h *API) triageEndpointAPIKey(settings store.AiTriageSettings) string {
+	if settings.APIKey != nil {
+		return *settings.APIKey
+	}
+	if h.triageAPIKey == "" || settings.EndpointURL == nil || !h.triageEndpointTrusted(*settings.EndpointURL) {
+		return ""
+	}
+	return h.triageAPIKey
+}
+
+// triageEndpointTrusted reports whether endpoint's scheme, host and port
+// match an origin the operator allowed.
+func (h *API) triageEndpointTrusted(endpoint string) bool {
+	origin, ok := triageOrigin(endpoint)
+	return ok && slices.Contains(h.triageAllowedOrigins, origin)
+}
+
+// triageOrigins normalizes the operator's allowed origins, dropping entries
+// that do not parse.
+func triageOrigins(entries []string) []string {
+	origins := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !strings.Contains(entry, "://") {
+			entry = "https://" + entry
+		}
+		if origin, ok := triageOrigin(entry); ok {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
+}
+
+// triageOrigin returns the lower-cased scheme://host[:port] of an http or
+// https URL, leaving out the scheme's default port.
+func triageOrigin(raw string) (string, bool) {
+	parsed, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || parsed.Hostname() == "" {
+		return "", false
+	}
+	scheme := strings.ToLower(parsed.Scheme)
+	if scheme != "http" && scheme != "https" {
+		return "", false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	if port := parsed.Port(); port != "" && !(scheme == "http" && port == "80") && !(scheme == "https" && port == "443") {
+		host += ":" + port
+	}
+	return scheme + "://" + host, true
+}
+
+// newPublicTriageClient returns the client for endpoints project admins
+// choose. It refuses to connect to loopback, private, link-local and other
+// non-public addresses, checked after DNS resolution so that a public name
+// pointing inside the network is refused too, and does not follow
+// redirects.
+func newPublicTriageClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip, err := netip.ParseAddr(host)
+			if err != nil {
+				return err
+			}
+			if !isPublicTriageAddr(ip) {
+				return fmt.Errorf("triage endpoint address %s is not public", ip)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which is not routable
+// on the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func isPublicTriageAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !sharedAddressSpace.Contains(ip)
+}
+
+// httpTriageProvider asks an OpenAI-compatible chat completions endpoint,
+// such as a hosted model or a local Ollama or llama.cpp server. Fields the
+// model leaves out or gets wrong are filled in from the fallback.
+type httpTriageProvider struct {
+	client        *http.Client
+	endpoint      string
+	model         string
+	apiKey        string
+	fallback      TriageProvider
+	resolveMember memberResolver
+}
+
+type chatCompletionMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatCompletionRequest struct {
+	Model          string                  `json:"model,omitempty"`
+	Messages       []chatCompletionMessage `json:"messages"`
+	Temperature    float64                 `json:"temperature"`
+	ResponseFormat map[string]string       `json:"response_format,omitempty"`
+}
+
+type chatCompletionResponse struct {
+	Model   string `json:"model"`
+	Choices []struct {
+		Message chatCompletionMessage `json:"message"`
+	} `json:"choices"`
+}
+
+type triageModelAnswer struct {
+	Summary    *string            `json:"summary"`
+	Priority   *string            `json:"priority"`
+	State      *string            `json:"state"`
+	Assignee   *string            `json:"assignee"`
+	Type       *string            `json:"type"`
+	Confidence map[string]float64 `json:"confidence"`
+}
+
+func (p httpTriageProvider) Suggest(ctx context.Context, input TriageInput) (TriageSuggestion, error) {
+	prompt, ok := aiTriagePrompts[input.PromptVersion]
+	if !ok {
+		return TriageSuggestion{}, fmt.Errorf("unknown prompt version %q", input.PromptVersion)
+	}
+	out, err := p.fallback.Suggest(ctx, input)
+	if err != nil {
+		return TriageSuggestion{}, err
+	}
+
+	answer, model, err := p.complete(ctx, prompt, input)
+	if err != nil {
+		return TriageSuggestion{}, err
+	}
+	out.Model = model
+
+	if answer.Summary != nil {
+		if summary := truncateRunes(strings.TrimSpace(*answer.Summary), 140); summary != "" {
+			out.Summary = summary
+			out.SummaryConfidence = answerConfidence(answer, "summary")
+		}
+	}
+	if answer.Priority != nil {
+		priority := strings.ToLower(strings.TrimSpace(*answer.Priority))
+		if isTicketPriority(priority) {
+			out.Priority = priority
+			out.PriorityConfidence = answerConfidence(answer, "priority")
+		}
+	}
+	if answer.State != nil {
+		name := strings.TrimSpace(*answer.State)
+		for _, state := range input.States {
+			if strings.EqualFold(state.Name, name) {
+				out.StateID = state.ID
+				out.StateConfidence = answerConfidence(answer, "state")
+				break
+			}
+		}
+	}
+	if answer.Assignee != nil {
+		handle := strings.TrimPrefix(strings.TrimSpace(*answer.Assignee), "@")
+		if handle != "" {
+			if id, err := p.resolveMember(ctx, input.ProjectID, []string{handle}); err == nil && id != nil {
+				out.AssigneeID = id
+				out.AssigneeConfidence = answerConfidence(answer, "assignee")
+			}
+		}
+	}
+	if answer.Type != nil {
+		ticketType := strings.ToLower(strings.TrimSpace(*answer.Type))
+		if isTicketType(ticketType) {
+			out.Type = ticketType
+			out.TypeConfidence = answerConfidence(answer, "type")
+		}
+	}
+	return out, nil
+}
+
+func (p httpTriageProvider) complete(ctx context.Context, prompt string, input TriageInput) (triageModelAnswer, string, error) {
+	states := make([]string, 0, len(input.States))
+	for _, state := range input.States {
+		if !state.IsClosed {
+			states = append(states, state.Name)
+		}
+	}
+	ticket := map[string]any{
+		"title":       input.Title,
+		"description": derefString(input.Description),
+		"type":        input.Type,
+		"states":      states,
+		"priorities":  []TicketPriority{Low, Medium, High, Urgent},
+		"types":       []TicketType{Bug, Feature},
+	}
+	userMessage, err := json.Marshal(ticket)
+	if err != nil {
+		return triageModelAnswer{}, "", err
+	}
+	body, err := json.Marshal(chatCompletionRequest{
+		Model: p.model,
+		Messages: []chatCompletionMessage{
+			{Role: "system", Content: prompt},
+			{Role: "user", Content: string(userMessage)},
+		},
+		ResponseFormat: map[string]string{"type": "json_object"},
+	})
+	if err != nil {
+		return triageModelAnswer{}, "", err
+	}
+
+	endpoint, err := chatCompletionsURL(p.endpoint)
+	if err != nil {
+		return triageModelAnswer{}, "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return triageModelAnswer{}, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	client := p.client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return triageModelAnswer{}, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return triageModelAnswer{}, "", fmt.Errorf("triage provider returned status %d", resp.StatusCode)
+	}
+
+	var completion chatCompletionResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxTriageResponseBytes)).Decode(&completion); err != nil {
+		return triageModelAnswer{}, "", fmt.Errorf("decode triage provider response: %w", err)
+	}
+	if len(completion.Choices) == 0 {
+		return triageModelAnswer{}, "", fmt.Errorf("triage provider returned no choices")
+	}
+	var answer triageModelAnswer
+	if err := json.Unmarshal([]byte(stripCodeFence(completion.Choices[0].Message.Content)), &answer); err != nil {
+		return triageModelAnswer{}, "", fmt.Errorf("decode triage answer: %w", err)
+	}
+
+	model := completion.Model
+	if model == "" {
+		model = p.model
+	}
+	if model == "" {
+		model = store.AiTriageProviderHTTP
+	}
+	return answer, model, nil
+}
+
+// chatCompletionsURL accepts either an API base URL or the full chat
+// completions URL.
+func chatCompletionsURL(endpoint string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(endpoint))
+	if err != nil {
+		return "", err
+	}
+	if !strings.HasSuffix(parsed.Path, "/chat/completions") {
+		parsed.Path = strings.TrimSuffix(parsed.Path, "/") + "/chat/completions"
+	}
+	return parsed.String(), nil
+}
+
+// stripCodeFence removes the Markdown code fence some models wrap JSON in.
+func stripCodeFence(content string) string {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, "```") {
+		return content
+	}
+	content = strings.TrimPrefix(content, "```")
+	if newline := strings.IndexByte(content, '\n'); newline >= 0 {
+		content = content[newline+1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(content), "```"))
+}
+
+// answerConfidence reads a field's confidence from the model's answer,
+// clamped to [0, 1]. Models that leave it out get a neutral 0.5.
+func answerConfidence(answer triageModelAnswer, field string) float32 {
+	value, ok := answer.Confidence[field]
+	if !ok {
+		return 0.5
+	}
+	if value < 0 {
+		return 0
+	}
+	if value > 1 {
+		return 1
+	}
+	return float32(value)
+}
+
+func isTicketPriority(value string) bool {
+	switch TicketPriority(value) {
+	case Low, Medium, High, Urgent:
+		return true
+	}
+	return false
+}
+
+func isTicketType(value string) bool {
+	switch TicketType(value) {
+	case Bug, Feature:
+		return true
+	}
+	return false
+}
+
+func truncateRunes(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return strings.TrimSpace(string(runes[:limit]))
+}
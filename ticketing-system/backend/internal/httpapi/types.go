@@ -26,11 +26,14 @@ type storyUpdateRequest = StoryUpdateRequest
 type storyListResponse = StoryListResponse
 type ticketCommentResponse = TicketComment
 type ticketCommentCreateRequest = TicketCommentCreateRequest
+type ticketCommentUpdateRequest = TicketCommentUpdateRequest
 type ticketCommentListResponse = TicketCommentListResponse
 type ticketCreateRequest = TicketCreateRequest
 type ticketUpdateRequest = TicketUpdateRequest
 type ticketResponse = Ticket
 type ticketListResponse = TicketListResponse
+type ticketChangesResponse = TicketChangesResponse
+type ticketDeletionResponse = TicketDeletion
 type ticketDependencyResponse = TicketDependency
 type ticketDependencyCreateRequest = TicketDependencyCreateRequest
 type ticketDependencyListResponse = TicketDependencyListResponse
@@ -40,6 +43,23 @@ type boardFilterPresetResponse = BoardFilterPreset
 type boardFilterPresetListResponse = BoardFilterPresetListResponse
 type boardFilterPresetCreateRequest = BoardFilterPresetCreateRequest
 type boardFilterPresetUpdateRequest = BoardFilterPresetUpdateRequest
+type boardFilterPresetSubscriptionResponse = BoardFilterPresetSubscription
+type boardFilterPresetSubscriptionRequest = BoardFilterPresetSubscriptionRequest
+type ticketTemplateResponse = TicketTemplate
+type ticketTemplateListResponse = TicketTemplateListResponse
+type ticketTemplateCreateRequest = TicketTemplateCreateRequest
+type ticketTemplateUpdateRequest = TicketTemplateUpdateRequest
+type ticketRecurrenceResponse = TicketRecurrence
+type ticketRecurrenceListResponse = TicketRecurrenceListResponse
+type ticketRecurrenceCreateRequest = TicketRecurrenceCreateRequest
+type ticketRecurrenceUpdateRequest = TicketRecurrenceUpdateRequest
+type automationAction = AutomationAction
+type automationRuleResponse = AutomationRule
+type automationRuleListResponse = AutomationRuleListResponse
+type automationRuleCreateRequest = AutomationRuleCreateRequest
+type automationRuleUpdateRequest = AutomationRuleUpdateRequest
+type automationRunResponse = AutomationRun
+type automationRunListResponse = AutomationRunListResponse
 type workflowState = WorkflowState
 type workflowStateInput = WorkflowStateInput
 type workflowResponse = WorkflowResponse
@@ -65,11 +85,25 @@ type sprintResponse = Sprint
 type sprintListResponse = SprintListResponse
 type sprintCreateRequest = SprintCreateRequest
 type sprintTicketsRequest = SprintTicketsRequest
+type sprintUpdateRequest = SprintUpdateRequest
+type sprintCompleteRequest = SprintCompleteRequest
+type sprintCompletionResponse = SprintCompletion
+type sprintCompletionTicketResponse = SprintCompletionTicket
 type capacitySettingResponse = CapacitySetting
 type capacitySettingInput = CapacitySettingInput
 type capacitySettingsResponse = CapacitySettingsResponse
 type capacitySettingsReplaceRequest = CapacitySettingsReplaceRequest
 type sprintForecastSummaryResponse = SprintForecastSummary
+type sprintForecastWhatIfRequest = SprintForecastWhatIfRequest
+type capacityAllocationResponse = CapacityAllocation
+type sprintAbsenceResponse = SprintAbsence
+type sprintAbsencesResponse = SprintAbsencesResponse
+type sprintAbsencesReplaceRequest = SprintAbsencesReplaceRequest
+type sprintBurndownResponse = SprintBurndown
+type sprintBurndownPointResponse = SprintBurndownPoint
+type sprintScopeChangeResponse = SprintScopeChange
+type sprintVelocityResponse = SprintVelocity
+type sprintVelocityHistoryResponse = SprintVelocityHistory
 type aiTriageSettingsResponse = AiTriageSettings
 type aiTriageSettingsUpdateRequest = AiTriageSettingsUpdateRequest
 type aiTriageSuggestionResponse = AiTriageSuggestion
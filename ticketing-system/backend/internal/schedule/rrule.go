@@ -0,0 +1,354 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleSearchDays bounds the day-by-day search for the next occurrence. It
+// covers a YEARLY rule with INTERVAL up to ten.
+const rruleSearchDays = 366 * 11
+
+const (
+	freqDaily   = "DAILY"
+	freqWeekly  = "WEEKLY"
+	freqMonthly = "MONTHLY"
+	freqYearly  = "YEARLY"
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"SU": time.Sunday, "MO": time.Monday, "TU": time.Tuesday, "WE": time.Wednesday,
+	"TH": time.Thursday, "FR": time.Friday, "SA": time.Saturday,
+}
+
+type rruleWeekday struct {
+	day time.Weekday
+	// nth selects the nth weekday of the month; negative counts from the
+	// end and zero matches every such weekday.
+	nth int
+}
+
+type rruleSchedule struct {
+	freq       string
+	interval   int
+	byMonth    []int
+	byMonthDay []int
+	byDay      []rruleWeekday
+	// times holds occurrence times as minutes after local midnight.
+	times   []int
+	until   *time.Time
+	dtstart time.Time
+	loc     *time.Location
+}
+
+// ParseRRule parses an RFC 5545 recurrence rule such as
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO;BYHOUR=9;BYMINUTE=0". Supported parts are
+// FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, UNTIL, BYMONTH,
+// BYMONTHDAY, BYDAY (with ordinals such as 1MO or -1FR), BYHOUR, BYMINUTE and
+// WKST=MO. dtstart anchors the interval and supplies defaults for fields the
+// rule leaves out, as in iCalendar.
+func ParseRRule(rule string, dtstart time.Time, loc *time.Location) (Schedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	rule = strings.TrimSpace(rule)
+	if len(rule) >= 6 && strings.EqualFold(rule[:6], "RRULE:") {
+		rule = rule[6:]
+	}
+	if rule == "" {
+		return nil, errors.New("rrule is empty")
+	}
+
+	s := &rruleSchedule{interval: 1, dtstart: dtstart.In(loc), loc: loc}
+	var hours, minutes []int
+	for _, part := range strings.Split(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rrule part %q", part)
+		}
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		var err error
+		switch key {
+		case "FREQ":
+			switch value {
+			case freqDaily, freqWeekly, freqMonthly, freqYearly:
+				s.freq = value
+			default:
+				return nil, fmt.Errorf("unsupported rrule FREQ %q", value)
+			}
+		case "INTERVAL":
+			s.interval, err = strconv.Atoi(value)
+			if err != nil || s.interval < 1 || s.interval > 10 {
+				return nil, errors.New("rrule INTERVAL must be between 1 and 10")
+			}
+		case "UNTIL":
+			until, err := parseRRuleUntil(value, loc)
+			if err != nil {
+				return nil, err
+			}
+			s.until = &until
+		case "COUNT":
+			return nil, errors.New("rrule COUNT is not supported; use UNTIL")
+		case "WKST":
+			if value != "MO" {
+				return nil, errors.New("rrule WKST must be MO")
+			}
+		case "BYMONTH":
+			s.byMonth, err = parseRRuleInts(value, "BYMONTH", 1, 12, false)
+		case "BYMONTHDAY":
+			s.byMonthDay, err = parseRRuleInts(value, "BYMONTHDAY", -31, 31, true)
+		case "BYHOUR":
+			hours, err = parseRRuleInts(value, "BYHOUR", 0, 23, false)
+		case "BYMINUTE":
+			minutes, err = parseRRuleInts(value, "BYMINUTE", 0, 59, false)
+		case "BYDAY":
+			s.byDay, err = parseRRuleByDay(value)
+		default:
+			return nil, fmt.Errorf("unsupported rrule part %s", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.freq == "" {
+		return nil, errors.New("rrule FREQ is required")
+	}
+	for _, day := range s.byDay {
+		if day.nth != 0 && s.freq != freqMonthly && s.freq != freqYearly {
+			return nil, errors.New("rrule BYDAY ordinals require FREQ=MONTHLY or FREQ=YEARLY")
+		}
+	}
+
+	if len(hours) == 0 {
+		hours = []int{s.dtstart.Hour()}
+	}
+	if len(minutes) == 0 {
+		minutes = []int{s.dtstart.Minute()}
+	}
+	for _, h := range hours {
+		for _, m := range minutes {
+			s.times = append(s.times, h*60+m)
+		}
+	}
+	sort.Ints(s.times)
+	return s, nil
+}
+
+func parseRRuleUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102", value, loc); err == nil {
+		return t.AddDate(0, 0, 1).Add(-time.Second), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid rrule UNTIL %q", value)
+}
+
+func parseRRuleInts(value, name string, min, max int, nonZero bool) ([]int, error) {
+	parts := strings.Split(value, ",")
+	out := make([]int, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < min || n > max || (nonZero && n == 0) {
+			return nil, fmt.Errorf("invalid rrule %s value %q", name, part)
+		}
+		out = append(out, n)
+	}
+	return out, nil
+}
+
+func parseRRuleByDay(value string) ([]rruleWeekday, error) {
+	parts := strings.Split(value, ",")
+	out := make([]rruleWeekday, 0, len(parts))
+	for _, part := range parts {
+		if len(part) < 2 {
+			return nil, fmt.Errorf("invalid rrule BYDAY value %q", part)
+		}
+		day, ok := rruleWeekdays[part[len(part)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid rrule BYDAY value %q", part)
+		}
+		nth := 0
+		if prefix := part[:len(part)-2]; prefix != "" {
+			n, err := strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid rrule BYDAY value %q", part)
+			}
+			nth = n
+		}
+		out = append(out, rruleWeekday{day: day, nth: nth})
+	}
+	return out, nil
+}
+
+func (s *rruleSchedule) Next(after time.Time) (time.Time, bool) {
+	after = after.In(s.loc)
+	day := dateOf(after, s.loc)
+	if start := dateOf(s.dtstart, s.loc); day.Before(start) {
+		day = start
+	}
+	for i := 0; i < rruleSearchDays; i++ {
+		if s.until != nil && day.After(*s.until) {
+			return time.Time{}, false
+		}
+		if s.dayMatches(day) {
+			for _, minutes := range s.times {
+				candidate := time.Date(day.Year(), day.Month(), day.Day(), minutes/60, minutes%60, 0, 0, s.loc)
+				if !candidate.After(after) || candidate.Before(s.dtstart) {
+					continue
+				}
+				if s.until != nil && candidate.After(*s.until) {
+					return time.Time{}, false
+				}
+				return candidate, true
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}, false
+}
+
+// periodDays covers rules that pick days by interval and weekday alone.
+func (s *rruleSchedule) periodDays() (int, bool) {
+	if s.until != nil || len(s.byMonth) > 0 || len(s.byMonthDay) > 0 {
+		return 0, false
+	}
+	for _, day := range s.byDay {
+		if day.nth != 0 {
+			return 0, false
+		}
+	}
+	switch s.freq {
+	case freqDaily:
+		if len(s.byDay) > 0 {
+			return s.interval * 7, true
+		}
+		return s.interval, true
+	case freqWeekly:
+		return s.interval * 7, true
+	case freqMonthly, freqYearly:
+		if s.interval == 1 && len(s.byDay) > 0 {
+			return 7, true
+		}
+	}
+	return 0, false
+}
+
+func (s *rruleSchedule) dayMatches(day time.Time) bool {
+	start := dateOf(s.dtstart, s.loc)
+	switch s.freq {
+	case freqDaily:
+		if daysBetween(start, day)%s.interval != 0 {
+			return false
+		}
+	case freqWeekly:
+		if (daysBetween(weekStart(start), weekStart(day))/7)%s.interval != 0 {
+			return false
+		}
+	case freqMonthly:
+		months := (day.Year()-start.Year())*12 + int(day.Month()-start.Month())
+		if months%s.interval != 0 {
+			return false
+		}
+	case freqYearly:
+		if (day.Year()-start.Year())%s.interval != 0 {
+			return false
+		}
+	}
+
+	if len(s.byMonth) > 0 && !containsInt(s.byMonth, int(day.Month())) {
+		return false
+	}
+	if len(s.byMonthDay) > 0 && !s.monthDayMatches(day) {
+		return false
+	}
+	if len(s.byDay) > 0 && !s.weekdayMatches(day) {
+		return false
+	}
+	if len(s.byMonthDay) > 0 || len(s.byDay) > 0 {
+		return true
+	}
+
+	// Without BYDAY or BYMONTHDAY the rule repeats on dtstart's weekday,
+	// day of month or date, depending on FREQ.
+	switch s.freq {
+	case freqWeekly:
+		return day.Weekday() == start.Weekday()
+	case freqMonthly:
+		return day.Day() == start.Day()
+	case freqYearly:
+		if len(s.byMonth) == 0 && day.Month() != start.Month() {
+			return false
+		}
+		return day.Day() == start.Day()
+	}
+	return true
+}
+
+func (s *rruleSchedule) monthDayMatches(day time.Time) bool {
+	last := daysInMonth(day)
+	for _, want := range s.byMonthDay {
+		if want < 0 {
+			want = last + want + 1
+		}
+		if day.Day() == want {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *rruleSchedule) weekdayMatches(day time.Time) bool {
+	for _, want := range s.byDay {
+		if day.Weekday() != want.day {
+			continue
+		}
+		switch {
+		case want.nth == 0:
+			return true
+		case want.nth > 0 && (day.Day()-1)/7+1 == want.nth:
+			return true
+		case want.nth < 0 && (daysInMonth(day)-day.Day())/7+1 == -want.nth:
+			return true
+		}
+	}
+	return false
+}
+
+func dateOf(t time.Time, loc *time.Location) time.Time {
+	t = t.In(loc)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+}
+
+// daysBetween counts calendar days from a to b, ignoring DST shifts.
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
+
+// weekStart returns the Monday on or before day.
+func weekStart(day time.Time) time.Time {
+	return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+}
+
+func daysInMonth(day time.Time) int {
+	return time.Date(day.Year(), day.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func containsInt(values []int, want int) bool {
+	for _, v := range values {
+		if v == want {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Package schedule parses recurrence rules and computes their next
+// occurrence. Two syntaxes are supported: standard five-field cron
+// expressions and a subset of iCalendar RRULEs.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	// Embed the zone database: the runtime image does not ship tzdata.
+	_ "time/tzdata"
+)
+
+const (
+	KindCron  = "cron"
+	KindRRule = "rrule"
+)
+
+// Schedule yields occurrences of a recurrence rule.
+type Schedule interface {
+	// Next returns the first occurrence strictly after after. The boolean is
+	// false when the rule has no further occurrences.
+	Next(after time.Time) (time.Time, bool)
+}
+
+// Parse builds a schedule of the given kind. dtstart anchors RRULE intervals
+// and default times; cron expressions ignore it. Occurrences are computed in
+// loc, which defaults to UTC.
+func Parse(kind, expr string, dtstart time.Time, loc *time.Location) (Schedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	switch strings.ToLower(strings.TrimSpace(kind)) {
+	case KindCron:
+		return ParseCron(expr, loc)
+	case KindRRule:
+		return ParseRRule(expr, dtstart, loc)
+	default:
+		return nil, fmt.Errorf("unknown schedule kind %q", kind)
+	}
+}
+
+// PeriodDays returns the number of local calendar days after which the
+// occurrences of s repeat at the same local times. The boolean is false when
+// they do not repeat after a fixed number of days, as with monthly dates or
+// an UNTIL. Within one UTC offset the occurrences therefore repeat every
+// PeriodDays*24 hours.
+func PeriodDays(s Schedule) (int, bool) {
+	if p, ok := s.(interface{ periodDays() (int, bool) }); ok {
+		return p.periodDays()
+	}
+	return 0, false
+}
+
+// LoadLocation resolves an IANA time zone name, treating an empty name as UTC.
+func LoadLocation(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.New("unknown time zone " + name)
+	}
+	return loc, nil
+}
@@ -0,0 +1,174 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("parse %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestCronNext(t *testing.T) {
+	tests := []struct {
+		name     string
+		expr     string
+		after    string
+		expected string
+	}{
+		{name: "every 15 minutes", expr: "*/15 * * * *", after: "2024-03-09T10:07:30Z", expected: "2024-03-09T10:15:00Z"},
+		{name: "strictly after", expr: "0 9 * * *", after: "2024-03-09T09:00:00Z", expected: "2024-03-10T09:00:00Z"},
+		{name: "weekdays by name", expr: "30 8 * * MON-FRI", after: "2024-03-08T09:00:00Z", expected: "2024-03-11T08:30:00Z"},
+		{name: "sunday as seven", expr: "0 0 * * 7", after: "2024-03-09T12:00:00Z", expected: "2024-03-10T00:00:00Z"},
+		{name: "monthly macro", expr: "@monthly", after: "2024-01-31T12:00:00Z", expected: "2024-02-01T00:00:00Z"},
+		{name: "day of month or weekday", expr: "0 0 13 * FRI", after: "2024-03-09T00:00:00Z", expected: "2024-03-13T00:00:00Z"},
+		{name: "leap day", expr: "0 0 29 2 *", after: "2024-03-01T00:00:00Z", expected: "2028-02-29T00:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseCron(tt.expr, time.UTC)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			got, ok := s.Next(mustTime(t, tt.after))
+			if !ok {
+				t.Fatal("expected an occurrence")
+			}
+			if !got.Equal(mustTime(t, tt.expected)) {
+				t.Fatalf("expected %s, got %s", tt.expected, got.UTC().Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestCronNextInLocation(t *testing.T) {
+	loc, err := LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	s, err := ParseCron("0 9 * * *", loc)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	// 2024-03-10 is the spring-forward day; 09:00 EDT is 13:00 UTC.
+	got, _ := s.Next(mustTime(t, "2024-03-10T00:00:00Z"))
+	if want := mustTime(t, "2024-03-10T13:00:00Z"); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got.UTC())
+	}
+}
+
+func TestCronInvalid(t *testing.T) {
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * * * MOX", "5-1 * * * *", "*/0 * * * *"} {
+		if _, err := ParseCron(expr, time.UTC); err == nil {
+			t.Fatalf("expected error for %q", expr)
+		}
+	}
+	s, err := ParseCron("0 0 30 2 *", time.UTC)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if _, ok := s.Next(time.Now()); ok {
+		t.Fatal("expected no occurrence for February 30th")
+	}
+}
+
+func TestRRuleNext(t *testing.T) {
+	dtstart := mustTime(t, "2024-01-01T09:00:00Z") // a Monday
+	tests := []struct {
+		name     string
+		rule     string
+		after    string
+		expected string
+	}{
+		{name: "daily defaults to dtstart time", rule: "FREQ=DAILY", after: "2024-03-09T10:00:00Z", expected: "2024-03-10T09:00:00Z"},
+		{name: "every other week", rule: "FREQ=WEEKLY;INTERVAL=2", after: "2024-01-02T00:00:00Z", expected: "2024-01-15T09:00:00Z"},
+		{name: "weekly by day and hour", rule: "RRULE:FREQ=WEEKLY;BYDAY=TU,TH;BYHOUR=14;BYMINUTE=30", after: "2024-01-02T15:00:00Z", expected: "2024-01-04T14:30:00Z"},
+		{name: "first monday of month", rule: "FREQ=MONTHLY;BYDAY=1MO", after: "2024-01-02T00:00:00Z", expected: "2024-02-05T09:00:00Z"},
+		{name: "last friday of month", rule: "FREQ=MONTHLY;BYDAY=-1FR", after: "2024-01-02T00:00:00Z", expected: "2024-01-26T09:00:00Z"},
+		{name: "last day of month", rule: "FREQ=MONTHLY;BYMONTHDAY=-1", after: "2024-02-01T00:00:00Z", expected: "2024-02-29T09:00:00Z"},
+		{name: "quarterly", rule: "FREQ=MONTHLY;INTERVAL=3", after: "2024-01-01T09:00:00Z", expected: "2024-04-01T09:00:00Z"},
+		{name: "yearly by month", rule: "FREQ=YEARLY;BYMONTH=6;BYMONTHDAY=15", after: "2024-01-01T00:00:00Z", expected: "2024-06-15T09:00:00Z"},
+		{name: "before dtstart", rule: "FREQ=DAILY", after: "2023-06-01T00:00:00Z", expected: "2024-01-01T09:00:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := ParseRRule(tt.rule, dtstart, time.UTC)
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			got, ok := s.Next(mustTime(t, tt.after))
+			if !ok {
+				t.Fatal("expected an occurrence")
+			}
+			if !got.Equal(mustTime(t, tt.expected)) {
+				t.Fatalf("expected %s, got %s", tt.expected, got.UTC().Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestRRuleUntil(t *testing.T) {
+	dtstart := mustTime(t, "2024-01-01T09:00:00Z")
+	s, err := ParseRRule("FREQ=DAILY;UNTIL=20240103", dtstart, time.UTC)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	if got, ok := s.Next(mustTime(t, "2024-01-02T10:00:00Z")); !ok || !got.Equal(mustTime(t, "2024-01-03T09:00:00Z")) {
+		t.Fatalf("expected last occurrence on Jan 3, got %s (%v)", got, ok)
+	}
+	if _, ok := s.Next(mustTime(t, "2024-01-03T10:00:00Z")); ok {
+		t.Fatal("expected no occurrence after UNTIL")
+	}
+}
+
+func TestRRuleInvalid(t *testing.T) {
+	dtstart := time.Now()
+	for _, rule := range []string{"", "INTERVAL=2", "FREQ=HOURLY", "FREQ=DAILY;COUNT=3", "FREQ=WEEKLY;BYDAY=1MO", "FREQ=DAILY;BYMONTHDAY=0", "FREQ=DAILY;FOO=1"} {
+		if _, err := ParseRRule(rule, dtstart, time.UTC); err == nil {
+			t.Fatalf("expected error for %q", rule)
+		}
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	if _, err := Parse("cron", "@daily", time.Time{}, nil); err != nil {
+		t.Fatalf("cron: %v", err)
+	}
+	if _, err := Parse("rrule", "FREQ=DAILY", time.Now(), nil); err != nil {
+		t.Fatalf("rrule: %v", err)
+	}
+	if _, err := Parse("interval", "5m", time.Time{}, nil); err == nil {
+		t.Fatal("expected error for unknown kind")
+	}
+}
+
+func TestPeriodDays(t *testing.T) {
+	dtstart := mustTime(t, "2024-01-01T09:00:00Z")
+	tests := []struct {
+		kind, expr string
+		want       int
+	}{
+		{kind: KindCron, expr: "0 * * * *", want: 7},
+		{kind: KindCron, expr: "30 8 * * MON-FRI", want: 7},
+		{kind: KindCron, expr: "@monthly", want: 0},
+		{kind: KindRRule, expr: "FREQ=DAILY;INTERVAL=3", want: 3},
+		{kind: KindRRule, expr: "FREQ=DAILY;INTERVAL=2;BYDAY=MO,WE", want: 14},
+		{kind: KindRRule, expr: "FREQ=WEEKLY;INTERVAL=2", want: 14},
+		{kind: KindRRule, expr: "FREQ=MONTHLY;BYDAY=1MO", want: 0},
+		{kind: KindRRule, expr: "FREQ=DAILY;UNTIL=20250101", want: 0},
+	}
+	for _, tt := range tests {
+		s, err := Parse(tt.kind, tt.expr, dtstart, time.UTC)
+		if err != nil {
+			t.Fatalf("parse %q: %v", tt.expr, err)
+		}
+		if got, _ := PeriodDays(s); got != tt.want {
+			t.Fatalf("%q: expected %d, got %d", tt.expr, tt.want, got)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 	"time"
 
@@ -9,8 +10,40 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+const (
+	AiTriageProviderHeuristic = "heuristic"
+	AiTriageProviderHTTP      = "http"
+
+	DefaultAiTriagePromptVersion = "triage-v1"
+	DefaultAiTriageTimeoutMS     = 10000
+)
+
+// AiTriageSettings controls triage for a project. EndpointURL, APIKey and
+// Model are only used by the http provider; APIKey is never returned by the
+// API. When AutoApply is set, suggested fields
+// whose confidence reaches their threshold in AutoApplyThresholds are applied
+// to new tickets; fields without a threshold are never applied.
 type AiTriageSettings struct {
-	Enabled bool
+	Enabled             bool
+	Provider            string
+	EndpointURL         *string
+	APIKey              *string
+	Model               *string
+	TimeoutMS           int
+	PromptVersion       string
+	AutoApply           bool
+	AutoApplyThresholds map[string]float32
+}
+
+// DefaultAiTriageSettings are the settings of a project that has never
+// configured triage.
+func DefaultAiTriageSettings() AiTriageSettings {
+	return AiTriageSettings{
+		Provider:            AiTriageProviderHeuristic,
+		TimeoutMS:           DefaultAiTriageTimeoutMS,
+		PromptVersion:       DefaultAiTriagePromptVersion,
+		AutoApplyThresholds: map[string]float32{},
+	}
 }
 
 type AiTriageSuggestion struct {
@@ -20,10 +53,14 @@ type AiTriageSuggestion struct {
 	Priority           string
 	StateID            uuid.UUID
 	AssigneeID         *uuid.UUID
+	TemplateID         *uuid.UUID
+	Type               *string
 	ConfidenceSummary  float32
 	ConfidencePriority float32
 	ConfidenceState    float32
 	ConfidenceAssignee float32
+	ConfidenceTemplate float32
+	ConfidenceType     float32
 	PromptVersion      string
 	Model              string
 	CreatedAt          time.Time
@@ -38,10 +75,14 @@ type AiTriageSuggestionCreateInput struct {
 	Priority           string
 	StateID            uuid.UUID
 	AssigneeID         *uuid.UUID
+	TemplateID         *uuid.UUID
+	Type               *string
 	ConfidenceSummary  float32
 	ConfidencePriority float32
 	ConfidenceState    float32
 	ConfidenceAssignee float32
+	ConfidenceTemplate float32
+	ConfidenceType     float32
 	PromptVersion      string
 	Model              string
 }
@@ -53,6 +94,7 @@ type AiTriageSuggestionDecision struct {
 	ActorID        uuid.UUID
 	AcceptedFields []string
 	RejectedFields []string
+	AutoApplied    bool
 	CreatedAt      time.Time
 }
 
@@ -60,26 +102,44 @@ type AiTriageSuggestionDecisionCreateInput struct {
 	ActorID        uuid.UUID
 	AcceptedFields []string
 	RejectedFields []string
+	// AutoApplied marks the decision auto-triage records for the fields it
+	// applied, which is not a person's judgement of the suggestion.
+	AutoApplied bool
 }
 
 func (s *Store) GetAiTriageSettings(ctx context.Context, projectID uuid.UUID) (AiTriageSettings, error) {
-	var enabled bool
-	err := s.db.QueryRow(ctx, mustSQL("ai_triage_settings_get", nil), projectID).Scan(&enabled)
+	settings, err := queryOne(ctx, s.db, mustSQL("ai_triage_settings_get", nil), scanAiTriageSettings, projectID)
 	if err == pgx.ErrNoRows {
-		return AiTriageSettings{Enabled: false}, nil
+		return DefaultAiTriageSettings(), nil
 	}
 	if err != nil {
 		return AiTriageSettings{}, err
 	}
-	return AiTriageSettings{Enabled: enabled}, nil
+	return settings, nil
 }
 
-func (s *Store) UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, enabled bool) (AiTriageSettings, error) {
-	var updated bool
-	if err := s.db.QueryRow(ctx, mustSQL("ai_triage_settings_upsert", nil), projectID, enabled).Scan(&updated); err != nil {
+// UpdateAiTriageSettings replaces a project's triage settings.
+func (s *Store) UpdateAiTriageSettings(ctx context.Context, projectID uuid.UUID, settings AiTriageSettings) (AiTriageSettings, error) {
+	thresholds := settings.AutoApplyThresholds
+	if thresholds == nil {
+		thresholds = map[string]float32{}
+	}
+	thresholdsJSON, err := json.Marshal(thresholds)
+	if err != nil {
 		return AiTriageSettings{}, err
 	}
-	return AiTriageSettings{Enabled: updated}, nil
+	return queryOne(ctx, s.db, mustSQL("ai_triage_settings_upsert", nil), scanAiTriageSettings,
+		projectID,
+		settings.Enabled,
+		settings.Provider,
+		settings.EndpointURL,
+		settings.Model,
+		settings.TimeoutMS,
+		settings.PromptVersion,
+		settings.AutoApply,
+		thresholdsJSON,
+		settings.APIKey,
+	)
 }
 
 func (s *Store) CreateAiTriageSuggestion(ctx context.Context, projectID uuid.UUID, input AiTriageSuggestionCreateInput) (AiTriageSuggestion, error) {
@@ -105,6 +165,10 @@ func (s *Store) CreateAiTriageSuggestion(ctx context.Context, projectID uuid.UUI
 		input.ConfidenceAssignee,
 		input.PromptVersion,
 		input.Model,
+		input.TemplateID,
+		input.ConfidenceTemplate,
+		input.Type,
+		input.ConfidenceType,
 	).Scan(&id, &createdAt)
 	if err != nil {
 		return AiTriageSuggestion{}, err
@@ -121,10 +185,14 @@ func (s *Store) GetAiTriageSuggestion(ctx context.Context, projectID, suggestion
 		&item.Priority,
 		&item.StateID,
 		&item.AssigneeID,
+		&item.TemplateID,
+		&item.Type,
 		&item.ConfidenceSummary,
 		&item.ConfidencePriority,
 		&item.ConfidenceState,
 		&item.ConfidenceAssignee,
+		&item.ConfidenceTemplate,
+		&item.ConfidenceType,
 		&item.PromptVersion,
 		&item.Model,
 		&item.CreatedAt,
@@ -142,6 +210,7 @@ func (s *Store) CreateAiTriageSuggestionDecision(ctx context.Context, projectID,
 	decision.ActorID = input.ActorID
 	decision.AcceptedFields = accepted
 	decision.RejectedFields = rejected
+	decision.AutoApplied = input.AutoApplied
 
 	err := s.db.QueryRow(
 		ctx,
@@ -151,6 +220,7 @@ func (s *Store) CreateAiTriageSuggestionDecision(ctx context.Context, projectID,
 		input.ActorID,
 		accepted,
 		rejected,
+		input.AutoApplied,
 	).Scan(&decision.ID, &decision.CreatedAt)
 	if err != nil {
 		return AiTriageSuggestionDecision{}, err
@@ -158,6 +228,32 @@ func (s *Store) CreateAiTriageSuggestionDecision(ctx context.Context, projectID,
 	return decision, nil
 }
 
+func scanAiTriageSettings(row pgx.Row) (AiTriageSettings, error) {
+	var out AiTriageSettings
+	var thresholds []byte
+	err := row.Scan(
+		&out.Enabled,
+		&out.Provider,
+		&out.EndpointURL,
+		&out.APIKey,
+		&out.Model,
+		&out.TimeoutMS,
+		&out.PromptVersion,
+		&out.AutoApply,
+		&thresholds,
+	)
+	if err != nil {
+		return out, err
+	}
+	out.AutoApplyThresholds = map[string]float32{}
+	if len(thresholds) > 0 {
+		if err := json.Unmarshal(thresholds, &out.AutoApplyThresholds); err != nil {
+			return out, err
+		}
+	}
+	return out, nil
+}
+
 func dedupeAiFields(values []string) []string {
 	seen := map[string]struct{}{}
 	out := make([]string, 0, len(values))
@@ -0,0 +1,184 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// aiTriageCalibrationBuckets is the number of equal-width confidence buckets
+// in the calibration breakdown.
+const aiTriageCalibrationBuckets = 10
+
+// AiTriageFieldOutcome is whether one field of a suggestion was accepted.
+type AiTriageFieldOutcome struct {
+	DecidedAt     time.Time
+	PromptVersion string
+	Model         string
+	Field         string
+	Accepted      bool
+	Confidence    float32
+}
+
+type AiTriageAcceptance struct {
+	Accepted int
+	Rejected int
+}
+
+// Rate is the share of decided fields that were accepted, or 0 when none
+// were decided.
+func (a AiTriageAcceptance) Rate() float64 {
+	if a.Accepted+a.Rejected == 0 {
+		return 0
+	}
+	return float64(a.Accepted) / float64(a.Accepted+a.Rejected)
+}
+
+func (a *AiTriageAcceptance) add(accepted bool) {
+	if accepted {
+		a.Accepted++
+	} else {
+		a.Rejected++
+	}
+}
+
+type AiTriageFieldAcceptance struct {
+	Field string
+	AiTriageAcceptance
+}
+
+type AiTriageAcceptancePoint struct {
+	Date   time.Time
+	Fields []AiTriageFieldAcceptance
+}
+
+type AiTriageVersionAccuracy struct {
+	PromptVersion string
+	Model         string
+	AiTriageAcceptance
+}
+
+// AiTriageCalibrationBucket covers confidences in [MinConfidence,
+// MaxConfidence); the last bucket includes 1.
+type AiTriageCalibrationBucket struct {
+	MinConfidence     float64
+	MaxConfidence     float64
+	AverageConfidence float64
+	AiTriageAcceptance
+}
+
+type AiTriageAccuracyReport struct {
+	From        time.Time
+	To          time.Time
+	Fields      []AiTriageFieldAcceptance
+	Points      []AiTriageAcceptancePoint
+	Versions    []AiTriageVersionAccuracy
+	Calibration []AiTriageCalibrationBucket
+}
+
+// GetAiTriageAccuracy reports how often suggested fields were accepted in
+// decisions recorded in the range. Only the latest decision on each
+// suggestion counts. A non-nil field narrows the report to that field.
+func (s *Store) GetAiTriageAccuracy(ctx context.Context, projectID uuid.UUID, from, to time.Time, field *string) (AiTriageAccuracyReport, error) {
+	from, to = normalizeReportingRange(from, to)
+	outcomes, err := queryMany(ctx, s.db, mustSQL("ai_triage_field_outcomes", nil), func(row pgx.Row) (AiTriageFieldOutcome, error) {
+		var out AiTriageFieldOutcome
+		err := row.Scan(&out.DecidedAt, &out.PromptVersion, &out.Model, &out.Field, &out.Accepted, &out.Confidence)
+		return out, err
+	}, projectID, from, to, field)
+	if err != nil {
+		return AiTriageAccuracyReport{From: from, To: to}, err
+	}
+	return BuildAiTriageAccuracy(from, to, outcomes), nil
+}
+
+// BuildAiTriageAccuracy aggregates field outcomes into acceptance per field,
+// a daily series, acceptance per prompt version and model, and acceptance by
+// confidence bucket. Days without decisions are included with no fields.
+func BuildAiTriageAccuracy(from, to time.Time, outcomes []AiTriageFieldOutcome) AiTriageAccuracyReport {
+	report := AiTriageAccuracyReport{From: from, To: to}
+
+	type versionKey struct{ promptVersion, model string }
+	totals := map[string]*AiTriageAcceptance{}
+	daily := map[string]map[string]*AiTriageAcceptance{}
+	versions := map[versionKey]*AiTriageAcceptance{}
+	buckets := make([]AiTriageCalibrationBucket, aiTriageCalibrationBuckets)
+	confidenceSums := make([]float64, aiTriageCalibrationBuckets)
+	for i := range buckets {
+		buckets[i].MinConfidence = float64(i) / aiTriageCalibrationBuckets
+		buckets[i].MaxConfidence = float64(i+1) / aiTriageCalibrationBuckets
+	}
+
+	for _, outcome := range outcomes {
+		if totals[outcome.Field] == nil {
+			totals[outcome.Field] = &AiTriageAcceptance{}
+		}
+		totals[outcome.Field].add(outcome.Accepted)
+
+		day := normalizeDateUTC(outcome.DecidedAt).Format("2006-01-02")
+		if daily[day] == nil {
+			daily[day] = map[string]*AiTriageAcceptance{}
+		}
+		if daily[day][outcome.Field] == nil {
+			daily[day][outcome.Field] = &AiTriageAcceptance{}
+		}
+		daily[day][outcome.Field].add(outcome.Accepted)
+
+		key := versionKey{outcome.PromptVersion, outcome.Model}
+		if versions[key] == nil {
+			versions[key] = &AiTriageAcceptance{}
+		}
+		versions[key].add(outcome.Accepted)
+
+		bucket := int(float64(outcome.Confidence) * aiTriageCalibrationBuckets)
+		if bucket < 0 {
+			bucket = 0
+		}
+		if bucket >= aiTriageCalibrationBuckets {
+			bucket = aiTriageCalibrationBuckets - 1
+		}
+		buckets[bucket].add(outcome.Accepted)
+		confidenceSums[bucket] += float64(outcome.Confidence)
+	}
+
+	report.Fields = sortedFieldAcceptance(totals)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		report.Points = append(report.Points, AiTriageAcceptancePoint{
+			Date:   day,
+			Fields: sortedFieldAcceptance(daily[day.Format("2006-01-02")]),
+		})
+	}
+	report.Versions = make([]AiTriageVersionAccuracy, 0, len(versions))
+	for key, acceptance := range versions {
+		report.Versions = append(report.Versions, AiTriageVersionAccuracy{
+			PromptVersion:      key.promptVersion,
+			Model:              key.model,
+			AiTriageAcceptance: *acceptance,
+		})
+	}
+	sort.Slice(report.Versions, func(i, j int) bool {
+		if report.Versions[i].PromptVersion != report.Versions[j].PromptVersion {
+			return report.Versions[i].PromptVersion < report.Versions[j].PromptVersion
+		}
+		return report.Versions[i].Model < report.Versions[j].Model
+	})
+	for i := range buckets {
+		if count := buckets[i].Accepted + buckets[i].Rejected; count > 0 {
+			buckets[i].AverageConfidence = confidenceSums[i] / float64(count)
+		}
+	}
+	report.Calibration = buckets
+	return report
+}
+
+func sortedFieldAcceptance(byField map[string]*AiTriageAcceptance) []AiTriageFieldAcceptance {
+	out := make([]AiTriageFieldAcceptance, 0, len(byField))
+	for field, acceptance := range byField {
+		out = append(out, AiTriageFieldAcceptance{Field: field, AiTriageAcceptance: *acceptance})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
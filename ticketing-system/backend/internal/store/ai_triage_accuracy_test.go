@@ -0,0 +1,43 @@
+package store
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestBuildAiTriageAccuracy(t *testing.T) {
+	from := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 2)
+	outcomes := []AiTriageFieldOutcome{
+		{DecidedAt: from.Add(9 * time.Hour), PromptVersion: "triage-v1", Model: "heuristic-local-v1", Field: "priority", Accepted: true, Confidence: 0.92},
+		{DecidedAt: from.Add(9 * time.Hour), PromptVersion: "triage-v1", Model: "heuristic-local-v1", Field: "assignee", Accepted: false, Confidence: 0.15},
+		{DecidedAt: to.Add(10 * time.Hour), PromptVersion: "triage-v1", Model: "naive-bayes-v1", Field: "priority", Accepted: false, Confidence: 0.98},
+		{DecidedAt: to.Add(11 * time.Hour), PromptVersion: "triage-v1", Model: "naive-bayes-v1", Field: "priority", Accepted: true, Confidence: 1},
+	}
+
+	got := BuildAiTriageAccuracy(from, to, outcomes)
+
+	if len(got.Fields) != 2 || got.Fields[0].Field != "assignee" || got.Fields[1].Field != "priority" {
+		t.Fatalf("unexpected fields %+v", got.Fields)
+	}
+	if priority := got.Fields[1]; priority.Accepted != 2 || priority.Rejected != 1 || math.Abs(priority.Rate()-2.0/3) > 1e-9 {
+		t.Fatalf("unexpected priority acceptance %+v", priority)
+	}
+	if len(got.Points) != 3 || len(got.Points[0].Fields) != 2 || len(got.Points[1].Fields) != 0 || len(got.Points[2].Fields) != 1 {
+		t.Fatalf("unexpected series %+v", got.Points)
+	}
+	if len(got.Versions) != 2 || got.Versions[0].Model != "heuristic-local-v1" || got.Versions[1].Accepted != 1 || got.Versions[1].Rejected != 1 {
+		t.Fatalf("unexpected versions %+v", got.Versions)
+	}
+	if len(got.Calibration) != 10 {
+		t.Fatalf("expected 10 calibration buckets, got %d", len(got.Calibration))
+	}
+	top := got.Calibration[9]
+	if top.Accepted != 2 || top.Rejected != 1 || math.Abs(top.AverageConfidence-(0.92+0.98+1)/3) > 1e-6 {
+		t.Fatalf("unexpected top bucket %+v", top)
+	}
+	if bottom := got.Calibration[1]; bottom.Rejected != 1 || bottom.MinConfidence != 0.1 {
+		t.Fatalf("unexpected low bucket %+v", bottom)
+	}
+}
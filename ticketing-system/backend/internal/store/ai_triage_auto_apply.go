@@ -0,0 +1,95 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// AiTriageAppliedField is one field auto-triage changed on a ticket. From
+// and To are raw values: state and assignee IDs, priority and type names. A
+// nil From means the field was unset.
+type AiTriageAppliedField struct {
+	Field string  `json:"field"`
+	From  *string `json:"from,omitempty"`
+	To    *string `json:"to,omitempty"`
+}
+
+// AiTriageAutoApplication records the fields auto-triage applied to a new
+// ticket from a suggestion.
+type AiTriageAutoApplication struct {
+	TicketID     uuid.UUID
+	ProjectID    uuid.UUID
+	SuggestionID uuid.UUID
+	Fields       []AiTriageAppliedField
+	AppliedAt    time.Time
+	RevertedAt   *time.Time
+}
+
+func (s *Store) CreateAiTriageAutoApplication(ctx context.Context, projectID, ticketID, suggestionID uuid.UUID, fields []AiTriageAppliedField) (AiTriageAutoApplication, error) {
+	if fields == nil {
+		fields = []AiTriageAppliedField{}
+	}
+	payload, err := json.Marshal(fields)
+	if err != nil {
+		return AiTriageAutoApplication{}, err
+	}
+	return queryOne(ctx, s.db, mustSQL("ai_triage_auto_application_insert", nil), scanAiTriageAutoApplication, ticketID, projectID, suggestionID, payload)
+}
+
+// GetAiTriageAutoApplication returns pgx.ErrNoRows if auto-triage did not
+// change the ticket.
+func (s *Store) GetAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID) (AiTriageAutoApplication, error) {
+	return queryOne(ctx, s.db, mustSQL("ai_triage_auto_application_get", nil), scanAiTriageAutoApplication, projectID, ticketID)
+}
+
+// RevertAiTriageAutoApplication marks the auto application reverted and
+// applies the update restore builds from it and the current ticket, in one
+// transaction, so a failed restore leaves the application revertable. It
+// returns the ticket before and after the restore, and pgx.ErrNoRows if there
+// is no application or it was already reverted.
+func (s *Store) RevertAiTriageAutoApplication(ctx context.Context, projectID, ticketID uuid.UUID, restore func(AiTriageAutoApplication, Ticket) (TicketUpdateInput, bool)) (AiTriageAutoApplication, Ticket, Ticket, error) {
+	type result struct {
+		application AiTriageAutoApplication
+		before      Ticket
+	}
+	res, err := withTx(ctx, s.db, func(tx pgx.Tx) (result, error) {
+		application, err := queryOne(ctx, tx, mustSQL("ai_triage_auto_application_revert", nil), scanAiTriageAutoApplication, projectID, ticketID)
+		if err != nil {
+			return result{}, err
+		}
+		before, err := queryOne(ctx, tx, mustSQL("tickets_get", nil), scanTicket, ticketID)
+		if err != nil {
+			return result{}, err
+		}
+		if update, ok := restore(application, before); ok {
+			if err := s.updateTicketTx(ctx, tx, ticketID, update); err != nil {
+				return result{}, err
+			}
+		}
+		return result{application: application, before: before}, nil
+	})
+	if err != nil {
+		return AiTriageAutoApplication{}, Ticket{}, Ticket{}, err
+	}
+	after, err := s.GetTicket(ctx, ticketID)
+	if err != nil {
+		return AiTriageAutoApplication{}, Ticket{}, Ticket{}, err
+	}
+	return res.application, res.before, after, nil
+}
+
+func scanAiTriageAutoApplication(row pgx.Row) (AiTriageAutoApplication, error) {
+	var out AiTriageAutoApplication
+	var fields []byte
+	if err := row.Scan(&out.TicketID, &out.ProjectID, &out.SuggestionID, &fields, &out.AppliedAt, &out.RevertedAt); err != nil {
+		return out, err
+	}
+	if err := json.Unmarshal(fields, &out.Fields); err != nil {
+		return out, err
+	}
+	return out, nil
+}
@@ -0,0 +1,111 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const AiTriageProviderLearned = "learned"
+
+// AiTriageModelTrainingLease is how long a project claimed for training stays
+// locked to one trainer.
+const AiTriageModelTrainingLease = 30 * time.Minute
+
+// AiTriageTrainingTicket is a closed ticket as the learned provider sees it.
+type AiTriageTrainingTicket struct {
+	Title       string
+	Description string
+	Priority    string
+	Type        string
+	AssigneeID  *uuid.UUID
+}
+
+// AiTriageFeedback is a recorded decision on a suggestion, with the
+// suggestion's input and suggested values.
+type AiTriageFeedback struct {
+	Title          string
+	Description    *string
+	Priority       string
+	Type           *string
+	AssigneeID     *uuid.UUID
+	AcceptedFields []string
+	RejectedFields []string
+}
+
+// AiTriageModel is a project's trained triage model. Model is opaque to the
+// store.
+type AiTriageModel struct {
+	ProjectID     uuid.UUID
+	Model         json.RawMessage
+	ExampleCount  int
+	FeedbackCount int
+	TrainedAt     time.Time
+}
+
+// ListAiTriageTrainingTickets returns up to limit closed tickets, most
+// recently updated first.
+func (s *Store) ListAiTriageTrainingTickets(ctx context.Context, projectID uuid.UUID, limit int) ([]AiTriageTrainingTicket, error) {
+	return queryMany(ctx, s.db, mustSQL("ai_triage_training_tickets", nil), func(row pgx.Row) (AiTriageTrainingTicket, error) {
+		var out AiTriageTrainingTicket
+		err := row.Scan(&out.Title, &out.Description, &out.Priority, &out.Type, &out.AssigneeID)
+		return out, err
+	}, projectID, limit)
+}
+
+// ListAiTriageFeedback returns up to limit suggestion decisions, newest
+// first.
+func (s *Store) ListAiTriageFeedback(ctx context.Context, projectID uuid.UUID, limit int) ([]AiTriageFeedback, error) {
+	return queryMany(ctx, s.db, mustSQL("ai_triage_feedback", nil), func(row pgx.Row) (AiTriageFeedback, error) {
+		var out AiTriageFeedback
+		err := row.Scan(
+			&out.Title,
+			&out.Description,
+			&out.Priority,
+			&out.Type,
+			&out.AssigneeID,
+			&out.AcceptedFields,
+			&out.RejectedFields,
+		)
+		return out, err
+	}, projectID, limit)
+}
+
+// GetAiTriageModel returns pgx.ErrNoRows if the project has no trained
+// model yet.
+func (s *Store) GetAiTriageModel(ctx context.Context, projectID uuid.UUID) (AiTriageModel, error) {
+	return queryOne(ctx, s.db, mustSQL("ai_triage_model_get", nil), scanAiTriageModel, projectID)
+}
+
+// GetAiTriageModelTrainedAt returns when the project's model was trained,
+// without loading it, or pgx.ErrNoRows if there is none.
+func (s *Store) GetAiTriageModelTrainedAt(ctx context.Context, projectID uuid.UUID) (time.Time, error) {
+	var trainedAt time.Time
+	err := s.db.QueryRow(ctx, mustSQL("ai_triage_model_trained_at", nil), projectID).Scan(&trainedAt)
+	return trainedAt, err
+}
+
+func (s *Store) SaveAiTriageModel(ctx context.Context, projectID uuid.UUID, model json.RawMessage, exampleCount, feedbackCount int) (AiTriageModel, error) {
+	return queryOne(ctx, s.db, mustSQL("ai_triage_model_upsert", nil), scanAiTriageModel, projectID, model, exampleCount, feedbackCount)
+}
+
+// ClaimDueAiTriageModels leases up to limit projects using the learned
+// provider whose model is missing or older than maxAge, stalest first,
+// skipping projects another trainer holds. Saving the model releases the
+// lease; a failed run keeps it until it expires.
+func (s *Store) ClaimDueAiTriageModels(ctx context.Context, maxAge time.Duration, limit int) ([]uuid.UUID, error) {
+	return queryMany(ctx, s.db, mustSQL("ai_triage_models_claim_due", nil), func(row pgx.Row) (uuid.UUID, error) {
+		var id uuid.UUID
+		err := row.Scan(&id)
+		return id, err
+	}, maxAge.Seconds(), AiTriageModelTrainingLease.Seconds(), limit)
+}
+
+func scanAiTriageModel(row pgx.Row) (AiTriageModel, error) {
+	var out AiTriageModel
+	err := row.Scan(&out.ProjectID, &out.Model, &out.ExampleCount, &out.FeedbackCount, &out.TrainedAt)
+	return out, err
+}
@@ -0,0 +1,557 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/schedule"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	AutomationTriggerTicketCreated  = "ticket.created"
+	AutomationTriggerTicketUpdated  = "ticket.updated"
+	AutomationTriggerStateChanged   = "ticket.state_changed"
+	AutomationTriggerCommentCreated = "comment.created"
+	AutomationTriggerSchedule       = "schedule"
+)
+
+const (
+	AutomationActionSetField           = "set_field"
+	AutomationActionAssign             = "assign"
+	AutomationActionAddComment         = "add_comment"
+	AutomationActionMoveState          = "move_state"
+	AutomationActionNotify             = "notify"
+	AutomationActionCallWebhook        = "call_webhook"
+	AutomationActionCreateLinkedTicket = "create_linked_ticket"
+)
+
+const (
+	AutomationRunSucceeded = "succeeded"
+	AutomationRunFailed    = "failed"
+	AutomationRunSkipped   = "skipped"
+)
+
+// AutomationRuleLease is how long a claimed scheduled rule stays locked to one
+// scheduler.
+const AutomationRuleLease = 5 * time.Minute
+
+const (
+	maxAutomationActions    = 10
+	defaultAutomationRunLog = 50
+	maxAutomationRunLog     = 200
+)
+
+type AutomationRule struct {
+	ID           uuid.UUID
+	ProjectID    uuid.UUID
+	Name         string
+	Trigger      string
+	Conditions   BoardFilter
+	Actions      []AutomationAction
+	ScheduleKind *string
+	Schedule     *string
+	Timezone     string
+	Enabled      bool
+	NextRunAt    *time.Time
+	LastRunAt    *time.Time
+	CreatedBy    *uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// AutomationAction is one step a rule performs. Which fields apply depends on
+// Type; Message and Title may use the placeholders {key}, {title} and {rule}.
+type AutomationAction struct {
+	Type         string     `json:"type"`
+	Field        string     `json:"field,omitempty"`
+	Value        string     `json:"value,omitempty"`
+	UserID       *uuid.UUID `json:"userId,omitempty"`
+	StateID      *uuid.UUID `json:"stateId,omitempty"`
+	Message      string     `json:"message,omitempty"`
+	Title        string     `json:"title,omitempty"`
+	RelationType string     `json:"relationType,omitempty"`
+}
+
+type AutomationRuleCreateInput struct {
+	Name         string
+	Trigger      string
+	Conditions   BoardFilter
+	Actions      []AutomationAction
+	ScheduleKind *string
+	Schedule     *string
+	Timezone     string
+	Enabled      bool
+	CreatedBy    *uuid.UUID
+}
+
+type AutomationRuleUpdateInput struct {
+	Name         *string
+	Trigger      *string
+	Conditions   *BoardFilter
+	Actions      *[]AutomationAction
+	ScheduleKind *string
+	Schedule     *string
+	Timezone     *string
+	Enabled      *bool
+}
+
+// AutomationRun is one entry in a project's automation execution log.
+type AutomationRun struct {
+	ID        uuid.UUID
+	RuleID    uuid.UUID
+	ProjectID uuid.UUID
+	TicketID  *uuid.UUID
+	Trigger   string
+	Status    string
+	Depth     int
+	Results   []AutomationActionResult
+	Error     *string
+	CreatedAt time.Time
+}
+
+type AutomationActionResult struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+type AutomationRunCreateInput struct {
+	RuleID    uuid.UUID
+	ProjectID uuid.UUID
+	TicketID  *uuid.UUID
+	Trigger   string
+	Status    string
+	Depth     int
+	Results   []AutomationActionResult
+	Error     *string
+}
+
+type AutomationRunFilter struct {
+	ProjectID uuid.UUID
+	RuleID    *uuid.UUID
+	Limit     int
+}
+
+func (s *Store) ListAutomationRules(ctx context.Context, projectID uuid.UUID) ([]AutomationRule, error) {
+	query := mustSQL("automation_rules_list", nil)
+	return queryMany(ctx, s.db, query, scanAutomationRule, projectID)
+}
+
+// ListAutomationRulesForTrigger returns the enabled rules of a project that
+// fire on trigger, oldest first.
+func (s *Store) ListAutomationRulesForTrigger(ctx context.Context, projectID uuid.UUID, trigger string) ([]AutomationRule, error) {
+	query := mustSQL("automation_rules_list_for_trigger", nil)
+	return queryMany(ctx, s.db, query, scanAutomationRule, projectID, trigger)
+}
+
+func (s *Store) GetAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) (AutomationRule, error) {
+	query := mustSQL("automation_rules_get", nil)
+	return queryOne(ctx, s.db, query, scanAutomationRule, projectID, ruleID)
+}
+
+func (s *Store) CreateAutomationRule(ctx context.Context, projectID uuid.UUID, input AutomationRuleCreateInput) (AutomationRule, error) {
+	rule := AutomationRule{
+		ProjectID:    projectID,
+		Name:         strings.TrimSpace(input.Name),
+		Trigger:      strings.TrimSpace(input.Trigger),
+		Conditions:   input.Conditions,
+		Actions:      input.Actions,
+		ScheduleKind: input.ScheduleKind,
+		Schedule:     input.Schedule,
+		Timezone:     strings.TrimSpace(input.Timezone),
+		Enabled:      input.Enabled,
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := prepareAutomationRule(ctx, s.db, &rule); err != nil {
+		return AutomationRule{}, err
+	}
+	conditions, actions, err := marshalAutomationRule(rule)
+	if err != nil {
+		return AutomationRule{}, err
+	}
+
+	query := mustSQL("automation_rules_insert", nil)
+	return queryOne(ctx, s.db, query, scanAutomationRule,
+		projectID,
+		rule.Name,
+		rule.Trigger,
+		conditions,
+		actions,
+		rule.ScheduleKind,
+		rule.Schedule,
+		rule.Timezone,
+		rule.Enabled,
+		rule.NextRunAt,
+		input.CreatedBy,
+	)
+}
+
+// UpdateAutomationRule applies input and, for scheduled rules, recomputes the
+// next run from now.
+func (s *Store) UpdateAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID, input AutomationRuleUpdateInput) (AutomationRule, error) {
+	return withTx(ctx, s.db, func(tx pgx.Tx) (AutomationRule, error) {
+		query := mustSQL("automation_rules_get", map[string]any{"ForUpdate": true})
+		rule, err := queryOne(ctx, tx, query, scanAutomationRule, projectID, ruleID)
+		if err != nil {
+			return AutomationRule{}, err
+		}
+
+		if input.Name != nil {
+			rule.Name = strings.TrimSpace(*input.Name)
+		}
+		if input.Trigger != nil {
+			rule.Trigger = strings.TrimSpace(*input.Trigger)
+		}
+		if input.Conditions != nil {
+			rule.Conditions = *input.Conditions
+		}
+		if input.Actions != nil {
+			rule.Actions = *input.Actions
+		}
+		if input.ScheduleKind != nil {
+			rule.ScheduleKind = input.ScheduleKind
+		}
+		if input.Schedule != nil {
+			rule.Schedule = input.Schedule
+		}
+		if input.Timezone != nil {
+			rule.Timezone = strings.TrimSpace(*input.Timezone)
+		}
+		if input.Enabled != nil {
+			rule.Enabled = *input.Enabled
+		}
+		if err := prepareAutomationRule(ctx, tx, &rule); err != nil {
+			return AutomationRule{}, err
+		}
+		conditions, actions, err := marshalAutomationRule(rule)
+		if err != nil {
+			return AutomationRule{}, err
+		}
+
+		update := mustSQL("automation_rules_update", nil)
+		return queryOne(ctx, tx, update, scanAutomationRule,
+			projectID,
+			ruleID,
+			rule.Name,
+			rule.Trigger,
+			conditions,
+			actions,
+			rule.ScheduleKind,
+			rule.Schedule,
+			rule.Timezone,
+			rule.Enabled,
+			rule.NextRunAt,
+		)
+	})
+}
+
+func (s *Store) DeleteAutomationRule(ctx context.Context, projectID, ruleID uuid.UUID) error {
+	query := mustSQL("automation_rules_delete", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, projectID, ruleID)
+}
+
+// ClaimDueAutomationRules leases up to limit scheduled rules whose next run
+// has passed, skipping rows another scheduler holds.
+func (s *Store) ClaimDueAutomationRules(ctx context.Context, limit int) ([]AutomationRule, error) {
+	query := mustSQL("automation_rules_claim_due", nil)
+	return queryMany(ctx, s.db, query, scanAutomationRule, limit, AutomationRuleLease.Seconds())
+}
+
+// CompleteScheduledAutomationRule records a scheduled run and releases the
+// lease. A nil nextRunAt stops the schedule.
+func (s *Store) CompleteScheduledAutomationRule(ctx context.Context, ruleID uuid.UUID, nextRunAt *time.Time) error {
+	query := mustSQL("automation_rules_complete", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, ruleID, nextRunAt)
+}
+
+func (s *Store) CreateAutomationRun(ctx context.Context, input AutomationRunCreateInput) (AutomationRun, error) {
+	results := input.Results
+	if results == nil {
+		results = []AutomationActionResult{}
+	}
+	payload, err := json.Marshal(results)
+	if err != nil {
+		return AutomationRun{}, err
+	}
+	query := mustSQL("automation_rule_runs_insert", nil)
+	return queryOne(ctx, s.db, query, scanAutomationRun,
+		input.RuleID,
+		input.ProjectID,
+		input.TicketID,
+		input.Trigger,
+		input.Status,
+		input.Depth,
+		payload,
+		input.Error,
+	)
+}
+
+// ListAutomationRuns returns the newest execution log entries of a project,
+// optionally limited to one rule.
+func (s *Store) ListAutomationRuns(ctx context.Context, filter AutomationRunFilter) ([]AutomationRun, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAutomationRunLog
+	}
+	if filter.Limit > maxAutomationRunLog {
+		filter.Limit = maxAutomationRunLog
+	}
+	args := []any{filter.ProjectID, filter.Limit}
+	if filter.RuleID != nil {
+		args = append(args, *filter.RuleID)
+	}
+	query := mustSQL("automation_rule_runs_list", map[string]any{"ByRule": filter.RuleID != nil})
+	return queryMany(ctx, s.db, query, scanAutomationRun, args...)
+}
+
+// NextAutomationRuleRun returns the first occurrence of a scheduled rule after
+// after, or nil when the schedule has ended. RRULEs are anchored at the rule's
+// creation time.
+func NextAutomationRuleRun(rule AutomationRule, after time.Time) (*time.Time, error) {
+	if rule.ScheduleKind == nil || rule.Schedule == nil {
+		return nil, errors.New("schedule required")
+	}
+	loc, err := schedule.LoadLocation(rule.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	sched, err := schedule.Parse(*rule.ScheduleKind, *rule.Schedule, rule.CreatedAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	next, ok := sched.Next(after)
+	if !ok {
+		return nil, nil
+	}
+	next = next.UTC()
+	return &next, nil
+}
+
+// RenderAutomationText expands the placeholders {key}, {title} and {rule} in
+// an action's message or title.
+func RenderAutomationText(text string, ticket Ticket, ruleName string) string {
+	replacer := strings.NewReplacer(
+		"{key}", ticket.Key,
+		"{title}", ticket.Title,
+		"{rule}", ruleName,
+	)
+	return strings.TrimSpace(replacer.Replace(text))
+}
+
+// prepareAutomationRule validates rule, normalizes its schedule fields and
+// sets NextRunAt.
+func prepareAutomationRule(ctx context.Context, q dbQuerier, rule *AutomationRule) error {
+	if rule.Name == "" {
+		return errors.New("name required")
+	}
+	switch rule.Trigger {
+	case AutomationTriggerTicketCreated, AutomationTriggerTicketUpdated, AutomationTriggerStateChanged,
+		AutomationTriggerCommentCreated, AutomationTriggerSchedule:
+	default:
+		return errors.New("invalid trigger")
+	}
+	if err := validateBoardFilter(rule.Conditions); err != nil {
+		return err
+	}
+	if len(rule.Actions) == 0 {
+		return errors.New("at least one action required")
+	}
+	if len(rule.Actions) > maxAutomationActions {
+		return fmt.Errorf("rules support at most %d actions", maxAutomationActions)
+	}
+	stateIDs := []uuid.UUID{}
+	if rule.Conditions.StateID != nil {
+		stateIDs = append(stateIDs, *rule.Conditions.StateID)
+	}
+	for i := range rule.Actions {
+		if err := normalizeAutomationAction(&rule.Actions[i]); err != nil {
+			return fmt.Errorf("action %d: %w", i+1, err)
+		}
+		if rule.Actions[i].StateID != nil {
+			stateIDs = append(stateIDs, *rule.Actions[i].StateID)
+		}
+	}
+
+	if rule.Timezone == "" {
+		rule.Timezone = "UTC"
+	}
+	rule.NextRunAt = nil
+	if rule.Trigger == AutomationTriggerSchedule {
+		if rule.ScheduleKind == nil || rule.Schedule == nil || strings.TrimSpace(*rule.Schedule) == "" {
+			return errors.New("scheduled rules require scheduleKind and schedule")
+		}
+		kind := strings.ToLower(strings.TrimSpace(*rule.ScheduleKind))
+		expr := strings.TrimSpace(*rule.Schedule)
+		rule.ScheduleKind, rule.Schedule = &kind, &expr
+		next, err := NextAutomationRuleRun(*rule, time.Now())
+		if err != nil {
+			return err
+		}
+		if rule.Enabled {
+			rule.NextRunAt = next
+		}
+	} else {
+		rule.ScheduleKind, rule.Schedule = nil, nil
+	}
+
+	if len(stateIDs) > 0 {
+		var ok bool
+		if err := q.QueryRow(ctx, mustSQL("automation_rules_states_valid", nil), rule.ProjectID, stateIDs).Scan(&ok); err != nil {
+			return err
+		}
+		if !ok {
+			return errors.New("state not found in project workflow")
+		}
+	}
+	return nil
+}
+
+func normalizeAutomationAction(action *AutomationAction) error {
+	action.Type = strings.TrimSpace(action.Type)
+	action.Message = strings.TrimSpace(action.Message)
+	action.Title = strings.TrimSpace(action.Title)
+	switch action.Type {
+	case AutomationActionSetField:
+		action.Field = strings.TrimSpace(action.Field)
+		action.Value = strings.TrimSpace(action.Value)
+		return validateAutomationField(action.Field, action.Value)
+	case AutomationActionAssign:
+		if action.UserID == nil {
+			return errors.New("assign requires userId")
+		}
+	case AutomationActionAddComment:
+		if action.Message == "" {
+			return errors.New("add_comment requires message")
+		}
+	case AutomationActionMoveState:
+		if action.StateID == nil {
+			return errors.New("move_state requires stateId")
+		}
+	case AutomationActionNotify:
+		if action.Message == "" {
+			return errors.New("notify requires message")
+		}
+	case AutomationActionCallWebhook:
+	case AutomationActionCreateLinkedTicket:
+		if action.Title == "" {
+			return errors.New("create_linked_ticket requires title")
+		}
+		action.RelationType = strings.ToLower(strings.TrimSpace(action.RelationType))
+		switch action.RelationType {
+		case "":
+			action.RelationType = DependencyRelationRelated
+		case DependencyRelationBlocks, DependencyRelationBlockedBy, DependencyRelationRelated:
+		default:
+			return errors.New("invalid relation type")
+		}
+	default:
+		return fmt.Errorf("unknown action type %q", action.Type)
+	}
+	return nil
+}
+
+func validateAutomationField(field, value string) error {
+	switch field {
+	case "priority":
+		_, err := normalizeTemplatePriority(&value)
+		if err == nil && value == "" {
+			err = errors.New("invalid priority")
+		}
+		return err
+	case "type":
+		if value == "" {
+			return errors.New("invalid ticket type")
+		}
+		_, err := normalizeTicketType(value)
+		return err
+	case "storyPoints", "timeEstimate":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 {
+			return fmt.Errorf("%s must be a non-negative integer", field)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported field %q", field)
+	}
+}
+
+func marshalAutomationRule(rule AutomationRule) ([]byte, []byte, error) {
+	conditions, err := json.Marshal(rule.Conditions)
+	if err != nil {
+		return nil, nil, err
+	}
+	actions, err := json.Marshal(rule.Actions)
+	if err != nil {
+		return nil, nil, err
+	}
+	return conditions, actions, nil
+}
+
+func scanAutomationRule(row pgx.Row) (AutomationRule, error) {
+	var rule AutomationRule
+	var conditionsRaw, actionsRaw []byte
+	if err := row.Scan(
+		&rule.ID,
+		&rule.ProjectID,
+		&rule.Name,
+		&rule.Trigger,
+		&conditionsRaw,
+		&actionsRaw,
+		&rule.ScheduleKind,
+		&rule.Schedule,
+		&rule.Timezone,
+		&rule.Enabled,
+		&rule.NextRunAt,
+		&rule.LastRunAt,
+		&rule.CreatedBy,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+	); err != nil {
+		return AutomationRule{}, err
+	}
+	if len(conditionsRaw) > 0 {
+		if err := json.Unmarshal(conditionsRaw, &rule.Conditions); err != nil {
+			return AutomationRule{}, err
+		}
+	}
+	rule.Actions = []AutomationAction{}
+	if len(actionsRaw) > 0 {
+		if err := json.Unmarshal(actionsRaw, &rule.Actions); err != nil {
+			return AutomationRule{}, err
+		}
+	}
+	return rule, nil
+}
+
+func scanAutomationRun(row pgx.Row) (AutomationRun, error) {
+	var run AutomationRun
+	var resultsRaw []byte
+	if err := row.Scan(
+		&run.ID,
+		&run.RuleID,
+		&run.ProjectID,
+		&run.TicketID,
+		&run.Trigger,
+		&run.Status,
+		&run.Depth,
+		&resultsRaw,
+		&run.Error,
+		&run.CreatedAt,
+	); err != nil {
+		return AutomationRun{}, err
+	}
+	run.Results = []AutomationActionResult{}
+	if len(resultsRaw) > 0 {
+		if err := json.Unmarshal(resultsRaw, &run.Results); err != nil {
+			return AutomationRun{}, err
+		}
+	}
+	return run, nil
+}
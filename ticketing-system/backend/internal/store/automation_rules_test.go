@@ -0,0 +1,94 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBoardFilterMatches(t *testing.T) {
+	stateID := uuid.New()
+	assigneeID := uuid.New()
+	ticket := Ticket{
+		Key:         "OPS-7",
+		Title:       "Rotate database credentials",
+		Description: "Quarterly rotation",
+		StateID:     stateID,
+		AssigneeID:  &assigneeID,
+		Priority:    "high",
+		Type:        "feature",
+	}
+	ptr := func(v string) *string { return &v }
+	blocked := true
+	other := uuid.New()
+
+	cases := []struct {
+		name   string
+		filter BoardFilter
+		want   bool
+	}{
+		{"empty", BoardFilter{}, true},
+		{"state", BoardFilter{StateID: &stateID}, true},
+		{"other state", BoardFilter{StateID: &other}, false},
+		{"assignee", BoardFilter{AssigneeID: &assigneeID}, true},
+		{"other assignee", BoardFilter{AssigneeID: &other}, false},
+		{"priority case-insensitive", BoardFilter{Priority: ptr("HIGH")}, true},
+		{"type", BoardFilter{Type: ptr("bug")}, false},
+		{"query in title", BoardFilter{Query: ptr("database")}, true},
+		{"query in key", BoardFilter{Query: ptr("ops-7")}, true},
+		{"query miss", BoardFilter{Query: ptr("network")}, false},
+		{"blocked", BoardFilter{Blocked: &blocked}, false},
+	}
+	for _, tc := range cases {
+		if got := tc.filter.Matches(ticket); got != tc.want {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+}
+
+func TestNormalizeAutomationAction(t *testing.T) {
+	userID := uuid.New()
+	valid := []AutomationAction{
+		{Type: AutomationActionSetField, Field: "priority", Value: "urgent"},
+		{Type: AutomationActionSetField, Field: "storyPoints", Value: "3"},
+		{Type: AutomationActionAssign, UserID: &userID},
+		{Type: AutomationActionAddComment, Message: "Escalated by {rule}"},
+		{Type: AutomationActionNotify, Message: "{key} needs attention"},
+		{Type: AutomationActionCallWebhook},
+		{Type: AutomationActionCreateLinkedTicket, Title: "Follow up {key}"},
+	}
+	for _, action := range valid {
+		if err := normalizeAutomationAction(&action); err != nil {
+			t.Errorf("%s: unexpected error %v", action.Type, err)
+		}
+	}
+
+	linked := AutomationAction{Type: AutomationActionCreateLinkedTicket, Title: "Follow up"}
+	if err := normalizeAutomationAction(&linked); err != nil || linked.RelationType != DependencyRelationRelated {
+		t.Fatalf("expected default relation %q, got %q (%v)", DependencyRelationRelated, linked.RelationType, err)
+	}
+
+	invalid := []AutomationAction{
+		{Type: "add_label"},
+		{Type: AutomationActionSetField, Field: "priority", Value: "critical"},
+		{Type: AutomationActionSetField, Field: "timeEstimate", Value: "-1"},
+		{Type: AutomationActionSetField, Field: "title", Value: "x"},
+		{Type: AutomationActionAssign},
+		{Type: AutomationActionMoveState},
+		{Type: AutomationActionAddComment, Message: "  "},
+		{Type: AutomationActionCreateLinkedTicket, Title: "x", RelationType: "duplicates"},
+	}
+	for _, action := range invalid {
+		if err := normalizeAutomationAction(&action); err == nil {
+			t.Errorf("%+v: expected error", action)
+		}
+	}
+}
+
+func TestRenderAutomationText(t *testing.T) {
+	ticket := Ticket{Key: "OPS-7", Title: "Rotate credentials"}
+	got := RenderAutomationText("{rule}: {key} {title} ", ticket, "Escalate")
+	if want := "Escalate: OPS-7 Rotate credentials"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
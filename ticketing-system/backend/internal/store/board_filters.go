@@ -23,6 +23,41 @@ type BoardFilter struct {
 	Blocked    *bool      `json:"blocked,omitempty"`
 }
 
+// Matches reports whether ticket satisfies every criterion of the filter,
+// mirroring the SQL conditions used when listing tickets.
+func (f BoardFilter) Matches(ticket Ticket) bool {
+	if f.AssigneeID != nil && (ticket.AssigneeID == nil || *ticket.AssigneeID != *f.AssigneeID) {
+		return false
+	}
+	if f.StateID != nil && ticket.StateID != *f.StateID {
+		return false
+	}
+	if f.Priority != nil && !strings.EqualFold(strings.TrimSpace(*f.Priority), ticket.Priority) {
+		return false
+	}
+	if f.Type != nil && !strings.EqualFold(strings.TrimSpace(*f.Type), ticket.Type) {
+		return false
+	}
+	if f.Blocked != nil && *f.Blocked != ticket.IsBlocked {
+		return false
+	}
+	if f.Query != nil {
+		if q := strings.ToLower(strings.TrimSpace(*f.Query)); q != "" &&
+			!strings.Contains(strings.ToLower(ticket.Title), q) &&
+			!strings.Contains(strings.ToLower(ticket.Description), q) &&
+			!strings.Contains(strings.ToLower(ticket.Key), q) {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	BoardFilterPresetVisibilityPrivate = "private"
+	BoardFilterPresetVisibilityProject = "project"
+	BoardFilterPresetVisibilityGroups  = "groups"
+)
+
 type BoardFilterPreset struct {
 	ID         uuid.UUID
 	ProjectID  uuid.UUID
@@ -30,6 +65,11 @@ type BoardFilterPreset struct {
 	Name       string
 	Filters    BoardFilter
 	ShareToken *string
+	Visibility string
+	GroupIDs   []uuid.UUID
+	Pinned     bool
+	IsDefault  bool
+	Position   int
 	CreatedAt  time.Time
 	UpdatedAt  time.Time
 }
@@ -38,17 +78,70 @@ type BoardFilterPresetCreateInput struct {
 	Name               string
 	Filters            BoardFilter
 	GenerateShareToken bool
+	Visibility         string
+	GroupIDs           []uuid.UUID
+	Pinned             bool
+	IsDefault          bool
+	Position           int
 }
 
 type BoardFilterPresetUpdateInput struct {
 	Name               *string
 	Filters            *BoardFilter
 	GenerateShareToken *bool
+	Visibility         *string
+	GroupIDs           *[]uuid.UUID
+	Pinned             *bool
+	IsDefault          *bool
+	Position           *int
+}
+
+type BoardFilterPresetSubscription struct {
+	ID              uuid.UUID
+	PresetID        uuid.UUID
+	UserID          uuid.UUID
+	IntervalMinutes int
+	NotifyInApp     bool
+	SendWebhook     bool
+	LastEvaluatedAt *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type BoardFilterPresetSubscriptionInput struct {
+	IntervalMinutes int
+	NotifyInApp     bool
+	SendWebhook     bool
+}
+
+// DuePresetSubscription is a subscription claimed for evaluation together with
+// the preset it watches. LastMatches is nil until a baseline has been recorded.
+type DuePresetSubscription struct {
+	ID          uuid.UUID
+	PresetID    uuid.UUID
+	UserID      uuid.UUID
+	NotifyInApp bool
+	SendWebhook bool
+	LastMatches []BoardFilterMatch
+	// MatchesTruncated is set when the last evaluation matched more tickets
+	// than are tracked; LastMatches is then nil.
+	MatchesTruncated bool
+	ProjectID        uuid.UUID
+	PresetName       string
+	Filters          BoardFilter
 }
 
-func (s *Store) ListBoardFilterPresets(ctx context.Context, projectID, ownerID uuid.UUID) ([]BoardFilterPreset, error) {
+type BoardFilterMatch struct {
+	ID    uuid.UUID `json:"id"`
+	Key   string    `json:"key"`
+	Title string    `json:"title"`
+}
+
+// ListBoardFilterPresets returns the presets in a project visible to userID:
+// their own, project-wide ones, and ones shared with a group they belong to.
+func (s *Store) ListBoardFilterPresets(ctx context.Context, projectID, userID uuid.UUID) ([]BoardFilterPreset, error) {
 	query := mustSQL("board_filter_presets_list", nil)
-	return queryMany(ctx, s.db, query, scanBoardFilterPreset, projectID, ownerID)
+	return queryMany(ctx, s.db, query, scanBoardFilterPreset, projectID, userID)
 }
 
 func (s *Store) CreateBoardFilterPreset(ctx context.Context, projectID, ownerID uuid.UUID, input BoardFilterPresetCreateInput) (BoardFilterPreset, error) {
@@ -59,6 +152,16 @@ func (s *Store) CreateBoardFilterPreset(ctx context.Context, projectID, ownerID
 	if err := validateBoardFilter(input.Filters); err != nil {
 		return BoardFilterPreset{}, err
 	}
+	visibility, err := normalizeBoardFilterPresetVisibility(input.Visibility)
+	if err != nil {
+		return BoardFilterPreset{}, err
+	}
+	if (input.Pinned || input.IsDefault) && visibility != BoardFilterPresetVisibilityProject {
+		return BoardFilterPreset{}, errors.New("pinned or default presets must be project-visible")
+	}
+	if err := validateBoardFilterPresetGroups(visibility, input.GroupIDs); err != nil {
+		return BoardFilterPreset{}, err
+	}
 
 	payload, err := json.Marshal(input.Filters)
 	if err != nil {
@@ -74,15 +177,33 @@ func (s *Store) CreateBoardFilterPreset(ctx context.Context, projectID, ownerID
 		shareToken = &token
 	}
 
-	var id uuid.UUID
-	query := mustSQL("board_filter_presets_insert", nil)
-	if err := s.db.QueryRow(ctx, query, projectID, ownerID, name, payload, shareToken).Scan(&id); err != nil {
+	id, err := withTx(ctx, s.db, func(tx pgx.Tx) (uuid.UUID, error) {
+		var id uuid.UUID
+		query := mustSQL("board_filter_presets_insert", nil)
+		if err := tx.QueryRow(ctx, query, projectID, ownerID, name, payload, shareToken, visibility, input.Pinned, input.Position).Scan(&id); err != nil {
+			return uuid.Nil, err
+		}
+		if visibility == BoardFilterPresetVisibilityGroups {
+			if err := replaceBoardFilterPresetGroups(ctx, tx, projectID, id, input.GroupIDs); err != nil {
+				return uuid.Nil, err
+			}
+		}
+		if input.IsDefault {
+			if err := setBoardFilterPresetDefault(ctx, tx, projectID, id, true); err != nil {
+				return uuid.Nil, err
+			}
+		}
+		return id, nil
+	})
+	if err != nil {
 		return BoardFilterPreset{}, err
 	}
-	return s.GetBoardFilterPreset(ctx, projectID, ownerID, id)
+	return s.getBoardFilterPresetByID(ctx, projectID, id)
 }
 
-func (s *Store) UpdateBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID, input BoardFilterPresetUpdateInput) (BoardFilterPreset, error) {
+// UpdateBoardFilterPreset applies input to a preset. Callers are responsible
+// for checking that the actor may edit it.
+func (s *Store) UpdateBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID, input BoardFilterPresetUpdateInput) (BoardFilterPreset, error) {
 	updates := []string{"updated_at = now()"}
 	args := []any{}
 	arg := func(value any) string {
@@ -121,31 +242,142 @@ func (s *Store) UpdateBoardFilterPreset(ctx context.Context, projectID, ownerID,
 		}
 	}
 
-	if len(updates) == 1 {
+	var visibility string
+	if input.Visibility != nil {
+		normalized, err := normalizeBoardFilterPresetVisibility(*input.Visibility)
+		if err != nil {
+			return BoardFilterPreset{}, err
+		}
+		visibility = normalized
+		updates = append(updates, "visibility = "+arg(visibility))
+	}
+	if input.Pinned != nil {
+		updates = append(updates, "pinned = "+arg(*input.Pinned))
+	}
+	if input.Position != nil {
+		updates = append(updates, "position = "+arg(*input.Position))
+	}
+
+	if len(updates) == 1 && input.GroupIDs == nil && input.IsDefault == nil {
 		return BoardFilterPreset{}, errors.New("no updates")
 	}
 
-	args = append(args, projectID, ownerID, presetID)
+	args = append(args, projectID, presetID)
 	query := mustSQL("board_filter_presets_update", map[string]any{
 		"Updates":    strings.Join(updates, ", "),
-		"ProjectArg": len(args) - 2,
-		"OwnerArg":   len(args) - 1,
+		"ProjectArg": len(args) - 1,
 		"IDArg":      len(args),
 	})
-	if err := execOne(ctx, s.db, query, pgx.ErrNoRows, args...); err != nil {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		if input.IsDefault != nil && !*input.IsDefault {
+			if err := setBoardFilterPresetDefault(ctx, tx, projectID, presetID, false); err != nil {
+				return struct{}{}, err
+			}
+		}
+		// The update returns the stored visibility, so a request that only
+		// sends groupIds is checked against what the preset actually is.
+		var effective string
+		if err := tx.QueryRow(ctx, query, args...).Scan(&effective); err != nil {
+			return struct{}{}, err
+		}
+		if input.GroupIDs != nil || (visibility != "" && visibility != BoardFilterPresetVisibilityGroups) {
+			var groupIDs []uuid.UUID
+			if input.GroupIDs != nil {
+				groupIDs = *input.GroupIDs
+			}
+			if err := validateBoardFilterPresetGroups(effective, groupIDs); err != nil {
+				return struct{}{}, err
+			}
+			if err := replaceBoardFilterPresetGroups(ctx, tx, projectID, presetID, groupIDs); err != nil {
+				return struct{}{}, err
+			}
+		}
+		if input.IsDefault != nil && *input.IsDefault {
+			if err := setBoardFilterPresetDefault(ctx, tx, projectID, presetID, true); err != nil {
+				return struct{}{}, err
+			}
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
 		return BoardFilterPreset{}, err
 	}
-	return s.GetBoardFilterPreset(ctx, projectID, ownerID, presetID)
+	return s.getBoardFilterPresetByID(ctx, projectID, presetID)
 }
 
-func (s *Store) DeleteBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID) error {
+// DeleteBoardFilterPreset removes a preset. Callers are responsible for
+// checking that the actor may delete it.
+func (s *Store) DeleteBoardFilterPreset(ctx context.Context, projectID, presetID uuid.UUID) error {
 	query := mustSQL("board_filter_presets_delete", nil)
-	return execOne(ctx, s.db, query, pgx.ErrNoRows, projectID, ownerID, presetID)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, projectID, presetID)
 }
 
-func (s *Store) GetBoardFilterPreset(ctx context.Context, projectID, ownerID, presetID uuid.UUID) (BoardFilterPreset, error) {
+// GetBoardFilterPreset returns a preset if it is visible to userID.
+func (s *Store) GetBoardFilterPreset(ctx context.Context, projectID, userID, presetID uuid.UUID) (BoardFilterPreset, error) {
 	query := mustSQL("board_filter_presets_get", nil)
-	return queryOne(ctx, s.db, query, scanBoardFilterPreset, projectID, ownerID, presetID)
+	return queryOne(ctx, s.db, query, scanBoardFilterPreset, projectID, userID, presetID)
+}
+
+func (s *Store) getBoardFilterPresetByID(ctx context.Context, projectID, presetID uuid.UUID) (BoardFilterPreset, error) {
+	query := mustSQL("board_filter_presets_get_by_id", nil)
+	return queryOne(ctx, s.db, query, scanBoardFilterPreset, projectID, presetID)
+}
+
+func replaceBoardFilterPresetGroups(ctx context.Context, tx pgx.Tx, projectID, presetID uuid.UUID, groupIDs []uuid.UUID) error {
+	if _, err := tx.Exec(ctx, mustSQL("board_filter_preset_groups_delete", nil), presetID); err != nil {
+		return err
+	}
+	unique := make([]uuid.UUID, 0, len(groupIDs))
+	seen := make(map[uuid.UUID]struct{}, len(groupIDs))
+	for _, id := range groupIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+	tag, err := tx.Exec(ctx, mustSQL("board_filter_preset_groups_insert", nil), presetID, projectID, unique)
+	if err != nil {
+		return err
+	}
+	if int(tag.RowsAffected()) != len(unique) {
+		return errors.New("groups must be assigned to the project")
+	}
+	return nil
+}
+
+func setBoardFilterPresetDefault(ctx context.Context, tx pgx.Tx, projectID, presetID uuid.UUID, isDefault bool) error {
+	if isDefault {
+		if _, err := tx.Exec(ctx, mustSQL("board_filter_presets_clear_default", nil), projectID, presetID); err != nil {
+			return err
+		}
+	}
+	return execOne(ctx, tx, mustSQL("board_filter_presets_set_default", nil), pgx.ErrNoRows, projectID, presetID, isDefault)
+}
+
+// validateBoardFilterPresetGroups rejects group assignments on presets that
+// are not shared with groups.
+func validateBoardFilterPresetGroups(visibility string, groupIDs []uuid.UUID) error {
+	if len(groupIDs) > 0 && visibility != BoardFilterPresetVisibilityGroups {
+		return errors.New("groupIds require groups visibility")
+	}
+	return nil
+}
+
+func normalizeBoardFilterPresetVisibility(value string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", BoardFilterPresetVisibilityPrivate:
+		return BoardFilterPresetVisibilityPrivate, nil
+	case BoardFilterPresetVisibilityProject:
+		return BoardFilterPresetVisibilityProject, nil
+	case BoardFilterPresetVisibilityGroups:
+		return BoardFilterPresetVisibilityGroups, nil
+	default:
+		return "", errors.New("invalid visibility")
+	}
 }
 
 func (s *Store) GetSharedBoardFilterPreset(ctx context.Context, projectID uuid.UUID, token string) (BoardFilterPreset, error) {
@@ -157,6 +389,142 @@ func (s *Store) GetSharedBoardFilterPreset(ctx context.Context, projectID uuid.U
 	return queryOne(ctx, s.db, query, scanBoardFilterPreset, projectID, token)
 }
 
+func (s *Store) GetBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) (BoardFilterPresetSubscription, error) {
+	query := mustSQL("board_filter_preset_subscriptions_get", nil)
+	return queryOne(ctx, s.db, query, scanBoardFilterPresetSubscription, presetID, userID)
+}
+
+func (s *Store) UpsertBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID, input BoardFilterPresetSubscriptionInput) (BoardFilterPresetSubscription, error) {
+	if input.IntervalMinutes < 5 || input.IntervalMinutes > 10080 {
+		return BoardFilterPresetSubscription{}, errors.New("interval must be between 5 and 10080 minutes")
+	}
+	if !input.NotifyInApp && !input.SendWebhook {
+		return BoardFilterPresetSubscription{}, errors.New("at least one delivery channel required")
+	}
+	query := mustSQL("board_filter_preset_subscriptions_upsert", nil)
+	return queryOne(ctx, s.db, query, scanBoardFilterPresetSubscription, presetID, userID, input.IntervalMinutes, input.NotifyInApp, input.SendWebhook)
+}
+
+func (s *Store) DeleteBoardFilterPresetSubscription(ctx context.Context, presetID, userID uuid.UUID) error {
+	query := mustSQL("board_filter_preset_subscriptions_delete", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, presetID, userID)
+}
+
+// PresetSubscriptionLease is how long a claimed subscription stays with the
+// replica evaluating it before another may retry it.
+const PresetSubscriptionLease = 10 * time.Minute
+
+// ClaimDuePresetSubscriptions leases up to limit due subscriptions and
+// returns them. Rows locked or leased by another replica are skipped.
+// Recording the evaluation advances last_evaluated_at and releases the
+// lease; a failed run keeps it until it expires.
+func (s *Store) ClaimDuePresetSubscriptions(ctx context.Context, limit int) ([]DuePresetSubscription, error) {
+	if limit <= 0 {
+		limit = 50
+	}
+	query := mustSQL("board_filter_preset_subscriptions_claim_due", nil)
+	return queryMany(ctx, s.db, query, scanDuePresetSubscription, limit, PresetSubscriptionLease.Seconds())
+}
+
+// RecordPresetSubscriptionMatches stores the match set a subscription saw
+// and marks it evaluated. A truncated evaluation stores no matches, so the
+// next complete one starts a fresh baseline.
+func (s *Store) RecordPresetSubscriptionMatches(ctx context.Context, subscriptionID uuid.UUID, matches []BoardFilterMatch, truncated bool) error {
+	var payload []byte
+	if !truncated {
+		if matches == nil {
+			matches = []BoardFilterMatch{}
+		}
+		var err error
+		if payload, err = json.Marshal(matches); err != nil {
+			return err
+		}
+	}
+	query := mustSQL("board_filter_preset_subscriptions_record_matches", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, subscriptionID, payload, truncated)
+}
+
+// MarkPresetSubscriptionEvaluated advances a subscription's watermark
+// without touching its matches, for runs that had nothing to evaluate.
+func (s *Store) MarkPresetSubscriptionEvaluated(ctx context.Context, subscriptionID uuid.UUID) error {
+	query := mustSQL("board_filter_preset_subscriptions_mark_evaluated", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, subscriptionID)
+}
+
+// ListBoardFilterMatches returns up to limit tickets in a project matching a
+// board filter, ordered by id. Callers that need the complete set ask for one
+// more than they can handle and treat a full page as truncated.
+func (s *Store) ListBoardFilterMatches(ctx context.Context, projectID uuid.UUID, filter BoardFilter, limit int) ([]BoardFilterMatch, error) {
+	ticketFilter := TicketFilter{
+		ProjectID:  projectID,
+		StateID:    filter.StateID,
+		AssigneeID: filter.AssigneeID,
+		Blocked:    filter.Blocked,
+		Priority:   filter.Priority,
+		Type:       filter.Type,
+	}
+	if filter.Query != nil {
+		ticketFilter.Query = *filter.Query
+	}
+	where, args := ticketFilterConditions(ticketFilter)
+	query := mustSQL("board_filter_matches", map[string]any{
+		"Where":    where,
+		"LimitArg": len(args) + 1,
+	})
+	return queryMany(ctx, s.db, query, func(row pgx.Row) (BoardFilterMatch, error) {
+		var match BoardFilterMatch
+		err := row.Scan(&match.ID, &match.Key, &match.Title)
+		return match, err
+	}, append(args, limit)...)
+}
+
+func scanBoardFilterPresetSubscription(row pgx.Row) (BoardFilterPresetSubscription, error) {
+	var sub BoardFilterPresetSubscription
+	err := row.Scan(
+		&sub.ID,
+		&sub.PresetID,
+		&sub.UserID,
+		&sub.IntervalMinutes,
+		&sub.NotifyInApp,
+		&sub.SendWebhook,
+		&sub.LastEvaluatedAt,
+		&sub.CreatedAt,
+		&sub.UpdatedAt,
+	)
+	return sub, err
+}
+
+func scanDuePresetSubscription(row pgx.Row) (DuePresetSubscription, error) {
+	var sub DuePresetSubscription
+	var matchesRaw, filtersRaw []byte
+	if err := row.Scan(
+		&sub.ID,
+		&sub.PresetID,
+		&sub.UserID,
+		&sub.NotifyInApp,
+		&sub.SendWebhook,
+		&matchesRaw,
+		&sub.MatchesTruncated,
+		&sub.ProjectID,
+		&sub.PresetName,
+		&filtersRaw,
+	); err != nil {
+		return DuePresetSubscription{}, err
+	}
+	if matchesRaw != nil {
+		sub.LastMatches = []BoardFilterMatch{}
+		if err := json.Unmarshal(matchesRaw, &sub.LastMatches); err != nil {
+			return DuePresetSubscription{}, err
+		}
+	}
+	if len(filtersRaw) > 0 {
+		if err := json.Unmarshal(filtersRaw, &sub.Filters); err != nil {
+			return DuePresetSubscription{}, err
+		}
+	}
+	return sub, nil
+}
+
 func scanBoardFilterPreset(row pgx.Row) (BoardFilterPreset, error) {
 	var preset BoardFilterPreset
 	var filtersRaw []byte
@@ -167,6 +535,11 @@ func scanBoardFilterPreset(row pgx.Row) (BoardFilterPreset, error) {
 		&preset.Name,
 		&filtersRaw,
 		&preset.ShareToken,
+		&preset.Visibility,
+		&preset.Pinned,
+		&preset.IsDefault,
+		&preset.Position,
+		&preset.GroupIDs,
 		&preset.CreatedAt,
 		&preset.UpdatedAt,
 	); err != nil {
@@ -0,0 +1,30 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestValidateBoardFilterPresetGroups(t *testing.T) {
+	groups := []uuid.UUID{uuid.New()}
+
+	cases := []struct {
+		name       string
+		visibility string
+		groupIDs   []uuid.UUID
+		wantErr    bool
+	}{
+		{"groups with ids", BoardFilterPresetVisibilityGroups, groups, false},
+		{"groups cleared", BoardFilterPresetVisibilityGroups, nil, false},
+		{"private cleared", BoardFilterPresetVisibilityPrivate, []uuid.UUID{}, false},
+		{"private with ids", BoardFilterPresetVisibilityPrivate, groups, true},
+		{"project with ids", BoardFilterPresetVisibilityProject, groups, true},
+	}
+	for _, tc := range cases {
+		err := validateBoardFilterPresetGroups(tc.visibility, tc.groupIDs)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: expected error %v, got %v", tc.name, tc.wantErr, err)
+		}
+	}
+}
@@ -0,0 +1,350 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	ForecastUnitTickets = "tickets"
+	ForecastUnitPoints  = "points"
+	ForecastUnitHours   = "hours"
+)
+
+type SprintAbsence struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	SprintID  uuid.UUID
+	UserID    uuid.UUID
+	UserName  string
+	Days      int
+	Note      *string
+	CreatedAt time.Time
+}
+
+type SprintAbsenceInput struct {
+	UserID uuid.UUID
+	Days   int
+	Note   *string
+}
+
+// ForecastTicket is one unit of committed work. Sprint forecasts load these
+// from the sprint; what-if forecasts pass them in, optionally overriding an
+// existing ticket's assignee or estimates.
+type ForecastTicket struct {
+	TicketID     *uuid.UUID
+	AssigneeID   *uuid.UUID
+	AssigneeName string
+	StoryPoints  *int
+	TimeEstimate *int
+}
+
+type SprintForecastInput struct {
+	SprintID   *uuid.UUID
+	Iterations int
+	Unit       string
+	// Tickets replaces the sprint's committed tickets when non-nil.
+	Tickets []ForecastTicket
+}
+
+// CapacityAllocation compares one person's committed work against their
+// capacity for the sprint, after planned absences.
+type CapacityAllocation struct {
+	UserID        uuid.UUID
+	Name          string
+	BaseCapacity  int
+	AbsenceDays   int
+	Capacity      int
+	Committed     int
+	OverAllocated bool
+	Delta         int
+}
+
+func NormalizeForecastUnit(unit string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(unit)) {
+	case "", ForecastUnitTickets:
+		return ForecastUnitTickets, nil
+	case ForecastUnitPoints:
+		return ForecastUnitPoints, nil
+	case ForecastUnitHours:
+		return ForecastUnitHours, nil
+	}
+	return "", errors.New("unit must be tickets, points or hours")
+}
+
+func (s *Store) ListSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID) ([]SprintAbsence, error) {
+	return queryMany(ctx, s.db, mustSQL("sprint_absences_list", nil), scanSprintAbsence, sprintID, projectID)
+}
+
+func (s *Store) ReplaceSprintAbsences(ctx context.Context, projectID, sprintID uuid.UUID, inputs []SprintAbsenceInput) ([]SprintAbsence, error) {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		sprint, err := queryOne(ctx, tx, mustSQL("sprints_get", map[string]any{"ForUpdate": true}), scanSprintRow, sprintID, projectID)
+		if err != nil {
+			return struct{}{}, err
+		}
+		// Capacity is scaled by workdays, so a longer absence would take a
+		// user below zero.
+		workdays := sprintWorkdays(sprint.StartDate, sprint.EndDate)
+		if _, err := tx.Exec(ctx, mustSQL("sprint_absences_delete_for_sprint", nil), sprintID, projectID); err != nil {
+			return struct{}{}, err
+		}
+		seen := map[uuid.UUID]bool{}
+		for _, input := range inputs {
+			if input.UserID == uuid.Nil {
+				return struct{}{}, errors.New("absence user id required")
+			}
+			if seen[input.UserID] {
+				return struct{}{}, fmt.Errorf("duplicate absence for user %s", input.UserID)
+			}
+			seen[input.UserID] = true
+			if input.Days <= 0 || input.Days > workdays {
+				return struct{}{}, fmt.Errorf("absence days must be between 1 and %d, the sprint's workdays", workdays)
+			}
+			if _, err := tx.Exec(ctx, mustSQL("sprint_absences_insert", nil), projectID, sprintID, input.UserID, input.Days, input.Note); err != nil {
+				return struct{}{}, err
+			}
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.ListSprintAbsences(ctx, projectID, sprintID)
+}
+
+// loadForecastTickets returns the committed work for a forecast: the sprint's
+// tickets, or the hypothetical set with existing tickets filled in from the
+// database and explicit fields taking precedence.
+func (s *Store) loadForecastTickets(ctx context.Context, projectID, sprintID uuid.UUID, hypothetical []ForecastTicket) ([]ForecastTicket, error) {
+	if hypothetical == nil {
+		return queryMany(ctx, s.db, mustSQL("forecast_sprint_tickets", nil), scanForecastTicket, sprintID)
+	}
+
+	ids := make([]uuid.UUID, 0, len(hypothetical))
+	for _, ticket := range hypothetical {
+		if ticket.TicketID != nil {
+			ids = append(ids, *ticket.TicketID)
+		}
+	}
+	existing := map[uuid.UUID]ForecastTicket{}
+	if len(ids) > 0 {
+		rows, err := queryMany(ctx, s.db, mustSQL("forecast_tickets_by_id", nil), scanForecastTicket, projectID, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			existing[*row.TicketID] = row
+		}
+	}
+
+	out := make([]ForecastTicket, 0, len(hypothetical))
+	var unnamed []uuid.UUID
+	for _, ticket := range hypothetical {
+		merged := ticket
+		if ticket.TicketID != nil {
+			base, ok := existing[*ticket.TicketID]
+			if !ok {
+				return nil, fmt.Errorf("ticket %s not in project", *ticket.TicketID)
+			}
+			merged = base
+			if ticket.AssigneeID != nil {
+				merged.AssigneeID = ticket.AssigneeID
+				merged.AssigneeName = ""
+			}
+			if ticket.StoryPoints != nil {
+				merged.StoryPoints = ticket.StoryPoints
+			}
+			if ticket.TimeEstimate != nil {
+				merged.TimeEstimate = ticket.TimeEstimate
+			}
+		}
+		if merged.StoryPoints != nil && *merged.StoryPoints < 0 {
+			return nil, errors.New("story points must be >= 0")
+		}
+		if merged.TimeEstimate != nil && *merged.TimeEstimate < 0 {
+			return nil, errors.New("time estimate must be >= 0")
+		}
+		if merged.AssigneeID != nil && merged.AssigneeName == "" {
+			unnamed = append(unnamed, *merged.AssigneeID)
+		}
+		out = append(out, merged)
+	}
+
+	if len(unnamed) > 0 {
+		rows, err := s.db.Query(ctx, mustSQL("forecast_user_names", nil), unnamed)
+		if err != nil {
+			return nil, err
+		}
+		defer rows.Close()
+		names := map[uuid.UUID]string{}
+		for rows.Next() {
+			var id uuid.UUID
+			var name string
+			if err := rows.Scan(&id, &name); err != nil {
+				return nil, err
+			}
+			names[id] = name
+		}
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+		for i := range out {
+			if out[i].AssigneeID != nil && out[i].AssigneeName == "" {
+				out[i].AssigneeName = names[*out[i].AssigneeID]
+			}
+		}
+	}
+	return out, nil
+}
+
+// forecastTicketSize is the size of a ticket in the forecast unit. Hours are
+// derived from the time estimate, which is stored in minutes.
+func forecastTicketSize(ticket ForecastTicket, unit string) float64 {
+	switch unit {
+	case ForecastUnitPoints:
+		if ticket.StoryPoints != nil {
+			return float64(*ticket.StoryPoints)
+		}
+		return 0
+	case ForecastUnitHours:
+		if ticket.TimeEstimate != nil {
+			return float64(*ticket.TimeEstimate) / 60
+		}
+		return 0
+	}
+	return 1
+}
+
+func forecastCommitted(tickets []ForecastTicket, unit string) int {
+	total := 0.0
+	for _, ticket := range tickets {
+		total += forecastTicketSize(ticket, unit)
+	}
+	return int(math.Ceil(total))
+}
+
+// sprintWorkdays counts the weekdays in the sprint, used to scale user
+// capacity by planned absences.
+func sprintWorkdays(start, end time.Time) int {
+	days := 0
+	for day := normalizeDateUTC(start); !day.After(normalizeDateUTC(end)); day = day.AddDate(0, 0, 1) {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	if days == 0 {
+		return 1
+	}
+	return days
+}
+
+// buildCapacityAllocations sums team and user capacity in the given unit,
+// scales each user's capacity by their absences, and compares it to the work
+// assigned to them. Anyone with assigned work but no capacity left is
+// reported as over-allocated.
+func buildCapacityAllocations(unit string, settings []CapacitySetting, absences []SprintAbsence, tickets []ForecastTicket, workdays int) (int, []CapacityAllocation, []string) {
+	total := 0
+	byUser := map[uuid.UUID]*CapacityAllocation{}
+	order := []uuid.UUID{}
+	allocationFor := func(userID uuid.UUID, name string) *CapacityAllocation {
+		if item, ok := byUser[userID]; ok {
+			if item.Name == "" {
+				item.Name = name
+			}
+			return item
+		}
+		item := &CapacityAllocation{UserID: userID, Name: name}
+		byUser[userID] = item
+		order = append(order, userID)
+		return item
+	}
+
+	for _, setting := range settings {
+		if setting.Unit != unit {
+			continue
+		}
+		if setting.Scope == "user" && setting.UserID != nil {
+			allocationFor(*setting.UserID, setting.Label).BaseCapacity += setting.Capacity
+			continue
+		}
+		total += setting.Capacity
+	}
+	for _, absence := range absences {
+		if item, ok := byUser[absence.UserID]; ok {
+			item.AbsenceDays += absence.Days
+		} else if absence.UserID != uuid.Nil {
+			allocationFor(absence.UserID, absence.UserName).AbsenceDays += absence.Days
+		}
+	}
+
+	committed := map[uuid.UUID]float64{}
+	for _, ticket := range tickets {
+		if ticket.AssigneeID == nil {
+			continue
+		}
+		allocationFor(*ticket.AssigneeID, ticket.AssigneeName)
+		committed[*ticket.AssigneeID] += forecastTicketSize(ticket, unit)
+	}
+
+	allocations := make([]CapacityAllocation, 0, len(order))
+	warnings := []string{}
+	for _, userID := range order {
+		item := byUser[userID]
+		available := workdays - item.AbsenceDays
+		if available < 0 {
+			available = 0
+		}
+		item.Capacity = int(math.Round(float64(item.BaseCapacity) * float64(available) / float64(workdays)))
+		item.Committed = int(math.Ceil(committed[userID]))
+		item.Delta = item.Committed - item.Capacity
+		item.OverAllocated = item.Delta > 0
+		total += item.Capacity
+		if item.OverAllocated {
+			name := item.Name
+			if name == "" {
+				name = userID.String()
+			}
+			warnings = append(warnings, fmt.Sprintf("%s is over-allocated by %d %s (%d committed, %d capacity)", name, item.Delta, unit, item.Committed, item.Capacity))
+		}
+		allocations = append(allocations, *item)
+	}
+	sort.SliceStable(allocations, func(i, j int) bool {
+		return allocations[i].Delta > allocations[j].Delta
+	})
+	return total, allocations, warnings
+}
+
+func scanSprintAbsence(row pgx.Row) (SprintAbsence, error) {
+	var out SprintAbsence
+	err := row.Scan(
+		&out.ID,
+		&out.ProjectID,
+		&out.SprintID,
+		&out.UserID,
+		&out.UserName,
+		&out.Days,
+		&out.Note,
+		&out.CreatedAt,
+	)
+	return out, err
+}
+
+func scanForecastTicket(row pgx.Row) (ForecastTicket, error) {
+	var out ForecastTicket
+	var id uuid.UUID
+	var name *string
+	err := row.Scan(&id, &out.AssigneeID, &name, &out.StoryPoints, &out.TimeEstimate)
+	out.TicketID = &id
+	if name != nil {
+		out.AssigneeName = *name
+	}
+	return out, err
+}
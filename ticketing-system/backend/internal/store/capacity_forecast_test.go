@@ -0,0 +1,110 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSprintWorkdays(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday
+	if got := sprintWorkdays(start, start.AddDate(0, 0, 13)); got != 10 {
+		t.Fatalf("expected 10 workdays in two weeks, got %d", got)
+	}
+	saturday := start.AddDate(0, 0, 5)
+	if got := sprintWorkdays(saturday, saturday.AddDate(0, 0, 1)); got != 1 {
+		t.Fatalf("expected weekend-only sprint to count as 1 workday, got %d", got)
+	}
+}
+
+func TestBuildCapacityAllocations(t *testing.T) {
+	alice := uuid.New()
+	bob := uuid.New()
+	carol := uuid.New()
+	points := func(v int) *int { return &v }
+	minutes := func(v int) *int { return &v }
+	settings := []CapacitySetting{
+		{Scope: "team", Label: "Team", Capacity: 5, Unit: ForecastUnitPoints},
+		{Scope: "team", Label: "Team tickets", Capacity: 99, Unit: ForecastUnitTickets},
+		{Scope: "user", UserID: &alice, Label: "Alice", Capacity: 10, Unit: ForecastUnitPoints},
+		{Scope: "user", UserID: &bob, Label: "Bob", Capacity: 10, Unit: ForecastUnitPoints},
+	}
+	absences := []SprintAbsence{{UserID: alice, UserName: "Alice", Days: 5}}
+	tickets := []ForecastTicket{
+		{AssigneeID: &alice, AssigneeName: "Alice", StoryPoints: points(8)},
+		{AssigneeID: &bob, AssigneeName: "Bob", StoryPoints: points(3), TimeEstimate: minutes(90)},
+		{AssigneeID: &carol, AssigneeName: "Carol", StoryPoints: points(2)},
+		{StoryPoints: points(13)},
+	}
+
+	total, allocations, warnings := buildCapacityAllocations(ForecastUnitPoints, settings, absences, tickets, 10)
+
+	if total != 5+5+10 {
+		t.Fatalf("expected total capacity 20, got %d", total)
+	}
+	if len(allocations) != 3 {
+		t.Fatalf("expected 3 allocations, got %d", len(allocations))
+	}
+	byName := map[string]CapacityAllocation{}
+	for _, item := range allocations {
+		byName[item.Name] = item
+	}
+	if a := byName["Alice"]; a.Capacity != 5 || a.Committed != 8 || !a.OverAllocated || a.Delta != 3 || a.AbsenceDays != 5 {
+		t.Fatalf("unexpected allocation for Alice: %+v", a)
+	}
+	if b := byName["Bob"]; b.OverAllocated || b.Delta != -7 {
+		t.Fatalf("unexpected allocation for Bob: %+v", b)
+	}
+	if c := byName["Carol"]; c.Capacity != 0 || !c.OverAllocated {
+		t.Fatalf("expected Carol without capacity to be over-allocated: %+v", c)
+	}
+	if allocations[0].Name != "Alice" {
+		t.Fatalf("expected most over-allocated first, got %s", allocations[0].Name)
+	}
+	if len(warnings) != 2 || !strings.Contains(warnings[0], "Alice is over-allocated by 3 points") {
+		t.Fatalf("unexpected warnings %v", warnings)
+	}
+
+	_, hourAllocations, _ := buildCapacityAllocations(ForecastUnitHours, settings, nil, tickets, 10)
+	for _, item := range hourAllocations {
+		if item.Name == "Bob" && item.Committed != 2 {
+			t.Fatalf("expected 90 minutes to round up to 2 hours, got %d", item.Committed)
+		}
+	}
+}
+
+func TestNormalizeForecastUnit(t *testing.T) {
+	for input, want := range map[string]string{"": ForecastUnitTickets, "Points": ForecastUnitPoints, " hours ": ForecastUnitHours} {
+		got, err := NormalizeForecastUnit(input)
+		if err != nil || got != want {
+			t.Fatalf("%q: expected %q, got %q (%v)", input, want, got, err)
+		}
+	}
+	if _, err := NormalizeForecastUnit("days"); err == nil {
+		t.Fatalf("expected error for unknown unit")
+	}
+}
+
+func TestReplaceSprintAbsencesCapsDaysAtWorkdays(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	project, err := s.CreateProject(ctx, ProjectCreateInput{Key: strings.ToUpper(uuid.NewString()[:4]), Name: "Absences"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	t.Cleanup(func() { _ = s.DeleteProject(context.Background(), project.ID) })
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC) // Monday
+	sprint, err := s.CreateSprint(ctx, project.ID, SprintCreateInput{Name: "Sprint", StartDate: start, EndDate: start.AddDate(0, 0, 13)})
+	if err != nil {
+		t.Fatalf("create sprint: %v", err)
+	}
+
+	_, err = s.ReplaceSprintAbsences(ctx, project.ID, sprint.ID, []SprintAbsenceInput{{UserID: uuid.New(), Days: 11}})
+	if err == nil || !strings.Contains(err.Error(), "between 1 and 10") {
+		t.Fatalf("expected 11 days to exceed the sprint's 10 workdays, got %v", err)
+	}
+}
@@ -13,16 +13,20 @@ import (
 type Comment struct {
 	ID         uuid.UUID
 	TicketID   uuid.UUID
-	AuthorID   uuid.UUID
+	AuthorID   *uuid.UUID
 	AuthorName string
 	Message    string
-	CreatedAt  time.Time
+	// Public comments are published as updates on the status page.
+	Public    bool
+	CreatedAt time.Time
 }
 
 type CommentCreateInput struct {
-	AuthorID   uuid.UUID
+	// AuthorID is nil for comments posted on behalf of the system.
+	AuthorID   *uuid.UUID
 	AuthorName string
 	Message    string
+	Public     bool
 }
 
 func (s *Store) ListComments(ctx context.Context, ticketID uuid.UUID) ([]Comment, error) {
@@ -41,7 +45,7 @@ func (s *Store) CreateComment(ctx context.Context, ticketID uuid.UUID, input Com
 
 	query := mustSQL("comments_insert", nil)
 	var id uuid.UUID
-	if err := s.db.QueryRow(ctx, query, ticketID, input.AuthorID, input.AuthorName, message).Scan(&id); err != nil {
+	if err := s.db.QueryRow(ctx, query, ticketID, input.AuthorID, input.AuthorName, message, input.Public).Scan(&id); err != nil {
 		return Comment{}, err
 	}
 
@@ -57,6 +61,12 @@ func (s *Store) CreateComment(ctx context.Context, ticketID uuid.UUID, input Com
 	return Comment{}, pgx.ErrNoRows
 }
 
+// SetCommentPublic publishes a ticket's comment on the status page or
+// withdraws it. It returns pgx.ErrNoRows if the comment is not on the ticket.
+func (s *Store) SetCommentPublic(ctx context.Context, ticketID, commentID uuid.UUID, public bool) (Comment, error) {
+	return queryOne(ctx, s.db, mustSQL("comments_set_public", nil), scanComment, ticketID, commentID, public)
+}
+
 func (s *Store) DeleteComment(ctx context.Context, id uuid.UUID) error {
 	query := mustSQL("comments_delete", nil)
 	return execOne(ctx, s.db, query, pgx.ErrNoRows, id)
@@ -70,6 +80,7 @@ func scanComment(row pgx.Row) (Comment, error) {
 		&comment.AuthorID,
 		&comment.AuthorName,
 		&comment.Message,
+		&comment.Public,
 		&comment.CreatedAt,
 	)
 	return comment, err
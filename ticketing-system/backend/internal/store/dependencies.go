@@ -15,11 +15,19 @@ const (
 	DependencyRelationBlocks    = "blocks"
 	DependencyRelationBlockedBy = "blocked_by"
 	DependencyRelationRelated   = "related"
+	// DependencyRelationDuplicateOf points from a duplicate to the ticket it
+	// duplicates; DependencyRelationDuplicatedBy is the same relation read
+	// from the other side.
+	DependencyRelationDuplicateOf  = "duplicate_of"
+	DependencyRelationDuplicatedBy = "duplicated_by"
 )
 
 var (
 	ErrDependencyCycle        = errors.New("dependency cycle detected")
 	ErrInvalidDependencyInput = errors.New("invalid dependency input")
+	// ErrDuplicateOfDuplicate is returned when marking a ticket as a
+	// duplicate of a ticket that is itself a duplicate.
+	ErrDuplicateOfDuplicate = errors.New("ticket is itself a duplicate")
 )
 
 type TicketDependency struct {
@@ -73,6 +81,14 @@ func (s *Store) CreateTicketDependency(ctx context.Context, projectID uuid.UUID,
 		}
 	}
 
+	if storedType == DependencyRelationDuplicateOf {
+		if _, err := s.GetDuplicateTarget(ctx, projectID, target); err == nil {
+			return TicketDependency{}, ErrDuplicateOfDuplicate
+		} else if !errors.Is(err, pgx.ErrNoRows) {
+			return TicketDependency{}, err
+		}
+	}
+
 	query := mustSQL("ticket_dependencies_insert", nil)
 	var id uuid.UUID
 	if err := s.db.QueryRow(ctx, query, projectID, source, target, storedType, input.CreatedBy).Scan(&id); err != nil {
@@ -86,6 +102,14 @@ func (s *Store) CreateTicketDependency(ctx context.Context, projectID uuid.UUID,
 	return s.GetTicketDependencyForTicket(ctx, id, projectID, input.TicketID)
 }
 
+// GetDuplicateTarget returns the ticket a ticket was marked a duplicate of,
+// or pgx.ErrNoRows if it is not a duplicate.
+func (s *Store) GetDuplicateTarget(ctx context.Context, projectID, ticketID uuid.UUID) (uuid.UUID, error) {
+	var target uuid.UUID
+	err := s.db.QueryRow(ctx, mustSQL("ticket_dependencies_duplicate_target", nil), projectID, ticketID).Scan(&target)
+	return target, err
+}
+
 func (s *Store) ListTicketDependencies(ctx context.Context, projectID, ticketID uuid.UUID) ([]TicketDependency, error) {
 	query := mustSQL("ticket_dependencies_list_for_ticket", nil)
 	return queryMany(ctx, s.db, query, scanTicketDependency, projectID, ticketID)
@@ -183,6 +207,10 @@ func normalizeDependencyCreate(source, target uuid.UUID, relation string) (uuid.
 		return source, target, DependencyRelationBlocks, nil
 	case DependencyRelationBlockedBy:
 		return target, source, DependencyRelationBlocks, nil
+	case DependencyRelationDuplicateOf:
+		return source, target, DependencyRelationDuplicateOf, nil
+	case DependencyRelationDuplicatedBy:
+		return target, source, DependencyRelationDuplicateOf, nil
 	case DependencyRelationRelated:
 		if strings.Compare(source.String(), target.String()) > 0 {
 			return target, source, DependencyRelationRelated, nil
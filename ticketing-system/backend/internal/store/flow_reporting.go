@@ -0,0 +1,334 @@
+package store
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ReportingFilter narrows flow reports to a subset of a project's tickets.
+// Nil fields match everything.
+type ReportingFilter struct {
+	Type       *string
+	Priority   *string
+	AssigneeID *uuid.UUID
+	StoryID    *uuid.UUID
+}
+
+func (f ReportingFilter) args(projectID uuid.UUID) []any {
+	return []any{projectID, f.Type, f.Priority, f.AssigneeID, f.StoryID}
+}
+
+type CumulativeFlowReport struct {
+	From   time.Time
+	To     time.Time
+	States []WorkflowState
+	Points []StateOpenSeriesPoint
+}
+
+// DurationBucket counts durations in [MinHours, MaxHours). The last bucket
+// has no upper bound.
+type DurationBucket struct {
+	MinHours float64
+	MaxHours *float64
+	Count    int
+}
+
+type DurationDistribution struct {
+	Count        int
+	AverageHours float64
+	P50Hours     float64
+	P85Hours     float64
+	P95Hours     float64
+	Histogram    []DurationBucket
+}
+
+type LeadTimeReport struct {
+	From      time.Time
+	To        time.Time
+	LeadTime  DurationDistribution
+	CycleTime DurationDistribution
+}
+
+type AgingWipItem struct {
+	TicketID       uuid.UUID
+	Key            string
+	Title          string
+	Type           string
+	Priority       string
+	StateID        uuid.UUID
+	StateName      string
+	AssigneeID     *uuid.UUID
+	AssigneeName   *string
+	EnteredStateAt time.Time
+	CreatedAt      time.Time
+	StateAgeHours  float64
+	AgeHours       float64
+}
+
+type AgingWipState struct {
+	StateID     uuid.UUID
+	StateName   string
+	Count       int
+	P50AgeHours float64
+	P85AgeHours float64
+	MaxAgeHours float64
+}
+
+type AgingWipReport struct {
+	GeneratedAt time.Time
+	States      []AgingWipState
+	Items       []AgingWipItem
+}
+
+// durationHistogramBounds are the bucket edges in hours: under 4h, under a
+// day, 3 days, a week, two weeks, 30 days, and beyond.
+var durationHistogramBounds = []float64{0, 4, 24, 72, 168, 336, 720}
+
+// RemovedStatesLabel names the cumulative flow bucket for tickets that were
+// in a workflow state that has since been deleted. Its state id is nil.
+const RemovedStatesLabel = "Removed states"
+
+// cumulativeFlowRow is the number of tickets in a state at the end of a day.
+type cumulativeFlowRow struct {
+	Day     time.Time
+	StateID uuid.UUID
+	Value   int
+}
+
+// GetProjectCumulativeFlow counts, for each day in the range, how many
+// tickets were in each workflow state at the end of that day.
+func (s *Store) GetProjectCumulativeFlow(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter ReportingFilter) (CumulativeFlowReport, error) {
+	from, to = normalizeReportingSeriesRange(from, to)
+	report := CumulativeFlowReport{From: from, To: to, Points: make([]StateOpenSeriesPoint, 0)}
+
+	states, err := s.ListWorkflowStates(ctx, projectID)
+	if err != nil {
+		return report, err
+	}
+
+	scanFlowRow := func(row pgx.Row) (cumulativeFlowRow, error) {
+		var item cumulativeFlowRow
+		err := row.Scan(&item.Day, &item.StateID, &item.Value)
+		return item, err
+	}
+	args := append(filter.args(projectID), from, to)
+	rows, err := queryMany(ctx, s.db, mustSQL("reporting_cumulative_flow", nil), scanFlowRow, args...)
+	if err != nil {
+		return report, err
+	}
+
+	report.States, report.Points = buildCumulativeFlow(from, to, states, rows)
+	return report, nil
+}
+
+// buildCumulativeFlow lays out one point per day from from to to with a
+// count for each state. Tickets in states that no longer exist are counted
+// under a trailing RemovedStatesLabel state, added only when needed, so the
+// daily totals still match the number of tickets.
+func buildCumulativeFlow(from, to time.Time, states []WorkflowState, rows []cumulativeFlowRow) ([]WorkflowState, []StateOpenSeriesPoint) {
+	known := make(map[uuid.UUID]bool, len(states))
+	for _, state := range states {
+		known[state.ID] = true
+	}
+	countsByDay := make(map[string]map[uuid.UUID]int)
+	removed := false
+	for _, row := range rows {
+		day := normalizeDateUTC(row.Day).Format("2006-01-02")
+		if _, ok := countsByDay[day]; !ok {
+			countsByDay[day] = make(map[uuid.UUID]int)
+		}
+		stateID := row.StateID
+		if !known[stateID] {
+			stateID = uuid.Nil
+			removed = true
+		}
+		countsByDay[day][stateID] += row.Value
+	}
+	if removed {
+		states = append(append([]WorkflowState(nil), states...), WorkflowState{Name: RemovedStatesLabel})
+	}
+
+	points := make([]StateOpenSeriesPoint, 0)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		dayKey := day.Format("2006-01-02")
+		counts := make([]StatCount, 0, len(states))
+		for _, state := range states {
+			counts = append(counts, StatCount{Label: state.Name, Value: countsByDay[dayKey][state.ID]})
+		}
+		points = append(points, StateOpenSeriesPoint{Date: day, Counts: counts})
+	}
+	return states, points
+}
+
+// GetProjectLeadTimeDistribution summarises lead and cycle times of tickets
+// closed in the range.
+func (s *Store) GetProjectLeadTimeDistribution(ctx context.Context, projectID uuid.UUID, from, to time.Time, filter ReportingFilter) (LeadTimeReport, error) {
+	from, to = normalizeReportingRange(from, to)
+	report := LeadTimeReport{From: from, To: to}
+
+	args := append(filter.args(projectID), from, to)
+	rows, err := s.db.Query(ctx, mustSQL("reporting_lead_times", nil), args...)
+	if err != nil {
+		return report, err
+	}
+	defer rows.Close()
+	var leadHours, cycleHours []float64
+	for rows.Next() {
+		var createdAt, startedAt, closedAt time.Time
+		if err := rows.Scan(&createdAt, &startedAt, &closedAt); err != nil {
+			return report, err
+		}
+		leadHours = append(leadHours, math.Max(closedAt.Sub(createdAt).Hours(), 0))
+		cycleHours = append(cycleHours, math.Max(closedAt.Sub(startedAt).Hours(), 0))
+	}
+	if err := rows.Err(); err != nil {
+		return report, err
+	}
+
+	report.LeadTime = BuildDurationDistribution(leadHours)
+	report.CycleTime = BuildDurationDistribution(cycleHours)
+	return report, nil
+}
+
+// GetProjectAgingWip lists open tickets by how long they have been in their
+// current state, oldest first.
+func (s *Store) GetProjectAgingWip(ctx context.Context, projectID uuid.UUID, filter ReportingFilter) (AgingWipReport, error) {
+	states, err := s.ListWorkflowStates(ctx, projectID)
+	if err != nil {
+		return AgingWipReport{}, err
+	}
+	items, err := queryMany(ctx, s.db, mustSQL("reporting_aging_wip", nil), scanAgingWipItem, filter.args(projectID)...)
+	if err != nil {
+		return AgingWipReport{}, err
+	}
+	return BuildAgingWip(states, items, time.Now().UTC()), nil
+}
+
+// BuildDurationDistribution computes nearest-rank percentiles and histogram
+// counts for a set of durations in hours.
+func BuildDurationDistribution(hours []float64) DurationDistribution {
+	out := DurationDistribution{Count: len(hours), Histogram: make([]DurationBucket, 0, len(durationHistogramBounds))}
+	for i, lower := range durationHistogramBounds {
+		bucket := DurationBucket{MinHours: lower}
+		if i+1 < len(durationHistogramBounds) {
+			upper := durationHistogramBounds[i+1]
+			bucket.MaxHours = &upper
+		}
+		out.Histogram = append(out.Histogram, bucket)
+	}
+	if len(hours) == 0 {
+		return out
+	}
+
+	sorted := append([]float64(nil), hours...)
+	sort.Float64s(sorted)
+	total := 0.0
+	for _, value := range sorted {
+		total += value
+		for i := len(out.Histogram) - 1; i >= 0; i-- {
+			if value >= out.Histogram[i].MinHours {
+				out.Histogram[i].Count++
+				break
+			}
+		}
+	}
+	out.AverageHours = total / float64(len(sorted))
+	out.P50Hours = percentileOf(sorted, 0.50)
+	out.P85Hours = percentileOf(sorted, 0.85)
+	out.P95Hours = percentileOf(sorted, 0.95)
+	return out
+}
+
+// BuildAgingWip fills in ages relative to now and summarises them per open
+// state in workflow order.
+func BuildAgingWip(states []WorkflowState, items []AgingWipItem, now time.Time) AgingWipReport {
+	report := AgingWipReport{GeneratedAt: now, States: make([]AgingWipState, 0), Items: make([]AgingWipItem, 0, len(items))}
+
+	agesByState := make(map[uuid.UUID][]float64)
+	for _, item := range items {
+		item.StateAgeHours = math.Max(now.Sub(item.EnteredStateAt).Hours(), 0)
+		item.AgeHours = math.Max(now.Sub(item.CreatedAt).Hours(), 0)
+		agesByState[item.StateID] = append(agesByState[item.StateID], item.StateAgeHours)
+		report.Items = append(report.Items, item)
+	}
+	sort.SliceStable(report.Items, func(i, j int) bool {
+		return report.Items[i].StateAgeHours > report.Items[j].StateAgeHours
+	})
+
+	for _, state := range states {
+		if state.IsClosed {
+			continue
+		}
+		ages := agesByState[state.ID]
+		summary := AgingWipState{StateID: state.ID, StateName: state.Name, Count: len(ages)}
+		if len(ages) > 0 {
+			sort.Float64s(ages)
+			summary.P50AgeHours = percentileOf(ages, 0.50)
+			summary.P85AgeHours = percentileOf(ages, 0.85)
+			summary.MaxAgeHours = ages[len(ages)-1]
+		}
+		report.States = append(report.States, summary)
+	}
+	return report
+}
+
+// percentileOf returns the nearest-rank percentile of sorted values.
+func percentileOf(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+func normalizeReportingRange(from, to time.Time) (time.Time, time.Time) {
+	from = normalizeDateUTC(from)
+	to = normalizeDateUTC(to)
+	if to.Before(from) {
+		from, to = to, from
+	}
+	return from, to
+}
+
+// maxReportingSeriesDays bounds the days a daily series report covers,
+// since those are computed per day and ticket.
+const maxReportingSeriesDays = 366
+
+// normalizeReportingSeriesRange is normalizeReportingRange for daily series,
+// keeping the last maxReportingSeriesDays days of a longer range.
+func normalizeReportingSeriesRange(from, to time.Time) (time.Time, time.Time) {
+	from, to = normalizeReportingRange(from, to)
+	if earliest := to.AddDate(0, 0, -(maxReportingSeriesDays - 1)); from.Before(earliest) {
+		from = earliest
+	}
+	return from, to
+}
+
+func scanAgingWipItem(row pgx.Row) (AgingWipItem, error) {
+	var out AgingWipItem
+	err := row.Scan(
+		&out.TicketID,
+		&out.Key,
+		&out.Title,
+		&out.Type,
+		&out.Priority,
+		&out.StateID,
+		&out.StateName,
+		&out.AssigneeID,
+		&out.AssigneeName,
+		&out.EnteredStateAt,
+		&out.CreatedAt,
+	)
+	return out, err
+}
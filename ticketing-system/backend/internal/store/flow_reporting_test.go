@@ -0,0 +1,104 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildDurationDistribution(t *testing.T) {
+	got := BuildDurationDistribution([]float64{1, 2, 10, 30, 50, 100, 200, 400, 800, 3})
+
+	if got.Count != 10 {
+		t.Fatalf("expected count 10, got %d", got.Count)
+	}
+	if got.P50Hours != 30 || got.P85Hours != 400 || got.P95Hours != 800 {
+		t.Fatalf("unexpected percentiles p50=%v p85=%v p95=%v", got.P50Hours, got.P85Hours, got.P95Hours)
+	}
+	if got.AverageHours != 159.6 {
+		t.Fatalf("expected average 159.6, got %v", got.AverageHours)
+	}
+	wantCounts := []int{3, 1, 2, 1, 1, 1, 1}
+	if len(got.Histogram) != len(wantCounts) {
+		t.Fatalf("expected %d buckets, got %d", len(wantCounts), len(got.Histogram))
+	}
+	for i, want := range wantCounts {
+		if got.Histogram[i].Count != want {
+			t.Fatalf("bucket %d: expected %d, got %d", i, want, got.Histogram[i].Count)
+		}
+	}
+	if got.Histogram[len(got.Histogram)-1].MaxHours != nil {
+		t.Fatalf("expected last bucket to be open-ended")
+	}
+}
+
+func TestBuildDurationDistributionEmpty(t *testing.T) {
+	got := BuildDurationDistribution(nil)
+	if got.Count != 0 || got.P95Hours != 0 || len(got.Histogram) == 0 {
+		t.Fatalf("unexpected empty distribution %+v", got)
+	}
+}
+
+func TestBuildAgingWip(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	todo := WorkflowState{ID: uuid.New(), Name: "To Do"}
+	doing := WorkflowState{ID: uuid.New(), Name: "In Progress"}
+	done := WorkflowState{ID: uuid.New(), Name: "Done", IsClosed: true}
+	items := []AgingWipItem{
+		{Key: "OPS-1", StateID: doing.ID, EnteredStateAt: now.Add(-10 * time.Hour), CreatedAt: now.Add(-48 * time.Hour)},
+		{Key: "OPS-2", StateID: doing.ID, EnteredStateAt: now.Add(-72 * time.Hour), CreatedAt: now.Add(-96 * time.Hour)},
+		{Key: "OPS-3", StateID: todo.ID, EnteredStateAt: now.Add(-5 * time.Hour), CreatedAt: now.Add(-5 * time.Hour)},
+	}
+
+	got := BuildAgingWip([]WorkflowState{todo, doing, done}, items, now)
+
+	if got.Items[0].Key != "OPS-2" || got.Items[0].StateAgeHours != 72 || got.Items[0].AgeHours != 96 {
+		t.Fatalf("expected oldest item first, got %+v", got.Items[0])
+	}
+	if len(got.States) != 2 {
+		t.Fatalf("expected only open states, got %d", len(got.States))
+	}
+	if got.States[1].StateName != "In Progress" || got.States[1].Count != 2 || got.States[1].P50AgeHours != 10 || got.States[1].MaxAgeHours != 72 {
+		t.Fatalf("unexpected in-progress summary %+v", got.States[1])
+	}
+}
+
+func TestBuildCumulativeFlowCountsRemovedStates(t *testing.T) {
+	day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	open := WorkflowState{ID: uuid.New(), Name: "Open"}
+	deletedA, deletedB := uuid.New(), uuid.New()
+	rows := []cumulativeFlowRow{
+		{Day: day, StateID: open.ID, Value: 2},
+		{Day: day, StateID: deletedA, Value: 1},
+		{Day: day, StateID: deletedB, Value: 3},
+	}
+
+	states, points := buildCumulativeFlow(day, day.AddDate(0, 0, 1), []WorkflowState{open}, rows)
+
+	if len(states) != 2 || states[1].Name != RemovedStatesLabel || states[1].ID != uuid.Nil {
+		t.Fatalf("expected a trailing removed bucket, got %+v", states)
+	}
+	if len(points) != 2 {
+		t.Fatalf("expected 2 days, got %d", len(points))
+	}
+	if counts := points[0].Counts; counts[0].Value != 2 || counts[1].Label != RemovedStatesLabel || counts[1].Value != 4 {
+		t.Fatalf("unexpected counts %+v", counts)
+	}
+	if counts := points[1].Counts; counts[0].Value != 0 || counts[1].Value != 0 {
+		t.Fatalf("expected an empty second day, got %+v", counts)
+	}
+
+	states, _ = buildCumulativeFlow(day, day, []WorkflowState{open}, rows[:1])
+	if len(states) != 1 {
+		t.Fatalf("expected no removed bucket without removed states, got %+v", states)
+	}
+}
+
+func TestNormalizeReportingSeriesRangeCapsLength(t *testing.T) {
+	to := time.Date(2026, 3, 2, 15, 0, 0, 0, time.UTC)
+	from, gotTo := normalizeReportingSeriesRange(to, to.AddDate(-5, 0, 0))
+	if !gotTo.Equal(normalizeDateUTC(to)) || from.AddDate(0, 0, maxReportingSeriesDays-1) != gotTo {
+		t.Fatalf("expected the last %d days ending %s, got %s to %s", maxReportingSeriesDays, to, from, gotTo)
+	}
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// IncidentPostmortem is the postmortem document of an incident ticket.
+type IncidentPostmortem struct {
+	TicketID            uuid.UUID
+	ProjectID           uuid.UUID
+	Summary             string
+	Impact              string
+	RootCause           string
+	ContributingFactors string
+	Lessons             string
+	UpdatedBy           *uuid.UUID
+	UpdatedByName       *string
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// IncidentPostmortemUpdateInput replaces the sections that are not nil.
+type IncidentPostmortemUpdateInput struct {
+	Summary             *string
+	Impact              *string
+	RootCause           *string
+	ContributingFactors *string
+	Lessons             *string
+	UpdatedBy           *uuid.UUID
+}
+
+// PostmortemActionItem is a ticket created from an incident's postmortem,
+// with the incident it came from.
+type PostmortemActionItem struct {
+	IncidentTicketID uuid.UUID
+	IncidentKey      string
+	IncidentTitle    string
+	TicketID         uuid.UUID
+	ProjectID        uuid.UUID
+	ProjectKey       string
+	Key              string
+	Title            string
+	Priority         string
+	StateName        string
+	StateClosed      bool
+	AssigneeID       *uuid.UUID
+	AssigneeName     *string
+	CreatedAt        time.Time
+}
+
+// GetIncidentPostmortem returns pgx.ErrNoRows if the incident has no
+// postmortem yet.
+func (s *Store) GetIncidentPostmortem(ctx context.Context, ticketID uuid.UUID) (IncidentPostmortem, error) {
+	return queryOne(ctx, s.db, mustSQL("incident_postmortem_get", nil), scanIncidentPostmortem, ticketID)
+}
+
+// SaveIncidentPostmortem creates the postmortem on first save.
+func (s *Store) SaveIncidentPostmortem(ctx context.Context, ticketID uuid.UUID, input IncidentPostmortemUpdateInput) (IncidentPostmortem, error) {
+	if err := execOne(ctx, s.db, mustSQL("incident_postmortem_upsert", nil), nil,
+		ticketID,
+		input.Summary,
+		input.Impact,
+		input.RootCause,
+		input.ContributingFactors,
+		input.Lessons,
+		input.UpdatedBy,
+	); err != nil {
+		return IncidentPostmortem{}, err
+	}
+	return s.GetIncidentPostmortem(ctx, ticketID)
+}
+
+// CreatePostmortemActionItem creates a ticket in the incident's project and
+// records it as one of the incident's postmortem action items, related to
+// the incident, in one transaction. An empty postmortem is created if there
+// is none.
+func (s *Store) CreatePostmortemActionItem(ctx context.Context, projectID, incidentTicketID uuid.UUID, input TicketCreateInput, createdBy *uuid.UUID) (Ticket, PostmortemActionItem, error) {
+	ticketID, err := withTx(ctx, s.db, func(tx pgx.Tx) (uuid.UUID, error) {
+		ticketID, err := s.insertTicket(ctx, tx, projectID, input)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("incident_postmortem_ensure", nil), incidentTicketID, createdBy); err != nil {
+			return uuid.Nil, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("incident_postmortem_action_item_insert", nil), ticketID, incidentTicketID); err != nil {
+			return uuid.Nil, err
+		}
+		source, target, relation, err := normalizeDependencyCreate(ticketID, incidentTicketID, DependencyRelationRelated)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("ticket_dependencies_insert", nil), projectID, source, target, relation, createdBy); err != nil {
+			return uuid.Nil, err
+		}
+		return ticketID, nil
+	})
+	if err != nil {
+		return Ticket{}, PostmortemActionItem{}, err
+	}
+	ticket, err := s.GetTicket(ctx, ticketID)
+	if err != nil {
+		return Ticket{}, PostmortemActionItem{}, err
+	}
+	item, err := queryOne(ctx, s.db, mustSQL("incident_postmortem_action_item_get", nil), scanPostmortemActionItem, ticketID)
+	return ticket, item, err
+}
+
+// ListPostmortemActionItems returns an incident's action items in the order
+// they were created.
+func (s *Store) ListPostmortemActionItems(ctx context.Context, incidentTicketID uuid.UUID) ([]PostmortemActionItem, error) {
+	return queryMany(ctx, s.db, mustSQL("incident_postmortem_action_items_list", nil), scanPostmortemActionItem, incidentTicketID)
+}
+
+// ListPostmortemActionItemsForProjects returns up to limit action items of
+// incidents in the given projects, oldest first. Closed items are included
+// only when includeClosed is set.
+func (s *Store) ListPostmortemActionItemsForProjects(ctx context.Context, projectIDs []uuid.UUID, includeClosed bool, limit int) ([]PostmortemActionItem, error) {
+	if limit <= 0 {
+		limit = 200
+	}
+	if limit > 500 {
+		limit = 500
+	}
+	return queryMany(ctx, s.db, mustSQL("incident_postmortem_action_items_report", nil), scanPostmortemActionItem, projectIDs, includeClosed, limit)
+}
+
+func scanIncidentPostmortem(row pgx.Row) (IncidentPostmortem, error) {
+	var out IncidentPostmortem
+	err := row.Scan(
+		&out.TicketID,
+		&out.ProjectID,
+		&out.Summary,
+		&out.Impact,
+		&out.RootCause,
+		&out.ContributingFactors,
+		&out.Lessons,
+		&out.UpdatedBy,
+		&out.UpdatedByName,
+		&out.CreatedAt,
+		&out.UpdatedAt,
+	)
+	return out, err
+}
+
+func scanPostmortemActionItem(row pgx.Row) (PostmortemActionItem, error) {
+	var out PostmortemActionItem
+	err := row.Scan(
+		&out.IncidentTicketID,
+		&out.IncidentKey,
+		&out.IncidentTitle,
+		&out.TicketID,
+		&out.ProjectID,
+		&out.ProjectKey,
+		&out.Key,
+		&out.Title,
+		&out.Priority,
+		&out.StateName,
+		&out.StateClosed,
+		&out.AssigneeID,
+		&out.AssigneeName,
+		&out.CreatedAt,
+	)
+	return out, err
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	IncidentTimelineAnnotation = "annotation"
+	IncidentTimelineAlert      = "alert"
+
+	AlertStatusFiring   = "firing"
+	AlertStatusResolved = "resolved"
+)
+
+// IncidentTimelineEntry is a timeline event that is not derived from the
+// ticket's history: a responder's annotation or an ingested alert.
+type IncidentTimelineEntry struct {
+	ID               uuid.UUID
+	TicketID         uuid.UUID
+	Kind             string
+	OccurredAt       time.Time
+	Title            string
+	Body             *string
+	AuthorID         *uuid.UUID
+	AuthorName       *string
+	AlertStatus      *string
+	AlertFingerprint *string
+	CreatedAt        time.Time
+}
+
+// IncidentTimelineEntryCreateInput is an annotation when the Alert fields are
+// empty. AlertGroupKey routes later alerts of the same group to the ticket.
+type IncidentTimelineEntryCreateInput struct {
+	Kind             string
+	OccurredAt       time.Time
+	Title            string
+	Body             *string
+	AuthorID         *uuid.UUID
+	AlertStatus      *string
+	AlertFingerprint *string
+	AlertGroupKey    *string
+}
+
+// IncidentAlertSettings controls alert ingestion for a project. Token is set
+// once ingestion has been enabled; new incidents are created in StoryID.
+type IncidentAlertSettings struct {
+	ProjectID       uuid.UUID
+	Enabled         bool
+	Token           *string
+	StoryID         *uuid.UUID
+	DefaultSeverity *string
+	UpdatedAt       time.Time
+}
+
+// IncidentAlertSettingsUpdateInput replaces the settings. RotateToken issues a
+// new token; a token is also issued the first time ingestion is enabled.
+type IncidentAlertSettingsUpdateInput struct {
+	Enabled         bool
+	StoryID         *uuid.UUID
+	DefaultSeverity *string
+	RotateToken     bool
+}
+
+func (s *Store) ListIncidentTimelineEntries(ctx context.Context, ticketID uuid.UUID) ([]IncidentTimelineEntry, error) {
+	return queryMany(ctx, s.db, mustSQL("incident_timeline_entries_list", nil), scanIncidentTimelineEntry, ticketID)
+}
+
+// CreateIncidentTimelineEntry returns pgx.ErrNoRows for an alert state that
+// was already recorded on the ticket.
+func (s *Store) CreateIncidentTimelineEntry(ctx context.Context, ticketID uuid.UUID, input IncidentTimelineEntryCreateInput) (IncidentTimelineEntry, error) {
+	return queryOne(ctx, s.db, mustSQL("incident_timeline_entry_insert", nil), scanIncidentTimelineEntry,
+		ticketID,
+		input.Kind,
+		input.OccurredAt,
+		strings.TrimSpace(input.Title),
+		input.Body,
+		input.AuthorID,
+		input.AlertStatus,
+		input.AlertFingerprint,
+		input.AlertGroupKey,
+	)
+}
+
+// DeleteIncidentTimelineAnnotation deletes an annotation. Ingested alerts
+// cannot be deleted.
+func (s *Store) DeleteIncidentTimelineAnnotation(ctx context.Context, ticketID, entryID uuid.UUID) error {
+	return execOne(ctx, s.db, mustSQL("incident_timeline_annotation_delete", nil), pgx.ErrNoRows, entryID, ticketID)
+}
+
+// FindIncidentForAlertGroup returns the unresolved incident that received
+// alerts of groupKey most recently, or pgx.ErrNoRows.
+func (s *Store) FindIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string) (uuid.UUID, error) {
+	var id uuid.UUID
+	err := s.db.QueryRow(ctx, mustSQL("incident_alert_group_ticket", nil), projectID, groupKey).Scan(&id)
+	return id, err
+}
+
+// DeclareIncidentForAlertGroup creates the incident for an alert group and
+// records the group's mapping to it in one transaction. Declarations for a
+// group are serialised, so concurrent notifications declare one incident:
+// if the group already has an unresolved incident, that incident is
+// returned with declared set to false and nothing is created.
+func (s *Store) DeclareIncidentForAlertGroup(ctx context.Context, projectID uuid.UUID, groupKey string, input TicketCreateInput) (Ticket, bool, error) {
+	declared := false
+	ticketID, err := withTx(ctx, s.db, func(tx pgx.Tx) (uuid.UUID, error) {
+		if _, err := tx.Exec(ctx, mustSQL("incident_alert_group_lock", nil), projectID, groupKey); err != nil {
+			return uuid.Nil, err
+		}
+		var existing uuid.UUID
+		err := tx.QueryRow(ctx, mustSQL("incident_alert_group_ticket", nil), projectID, groupKey).Scan(&existing)
+		if err == nil {
+			return existing, nil
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, err
+		}
+		id, err := s.insertTicket(ctx, tx, projectID, input)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("incident_alert_group_upsert", nil), projectID, groupKey, id); err != nil {
+			return uuid.Nil, err
+		}
+		declared = true
+		return id, nil
+	})
+	if err != nil {
+		return Ticket{}, false, err
+	}
+	ticket, err := s.GetTicket(ctx, ticketID)
+	return ticket, declared, err
+}
+
+// GetIncidentAlertSettings returns disabled settings for a project that has
+// never configured alert ingestion.
+func (s *Store) GetIncidentAlertSettings(ctx context.Context, projectID uuid.UUID) (IncidentAlertSettings, error) {
+	settings, err := queryOne(ctx, s.db, mustSQL("incident_alert_settings_get", nil), scanIncidentAlertSettings, projectID)
+	if err == pgx.ErrNoRows {
+		return IncidentAlertSettings{ProjectID: projectID}, nil
+	}
+	return settings, err
+}
+
+// GetIncidentAlertSettingsByToken returns pgx.ErrNoRows unless token belongs
+// to a project with ingestion enabled.
+func (s *Store) GetIncidentAlertSettingsByToken(ctx context.Context, token string) (IncidentAlertSettings, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return IncidentAlertSettings{}, pgx.ErrNoRows
+	}
+	return queryOne(ctx, s.db, mustSQL("incident_alert_settings_get_by_token", nil), scanIncidentAlertSettings, token)
+}
+
+func (s *Store) UpdateIncidentAlertSettings(ctx context.Context, projectID uuid.UUID, input IncidentAlertSettingsUpdateInput) (IncidentAlertSettings, error) {
+	current, err := s.GetIncidentAlertSettings(ctx, projectID)
+	if err != nil {
+		return IncidentAlertSettings{}, err
+	}
+	token := current.Token
+	if input.RotateToken || (input.Enabled && token == nil) {
+		value, err := generateShareToken()
+		if err != nil {
+			return IncidentAlertSettings{}, err
+		}
+		token = &value
+	}
+	return queryOne(ctx, s.db, mustSQL("incident_alert_settings_upsert", nil), scanIncidentAlertSettings,
+		projectID,
+		input.Enabled,
+		token,
+		input.StoryID,
+		input.DefaultSeverity,
+	)
+}
+
+// AlertSeverity maps an alert's severity label to an incident severity.
+// sev1-sev4 are taken as is; the usual Prometheus levels map to the nearest
+// one. Unknown labels return nil.
+func AlertSeverity(label string) *string {
+	var severity string
+	switch value := strings.ToLower(strings.TrimSpace(label)); value {
+	case "sev1", "sev2", "sev3", "sev4":
+		severity = value
+	case "critical", "page":
+		severity = "sev1"
+	case "error", "high":
+		severity = "sev2"
+	case "warning", "warn":
+		severity = "sev3"
+	case "info", "low":
+		severity = "sev4"
+	default:
+		return nil
+	}
+	return &severity
+}
+
+func scanIncidentTimelineEntry(row pgx.Row) (IncidentTimelineEntry, error) {
+	var out IncidentTimelineEntry
+	err := row.Scan(
+		&out.ID,
+		&out.TicketID,
+		&out.Kind,
+		&out.OccurredAt,
+		&out.Title,
+		&out.Body,
+		&out.AuthorID,
+		&out.AuthorName,
+		&out.AlertStatus,
+		&out.AlertFingerprint,
+		&out.CreatedAt,
+	)
+	return out, err
+}
+
+func scanIncidentAlertSettings(row pgx.Row) (IncidentAlertSettings, error) {
+	var out IncidentAlertSettings
+	err := row.Scan(
+		&out.ProjectID,
+		&out.Enabled,
+		&out.Token,
+		&out.StoryID,
+		&out.DefaultSeverity,
+		&out.UpdatedAt,
+	)
+	return out, err
+}
@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	IncidentStatusDetected     = "detected"
+	IncidentStatusAcknowledged = "acknowledged"
+	IncidentStatusMitigated    = "mitigated"
+	IncidentStatusResolved     = "resolved"
+)
+
+// IncidentStatuses are the incident lifecycle in order. Column names are
+// derived from them (incident_<status>_at).
+var IncidentStatuses = []string{
+	IncidentStatusDetected,
+	IncidentStatusAcknowledged,
+	IncidentStatusMitigated,
+	IncidentStatusResolved,
+}
+
+// IncidentStatusRank is the position of status in the lifecycle, or -1 if it
+// is not a status.
+func IncidentStatusRank(status string) int {
+	for i, candidate := range IncidentStatuses {
+		if candidate == status {
+			return i
+		}
+	}
+	return -1
+}
+
+// IncidentSeverityRank orders severities from least to most severe: sev4
+// ranks 1 and sev1 ranks 4. No severity ranks 0.
+func IncidentSeverityRank(severity *string) int {
+	if severity == nil {
+		return 0
+	}
+	switch *severity {
+	case "sev1":
+		return 4
+	case "sev2":
+		return 3
+	case "sev3":
+		return 2
+	case "sev4":
+		return 1
+	}
+	return 0
+}
+
+type IncidentSeverityChange struct {
+	ID           uuid.UUID
+	TicketID     uuid.UUID
+	FromSeverity *string
+	ToSeverity   *string
+	ActorID      *uuid.UUID
+	ActorName    *string
+	CreatedAt    time.Time
+}
+
+// Escalated reports whether the change made the incident more severe.
+func (c IncidentSeverityChange) Escalated() bool {
+	return IncidentSeverityRank(c.ToSeverity) > IncidentSeverityRank(c.FromSeverity)
+}
+
+func (s *Store) CreateIncidentSeverityChange(ctx context.Context, ticketID uuid.UUID, from, to *string, actorID *uuid.UUID) (IncidentSeverityChange, error) {
+	return queryOne(ctx, s.db, mustSQL("incident_severity_change_insert", nil), scanIncidentSeverityChange, ticketID, from, to, actorID)
+}
+
+// ListIncidentSeverityChanges returns a ticket's severity history, oldest
+// first.
+func (s *Store) ListIncidentSeverityChanges(ctx context.Context, ticketID uuid.UUID) ([]IncidentSeverityChange, error) {
+	return queryMany(ctx, s.db, mustSQL("incident_severity_changes_list", nil), scanIncidentSeverityChange, ticketID)
+}
+
+func scanIncidentSeverityChange(row pgx.Row) (IncidentSeverityChange, error) {
+	var out IncidentSeverityChange
+	err := row.Scan(&out.ID, &out.TicketID, &out.FromSeverity, &out.ToSeverity, &out.ActorID, &out.ActorName, &out.CreatedAt)
+	return out, err
+}
+
+// IncidentMetricsRecord is one incident as the metrics see it.
+type IncidentMetricsRecord struct {
+	Severity       *string
+	DetectedAt     time.Time
+	AcknowledgedAt *time.Time
+	ResolvedAt     *time.Time
+}
+
+// IncidentMetrics holds time to acknowledge (MTTA, detected to
+// acknowledged) and time to resolve (MTTR, detected to resolved) for a set
+// of incidents. Only incidents that reached the status count towards each.
+type IncidentMetrics struct {
+	Declared          int
+	Open              int
+	TimeToAcknowledge DurationDistribution
+	TimeToResolve     DurationDistribution
+}
+
+type IncidentSeverityMetrics struct {
+	Severity *string
+	IncidentMetrics
+}
+
+type IncidentMetricsReport struct {
+	From time.Time
+	To   time.Time
+	IncidentMetrics
+	BySeverity []IncidentSeverityMetrics
+}
+
+// GetIncidentMetrics reports MTTA and MTTR for incidents detected in the
+// range, overall and per current severity.
+func (s *Store) GetIncidentMetrics(ctx context.Context, projectID uuid.UUID, from, to time.Time) (IncidentMetricsReport, error) {
+	from, to = normalizeReportingRange(from, to)
+	records, err := queryMany(ctx, s.db, mustSQL("incident_metrics_records", nil), func(row pgx.Row) (IncidentMetricsRecord, error) {
+		var out IncidentMetricsRecord
+		err := row.Scan(&out.Severity, &out.DetectedAt, &out.AcknowledgedAt, &out.ResolvedAt)
+		return out, err
+	}, projectID, from, to)
+	if err != nil {
+		return IncidentMetricsReport{From: from, To: to}, err
+	}
+	return BuildIncidentMetrics(from, to, records), nil
+}
+
+// BuildIncidentMetrics aggregates incidents overall and per severity, most
+// severe first with incidents without a severity last.
+func BuildIncidentMetrics(from, to time.Time, records []IncidentMetricsRecord) IncidentMetricsReport {
+	report := IncidentMetricsReport{From: from, To: to, IncidentMetrics: incidentMetrics(records)}
+
+	bySeverity := map[string][]IncidentMetricsRecord{}
+	for _, record := range records {
+		key := ""
+		if record.Severity != nil {
+			key = *record.Severity
+		}
+		bySeverity[key] = append(bySeverity[key], record)
+	}
+	report.BySeverity = make([]IncidentSeverityMetrics, 0, len(bySeverity))
+	for key, group := range bySeverity {
+		item := IncidentSeverityMetrics{IncidentMetrics: incidentMetrics(group)}
+		if key != "" {
+			severity := key
+			item.Severity = &severity
+		}
+		report.BySeverity = append(report.BySeverity, item)
+	}
+	sort.Slice(report.BySeverity, func(i, j int) bool {
+		return IncidentSeverityRank(report.BySeverity[i].Severity) > IncidentSeverityRank(report.BySeverity[j].Severity)
+	})
+	return report
+}
+
+func incidentMetrics(records []IncidentMetricsRecord) IncidentMetrics {
+	var out IncidentMetrics
+	var acknowledge, resolve []float64
+	for _, record := range records {
+		out.Declared++
+		if record.AcknowledgedAt != nil {
+			acknowledge = append(acknowledge, record.AcknowledgedAt.Sub(record.DetectedAt).Hours())
+		}
+		if record.ResolvedAt != nil {
+			resolve = append(resolve, record.ResolvedAt.Sub(record.DetectedAt).Hours())
+		} else {
+			out.Open++
+		}
+	}
+	out.TimeToAcknowledge = BuildDurationDistribution(acknowledge)
+	out.TimeToResolve = BuildDurationDistribution(resolve)
+	return out
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildIncidentMetrics(t *testing.T) {
+	from := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 6)
+	sev2, sev4 := "sev2", "sev4"
+	at := func(d time.Duration) *time.Time {
+		value := from.Add(d)
+		return &value
+	}
+	records := []IncidentMetricsRecord{
+		{Severity: &sev4, DetectedAt: from, AcknowledgedAt: at(2 * time.Hour), ResolvedAt: at(10 * time.Hour)},
+		{Severity: nil, DetectedAt: from},
+		{Severity: &sev2, DetectedAt: from, AcknowledgedAt: at(time.Hour)},
+		{Severity: &sev2, DetectedAt: from, AcknowledgedAt: at(3 * time.Hour), ResolvedAt: at(6 * time.Hour)},
+	}
+
+	got := BuildIncidentMetrics(from, to, records)
+
+	if got.Declared != 4 || got.Open != 2 {
+		t.Fatalf("unexpected counts %+v", got.IncidentMetrics)
+	}
+	if got.TimeToAcknowledge.Count != 3 || got.TimeToAcknowledge.AverageHours != 2 {
+		t.Fatalf("unexpected MTTA %+v", got.TimeToAcknowledge)
+	}
+	if got.TimeToResolve.Count != 2 || got.TimeToResolve.AverageHours != 8 {
+		t.Fatalf("unexpected MTTR %+v", got.TimeToResolve)
+	}
+	if len(got.BySeverity) != 3 || *got.BySeverity[0].Severity != "sev2" || *got.BySeverity[1].Severity != "sev4" || got.BySeverity[2].Severity != nil {
+		t.Fatalf("unexpected severity order %+v", got.BySeverity)
+	}
+	if got.BySeverity[0].Declared != 2 || got.BySeverity[0].TimeToResolve.Count != 1 {
+		t.Fatalf("unexpected sev2 metrics %+v", got.BySeverity[0])
+	}
+}
+
+func TestIncidentSeverityChangeEscalated(t *testing.T) {
+	sev1, sev3 := "sev1", "sev3"
+	if !(IncidentSeverityChange{FromSeverity: &sev3, ToSeverity: &sev1}).Escalated() {
+		t.Fatal("sev3 to sev1 should escalate")
+	}
+	if (IncidentSeverityChange{FromSeverity: &sev1, ToSeverity: &sev3}).Escalated() {
+		t.Fatal("sev1 to sev3 should not escalate")
+	}
+	if IncidentStatusRank("mitigated") != 2 || IncidentStatusRank("closed") != -1 {
+		t.Fatal("unexpected status ranks")
+	}
+}
+
+func TestAlertSeverity(t *testing.T) {
+	for label, want := range map[string]string{"SEV2": "sev2", "critical": "sev1", "warning": "sev3", "info": "sev4"} {
+		if got := AlertSeverity(label); got == nil || *got != want {
+			t.Fatalf("AlertSeverity(%q) = %v, want %s", label, got, want)
+		}
+	}
+	if got := AlertSeverity("none"); got != nil {
+		t.Fatalf("expected no severity, got %s", *got)
+	}
+}
+
+func TestTicketIncidentUpdatesAssignEachColumnOnce(t *testing.T) {
+	enabled, disabled := true, false
+	status := IncidentStatusAcknowledged
+	severity, impact := "sev2", "Checkout down"
+	person := uuid.New()
+	visible := true
+	cases := map[string]TicketUpdateInput{
+		"enable with status": {IncidentEnabled: &enabled, IncidentStatus: &status},
+		"disable with incident fields": {
+			IncidentEnabled:         &disabled,
+			IncidentStatus:          &status,
+			IncidentSeverity:        &severity,
+			IncidentImpact:          &impact,
+			IncidentCommanderID:     &person,
+			IncidentScribeID:        &person,
+			IncidentCommsLeadID:     &person,
+			IncidentCustomerVisible: &visible,
+		},
+	}
+	for name, input := range cases {
+		var args []any
+		updates, err := ticketIncidentUpdates(input, func(value any) string {
+			args = append(args, value)
+			return fmt.Sprintf("$%d", len(args))
+		})
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		seen := map[string]bool{}
+		for _, update := range updates {
+			column := strings.TrimSpace(strings.SplitN(update, "=", 2)[0])
+			if seen[column] {
+				t.Fatalf("%s: %s is assigned twice in %v", name, column, updates)
+			}
+			seen[column] = true
+		}
+	}
+
+	var args []any
+	updates, _ := ticketIncidentUpdates(cases["enable with status"], func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	})
+	if !slices.Contains(updates, "incident_status = $2") || !slices.Contains(updates, "incident_detected_at = COALESCE(incident_detected_at, now())") {
+		t.Fatalf("expected the requested status and stamps, got %v", updates)
+	}
+	updates, _ = ticketIncidentUpdates(cases["disable with incident fields"], func(value any) string { return "$1" })
+	if !slices.Contains(updates, "incident_status = NULL") || !slices.Contains(updates, "incident_commander_id = NULL") {
+		t.Fatalf("expected disabling to clear the incident, got %v", updates)
+	}
+}
+
+func TestCreatePostmortemActionItemLinksTicket(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	project, err := s.CreateProject(ctx, ProjectCreateInput{Key: strings.ToUpper(uuid.NewString()[:4]), Name: "Postmortems"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	t.Cleanup(func() { _ = s.DeleteProject(context.Background(), project.ID) })
+	if _, err := s.ReplaceWorkflowStates(ctx, project.ID, []WorkflowStateInput{{Name: "Open", Order: 0, IsDefault: true}}); err != nil {
+		t.Fatalf("workflow: %v", err)
+	}
+	story, err := s.CreateStory(ctx, project.ID, StoryCreateInput{Title: "Story"})
+	if err != nil {
+		t.Fatalf("create story: %v", err)
+	}
+	incident, err := s.CreateTicket(ctx, project.ID, TicketCreateInput{Title: "Outage", StoryID: story.ID, IncidentEnabled: true})
+	if err != nil {
+		t.Fatalf("create incident: %v", err)
+	}
+
+	ticket, item, err := s.CreatePostmortemActionItem(ctx, project.ID, incident.ID, TicketCreateInput{Title: "Add alerting", StoryID: story.ID}, nil)
+	if err != nil {
+		t.Fatalf("create action item: %v", err)
+	}
+	if item.TicketID != ticket.ID || item.IncidentTicketID != incident.ID {
+		t.Fatalf("unexpected action item %+v", item)
+	}
+	links, err := s.ListTicketDependencies(ctx, project.ID, ticket.ID)
+	if err != nil {
+		t.Fatalf("list dependencies: %v", err)
+	}
+	if len(links) != 1 || links[0].RelatedTicketID != incident.ID || links[0].RelationType != DependencyRelationRelated {
+		t.Fatalf("expected the action item to relate to the incident, got %+v", links)
+	}
+
+	// A ticket that fails validation leaves no action item behind.
+	if _, _, err := s.CreatePostmortemActionItem(ctx, project.ID, incident.ID, TicketCreateInput{Title: " ", StoryID: story.ID}, nil); err == nil {
+		t.Fatal("expected a blank title to fail")
+	}
+	items, err := s.ListPostmortemActionItemsForProjects(ctx, []uuid.UUID{project.ID}, true, -1)
+	if err != nil {
+		t.Fatalf("list action items with a negative limit: %v", err)
+	}
+	if len(items) != 1 {
+		t.Fatalf("expected 1 action item, got %d", len(items))
+	}
+}
@@ -0,0 +1,513 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/schedule"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxOnCallHandoffs bounds the handoffs walked one by one from a layer's
+// start to the requested time. Rotations that repeat every few days skip
+// whole periods instead, so only rules such as monthly dates walk every
+// handoff.
+const maxOnCallHandoffs = 100000
+
+// OnCallSchedule belongs to either a project or a group. Layers are ordered
+// by position; where several are active, the last one is on call.
+type OnCallSchedule struct {
+	ID        uuid.UUID
+	ProjectID *uuid.UUID
+	GroupID   *uuid.UUID
+	Name      string
+	Timezone  string
+	Layers    []OnCallLayer
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OnCallLayer rotates through ParticipantIDs, starting with the first at
+// StartsAt and handing off at each occurrence of the rotation schedule.
+type OnCallLayer struct {
+	ID             uuid.UUID
+	ScheduleID     uuid.UUID
+	Position       int
+	Name           string
+	RotationKind   string
+	Rotation       string
+	StartsAt       time.Time
+	EndsAt         *time.Time
+	ParticipantIDs []uuid.UUID
+}
+
+type OnCallLayerInput struct {
+	Name           string
+	RotationKind   string
+	Rotation       string
+	StartsAt       time.Time
+	EndsAt         *time.Time
+	ParticipantIDs []uuid.UUID
+}
+
+// OnCallScheduleInput creates a schedule or replaces one. The owner is only
+// read on create.
+type OnCallScheduleInput struct {
+	ProjectID *uuid.UUID
+	GroupID   *uuid.UUID
+	Name      string
+	Timezone  string
+	Layers    []OnCallLayerInput
+}
+
+type OnCallOverride struct {
+	ID         uuid.UUID
+	ScheduleID uuid.UUID
+	UserID     uuid.UUID
+	UserName   string
+	StartsAt   time.Time
+	EndsAt     time.Time
+	CreatedBy  *uuid.UUID
+	CreatedAt  time.Time
+}
+
+type OnCallOverrideCreateInput struct {
+	UserID    uuid.UUID
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy *uuid.UUID
+}
+
+// OnCallShift is the user on call in a schedule at some time, and the
+// bounds of their shift. EndsAt is nil for a shift with no further handoff.
+type OnCallShift struct {
+	ScheduleID   uuid.UUID
+	ScheduleName string
+	UserID       uuid.UUID
+	UserName     string
+	LayerID      *uuid.UUID
+	LayerName    *string
+	OverrideID   *uuid.UUID
+	StartsAt     time.Time
+	EndsAt       *time.Time
+}
+
+func (s *Store) GetOnCallSchedule(ctx context.Context, id uuid.UUID) (OnCallSchedule, error) {
+	sched, err := queryOne(ctx, s.db, mustSQL("oncall_schedule_get", nil), scanOnCallSchedule, id)
+	if err != nil {
+		return OnCallSchedule{}, err
+	}
+	schedules, err := s.withOnCallLayers(ctx, []OnCallSchedule{sched})
+	if err != nil {
+		return OnCallSchedule{}, err
+	}
+	return schedules[0], nil
+}
+
+// ListOnCallSchedulesForProject returns the project's own schedules, then
+// those of the groups assigned to the project.
+func (s *Store) ListOnCallSchedulesForProject(ctx context.Context, projectID uuid.UUID) ([]OnCallSchedule, error) {
+	schedules, err := queryMany(ctx, s.db, mustSQL("oncall_schedules_for_project", nil), scanOnCallSchedule, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withOnCallLayers(ctx, schedules)
+}
+
+func (s *Store) ListOnCallSchedulesForGroup(ctx context.Context, groupID uuid.UUID) ([]OnCallSchedule, error) {
+	schedules, err := queryMany(ctx, s.db, mustSQL("oncall_schedules_for_group", nil), scanOnCallSchedule, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withOnCallLayers(ctx, schedules)
+}
+
+func (s *Store) CreateOnCallSchedule(ctx context.Context, input OnCallScheduleInput) (OnCallSchedule, error) {
+	if (input.ProjectID == nil) == (input.GroupID == nil) {
+		return OnCallSchedule{}, errors.New("schedule needs a project or a group")
+	}
+	if err := s.prepareOnCallSchedule(ctx, &input); err != nil {
+		return OnCallSchedule{}, err
+	}
+	id, err := withTx(ctx, s.db, func(tx pgx.Tx) (uuid.UUID, error) {
+		var id uuid.UUID
+		if err := tx.QueryRow(ctx, mustSQL("oncall_schedule_insert", nil), input.ProjectID, input.GroupID, input.Name, input.Timezone).Scan(&id); err != nil {
+			return uuid.Nil, err
+		}
+		return id, insertOnCallLayers(ctx, tx, id, input.Layers)
+	})
+	if err != nil {
+		return OnCallSchedule{}, err
+	}
+	return s.GetOnCallSchedule(ctx, id)
+}
+
+// UpdateOnCallSchedule replaces a schedule's name, time zone and layers.
+func (s *Store) UpdateOnCallSchedule(ctx context.Context, id uuid.UUID, input OnCallScheduleInput) (OnCallSchedule, error) {
+	if err := s.prepareOnCallSchedule(ctx, &input); err != nil {
+		return OnCallSchedule{}, err
+	}
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		if err := execOne(ctx, tx, mustSQL("oncall_schedule_update", nil), pgx.ErrNoRows, id, input.Name, input.Timezone); err != nil {
+			return struct{}{}, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("oncall_layers_delete", nil), id); err != nil {
+			return struct{}{}, err
+		}
+		return struct{}{}, insertOnCallLayers(ctx, tx, id, input.Layers)
+	})
+	if err != nil {
+		return OnCallSchedule{}, err
+	}
+	return s.GetOnCallSchedule(ctx, id)
+}
+
+func (s *Store) DeleteOnCallSchedule(ctx context.Context, id uuid.UUID) error {
+	return execOne(ctx, s.db, mustSQL("oncall_schedule_delete", nil), pgx.ErrNoRows, id)
+}
+
+// ListOnCallOverrides returns the overrides of a schedule that end after
+// since, soonest first.
+func (s *Store) ListOnCallOverrides(ctx context.Context, scheduleID uuid.UUID, since time.Time) ([]OnCallOverride, error) {
+	return queryMany(ctx, s.db, mustSQL("oncall_overrides_list", nil), scanOnCallOverride, scheduleID, since)
+}
+
+func (s *Store) CreateOnCallOverride(ctx context.Context, scheduleID uuid.UUID, input OnCallOverrideCreateInput) (OnCallOverride, error) {
+	if !input.EndsAt.After(input.StartsAt) {
+		return OnCallOverride{}, errors.New("override must end after it starts")
+	}
+	return queryOne(ctx, s.db, mustSQL("oncall_override_insert", nil), scanOnCallOverride,
+		scheduleID,
+		input.UserID,
+		input.StartsAt,
+		input.EndsAt,
+		input.CreatedBy,
+	)
+}
+
+func (s *Store) DeleteOnCallOverride(ctx context.Context, scheduleID, overrideID uuid.UUID) error {
+	return execOne(ctx, s.db, mustSQL("oncall_override_delete", nil), pgx.ErrNoRows, overrideID, scheduleID)
+}
+
+// GetOnCallShift returns who is on call in sched at at. The boolean is false
+// when nobody is.
+func (s *Store) GetOnCallShift(ctx context.Context, sched OnCallSchedule, at time.Time) (OnCallShift, bool, error) {
+	overrides, err := s.ListOnCallOverrides(ctx, sched.ID, at)
+	if err != nil {
+		return OnCallShift{}, false, err
+	}
+	shift, ok, err := ResolveOnCall(sched, overrides, at)
+	if err != nil || !ok || shift.UserName != "" {
+		return shift, ok, err
+	}
+	if err := s.db.QueryRow(ctx, mustSQL("oncall_user_name", nil), shift.UserID).Scan(&shift.UserName); err != nil {
+		return OnCallShift{}, false, err
+	}
+	return shift, true, nil
+}
+
+// ListProjectOnCall returns who is on call at at in each schedule covering
+// the project, in the order of ListOnCallSchedulesForProject. Schedules with
+// nobody on call are left out, and so are schedules that fail to resolve,
+// which are logged.
+func (s *Store) ListProjectOnCall(ctx context.Context, projectID uuid.UUID, at time.Time) ([]OnCallShift, error) {
+	schedules, err := s.ListOnCallSchedulesForProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	shifts := make([]OnCallShift, 0, len(schedules))
+	for _, sched := range schedules {
+		shift, ok, err := s.GetOnCallShift(ctx, sched, at)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			// One broken schedule should not hide the others.
+			log.Printf("oncall_resolve_error schedule=%s error=%s", sched.ID, err.Error())
+			continue
+		}
+		if ok {
+			shifts = append(shifts, shift)
+		}
+	}
+	return shifts, nil
+}
+
+// ResolveOnCall returns who is on call in sched at at. An override active at
+// at wins, the most recently created one if several overlap. Otherwise the
+// last active layer decides. Layer shifts carry no user name.
+func ResolveOnCall(sched OnCallSchedule, overrides []OnCallOverride, at time.Time) (OnCallShift, bool, error) {
+	base := OnCallShift{ScheduleID: sched.ID, ScheduleName: sched.Name}
+
+	var override *OnCallOverride
+	for i := range overrides {
+		o := &overrides[i]
+		if o.StartsAt.After(at) || !o.EndsAt.After(at) {
+			continue
+		}
+		if override == nil || o.CreatedAt.After(override.CreatedAt) {
+			override = o
+		}
+	}
+	if override != nil {
+		shift := base
+		endsAt := override.EndsAt
+		shift.UserID = override.UserID
+		shift.UserName = override.UserName
+		shift.OverrideID = &override.ID
+		shift.StartsAt = override.StartsAt
+		shift.EndsAt = &endsAt
+		return shift, true, nil
+	}
+
+	loc, err := schedule.LoadLocation(sched.Timezone)
+	if err != nil {
+		return OnCallShift{}, false, err
+	}
+	for i := len(sched.Layers) - 1; i >= 0; i-- {
+		layer := sched.Layers[i]
+		if layer.StartsAt.After(at) || (layer.EndsAt != nil && !layer.EndsAt.After(at)) || len(layer.ParticipantIDs) == 0 {
+			continue
+		}
+		turn, startsAt, endsAt, err := onCallLayerTurn(layer, loc, at)
+		if err != nil {
+			return OnCallShift{}, false, err
+		}
+		if layer.EndsAt != nil && (endsAt == nil || layer.EndsAt.Before(*endsAt)) {
+			layerEnd := *layer.EndsAt
+			endsAt = &layerEnd
+		}
+		shift := base
+		shift.UserID = layer.ParticipantIDs[turn%len(layer.ParticipantIDs)]
+		shift.LayerID = &sched.Layers[i].ID
+		shift.LayerName = &sched.Layers[i].Name
+		shift.StartsAt = startsAt
+		shift.EndsAt = endsAt
+		return shift, true, nil
+	}
+	return OnCallShift{}, false, nil
+}
+
+// onCallLayerTurn counts the handoffs of layer between its start and at. It
+// returns the count and the bounds of the shift containing at.
+//
+// When the rotation repeats every few days, whole periods within one UTC
+// offset are skipped at once, using the handoffs counted in one period.
+func onCallLayerTurn(layer OnCallLayer, loc *time.Location, at time.Time) (int, time.Time, *time.Time, error) {
+	rotation, err := schedule.Parse(layer.RotationKind, layer.Rotation, layer.StartsAt, loc)
+	if err != nil {
+		return 0, time.Time{}, nil, err
+	}
+	days, periodic := schedule.PeriodDays(rotation)
+	period := time.Duration(days) * 24 * time.Hour
+	perPeriod := 0
+	var measureAfter time.Time
+
+	turn, startsAt := 0, layer.StartsAt
+	for walked := 0; walked <= maxOnCallHandoffs; walked++ {
+		// Skipping needs startsAt to be a handoff, so not before the first.
+		if periodic && turn > 0 {
+			if perPeriod == 0 && !startsAt.Before(measureAfter) {
+				perPeriod, measureAfter = onCallPeriodHandoffs(rotation, loc, startsAt, period)
+			}
+			if perPeriod > 0 {
+				if skip := onCallPeriodsWithin(loc, startsAt, period, at); skip > 0 {
+					turn += skip * perPeriod
+					startsAt = startsAt.Add(time.Duration(skip) * period)
+				}
+			}
+		}
+		next, ok := rotation.Next(startsAt)
+		if !ok {
+			return turn, startsAt, nil, nil
+		}
+		if next.After(at) {
+			return turn, startsAt, &next, nil
+		}
+		turn++
+		startsAt = next
+	}
+	return 0, time.Time{}, nil, fmt.Errorf("layer %q has more than %d handoffs before %s; move its start forward", layer.Name, maxOnCallHandoffs, at.Format(time.RFC3339))
+}
+
+// onCallPeriodHandoffs counts the handoffs in the period following the
+// handoff at from. It returns zero and the end of from's UTC offset when the
+// period crosses an offset change, which shifts local times; counting can
+// then be retried past that point.
+func onCallPeriodHandoffs(rotation schedule.Schedule, loc *time.Location, from time.Time, period time.Duration) (int, time.Time) {
+	end := from.Add(period)
+	_, zoneEnd := from.In(loc).ZoneBounds()
+	if !zoneEnd.IsZero() && !end.Before(zoneEnd) {
+		return 0, zoneEnd
+	}
+	count := 0
+	for t := from; count <= maxOnCallHandoffs; count++ {
+		next, ok := rotation.Next(t)
+		if !ok || next.After(end) {
+			return count, time.Time{}
+		}
+		t = next
+	}
+	return 0, end
+}
+
+// onCallPeriodsWithin returns how many whole periods fit after from without
+// passing at or leaving from's UTC offset.
+func onCallPeriodsWithin(loc *time.Location, from time.Time, period time.Duration, at time.Time) int {
+	limit := at
+	if _, zoneEnd := from.In(loc).ZoneBounds(); !zoneEnd.IsZero() && zoneEnd.Before(limit.Add(time.Nanosecond)) {
+		limit = zoneEnd.Add(-time.Nanosecond)
+	}
+	if !limit.After(from) {
+		return 0
+	}
+	return int(limit.Sub(from) / period)
+}
+
+// prepareOnCallSchedule validates input and normalizes its names, time zone
+// and rotations.
+func (s *Store) prepareOnCallSchedule(ctx context.Context, input *OnCallScheduleInput) error {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" {
+		return errors.New("name required")
+	}
+	input.Timezone = strings.TrimSpace(input.Timezone)
+	if input.Timezone == "" {
+		input.Timezone = "UTC"
+	}
+	loc, err := schedule.LoadLocation(input.Timezone)
+	if err != nil {
+		return err
+	}
+
+	participants := map[uuid.UUID]struct{}{}
+	for i := range input.Layers {
+		layer := &input.Layers[i]
+		layer.Name = strings.TrimSpace(layer.Name)
+		if layer.Name == "" {
+			layer.Name = fmt.Sprintf("Layer %d", i+1)
+		}
+		layer.RotationKind = strings.ToLower(strings.TrimSpace(layer.RotationKind))
+		layer.Rotation = strings.TrimSpace(layer.Rotation)
+		if _, err := schedule.Parse(layer.RotationKind, layer.Rotation, layer.StartsAt, loc); err != nil {
+			return fmt.Errorf("layer %q: %w", layer.Name, err)
+		}
+		if layer.EndsAt != nil && !layer.EndsAt.After(layer.StartsAt) {
+			return fmt.Errorf("layer %q must end after it starts", layer.Name)
+		}
+		if len(layer.ParticipantIDs) == 0 {
+			return fmt.Errorf("layer %q needs participants", layer.Name)
+		}
+		for _, id := range layer.ParticipantIDs {
+			participants[id] = struct{}{}
+		}
+	}
+	if len(participants) == 0 {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(participants))
+	for id := range participants {
+		ids = append(ids, id)
+	}
+	var ok bool
+	if err := s.db.QueryRow(ctx, mustSQL("oncall_participants_valid", nil), ids).Scan(&ok); err != nil {
+		return err
+	}
+	if !ok {
+		return errors.New("participant not found")
+	}
+	return nil
+}
+
+func insertOnCallLayers(ctx context.Context, tx pgx.Tx, scheduleID uuid.UUID, layers []OnCallLayerInput) error {
+	for i, layer := range layers {
+		if _, err := tx.Exec(ctx, mustSQL("oncall_layer_insert", nil),
+			scheduleID,
+			i,
+			layer.Name,
+			layer.RotationKind,
+			layer.Rotation,
+			layer.StartsAt,
+			layer.EndsAt,
+			layer.ParticipantIDs,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Store) withOnCallLayers(ctx context.Context, schedules []OnCallSchedule) ([]OnCallSchedule, error) {
+	if len(schedules) == 0 {
+		return schedules, nil
+	}
+	ids := make([]uuid.UUID, len(schedules))
+	for i, sched := range schedules {
+		ids[i] = sched.ID
+	}
+	layers, err := queryMany(ctx, s.db, mustSQL("oncall_layers_list", nil), scanOnCallLayer, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]int, len(schedules))
+	for i := range schedules {
+		schedules[i].Layers = []OnCallLayer{}
+		byID[schedules[i].ID] = i
+	}
+	for _, layer := range layers {
+		i := byID[layer.ScheduleID]
+		schedules[i].Layers = append(schedules[i].Layers, layer)
+	}
+	return schedules, nil
+}
+
+func scanOnCallSchedule(row pgx.Row) (OnCallSchedule, error) {
+	var out OnCallSchedule
+	err := row.Scan(
+		&out.ID,
+		&out.ProjectID,
+		&out.GroupID,
+		&out.Name,
+		&out.Timezone,
+		&out.CreatedAt,
+		&out.UpdatedAt,
+	)
+	return out, err
+}
+
+func scanOnCallLayer(row pgx.Row) (OnCallLayer, error) {
+	var out OnCallLayer
+	err := row.Scan(
+		&out.ID,
+		&out.ScheduleID,
+		&out.Position,
+		&out.Name,
+		&out.RotationKind,
+		&out.Rotation,
+		&out.StartsAt,
+		&out.EndsAt,
+		&out.ParticipantIDs,
+	)
+	return out, err
+}
+
+func scanOnCallOverride(row pgx.Row) (OnCallOverride, error) {
+	var out OnCallOverride
+	err := row.Scan(
+		&out.ID,
+		&out.ScheduleID,
+		&out.UserID,
+		&out.UserName,
+		&out.StartsAt,
+		&out.EndsAt,
+		&out.CreatedBy,
+		&out.CreatedAt,
+	)
+	return out, err
+}
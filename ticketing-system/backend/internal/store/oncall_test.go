@@ -0,0 +1,123 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"ticketing-system/backend/internal/schedule"
+
+	"github.com/google/uuid"
+)
+
+func TestResolveOnCall(t *testing.T) {
+	alice, bob, carol, dave := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	start := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC) // a Monday
+	weekdaysEnd := start.AddDate(0, 0, 4)
+	sched := OnCallSchedule{
+		ID:       uuid.New(),
+		Name:     "Primary",
+		Timezone: "UTC",
+		Layers: []OnCallLayer{
+			{ID: uuid.New(), Name: "Weekly", RotationKind: "rrule", Rotation: "FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0", StartsAt: start, ParticipantIDs: []uuid.UUID{alice, bob, carol}},
+			{ID: uuid.New(), Name: "Cover", RotationKind: "cron", Rotation: "0 9 * * *", StartsAt: start.AddDate(0, 0, 14), EndsAt: ptrTime(weekdaysEnd.AddDate(0, 0, 14)), ParticipantIDs: []uuid.UUID{dave}},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		at       time.Time
+		want     uuid.UUID
+		startsAt time.Time
+		endsAt   time.Time
+	}{
+		{name: "first shift", at: start.Add(time.Hour), want: alice, startsAt: start, endsAt: start.AddDate(0, 0, 7)},
+		{name: "after one handoff", at: start.AddDate(0, 0, 8), want: bob, startsAt: start.AddDate(0, 0, 7), endsAt: start.AddDate(0, 0, 14)},
+		{name: "wraps around", at: start.AddDate(0, 0, 22), want: alice, startsAt: start.AddDate(0, 0, 21), endsAt: start.AddDate(0, 0, 28)},
+		{name: "later layer takes precedence", at: start.AddDate(0, 0, 15), want: dave, startsAt: start.AddDate(0, 0, 15), endsAt: start.AddDate(0, 0, 16)},
+		{name: "layer end bounds the shift", at: start.AddDate(0, 0, 17).Add(time.Hour), want: dave, startsAt: start.AddDate(0, 0, 17), endsAt: weekdaysEnd.AddDate(0, 0, 14)},
+		{name: "back to the rotation", at: start.AddDate(0, 0, 19), want: carol, startsAt: start.AddDate(0, 0, 14), endsAt: start.AddDate(0, 0, 21)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shift, ok, err := ResolveOnCall(sched, nil, tt.at)
+			if err != nil || !ok {
+				t.Fatalf("expected a shift, got ok=%t err=%v", ok, err)
+			}
+			if shift.UserID != tt.want || !shift.StartsAt.Equal(tt.startsAt) || shift.EndsAt == nil || !shift.EndsAt.Equal(tt.endsAt) {
+				t.Fatalf("unexpected shift %+v", shift)
+			}
+		})
+	}
+
+	t.Run("nobody before the first layer starts", func(t *testing.T) {
+		if _, ok, err := ResolveOnCall(sched, nil, start.Add(-time.Hour)); ok || err != nil {
+			t.Fatalf("expected nobody on call, got ok=%t err=%v", ok, err)
+		}
+	})
+
+	t.Run("newest override wins", func(t *testing.T) {
+		at := start.Add(2 * time.Hour)
+		overrides := []OnCallOverride{
+			{ID: uuid.New(), UserID: bob, UserName: "Bob", StartsAt: start, EndsAt: start.Add(4 * time.Hour), CreatedAt: start},
+			{ID: uuid.New(), UserID: carol, UserName: "Carol", StartsAt: start.Add(time.Hour), EndsAt: start.Add(3 * time.Hour), CreatedAt: start.Add(time.Minute)},
+			{ID: uuid.New(), UserID: dave, UserName: "Dave", StartsAt: start.Add(3 * time.Hour), EndsAt: start.Add(5 * time.Hour), CreatedAt: start.Add(time.Hour)},
+		}
+		shift, ok, err := ResolveOnCall(sched, overrides, at)
+		if err != nil || !ok {
+			t.Fatalf("expected a shift, got ok=%t err=%v", ok, err)
+		}
+		if shift.UserID != carol || shift.UserName != "Carol" || shift.OverrideID == nil || shift.LayerID != nil {
+			t.Fatalf("unexpected shift %+v", shift)
+		}
+	})
+}
+
+func TestOnCallLayerTurnSkipsWholePeriods(t *testing.T) {
+	loc, err := schedule.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+	start := time.Date(2006, 1, 2, 9, 0, 0, 0, loc)
+	// Each rotation has more handoffs before the last time than walking one
+	// by one allows.
+	layers := []OnCallLayer{
+		{Name: "Quarter hours", RotationKind: "cron", Rotation: "*/15 * * * *", StartsAt: start.AddDate(17, 0, 0)},
+		{Name: "Half hours", RotationKind: "rrule", Rotation: "FREQ=DAILY;BYMINUTE=0,30;BYHOUR=0,1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16,17,18,19,20,21,22,23", StartsAt: start.AddDate(14, 0, 0)},
+	}
+	// In order, across both DST changes of 2026.
+	times := []time.Time{
+		time.Date(2026, 3, 8, 1, 30, 0, 0, loc),
+		time.Date(2026, 3, 8, 3, 30, 0, 0, loc),
+		time.Date(2026, 6, 17, 12, 7, 0, 0, loc),
+		time.Date(2026, 11, 1, 1, 30, 0, 0, loc),
+		time.Date(2026, 11, 1, 1, 30, 0, 0, loc).Add(time.Hour),
+	}
+	for _, layer := range layers {
+		rotation, err := schedule.Parse(layer.RotationKind, layer.Rotation, layer.StartsAt, loc)
+		if err != nil {
+			t.Fatalf("parse %s: %v", layer.Name, err)
+		}
+		// Walk every handoff for the expected shifts.
+		wantTurn, wantStart := 0, layer.StartsAt
+		next, _ := rotation.Next(wantStart)
+		for _, at := range times {
+			for !next.After(at) {
+				wantTurn++
+				wantStart = next
+				next, _ = rotation.Next(wantStart)
+			}
+
+			turn, startsAt, endsAt, err := onCallLayerTurn(layer, loc, at)
+			if err != nil {
+				t.Fatalf("%s at %s: %v", layer.Name, at, err)
+			}
+			if turn != wantTurn || !startsAt.Equal(wantStart) || endsAt == nil || !endsAt.Equal(next) {
+				t.Fatalf("%s at %s: expected turn %d from %s to %s, got %d from %s to %v", layer.Name, at, wantTurn, wantStart, next, turn, startsAt, endsAt)
+			}
+		}
+	}
+}
+
+func ptrTime(value time.Time) *time.Time {
+	return &value
+}
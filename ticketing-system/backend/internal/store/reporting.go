@@ -27,11 +27,7 @@ type ProjectReportingSummary struct {
 }
 
 func (s *Store) GetProjectReportingSummary(ctx context.Context, projectID uuid.UUID, from, to time.Time) (ProjectReportingSummary, error) {
-	from = normalizeDateUTC(from)
-	to = normalizeDateUTC(to)
-	if to.Before(from) {
-		from, to = to, from
-	}
+	from, to = normalizeReportingSeriesRange(from, to)
 
 	summary := ProjectReportingSummary{
 		From:            from,
@@ -105,6 +101,57 @@ func (s *Store) GetProjectReportingSummary(ctx context.Context, projectID uuid.U
 	return summary, nil
 }
 
+// ReportingTicketDetail is one row of the per-ticket reporting export.
+type ReportingTicketDetail struct {
+	Key            string
+	Title          string
+	Type           string
+	Priority       string
+	State          string
+	AssigneeName   *string
+	CreatedAt      time.Time
+	ClosedAt       *time.Time
+	CycleTimeHours *float64
+	StoryPoints    *int
+	TimeEstimate   *int
+	LoggedMinutes  int
+}
+
+// StreamReportingTicketDetails calls fn for every ticket that was open at
+// some point in the range, in ticket number order, without buffering the
+// result set.
+func (s *Store) StreamReportingTicketDetails(ctx context.Context, projectID uuid.UUID, from, to time.Time, fn func(ReportingTicketDetail) error) error {
+	from, to = normalizeReportingRange(from, to)
+	rows, err := s.db.Query(ctx, mustSQL("reporting_ticket_details", nil), projectID, from, to)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var item ReportingTicketDetail
+		if err := rows.Scan(
+			&item.Key,
+			&item.Title,
+			&item.Type,
+			&item.Priority,
+			&item.State,
+			&item.AssigneeName,
+			&item.CreatedAt,
+			&item.ClosedAt,
+			&item.CycleTimeHours,
+			&item.StoryPoints,
+			&item.TimeEstimate,
+			&item.LoggedMinutes,
+		); err != nil {
+			return err
+		}
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
 func normalizeDateUTC(value time.Time) time.Time {
 	return time.Date(value.UTC().Year(), value.UTC().Month(), value.UTC().Day(), 0, 0, 0, 0, time.UTC)
 }
@@ -0,0 +1,151 @@
+package store
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"ticketing-system/backend/internal/migrate"
+
+	"github.com/google/uuid"
+)
+
+// openTestStore connects to TEST_DATABASE_URL and applies the migrations. The
+// test is skipped when no database is configured.
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	url := os.Getenv("TEST_DATABASE_URL")
+	if url == "" {
+		t.Skip("TEST_DATABASE_URL not set")
+	}
+	ctx := context.Background()
+	s, err := New(ctx, url)
+	if err != nil {
+		t.Fatalf("connect: %v", err)
+	}
+	t.Cleanup(s.Close)
+	if err := migrate.Apply(ctx, s.DB(), "../../migrations"); err != nil {
+		t.Fatalf("migrate: %v", err)
+	}
+	return s
+}
+
+func TestReportingClosedAtUsesFinalClose(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	project, err := s.CreateProject(ctx, ProjectCreateInput{Key: strings.ToUpper(uuid.NewString()[:4]), Name: "Reporting"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	t.Cleanup(func() { _ = s.DeleteProject(context.Background(), project.ID) })
+
+	states, err := s.ReplaceWorkflowStates(ctx, project.ID, []WorkflowStateInput{
+		{Name: "Open", Order: 0, IsDefault: true},
+		{Name: "Done", Order: 1, IsClosed: true},
+		{Name: "Archived", Order: 2, IsClosed: true},
+	})
+	if err != nil {
+		t.Fatalf("workflow: %v", err)
+	}
+	stateID := map[string]uuid.UUID{}
+	for _, state := range states {
+		stateID[state.Name] = state.ID
+	}
+	story, err := s.CreateStory(ctx, project.ID, StoryCreateInput{Title: "Story"})
+	if err != nil {
+		t.Fatalf("create story: %v", err)
+	}
+	open := stateID["Open"]
+	ticket, err := s.CreateTicket(ctx, project.ID, TicketCreateInput{Title: "Reopened", StoryID: story.ID, StateID: &open})
+	if err != nil {
+		t.Fatalf("create ticket: %v", err)
+	}
+
+	// Close, reopen, close again, then archive.
+	for _, name := range []string{"Done", "Open", "Done", "Archived"} {
+		next := stateID[name]
+		if _, err := s.UpdateTicket(ctx, ticket.ID, TicketUpdateInput{StateID: &next}); err != nil {
+			t.Fatalf("move to %s: %v", name, err)
+		}
+	}
+
+	// Space the recorded transitions one day apart from creation.
+	base := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	if _, err := s.db.Exec(ctx, `UPDATE tickets SET created_at = $2 WHERE id = $1`, ticket.ID, base); err != nil {
+		t.Fatalf("backdate ticket: %v", err)
+	}
+	if _, err := s.db.Exec(ctx, `
+		UPDATE ticket_state_transitions tr
+		SET transitioned_at = $2::timestamptz + (n.rn - 1) * interval '1 day'
+		FROM (SELECT id, row_number() OVER (ORDER BY id) AS rn FROM ticket_state_transitions WHERE ticket_id = $1) n
+		WHERE tr.id = n.id`, ticket.ID, base); err != nil {
+		t.Fatalf("backdate transitions: %v", err)
+	}
+
+	var closedAt *time.Time
+	err = s.StreamReportingTicketDetails(ctx, project.ID, base, base.AddDate(0, 0, 10), func(detail ReportingTicketDetail) error {
+		if detail.Key == ticket.Key {
+			closedAt = detail.ClosedAt
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ticket details: %v", err)
+	}
+
+	// Transitions: created (day 0), Done (1), Open (2), Done (3), Archived (4).
+	want := base.AddDate(0, 0, 3)
+	if closedAt == nil || !closedAt.Equal(want) {
+		t.Fatalf("expected closed_at at the final close %s, got %v", want, closedAt)
+	}
+}
+
+func TestStateTransitionsSnapshotFromClosed(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	project, err := s.CreateProject(ctx, ProjectCreateInput{Key: strings.ToUpper(uuid.NewString()[:4]), Name: "Transitions"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	t.Cleanup(func() { _ = s.DeleteProject(context.Background(), project.ID) })
+
+	states, err := s.ReplaceWorkflowStates(ctx, project.ID, []WorkflowStateInput{
+		{Name: "Open", Order: 0, IsDefault: true},
+		{Name: "Done", Order: 1, IsClosed: true},
+		{Name: "Archived", Order: 2, IsClosed: true},
+	})
+	if err != nil {
+		t.Fatalf("workflow: %v", err)
+	}
+	stateID := map[string]uuid.UUID{}
+	for _, state := range states {
+		stateID[state.Name] = state.ID
+	}
+	story, err := s.CreateStory(ctx, project.ID, StoryCreateInput{Title: "Story"})
+	if err != nil {
+		t.Fatalf("create story: %v", err)
+	}
+	done := stateID["Done"]
+	ticket, err := s.CreateTicket(ctx, project.ID, TicketCreateInput{Title: "Archived", StoryID: story.ID, StateID: &done})
+	if err != nil {
+		t.Fatalf("create ticket: %v", err)
+	}
+	archived := stateID["Archived"]
+	if _, err := s.UpdateTicket(ctx, ticket.ID, TicketUpdateInput{StateID: &archived}); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+
+	var fromClosed bool
+	if err := s.db.QueryRow(ctx, `
+		SELECT from_closed FROM ticket_state_transitions
+		WHERE ticket_id = $1 AND to_state_id = $2`, ticket.ID, archived).Scan(&fromClosed); err != nil {
+		t.Fatalf("load transition: %v", err)
+	}
+	if !fromClosed {
+		t.Fatalf("expected the move out of Done to snapshot a closed source")
+	}
+}
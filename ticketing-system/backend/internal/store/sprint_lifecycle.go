@@ -0,0 +1,312 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	SprintStatusPlanned   = "planned"
+	SprintStatusActive    = "active"
+	SprintStatusCompleted = "completed"
+)
+
+const (
+	SprintCarryOverNextSprint = "next_sprint"
+	SprintCarryOverBacklog    = "backlog"
+)
+
+var (
+	ErrSprintActiveExists   = errors.New("project already has an active sprint")
+	ErrSprintStatusConflict = errors.New("sprint status does not allow this change")
+)
+
+type SprintUpdateInput struct {
+	Name      *string
+	Goal      *string
+	ClearGoal bool
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type SprintCompleteInput struct {
+	CarryOver      string
+	TargetSprintID *uuid.UUID
+	CompletedBy    *uuid.UUID
+}
+
+// SprintCompletion is the snapshot taken when a sprint is completed: what was
+// committed, what was done, and where the unfinished tickets went.
+type SprintCompletion struct {
+	SprintID           uuid.UUID
+	ProjectID          uuid.UUID
+	CarryOver          string
+	CarryOverSprintID  *uuid.UUID
+	CommittedTickets   int
+	CompletedTickets   int
+	CarriedOverTickets int
+	CommittedPoints    int
+	CompletedPoints    int
+	CompletedBy        *uuid.UUID
+	CreatedAt          time.Time
+	Tickets            []SprintCompletionTicket
+}
+
+type SprintCompletionTicket struct {
+	TicketID    uuid.UUID
+	Key         string
+	Title       string
+	StateName   string
+	StoryPoints *int
+	Done        bool
+}
+
+func (s *Store) UpdateSprint(ctx context.Context, projectID, sprintID uuid.UUID, input SprintUpdateInput) (Sprint, error) {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		current, err := queryOne(ctx, tx, mustSQL("sprints_get", map[string]any{"ForUpdate": true}), scanSprintRow, sprintID, projectID)
+		if err != nil {
+			return struct{}{}, err
+		}
+
+		name := current.Name
+		if input.Name != nil {
+			name = strings.TrimSpace(*input.Name)
+			if name == "" {
+				return struct{}{}, errors.New("name required")
+			}
+		}
+		goal := current.Goal
+		if input.ClearGoal {
+			goal = nil
+		} else if input.Goal != nil {
+			goal = input.Goal
+		}
+		startDate := current.StartDate
+		endDate := current.EndDate
+		if input.StartDate != nil {
+			startDate = normalizeDateUTC(*input.StartDate)
+		}
+		if input.EndDate != nil {
+			endDate = normalizeDateUTC(*input.EndDate)
+		}
+		if endDate.Before(startDate) {
+			return struct{}{}, errors.New("end_date must be on or after start_date")
+		}
+		if current.Status == SprintStatusCompleted && (!startDate.Equal(current.StartDate) || !endDate.Equal(current.EndDate)) {
+			return struct{}{}, fmt.Errorf("%w: completed sprint dates cannot change", ErrSprintStatusConflict)
+		}
+
+		if _, err := tx.Exec(ctx, mustSQL("sprints_update", nil), sprintID, projectID, name, goal, startDate, endDate); err != nil {
+			return struct{}{}, err
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return Sprint{}, err
+	}
+	return s.GetSprint(ctx, projectID, sprintID)
+}
+
+// DeleteSprint removes a planned or completed sprint. Active sprints have to
+// be completed first so their tickets are carried over.
+func (s *Store) DeleteSprint(ctx context.Context, projectID, sprintID uuid.UUID) error {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		current, err := queryOne(ctx, tx, mustSQL("sprints_get", map[string]any{"ForUpdate": true}), scanSprintRow, sprintID, projectID)
+		if err != nil {
+			return struct{}{}, err
+		}
+		if current.Status == SprintStatusActive {
+			return struct{}{}, fmt.Errorf("%w: complete the active sprint before deleting it", ErrSprintStatusConflict)
+		}
+		return struct{}{}, execOne(ctx, tx, mustSQL("sprints_delete", nil), pgx.ErrNoRows, sprintID, projectID)
+	})
+	return err
+}
+
+// StartSprint moves a planned sprint to active. A project can only have one
+// active sprint at a time.
+func (s *Store) StartSprint(ctx context.Context, projectID, sprintID uuid.UUID) (Sprint, error) {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		current, err := queryOne(ctx, tx, mustSQL("sprints_get", map[string]any{"ForUpdate": true}), scanSprintRow, sprintID, projectID)
+		if err != nil {
+			return struct{}{}, err
+		}
+		if current.Status != SprintStatusPlanned {
+			return struct{}{}, fmt.Errorf("%w: only planned sprints can be started", ErrSprintStatusConflict)
+		}
+		var activeID uuid.UUID
+		err = tx.QueryRow(ctx, mustSQL("sprints_active_other", nil), projectID, sprintID).Scan(&activeID)
+		if err == nil {
+			return struct{}{}, ErrSprintActiveExists
+		}
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return struct{}{}, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("sprints_start", nil), sprintID, projectID); err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return struct{}{}, ErrSprintActiveExists
+			}
+			return struct{}{}, err
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return Sprint{}, err
+	}
+	return s.GetSprint(ctx, projectID, sprintID)
+}
+
+// CompleteSprint closes an active sprint. It snapshots every committed ticket
+// and whether it was done, then moves the unfinished ones either into the
+// next planned sprint or back to the backlog.
+func (s *Store) CompleteSprint(ctx context.Context, projectID, sprintID uuid.UUID, input SprintCompleteInput) (SprintCompletion, error) {
+	carryOver := strings.TrimSpace(input.CarryOver)
+	if carryOver == "" {
+		carryOver = SprintCarryOverNextSprint
+	}
+	if carryOver != SprintCarryOverNextSprint && carryOver != SprintCarryOverBacklog {
+		return SprintCompletion{}, errors.New("carry_over must be next_sprint or backlog")
+	}
+	if carryOver == SprintCarryOverBacklog && input.TargetSprintID != nil {
+		return SprintCompletion{}, errors.New("target sprint is only valid when carrying over to the next sprint")
+	}
+
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		current, err := queryOne(ctx, tx, mustSQL("sprints_get", map[string]any{"ForUpdate": true}), scanSprintRow, sprintID, projectID)
+		if err != nil {
+			return struct{}{}, err
+		}
+		if current.Status != SprintStatusActive {
+			return struct{}{}, fmt.Errorf("%w: only active sprints can be completed", ErrSprintStatusConflict)
+		}
+
+		var targetID *uuid.UUID
+		if carryOver == SprintCarryOverNextSprint {
+			targetID, err = resolveCarryOverSprint(ctx, tx, projectID, sprintID, input.TargetSprintID)
+			if err != nil {
+				return struct{}{}, err
+			}
+		}
+
+		if _, err := tx.Exec(ctx, mustSQL("sprint_completions_insert", nil), sprintID, projectID, carryOver, targetID, input.CompletedBy); err != nil {
+			return struct{}{}, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("sprint_completion_tickets_insert", nil), sprintID); err != nil {
+			return struct{}{}, err
+		}
+		if targetID != nil {
+			if _, err := tx.Exec(ctx, mustSQL("sprint_tickets_carry_over", nil), sprintID, *targetID); err != nil {
+				return struct{}{}, err
+			}
+		}
+		if _, err := tx.Exec(ctx, mustSQL("sprint_tickets_release_unfinished", nil), sprintID); err != nil {
+			return struct{}{}, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("sprints_complete", nil), sprintID, projectID); err != nil {
+			return struct{}{}, err
+		}
+		return struct{}{}, nil
+	})
+	if err != nil {
+		return SprintCompletion{}, err
+	}
+	return s.GetSprintCompletion(ctx, projectID, sprintID)
+}
+
+func (s *Store) GetSprintCompletion(ctx context.Context, projectID, sprintID uuid.UUID) (SprintCompletion, error) {
+	completion, err := queryOne(ctx, s.db, mustSQL("sprint_completions_get", nil), scanSprintCompletion, sprintID, projectID)
+	if err != nil {
+		return SprintCompletion{}, err
+	}
+	tickets, err := queryMany(ctx, s.db, mustSQL("sprint_completion_tickets_list", nil), scanSprintCompletionTicket, sprintID)
+	if err != nil {
+		return SprintCompletion{}, err
+	}
+	completion.Tickets = tickets
+	return completion, nil
+}
+
+func resolveCarryOverSprint(ctx context.Context, tx pgx.Tx, projectID, sprintID uuid.UUID, requested *uuid.UUID) (*uuid.UUID, error) {
+	if requested == nil {
+		var nextID uuid.UUID
+		err := tx.QueryRow(ctx, mustSQL("sprints_next_planned", nil), projectID, sprintID).Scan(&nextID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.New("no planned sprint to carry unfinished tickets into")
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &nextID, nil
+	}
+	if *requested == sprintID {
+		return nil, errors.New("target sprint must differ from the completed sprint")
+	}
+	var status string
+	err := tx.QueryRow(ctx, mustSQL("sprints_status", nil), *requested, projectID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("sprint %s not in project", *requested)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if status != SprintStatusPlanned {
+		return nil, errors.New("target sprint must be planned")
+	}
+	return requested, nil
+}
+
+// requireOpenSprint checks the sprint belongs to the project and still
+// accepts ticket changes, and keeps it from being completed until tx ends.
+func requireOpenSprint(ctx context.Context, tx pgx.Tx, projectID, sprintID uuid.UUID) error {
+	var status string
+	err := tx.QueryRow(ctx, mustSQL("sprints_status", nil), sprintID, projectID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("sprint %s not in project", sprintID)
+	}
+	if err != nil {
+		return err
+	}
+	if status == SprintStatusCompleted {
+		return fmt.Errorf("%w: sprint is completed", ErrSprintStatusConflict)
+	}
+	return nil
+}
+
+func scanSprintCompletion(row pgx.Row) (SprintCompletion, error) {
+	var out SprintCompletion
+	err := row.Scan(
+		&out.SprintID,
+		&out.ProjectID,
+		&out.CarryOver,
+		&out.CarryOverSprintID,
+		&out.CommittedTickets,
+		&out.CompletedTickets,
+		&out.CommittedPoints,
+		&out.CompletedPoints,
+		&out.CompletedBy,
+		&out.CreatedAt,
+	)
+	out.CarriedOverTickets = out.CommittedTickets - out.CompletedTickets
+	return out, err
+}
+
+func scanSprintCompletionTicket(row pgx.Row) (SprintCompletionTicket, error) {
+	var out SprintCompletionTicket
+	err := row.Scan(
+		&out.TicketID,
+		&out.Key,
+		&out.Title,
+		&out.StateName,
+		&out.StoryPoints,
+		&out.Done,
+	)
+	return out, err
+}
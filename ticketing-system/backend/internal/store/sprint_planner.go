@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"hash/fnv"
+	"math"
 	"math/rand"
 	"sort"
 	"strings"
@@ -21,6 +22,9 @@ type Sprint struct {
 	Goal             *string
 	StartDate        time.Time
 	EndDate          time.Time
+	Status           string
+	StartedAt        *time.Time
+	CompletedAt      *time.Time
 	TicketIDs        []uuid.UUID
 	CommittedTickets int
 	CreatedAt        time.Time
@@ -42,6 +46,7 @@ type CapacitySetting struct {
 	UserID    *uuid.UUID
 	Label     string
 	Capacity  int
+	Unit      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -51,16 +56,31 @@ type CapacitySettingInput struct {
 	UserID   *uuid.UUID
 	Label    string
 	Capacity int
+	Unit     string
 }
 
+// SprintForecastSummary compares committed work with capacity in Unit
+// (tickets, story points or hours). ProjectedCompletion, Committed, Capacity
+// and OverCapacityDelta are all in that unit.
 type SprintForecastSummary struct {
 	Sprint              *Sprint
+	Unit                string
 	CommittedTickets    int
+	Committed           int
 	Capacity            int
 	ProjectedCompletion int
 	OverCapacityDelta   int
 	Confidence          float32
 	Iterations          int
+	Allocations         []CapacityAllocation
+	Warnings            []string
+	// Story-point forecast driven by the velocity of completed sprints.
+	CommittedPoints        int
+	VelocitySprints        int
+	AverageVelocityTickets float32
+	AverageVelocityPoints  float32
+	ProjectedPoints        int
+	PointsConfidence       float32
 }
 
 func (s *Store) ListSprints(ctx context.Context, projectID uuid.UUID) ([]Sprint, error) {
@@ -141,16 +161,26 @@ func (s *Store) GetSprint(ctx context.Context, projectID, sprintID uuid.UUID) (S
 	return sprint, nil
 }
 
+// ActiveSprintID returns the project's active sprint. Projects that never
+// start sprints explicitly fall back to the planned sprint whose date range
+// contains on. It returns nil when no sprint is running.
+func (s *Store) ActiveSprintID(ctx context.Context, projectID uuid.UUID, on time.Time) (*uuid.UUID, error) {
+	var sprintID uuid.UUID
+	err := s.db.QueryRow(ctx, mustSQL("sprints_active_for_date", nil), projectID, normalizeDateUTC(on)).Scan(&sprintID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sprintID, nil
+}
+
 func (s *Store) AddSprintTickets(ctx context.Context, projectID, sprintID uuid.UUID, ticketIDs []uuid.UUID) (Sprint, error) {
 	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
-		// Verify sprint belongs to project
-		var exists bool
-		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM sprints WHERE id = $1 AND project_id = $2)", sprintID, projectID).Scan(&exists); err != nil {
+		if err := requireOpenSprint(ctx, tx, projectID, sprintID); err != nil {
 			return struct{}{}, err
 		}
-		if !exists {
-			return struct{}{}, fmt.Errorf("sprint %s not in project", sprintID)
-		}
 		for _, ticketID := range ticketIDs {
 			var ticketExists bool
 			if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM tickets WHERE id = $1 AND project_id = $2)", ticketID, projectID).Scan(&ticketExists); err != nil {
@@ -173,14 +203,9 @@ func (s *Store) AddSprintTickets(ctx context.Context, projectID, sprintID uuid.U
 
 func (s *Store) RemoveSprintTickets(ctx context.Context, projectID, sprintID uuid.UUID, ticketIDs []uuid.UUID) (Sprint, error) {
 	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
-		// Verify sprint belongs to project
-		var exists bool
-		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM sprints WHERE id = $1 AND project_id = $2)", sprintID, projectID).Scan(&exists); err != nil {
+		if err := requireOpenSprint(ctx, tx, projectID, sprintID); err != nil {
 			return struct{}{}, err
 		}
-		if !exists {
-			return struct{}{}, fmt.Errorf("sprint %s not in project", sprintID)
-		}
 		for _, ticketID := range ticketIDs {
 			if _, err := tx.Exec(ctx, mustSQL("sprint_tickets_delete", nil), sprintID, ticketID); err != nil {
 				return struct{}{}, err
@@ -221,7 +246,11 @@ func (s *Store) ReplaceCapacitySettings(ctx context.Context, projectID uuid.UUID
 			if scope == "team" {
 				input.UserID = nil
 			}
-			if _, err := tx.Exec(ctx, mustSQL("capacity_settings_insert", nil), projectID, scope, input.UserID, label, input.Capacity); err != nil {
+			unit, err := NormalizeForecastUnit(input.Unit)
+			if err != nil {
+				return struct{}{}, err
+			}
+			if _, err := tx.Exec(ctx, mustSQL("capacity_settings_insert", nil), projectID, scope, input.UserID, label, input.Capacity, unit); err != nil {
 				return struct{}{}, err
 			}
 		}
@@ -233,7 +262,8 @@ func (s *Store) ReplaceCapacitySettings(ctx context.Context, projectID uuid.UUID
 	return s.ListCapacitySettings(ctx, projectID)
 }
 
-func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, sprintID *uuid.UUID, iterations int) (SprintForecastSummary, error) {
+func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUID, input SprintForecastInput) (SprintForecastSummary, error) {
+	iterations := input.Iterations
 	if iterations <= 0 {
 		iterations = 250
 	}
@@ -243,16 +273,20 @@ func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUI
 	if iterations > 5000 {
 		iterations = 5000
 	}
+	unit, err := NormalizeForecastUnit(input.Unit)
+	if err != nil {
+		return SprintForecastSummary{}, err
+	}
 
-	summary := SprintForecastSummary{Iterations: iterations}
+	summary := SprintForecastSummary{Iterations: iterations, Unit: unit, Allocations: []CapacityAllocation{}, Warnings: []string{}}
 	sprints, err := s.ListSprints(ctx, projectID)
 	if err != nil {
 		return summary, err
 	}
 	var selected *Sprint
-	if sprintID != nil {
+	if input.SprintID != nil {
 		for i := range sprints {
-			if sprints[i].ID == *sprintID {
+			if sprints[i].ID == *input.SprintID {
 				selected = &sprints[i]
 				break
 			}
@@ -260,10 +294,13 @@ func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUI
 	} else if len(sprints) > 0 {
 		today := normalizeDateUTC(time.Now())
 		for i := range sprints {
-			if !today.Before(sprints[i].StartDate) && !today.After(sprints[i].EndDate) {
+			if sprints[i].Status == SprintStatusActive {
 				selected = &sprints[i]
 				break
 			}
+			if selected == nil && !today.Before(sprints[i].StartDate) && !today.After(sprints[i].EndDate) {
+				selected = &sprints[i]
+			}
 		}
 		if selected == nil {
 			selected = &sprints[0]
@@ -274,20 +311,29 @@ func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUI
 	if err != nil {
 		return summary, err
 	}
-	for _, item := range settings {
-		summary.Capacity += item.Capacity
-	}
 
 	if selected == nil {
+		summary.Capacity, summary.Allocations, summary.Warnings = buildCapacityAllocations(unit, settings, nil, nil, 1)
 		return summary, nil
 	}
 	summary.Sprint = selected
-	summary.CommittedTickets = selected.CommittedTickets
-	if summary.CommittedTickets > summary.Capacity {
-		summary.OverCapacityDelta = summary.CommittedTickets - summary.Capacity
+
+	tickets, err := s.loadForecastTickets(ctx, projectID, selected.ID, input.Tickets)
+	if err != nil {
+		return summary, err
+	}
+	absences, err := s.ListSprintAbsences(ctx, projectID, selected.ID)
+	if err != nil {
+		return summary, err
+	}
+	summary.Capacity, summary.Allocations, summary.Warnings = buildCapacityAllocations(unit, settings, absences, tickets, sprintWorkdays(selected.StartDate, selected.EndDate))
+	summary.CommittedTickets = len(tickets)
+	summary.Committed = forecastCommitted(tickets, unit)
+	if summary.Committed > summary.Capacity {
+		summary.OverCapacityDelta = summary.Committed - summary.Capacity
 	}
 
-	historyRows, err := queryMany(ctx, s.db, mustSQL("forecast_daily_throughput_history", nil), scanDateValuePoint, projectID)
+	historyRows, err := queryMany(ctx, s.db, mustSQL("forecast_daily_throughput_history", map[string]any{"Unit": unit}), scanDateValuePoint, projectID)
 	if err != nil {
 		return summary, err
 	}
@@ -320,7 +366,7 @@ func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUI
 			total += history[rng.Intn(len(history))]
 		}
 		samples = append(samples, total)
-		if total >= summary.CommittedTickets {
+		if total >= summary.Committed {
 			hits++
 		}
 	}
@@ -328,9 +374,53 @@ func (s *Store) GetSprintForecastSummary(ctx context.Context, projectID uuid.UUI
 	sort.Ints(samples)
 	summary.ProjectedCompletion = samples[len(samples)/2]
 	summary.Confidence = float32(hits) / float32(iterations)
+
+	summary.CommittedPoints = forecastCommitted(tickets, ForecastUnitPoints)
+	velocity, err := s.ListSprintVelocity(ctx, projectID, 10)
+	if err != nil {
+		return summary, err
+	}
+	pastSprints := make([]SprintVelocity, 0, len(velocity.Items))
+	for _, item := range velocity.Items {
+		if item.SprintID != selected.ID {
+			pastSprints = append(pastSprints, item)
+		}
+	}
+	summary.VelocitySprints = len(pastSprints)
+	if len(pastSprints) > 0 {
+		for _, item := range pastSprints {
+			summary.AverageVelocityTickets += float32(item.CompletedTickets)
+			summary.AverageVelocityPoints += float32(item.CompletedPoints)
+		}
+		summary.AverageVelocityTickets /= float32(len(pastSprints))
+		summary.AverageVelocityPoints /= float32(len(pastSprints))
+		summary.ProjectedPoints, summary.PointsConfidence = forecastPointsFromVelocity(pastSprints, days, summary.CommittedPoints, iterations, rng)
+	}
 	return summary, nil
 }
 
+// forecastPointsFromVelocity samples completed story points from past sprints,
+// scaled to the length of the forecast sprint, and returns the median and the
+// share of samples that cover the committed points.
+func forecastPointsFromVelocity(past []SprintVelocity, days, committed, iterations int, rng *rand.Rand) (int, float32) {
+	samples := make([]int, 0, iterations)
+	hits := 0
+	for i := 0; i < iterations; i++ {
+		item := past[rng.Intn(len(past))]
+		pastDays := int(item.EndDate.Sub(item.StartDate).Hours()/24) + 1
+		if pastDays < 1 {
+			pastDays = 1
+		}
+		total := int(math.Round(float64(item.CompletedPoints) * float64(days) / float64(pastDays)))
+		samples = append(samples, total)
+		if total >= committed {
+			hits++
+		}
+	}
+	sort.Ints(samples)
+	return samples[len(samples)/2], float32(hits) / float32(iterations)
+}
+
 func scanSprintRow(row pgx.Row) (Sprint, error) {
 	var out Sprint
 	err := row.Scan(
@@ -340,6 +430,9 @@ func scanSprintRow(row pgx.Row) (Sprint, error) {
 		&out.Goal,
 		&out.StartDate,
 		&out.EndDate,
+		&out.Status,
+		&out.StartedAt,
+		&out.CompletedAt,
 		&out.CreatedAt,
 		&out.UpdatedAt,
 		&out.CommittedTickets,
@@ -362,6 +455,7 @@ func scanCapacitySetting(row pgx.Row) (CapacitySetting, error) {
 		&out.UserID,
 		&out.Label,
 		&out.Capacity,
+		&out.Unit,
 		&out.CreatedAt,
 		&out.UpdatedAt,
 	)
@@ -0,0 +1,324 @@
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	SprintScopeAdded       = "added"
+	SprintScopeRemoved     = "removed"
+	SprintScopeCarriedOver = "carried_over"
+)
+
+// SprintScopeEvent is one change to a sprint's ticket membership.
+type SprintScopeEvent struct {
+	TicketID  uuid.UUID
+	Key       string
+	Action    string
+	CreatedAt time.Time
+}
+
+// SprintBurndownTicket is a ticket that was in the sprint at some point, with
+// its state changes so done-ness can be replayed day by day.
+type SprintBurndownTicket struct {
+	TicketID      uuid.UUID
+	Key           string
+	StoryPoints   *int
+	CurrentlyDone bool
+	Transitions   []SprintDoneTransition
+}
+
+type SprintDoneTransition struct {
+	At   time.Time
+	Done bool
+}
+
+// SprintBurndownPoint is the end-of-day state of a sprint. The actual values
+// are nil for days that have not happened yet; the ideal line covers the
+// whole sprint.
+type SprintBurndownPoint struct {
+	Date             time.Time
+	ScopeTickets     *int
+	ScopePoints      *int
+	DoneTickets      *int
+	DonePoints       *int
+	RemainingTickets *int
+	RemainingPoints  *int
+	IdealTickets     float64
+	IdealPoints      float64
+}
+
+// SprintScopeChange marks a ticket added to or removed from a sprint after it
+// started.
+type SprintScopeChange struct {
+	Date        time.Time
+	At          time.Time
+	TicketID    uuid.UUID
+	Key         string
+	Action      string
+	StoryPoints *int
+}
+
+type SprintBurndown struct {
+	SprintID            uuid.UUID
+	StartDate           time.Time
+	EndDate             time.Time
+	InitialScopeTickets int
+	InitialScopePoints  int
+	Points              []SprintBurndownPoint
+	ScopeChanges        []SprintScopeChange
+}
+
+// SprintVelocity is the committed-versus-done snapshot of one completed
+// sprint.
+type SprintVelocity struct {
+	SprintID         uuid.UUID
+	Name             string
+	StartDate        time.Time
+	EndDate          time.Time
+	CommittedTickets int
+	CompletedTickets int
+	CommittedPoints  int
+	CompletedPoints  int
+	CompletedAt      time.Time
+}
+
+type SprintVelocityHistory struct {
+	Items          []SprintVelocity
+	AverageTickets float64
+	AveragePoints  float64
+}
+
+func (s *Store) GetSprintBurndown(ctx context.Context, projectID, sprintID uuid.UUID) (SprintBurndown, error) {
+	sprint, err := s.GetSprint(ctx, projectID, sprintID)
+	if err != nil {
+		return SprintBurndown{}, err
+	}
+	events, err := queryMany(ctx, s.db, mustSQL("sprint_scope_events_list", nil), scanSprintScopeEvent, sprintID)
+	if err != nil {
+		return SprintBurndown{}, err
+	}
+	tickets, err := queryMany(ctx, s.db, mustSQL("sprint_burndown_tickets", nil), scanSprintBurndownTicket, sprintID)
+	if err != nil {
+		return SprintBurndown{}, err
+	}
+
+	rows, err := s.db.Query(ctx, mustSQL("sprint_burndown_transitions", nil), sprintID)
+	if err != nil {
+		return SprintBurndown{}, err
+	}
+	defer rows.Close()
+	byTicket := make(map[uuid.UUID]int, len(tickets))
+	for i := range tickets {
+		byTicket[tickets[i].TicketID] = i
+	}
+	for rows.Next() {
+		var ticketID uuid.UUID
+		var transition SprintDoneTransition
+		if err := rows.Scan(&ticketID, &transition.At, &transition.Done); err != nil {
+			return SprintBurndown{}, err
+		}
+		if i, ok := byTicket[ticketID]; ok {
+			tickets[i].Transitions = append(tickets[i].Transitions, transition)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return SprintBurndown{}, err
+	}
+
+	return BuildSprintBurndown(sprint, tickets, events, time.Now().UTC()), nil
+}
+
+// BuildSprintBurndown replays scope events and state transitions to produce
+// daily burndown (remaining) and burnup (scope and done) series in tickets
+// and story points. Story points use each ticket's current estimate.
+// Carry-over at completion is not a scope change: unfinished tickets stay in
+// scope as remaining work on the final day.
+func BuildSprintBurndown(sprint Sprint, tickets []SprintBurndownTicket, events []SprintScopeEvent, now time.Time) SprintBurndown {
+	out := SprintBurndown{
+		SprintID:     sprint.ID,
+		StartDate:    sprint.StartDate,
+		EndDate:      sprint.EndDate,
+		Points:       []SprintBurndownPoint{},
+		ScopeChanges: []SprintScopeChange{},
+	}
+
+	ticketsByID := make(map[uuid.UUID]SprintBurndownTicket, len(tickets))
+	for _, ticket := range tickets {
+		ticketsByID[ticket.TicketID] = ticket
+	}
+	pointsOf := func(id uuid.UUID) int {
+		if ticket, ok := ticketsByID[id]; ok && ticket.StoryPoints != nil {
+			return *ticket.StoryPoints
+		}
+		return 0
+	}
+
+	baseline := normalizeDateUTC(sprint.StartDate)
+	if sprint.StartedAt != nil {
+		baseline = sprint.StartedAt.UTC()
+	}
+	cutoff := now.UTC()
+	if sprint.CompletedAt != nil && sprint.CompletedAt.Before(cutoff) {
+		cutoff = sprint.CompletedAt.UTC()
+	}
+
+	membersAt := func(at time.Time) map[uuid.UUID]bool {
+		members := map[uuid.UUID]bool{}
+		for _, event := range events {
+			if !event.CreatedAt.Before(at) {
+				break
+			}
+			switch event.Action {
+			case SprintScopeAdded:
+				members[event.TicketID] = true
+			case SprintScopeRemoved:
+				delete(members, event.TicketID)
+			}
+		}
+		return members
+	}
+
+	initial := membersAt(baseline)
+	out.InitialScopeTickets = len(initial)
+	for id := range initial {
+		out.InitialScopePoints += pointsOf(id)
+	}
+
+	for _, event := range events {
+		if event.Action == SprintScopeCarriedOver || event.CreatedAt.Before(baseline) || event.CreatedAt.After(cutoff) {
+			continue
+		}
+		change := SprintScopeChange{
+			Date:     normalizeDateUTC(event.CreatedAt),
+			At:       event.CreatedAt,
+			TicketID: event.TicketID,
+			Key:      event.Key,
+			Action:   event.Action,
+		}
+		if ticket, ok := ticketsByID[event.TicketID]; ok {
+			change.StoryPoints = ticket.StoryPoints
+		}
+		out.ScopeChanges = append(out.ScopeChanges, change)
+	}
+
+	start := normalizeDateUTC(sprint.StartDate)
+	end := normalizeDateUTC(sprint.EndDate)
+	days := int(end.Sub(start).Hours()/24) + 1
+	if days < 1 {
+		days = 1
+	}
+	for i := 0; i < days; i++ {
+		day := start.AddDate(0, 0, i)
+		point := SprintBurndownPoint{Date: day}
+		if days > 1 {
+			fraction := 1 - float64(i)/float64(days-1)
+			point.IdealTickets = float64(out.InitialScopeTickets) * fraction
+			point.IdealPoints = float64(out.InitialScopePoints) * fraction
+		}
+
+		if !day.After(cutoff) {
+			at := day.AddDate(0, 0, 1)
+			if at.After(cutoff) {
+				at = cutoff
+			}
+			scopeTickets, scopePoints, doneTickets, donePoints := 0, 0, 0, 0
+			for id := range membersAt(at) {
+				points := pointsOf(id)
+				scopeTickets++
+				scopePoints += points
+				if ticket, ok := ticketsByID[id]; ok && ticketDoneAt(ticket, at) {
+					doneTickets++
+					donePoints += points
+				}
+			}
+			remainingTickets := scopeTickets - doneTickets
+			remainingPoints := scopePoints - donePoints
+			point.ScopeTickets = &scopeTickets
+			point.ScopePoints = &scopePoints
+			point.DoneTickets = &doneTickets
+			point.DonePoints = &donePoints
+			point.RemainingTickets = &remainingTickets
+			point.RemainingPoints = &remainingPoints
+		}
+		out.Points = append(out.Points, point)
+	}
+	return out
+}
+
+// ticketDoneAt reports whether the ticket was in a closed state at the given
+// instant. Tickets that never changed state are judged by their current state.
+func ticketDoneAt(ticket SprintBurndownTicket, at time.Time) bool {
+	if len(ticket.Transitions) == 0 {
+		return ticket.CurrentlyDone
+	}
+	done := false
+	for _, transition := range ticket.Transitions {
+		if transition.At.After(at) {
+			break
+		}
+		done = transition.Done
+	}
+	return done
+}
+
+// ListSprintVelocity returns completed sprints oldest first, capped to the
+// most recent limit sprints.
+func (s *Store) ListSprintVelocity(ctx context.Context, projectID uuid.UUID, limit int) (SprintVelocityHistory, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	if limit > 50 {
+		limit = 50
+	}
+	items, err := queryMany(ctx, s.db, mustSQL("sprint_velocity_list", nil), scanSprintVelocity, projectID, limit)
+	if err != nil {
+		return SprintVelocityHistory{}, err
+	}
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	history := SprintVelocityHistory{Items: items}
+	if len(items) == 0 {
+		return history, nil
+	}
+	for _, item := range items {
+		history.AverageTickets += float64(item.CompletedTickets)
+		history.AveragePoints += float64(item.CompletedPoints)
+	}
+	history.AverageTickets /= float64(len(items))
+	history.AveragePoints /= float64(len(items))
+	return history, nil
+}
+
+func scanSprintScopeEvent(row pgx.Row) (SprintScopeEvent, error) {
+	var out SprintScopeEvent
+	err := row.Scan(&out.TicketID, &out.Key, &out.Action, &out.CreatedAt)
+	return out, err
+}
+
+func scanSprintBurndownTicket(row pgx.Row) (SprintBurndownTicket, error) {
+	var out SprintBurndownTicket
+	err := row.Scan(&out.TicketID, &out.Key, &out.StoryPoints, &out.CurrentlyDone)
+	return out, err
+}
+
+func scanSprintVelocity(row pgx.Row) (SprintVelocity, error) {
+	var out SprintVelocity
+	err := row.Scan(
+		&out.SprintID,
+		&out.Name,
+		&out.StartDate,
+		&out.EndDate,
+		&out.CommittedTickets,
+		&out.CompletedTickets,
+		&out.CommittedPoints,
+		&out.CompletedPoints,
+		&out.CompletedAt,
+	)
+	return out, err
+}
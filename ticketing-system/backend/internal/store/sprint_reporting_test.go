@@ -0,0 +1,114 @@
+package store
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildSprintBurndown(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	startedAt := start.Add(9 * time.Hour)
+	sprint := Sprint{
+		ID:        uuid.New(),
+		StartDate: start,
+		EndDate:   start.AddDate(0, 0, 4),
+		StartedAt: &startedAt,
+	}
+	three, five, two := 3, 5, 2
+	a := SprintBurndownTicket{TicketID: uuid.New(), Key: "OPS-1", StoryPoints: &three, Transitions: []SprintDoneTransition{
+		{At: start.AddDate(0, 0, 1).Add(15 * time.Hour), Done: true},
+	}}
+	b := SprintBurndownTicket{TicketID: uuid.New(), Key: "OPS-2", StoryPoints: &five}
+	c := SprintBurndownTicket{TicketID: uuid.New(), Key: "OPS-3", StoryPoints: &two, CurrentlyDone: true}
+	events := []SprintScopeEvent{
+		{TicketID: a.TicketID, Key: a.Key, Action: SprintScopeAdded, CreatedAt: start.Add(-24 * time.Hour)},
+		{TicketID: b.TicketID, Key: b.Key, Action: SprintScopeAdded, CreatedAt: start.Add(-24 * time.Hour)},
+		{TicketID: c.TicketID, Key: c.Key, Action: SprintScopeAdded, CreatedAt: start.AddDate(0, 0, 2).Add(10 * time.Hour)},
+		{TicketID: b.TicketID, Key: b.Key, Action: SprintScopeRemoved, CreatedAt: start.AddDate(0, 0, 3).Add(10 * time.Hour)},
+	}
+	now := start.AddDate(0, 0, 3).Add(12 * time.Hour)
+
+	got := BuildSprintBurndown(sprint, []SprintBurndownTicket{a, b, c}, events, now)
+
+	if got.InitialScopeTickets != 2 || got.InitialScopePoints != 8 {
+		t.Fatalf("expected initial scope 2 tickets / 8 points, got %d / %d", got.InitialScopeTickets, got.InitialScopePoints)
+	}
+	if len(got.Points) != 5 {
+		t.Fatalf("expected 5 daily points, got %d", len(got.Points))
+	}
+	if got.Points[0].IdealPoints != 8 || got.Points[4].IdealPoints != 0 {
+		t.Fatalf("unexpected ideal line %v .. %v", got.Points[0].IdealPoints, got.Points[4].IdealPoints)
+	}
+	if got.Points[4].ScopeTickets != nil {
+		t.Fatalf("expected future day to have no actual values")
+	}
+
+	type day struct{ scope, scopePoints, done, remainingPoints int }
+	want := []day{
+		{2, 8, 0, 8},
+		{2, 8, 1, 5},
+		{3, 10, 2, 5},
+		{2, 5, 2, 0},
+	}
+	for i, w := range want {
+		p := got.Points[i]
+		if *p.ScopeTickets != w.scope || *p.ScopePoints != w.scopePoints || *p.DoneTickets != w.done || *p.RemainingPoints != w.remainingPoints {
+			t.Fatalf("day %d: expected %+v, got scope=%d/%d done=%d remaining=%d", i, w, *p.ScopeTickets, *p.ScopePoints, *p.DoneTickets, *p.RemainingPoints)
+		}
+	}
+
+	if len(got.ScopeChanges) != 2 {
+		t.Fatalf("expected 2 scope changes, got %d", len(got.ScopeChanges))
+	}
+	if got.ScopeChanges[0].Key != "OPS-3" || got.ScopeChanges[0].Action != SprintScopeAdded {
+		t.Fatalf("unexpected first scope change %+v", got.ScopeChanges[0])
+	}
+	if got.ScopeChanges[1].Key != "OPS-2" || got.ScopeChanges[1].Action != SprintScopeRemoved {
+		t.Fatalf("unexpected second scope change %+v", got.ScopeChanges[1])
+	}
+}
+
+func TestBuildSprintBurndownIgnoresCarryOver(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	completedAt := start.AddDate(0, 0, 1).Add(17 * time.Hour)
+	sprint := Sprint{ID: uuid.New(), StartDate: start, EndDate: start.AddDate(0, 0, 1), CompletedAt: &completedAt}
+	ticket := SprintBurndownTicket{TicketID: uuid.New(), Key: "OPS-1"}
+	events := []SprintScopeEvent{
+		{TicketID: ticket.TicketID, Key: ticket.Key, Action: SprintScopeAdded, CreatedAt: start.Add(-time.Hour)},
+		{TicketID: ticket.TicketID, Key: ticket.Key, Action: SprintScopeCarriedOver, CreatedAt: completedAt},
+	}
+
+	got := BuildSprintBurndown(sprint, []SprintBurndownTicket{ticket}, events, start.AddDate(0, 0, 10))
+
+	last := got.Points[len(got.Points)-1]
+	if last.RemainingTickets == nil || *last.RemainingTickets != 1 {
+		t.Fatalf("expected carried-over ticket to remain in scope on the final day")
+	}
+	if len(got.ScopeChanges) != 0 {
+		t.Fatalf("expected carry-over not to be a scope change, got %+v", got.ScopeChanges)
+	}
+}
+
+func TestForecastPointsFromVelocity(t *testing.T) {
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	past := []SprintVelocity{
+		{StartDate: start, EndDate: start.AddDate(0, 0, 9), CompletedPoints: 20},
+		{StartDate: start.AddDate(0, 0, 14), EndDate: start.AddDate(0, 0, 23), CompletedPoints: 20},
+	}
+
+	projected, confidence := forecastPointsFromVelocity(past, 5, 10, 100, rand.New(rand.NewSource(1)))
+	if projected != 10 {
+		t.Fatalf("expected velocity scaled to half-length sprint to be 10, got %d", projected)
+	}
+	if confidence != 1 {
+		t.Fatalf("expected full confidence, got %v", confidence)
+	}
+
+	_, confidence = forecastPointsFromVelocity(past, 5, 11, 100, rand.New(rand.NewSource(1)))
+	if confidence != 0 {
+		t.Fatalf("expected zero confidence when commitment exceeds velocity, got %v", confidence)
+	}
+}
@@ -34,3 +34,113 @@ func TestMustSQLRendersHelperBasedTemplate(t *testing.T) {
 		}
 	}
 }
+
+func TestReportingTemplatesUseStateTransitions(t *testing.T) {
+	queries := map[string]string{
+		"reporting_throughput_by_day":        mustSQL("reporting_throughput_by_day", nil),
+		"reporting_average_cycle_time_hours": mustSQL("reporting_average_cycle_time_hours", nil),
+		"reporting_open_by_state_series":     mustSQL("reporting_open_by_state_series", nil),
+		"forecast_daily_throughput_history":  mustSQL("forecast_daily_throughput_history", map[string]any{"Unit": ForecastUnitPoints}),
+		"sprint_burndown_transitions":        mustSQL("sprint_burndown_transitions", nil),
+	}
+	for name, query := range queries {
+		if !strings.Contains(query, "ticket_state_transitions") {
+			t.Fatalf("expected %s to read ticket_state_transitions", name)
+		}
+		if strings.Contains(query, "new_value") || strings.Contains(query, "ws_closed.name") {
+			t.Fatalf("expected %s not to match states by name", name)
+		}
+	}
+}
+
+func TestAiTriageModelTrainingIsLeased(t *testing.T) {
+	claim := mustSQL("ai_triage_models_claim_due", nil)
+	if !strings.Contains(claim, "FOR UPDATE OF st SKIP LOCKED") || !strings.Contains(claim, "training_locked_until < now()") || !strings.Contains(claim, "LIMIT $3") {
+		t.Fatalf("expected the claim to skip leased projects, got %s", claim)
+	}
+	if !strings.Contains(mustSQL("ai_triage_model_upsert", nil), "training_locked_until = NULL") {
+		t.Fatalf("expected saving a model to release the lease")
+	}
+}
+
+func TestStatusPageUpdatesAreCappedPerIncident(t *testing.T) {
+	query := mustSQL("status_page_updates_list", nil)
+	if !strings.Contains(query, "PARTITION BY ticket_id") || !strings.Contains(query, "rn <= $2") {
+		t.Fatalf("expected updates to be capped per incident, got %s", query)
+	}
+}
+
+func TestAiTriageAutoAppliedDecisionsAreExcluded(t *testing.T) {
+	for _, name := range []string{"ai_triage_feedback", "ai_triage_field_outcomes"} {
+		if !strings.Contains(mustSQL(name, nil), "NOT d.auto_applied") {
+			t.Fatalf("expected %s to skip auto-applied decisions", name)
+		}
+	}
+}
+
+func TestStoryDeleteLeavesTicketTombstones(t *testing.T) {
+	query := mustSQL("stories_delete", nil)
+	if !strings.Contains(query, "INSERT INTO ticket_deletions") || !strings.Contains(query, "RETURNING id, project_id, key") {
+		t.Fatalf("expected deleted story tickets to leave tombstones, got %s", query)
+	}
+}
+
+func TestTicketListingsBreakTiesByID(t *testing.T) {
+	query := mustSQL("tickets_list", map[string]any{"LimitArg": 1, "OffsetArg": 2})
+	if !strings.Contains(query, "t.position ASC, t.id ASC") {
+		t.Fatalf("expected offset listing to order by id last, got %s", query)
+	}
+	if strings.Contains(mustSQL("ticket_changes_now", nil), "backend_xid") {
+		t.Fatalf("expected changes window to wait for transactions that have not written yet")
+	}
+}
+
+func TestClosedTransitionsUseFromClosedSnapshot(t *testing.T) {
+	queries := []string{
+		mustSQL("reporting_average_cycle_time_hours", nil),
+		mustSQL("forecast_daily_throughput_history", map[string]any{"Unit": ForecastUnitPoints}),
+	}
+	for _, query := range queries {
+		if !strings.Contains(query, "NOT COALESCE(ws_from.is_closed, tr.from_closed)") {
+			t.Fatalf("expected a deleted source state to fall back to its snapshot, got %s", query)
+		}
+	}
+}
+
+func TestSprintStatusIsReadWithShareLock(t *testing.T) {
+	if !strings.Contains(mustSQL("sprints_status", nil), "FOR SHARE") {
+		t.Fatalf("expected the sprint status to be share-locked")
+	}
+}
+
+func TestPresetSubscriptionClaimLeasesWithoutAdvancingWatermark(t *testing.T) {
+	claim := mustSQL("board_filter_preset_subscriptions_claim_due", nil)
+	if strings.Contains(claim, "last_evaluated_at = now()") || !strings.Contains(claim, "locked_until < now()") {
+		t.Fatalf("claim should lease the subscription, got:\n%s", claim)
+	}
+	record := mustSQL("board_filter_preset_subscriptions_record_matches", nil)
+	if !strings.Contains(record, "last_evaluated_at = now()") || !strings.Contains(record, "locked_until = NULL") {
+		t.Fatalf("recording should advance the watermark and release the lease, got:\n%s", record)
+	}
+}
+
+func TestTimesheetWeekLocksShareAKey(t *testing.T) {
+	for _, name := range []string{"timesheet_week_lock", "time_entries_week_lock", "time_entries_week_lock_for_entry"} {
+		query := mustSQL(name, nil)
+		if !strings.Contains(query, "pg_advisory_xact_lock(hashtextextended('timesheet:' || ") || !strings.Contains(query, "date_trunc('week', ") {
+			t.Fatalf("%s should take the week's advisory lock, got:\n%s", name, query)
+		}
+	}
+}
+
+func TestDuplicateCandidatesPrefilterOnTitleTrigrams(t *testing.T) {
+	query := mustSQL("ticket_duplicate_candidates", nil)
+	if !strings.Contains(query, "t.title % $2::text") {
+		t.Fatalf("expected an indexable trigram prefilter, got:\n%s", query)
+	}
+	// A title just above the floor with an identical description still
+	// reaches the threshold, so the prefilter drops no candidates.
+	if score := 0.7*(duplicateTitleSimilarityFloor+0.001) + 0.3; score < DuplicateSimilarityThreshold-1e-9 {
+		t.Fatalf("floor %.4f cuts off candidates scoring %.4f", duplicateTitleSimilarityFloor, score)
+	}
+}
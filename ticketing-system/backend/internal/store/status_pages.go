@@ -0,0 +1,211 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	StatusPageOperational = "operational"
+	StatusPageDegraded    = "degraded"
+	StatusPageMajorOutage = "major_outage"
+)
+
+var statusPageSlugPattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,62}[a-z0-9])?$`)
+
+// StatusPageSettings controls the public status page of a project. Slug is
+// the page's public address; it defaults to the lowercased project key when
+// the page is first enabled. Title defaults to the project name.
+type StatusPageSettings struct {
+	ProjectID   uuid.UUID
+	Enabled     bool
+	Slug        *string
+	Title       *string
+	ProjectName string
+	UpdatedAt   time.Time
+}
+
+// StatusPageSettingsUpdateInput replaces the settings.
+type StatusPageSettingsUpdateInput struct {
+	Enabled bool
+	Slug    *string
+	Title   *string
+}
+
+// StatusPageIncident is an incident as published on a status page. It only
+// carries fields meant for customers.
+type StatusPageIncident struct {
+	ID          uuid.UUID
+	Title       string
+	Severity    *string
+	Impact      *string
+	Status      *string
+	StartedAt   time.Time
+	MitigatedAt *time.Time
+	ResolvedAt  *time.Time
+	UpdatedAt   time.Time
+	// Updates are the incident's public comments, newest first.
+	Updates []StatusPageUpdate
+}
+
+type StatusPageUpdate struct {
+	ID        uuid.UUID
+	TicketID  uuid.UUID
+	Message   string
+	CreatedAt time.Time
+}
+
+// DisplayTitle returns the configured title, or the project name.
+func (s StatusPageSettings) DisplayTitle() string {
+	if s.Title != nil && strings.TrimSpace(*s.Title) != "" {
+		return *s.Title
+	}
+	return s.ProjectName
+}
+
+// GetStatusPageSettings returns disabled settings for a project that has
+// never configured its status page.
+func (s *Store) GetStatusPageSettings(ctx context.Context, projectID uuid.UUID) (StatusPageSettings, error) {
+	settings, err := queryOne(ctx, s.db, mustSQL("status_page_settings_get", nil), scanStatusPageSettings, projectID)
+	if err == pgx.ErrNoRows {
+		return StatusPageSettings{ProjectID: projectID}, nil
+	}
+	return settings, err
+}
+
+// GetStatusPageBySlug returns pgx.ErrNoRows unless slug belongs to an
+// enabled status page.
+func (s *Store) GetStatusPageBySlug(ctx context.Context, slug string) (StatusPageSettings, error) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" {
+		return StatusPageSettings{}, pgx.ErrNoRows
+	}
+	return queryOne(ctx, s.db, mustSQL("status_page_settings_get_by_slug", nil), scanStatusPageSettings, slug)
+}
+
+func (s *Store) UpdateStatusPageSettings(ctx context.Context, projectID uuid.UUID, input StatusPageSettingsUpdateInput) (StatusPageSettings, error) {
+	var slug *string
+	if input.Slug != nil {
+		value := strings.ToLower(strings.TrimSpace(*input.Slug))
+		if !statusPageSlugPattern.MatchString(value) {
+			return StatusPageSettings{}, errors.New("slug must be 1-64 lowercase letters, digits or dashes")
+		}
+		slug = &value
+	}
+	var title *string
+	if input.Title != nil {
+		if value := strings.TrimSpace(*input.Title); value != "" {
+			title = &value
+		}
+	}
+	settings, err := queryOne(ctx, s.db, mustSQL("status_page_settings_upsert", nil), scanStatusPageSettings,
+		projectID,
+		input.Enabled,
+		slug,
+		title,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return StatusPageSettings{}, errors.New("slug already in use")
+	}
+	return settings, err
+}
+
+// maxStatusPageUpdatesPerIncident caps the public updates listed for one
+// incident; older ones are left off the page.
+const maxStatusPageUpdatesPerIncident = 20
+
+// ListStatusPageIncidents returns the customer-visible incidents of a
+// project with their newest public updates. Unresolved incidents come first, then
+// the most recently started.
+func (s *Store) ListStatusPageIncidents(ctx context.Context, projectID uuid.UUID, limit int) ([]StatusPageIncident, error) {
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	incidents, err := queryMany(ctx, s.db, mustSQL("status_page_incidents_list", nil), scanStatusPageIncident, projectID, limit)
+	if err != nil || len(incidents) == 0 {
+		return incidents, err
+	}
+	ids := make([]uuid.UUID, len(incidents))
+	byID := make(map[uuid.UUID]int, len(incidents))
+	for i, incident := range incidents {
+		ids[i] = incident.ID
+		byID[incident.ID] = i
+	}
+	updates, err := queryMany(ctx, s.db, mustSQL("status_page_updates_list", nil), scanStatusPageUpdate, ids, maxStatusPageUpdatesPerIncident)
+	if err != nil {
+		return nil, err
+	}
+	for _, update := range updates {
+		incident := &incidents[byID[update.TicketID]]
+		incident.Updates = append(incident.Updates, update)
+		if update.CreatedAt.After(incident.UpdatedAt) {
+			incident.UpdatedAt = update.CreatedAt
+		}
+	}
+	return incidents, nil
+}
+
+// StatusPageStatus summarizes incidents for the top of a status page: a
+// major outage while a sev1 is unresolved, degraded while any other incident
+// is, and operational otherwise.
+func StatusPageStatus(incidents []StatusPageIncident) string {
+	status := StatusPageOperational
+	for _, incident := range incidents {
+		if incident.ResolvedAt != nil {
+			continue
+		}
+		if incident.Severity != nil && *incident.Severity == "sev1" {
+			return StatusPageMajorOutage
+		}
+		status = StatusPageDegraded
+	}
+	return status
+}
+
+func scanStatusPageSettings(row pgx.Row) (StatusPageSettings, error) {
+	var out StatusPageSettings
+	err := row.Scan(
+		&out.ProjectID,
+		&out.Enabled,
+		&out.Slug,
+		&out.Title,
+		&out.ProjectName,
+		&out.UpdatedAt,
+	)
+	return out, err
+}
+
+func scanStatusPageIncident(row pgx.Row) (StatusPageIncident, error) {
+	var out StatusPageIncident
+	err := row.Scan(
+		&out.ID,
+		&out.Title,
+		&out.Severity,
+		&out.Impact,
+		&out.Status,
+		&out.StartedAt,
+		&out.MitigatedAt,
+		&out.ResolvedAt,
+		&out.UpdatedAt,
+	)
+	return out, err
+}
+
+func scanStatusPageUpdate(row pgx.Row) (StatusPageUpdate, error) {
+	var out StatusPageUpdate
+	err := row.Scan(
+		&out.ID,
+		&out.TicketID,
+		&out.Message,
+		&out.CreatedAt,
+	)
+	return out, err
+}
@@ -0,0 +1,40 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStatusPageStatus(t *testing.T) {
+	sev1, sev3 := "sev1", "sev3"
+	resolved := time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		incidents []StatusPageIncident
+		want      string
+	}{
+		{name: "no incidents", want: StatusPageOperational},
+		{name: "only resolved", incidents: []StatusPageIncident{{Severity: &sev1, ResolvedAt: &resolved}}, want: StatusPageOperational},
+		{name: "open minor incident", incidents: []StatusPageIncident{{Severity: &sev3}, {}}, want: StatusPageDegraded},
+		{name: "open sev1", incidents: []StatusPageIncident{{Severity: &sev3}, {Severity: &sev1}}, want: StatusPageMajorOutage},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StatusPageStatus(tt.incidents); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestStatusPageSettingsDisplayTitle(t *testing.T) {
+	title := "Acme Status"
+	blank := "  "
+	if got := (StatusPageSettings{ProjectName: "Acme", Title: &title}).DisplayTitle(); got != title {
+		t.Fatalf("expected configured title, got %q", got)
+	}
+	if got := (StatusPageSettings{ProjectName: "Acme", Title: &blank}).DisplayTitle(); got != "Acme" {
+		t.Fatalf("expected project name, got %q", got)
+	}
+}
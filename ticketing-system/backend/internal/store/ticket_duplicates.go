@@ -0,0 +1,52 @@
+package store
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// DuplicateSimilarityThreshold is the lowest trigram similarity, from 0
+	// to 1, at which an open ticket is reported as a likely duplicate.
+	DuplicateSimilarityThreshold = 0.45
+	duplicateCandidateLimit      = 5
+	// duplicateTitleSimilarityFloor is the lowest title similarity that can
+	// reach DuplicateSimilarityThreshold once a matching description adds
+	// its 0.3 weight, less a margin since pg_trgm's % operator is strict.
+	duplicateTitleSimilarityFloor = (DuplicateSimilarityThreshold-0.3)/0.7 - 0.001
+)
+
+// DuplicateCandidate is an open ticket that looks like the same report.
+type DuplicateCandidate struct {
+	TicketID  uuid.UUID
+	Key       string
+	Title     string
+	StateName string
+	Score     float32
+}
+
+// FindDuplicateTickets returns the open tickets in the project most similar
+// to the title and description, best match first. excludeID leaves out the
+// ticket being checked.
+func (s *Store) FindDuplicateTickets(ctx context.Context, projectID uuid.UUID, title, description string, excludeID *uuid.UUID) ([]DuplicateCandidate, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return []DuplicateCandidate{}, nil
+	}
+	return withTx(ctx, s.db, func(tx pgx.Tx) ([]DuplicateCandidate, error) {
+		// The threshold is transaction-local, so pooled connections keep the default.
+		floor := strconv.FormatFloat(duplicateTitleSimilarityFloor, 'f', 4, 64)
+		if _, err := tx.Exec(ctx, mustSQL("ticket_duplicate_title_threshold", nil), floor); err != nil {
+			return nil, err
+		}
+		return queryMany(ctx, tx, mustSQL("ticket_duplicate_candidates", nil), func(row pgx.Row) (DuplicateCandidate, error) {
+			var out DuplicateCandidate
+			err := row.Scan(&out.TicketID, &out.Key, &out.Title, &out.StateName, &out.Score)
+			return out, err
+		}, projectID, title, strings.TrimSpace(description), excludeID, DuplicateSimilarityThreshold, duplicateCandidateLimit)
+	})
+}
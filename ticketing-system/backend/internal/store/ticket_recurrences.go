@@ -0,0 +1,341 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"ticketing-system/backend/internal/schedule"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// TicketRecurrenceLease is how long a claimed recurrence stays locked to one
+// scheduler. A replica that dies mid-run releases the row when it expires.
+const TicketRecurrenceLease = 5 * time.Minute
+
+// ErrTicketRecurrenceAlreadyRan is returned when the claimed occurrence of a
+// recurrence was completed by another run in the meantime.
+var ErrTicketRecurrenceAlreadyRan = errors.New("ticket recurrence occurrence already ran")
+
+type TicketRecurrence struct {
+	ID                uuid.UUID
+	ProjectID         uuid.UUID
+	Name              string
+	TemplateID        uuid.UUID
+	StoryID           uuid.UUID
+	Title             string
+	ScheduleKind      string
+	Schedule          string
+	Timezone          string
+	StartsAt          time.Time
+	AssigneeGroupID   *uuid.UUID
+	LastAssigneeID    *uuid.UUID
+	AddToActiveSprint bool
+	Enabled           bool
+	NextRunAt         *time.Time
+	LastRunAt         *time.Time
+	LastTicketID      *uuid.UUID
+	LastError         *string
+	CreatedBy         *uuid.UUID
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type TicketRecurrenceCreateInput struct {
+	Name              string
+	TemplateID        uuid.UUID
+	StoryID           uuid.UUID
+	Title             string
+	ScheduleKind      string
+	Schedule          string
+	Timezone          string
+	StartsAt          *time.Time
+	AssigneeGroupID   *uuid.UUID
+	AddToActiveSprint bool
+	Enabled           bool
+	CreatedBy         *uuid.UUID
+}
+
+type TicketRecurrenceUpdateInput struct {
+	Name               *string
+	TemplateID         *uuid.UUID
+	StoryID            *uuid.UUID
+	Title              *string
+	ScheduleKind       *string
+	Schedule           *string
+	Timezone           *string
+	StartsAt           *time.Time
+	AssigneeGroupID    *uuid.UUID
+	ClearAssigneeGroup bool
+	AddToActiveSprint  *bool
+	Enabled            *bool
+}
+
+func (s *Store) ListTicketRecurrences(ctx context.Context, projectID uuid.UUID) ([]TicketRecurrence, error) {
+	query := mustSQL("ticket_recurrences_list", nil)
+	return queryMany(ctx, s.db, query, scanTicketRecurrence, projectID)
+}
+
+func (s *Store) GetTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) (TicketRecurrence, error) {
+	query := mustSQL("ticket_recurrences_get", nil)
+	return queryOne(ctx, s.db, query, scanTicketRecurrence, projectID, recurrenceID)
+}
+
+func (s *Store) CreateTicketRecurrence(ctx context.Context, projectID uuid.UUID, input TicketRecurrenceCreateInput) (TicketRecurrence, error) {
+	startsAt := time.Now().UTC()
+	if input.StartsAt != nil {
+		startsAt = *input.StartsAt
+	}
+	rec := TicketRecurrence{
+		ProjectID:         projectID,
+		Name:              strings.TrimSpace(input.Name),
+		TemplateID:        input.TemplateID,
+		StoryID:           input.StoryID,
+		Title:             strings.TrimSpace(input.Title),
+		ScheduleKind:      strings.ToLower(strings.TrimSpace(input.ScheduleKind)),
+		Schedule:          strings.TrimSpace(input.Schedule),
+		Timezone:          strings.TrimSpace(input.Timezone),
+		StartsAt:          startsAt,
+		AssigneeGroupID:   input.AssigneeGroupID,
+		AddToActiveSprint: input.AddToActiveSprint,
+		Enabled:           input.Enabled,
+	}
+	if err := s.prepareTicketRecurrence(ctx, s.db, &rec); err != nil {
+		return TicketRecurrence{}, err
+	}
+
+	query := mustSQL("ticket_recurrences_insert", nil)
+	return queryOne(ctx, s.db, query, scanTicketRecurrence,
+		projectID,
+		rec.Name,
+		rec.TemplateID,
+		rec.StoryID,
+		rec.Title,
+		rec.ScheduleKind,
+		rec.Schedule,
+		rec.Timezone,
+		rec.StartsAt,
+		rec.AssigneeGroupID,
+		rec.AddToActiveSprint,
+		rec.Enabled,
+		rec.NextRunAt,
+		input.CreatedBy,
+	)
+}
+
+// UpdateTicketRecurrence applies input and recomputes the next run from now,
+// so editing a schedule never fires a run that the old schedule had missed.
+func (s *Store) UpdateTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID, input TicketRecurrenceUpdateInput) (TicketRecurrence, error) {
+	return withTx(ctx, s.db, func(tx pgx.Tx) (TicketRecurrence, error) {
+		query := mustSQL("ticket_recurrences_get", map[string]any{"ForUpdate": true})
+		rec, err := queryOne(ctx, tx, query, scanTicketRecurrence, projectID, recurrenceID)
+		if err != nil {
+			return TicketRecurrence{}, err
+		}
+
+		if input.Name != nil {
+			rec.Name = strings.TrimSpace(*input.Name)
+		}
+		if input.TemplateID != nil {
+			rec.TemplateID = *input.TemplateID
+		}
+		if input.StoryID != nil {
+			rec.StoryID = *input.StoryID
+		}
+		if input.Title != nil {
+			rec.Title = strings.TrimSpace(*input.Title)
+		}
+		if input.ScheduleKind != nil {
+			rec.ScheduleKind = strings.ToLower(strings.TrimSpace(*input.ScheduleKind))
+		}
+		if input.Schedule != nil {
+			rec.Schedule = strings.TrimSpace(*input.Schedule)
+		}
+		if input.Timezone != nil {
+			rec.Timezone = strings.TrimSpace(*input.Timezone)
+		}
+		if input.StartsAt != nil {
+			rec.StartsAt = *input.StartsAt
+		}
+		if input.ClearAssigneeGroup {
+			rec.AssigneeGroupID = nil
+		} else if input.AssigneeGroupID != nil {
+			rec.AssigneeGroupID = input.AssigneeGroupID
+		}
+		if input.AddToActiveSprint != nil {
+			rec.AddToActiveSprint = *input.AddToActiveSprint
+		}
+		if input.Enabled != nil {
+			rec.Enabled = *input.Enabled
+		}
+		if err := s.prepareTicketRecurrence(ctx, tx, &rec); err != nil {
+			return TicketRecurrence{}, err
+		}
+
+		update := mustSQL("ticket_recurrences_update", nil)
+		return queryOne(ctx, tx, update, scanTicketRecurrence,
+			projectID,
+			recurrenceID,
+			rec.Name,
+			rec.TemplateID,
+			rec.StoryID,
+			rec.Title,
+			rec.ScheduleKind,
+			rec.Schedule,
+			rec.Timezone,
+			rec.StartsAt,
+			rec.AssigneeGroupID,
+			rec.AddToActiveSprint,
+			rec.Enabled,
+			rec.NextRunAt,
+		)
+	})
+}
+
+func (s *Store) DeleteTicketRecurrence(ctx context.Context, projectID, recurrenceID uuid.UUID) error {
+	query := mustSQL("ticket_recurrences_delete", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, projectID, recurrenceID)
+}
+
+// ClaimDueTicketRecurrences leases up to limit recurrences whose next run has
+// passed. Rows are skipped while another scheduler holds their lease, so
+// several replicas can poll concurrently without creating duplicates.
+func (s *Store) ClaimDueTicketRecurrences(ctx context.Context, limit int) ([]TicketRecurrence, error) {
+	query := mustSQL("ticket_recurrences_claim_due", nil)
+	return queryMany(ctx, s.db, query, scanTicketRecurrence, limit, TicketRecurrenceLease.Seconds())
+}
+
+// CreateRecurringTicket creates the ticket for the claimed occurrence of rec
+// together with its subtasks, records the run and releases the lease in one
+// transaction. A nil nextRunAt ends the recurrence. The run is only recorded
+// while next_run_at still matches the claimed occurrence, so an occurrence
+// never produces two tickets; otherwise nothing is created and
+// ErrTicketRecurrenceAlreadyRan is returned.
+func (s *Store) CreateRecurringTicket(ctx context.Context, rec TicketRecurrence, input TicketCreateInput, subtasks []TicketCreateInput, nextRunAt *time.Time) (Ticket, []Ticket, error) {
+	ids, err := withTx(ctx, s.db, func(tx pgx.Tx) ([]uuid.UUID, error) {
+		ids, err := s.insertTicketWithSubtasks(ctx, tx, rec.ProjectID, input, subtasks, rec.CreatedBy)
+		if err != nil {
+			return nil, err
+		}
+		query := mustSQL("ticket_recurrences_complete", nil)
+		if err := execOne(ctx, tx, query, ErrTicketRecurrenceAlreadyRan, rec.ID, ids[0], input.AssigneeID, nextRunAt, rec.NextRunAt); err != nil {
+			return nil, err
+		}
+		return ids, nil
+	})
+	if err != nil {
+		return Ticket{}, nil, err
+	}
+	return s.getCreatedTickets(ctx, ids)
+}
+
+// FailTicketRecurrenceRun records why a run failed. The lease is kept, so the
+// run is retried once it expires.
+func (s *Store) FailTicketRecurrenceRun(ctx context.Context, recurrenceID uuid.UUID, message string) error {
+	query := mustSQL("ticket_recurrences_fail", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, recurrenceID, message)
+}
+
+// NextRecurrenceAssignee returns the group member after previous in a stable
+// rotation, wrapping around. It returns nil for an empty group.
+func (s *Store) NextRecurrenceAssignee(ctx context.Context, groupID uuid.UUID, previous *uuid.UUID) (*uuid.UUID, error) {
+	var userID uuid.UUID
+	err := s.db.QueryRow(ctx, mustSQL("ticket_recurrences_next_assignee", nil), groupID, previous).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &userID, nil
+}
+
+// NextTicketRecurrenceRun returns the first occurrence of rec's schedule after
+// after, or nil when the schedule has ended.
+func NextTicketRecurrenceRun(rec TicketRecurrence, after time.Time) (*time.Time, error) {
+	loc, err := schedule.LoadLocation(rec.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	sched, err := schedule.Parse(rec.ScheduleKind, rec.Schedule, rec.StartsAt, loc)
+	if err != nil {
+		return nil, err
+	}
+	if after.Before(rec.StartsAt) {
+		// Let a cron schedule fire exactly at starts_at.
+		after = rec.StartsAt.Add(-time.Second)
+	}
+	next, ok := sched.Next(after)
+	if !ok {
+		return nil, nil
+	}
+	next = next.UTC()
+	return &next, nil
+}
+
+// prepareTicketRecurrence validates rec, checks its references belong to the
+// project and sets NextRunAt.
+func (s *Store) prepareTicketRecurrence(ctx context.Context, q dbQuerier, rec *TicketRecurrence) error {
+	if rec.Name == "" {
+		return errors.New("name required")
+	}
+	if rec.Title == "" {
+		return errors.New("title required")
+	}
+	if rec.Timezone == "" {
+		rec.Timezone = "UTC"
+	}
+	next, err := NextTicketRecurrenceRun(*rec, time.Now())
+	if err != nil {
+		return err
+	}
+	rec.NextRunAt = nil
+	if rec.Enabled {
+		rec.NextRunAt = next
+	}
+
+	var templateOK, storyOK, groupOK bool
+	if err := q.QueryRow(ctx, mustSQL("ticket_recurrences_refs_valid", nil), rec.ProjectID, rec.TemplateID, rec.StoryID, rec.AssigneeGroupID).Scan(&templateOK, &storyOK, &groupOK); err != nil {
+		return err
+	}
+	switch {
+	case !templateOK:
+		return errors.New("template not found in project")
+	case !storyOK:
+		return errors.New("story not found in project")
+	case !groupOK:
+		return errors.New("assignee group is not assigned to project")
+	}
+	return nil
+}
+
+func scanTicketRecurrence(row pgx.Row) (TicketRecurrence, error) {
+	var rec TicketRecurrence
+	err := row.Scan(
+		&rec.ID,
+		&rec.ProjectID,
+		&rec.Name,
+		&rec.TemplateID,
+		&rec.StoryID,
+		&rec.Title,
+		&rec.ScheduleKind,
+		&rec.Schedule,
+		&rec.Timezone,
+		&rec.StartsAt,
+		&rec.AssigneeGroupID,
+		&rec.LastAssigneeID,
+		&rec.AddToActiveSprint,
+		&rec.Enabled,
+		&rec.NextRunAt,
+		&rec.LastRunAt,
+		&rec.LastTicketID,
+		&rec.LastError,
+		&rec.CreatedBy,
+		&rec.CreatedAt,
+		&rec.UpdatedAt,
+	)
+	return rec, err
+}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNextTicketRecurrenceRun(t *testing.T) {
+	startsAt := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	rec := TicketRecurrence{ScheduleKind: "cron", Schedule: "0 9 1 * *", Timezone: "UTC", StartsAt: startsAt}
+
+	next, err := NextTicketRecurrenceRun(rec, startsAt.Add(-48*time.Hour))
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+	if next == nil || !next.Equal(startsAt) {
+		t.Fatalf("expected first run at starts_at, got %v", next)
+	}
+
+	next, err = NextTicketRecurrenceRun(rec, startsAt)
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+	if want := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC); next == nil || !next.Equal(want) {
+		t.Fatalf("expected %s, got %v", want, next)
+	}
+
+	rec.ScheduleKind = "rrule"
+	rec.Schedule = "FREQ=DAILY;UNTIL=20240302"
+	next, err = NextTicketRecurrenceRun(rec, time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("next: %v", err)
+	}
+	if next != nil {
+		t.Fatalf("expected ended schedule, got %v", next)
+	}
+
+	rec.Timezone = "Mars/Olympus"
+	if _, err := NextTicketRecurrenceRun(rec, startsAt); err == nil {
+		t.Fatal("expected error for unknown time zone")
+	}
+}
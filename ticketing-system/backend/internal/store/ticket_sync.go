@@ -0,0 +1,221 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// TicketListCursor is the keyset position of a ticket in the default list
+// ordering (state sort order, position, id).
+type TicketListCursor struct {
+	StateOrder int       `json:"o"`
+	Position   float64   `json:"p"`
+	ID         uuid.UUID `json:"i"`
+}
+
+// TicketChangesCursor is the keyset position within a changes feed window.
+// SyncedAt pins the upper bound chosen on the first page. A feed walks the
+// updated tickets first and then the deletions; Phase records which of the
+// two UpdatedAt and ID point into.
+type TicketChangesCursor struct {
+	Phase     string    `json:"p,omitempty"`
+	UpdatedAt time.Time `json:"u"`
+	ID        uuid.UUID `json:"i"`
+	SyncedAt  time.Time `json:"s"`
+}
+
+const ticketChangesPhaseDeleted = "deleted"
+
+// ticketChangesSafetyLag keeps the sync window's upper bound behind the
+// current time so rows stamped just before a slow commit are not skipped.
+const ticketChangesSafetyLag = 2 * time.Second
+
+type TicketDeletion struct {
+	TicketID  uuid.UUID
+	ProjectID uuid.UUID
+	Key       string
+	DeletedAt time.Time
+}
+
+type TicketChanges struct {
+	Items      []Ticket
+	Deleted    []TicketDeletion
+	NextCursor *string
+	SyncedAt   time.Time
+}
+
+func encodeCursor(value any) string {
+	raw, _ := json.Marshal(value)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(cursor string, dest any) error {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return ErrInvalidCursor
+	}
+	if err := json.Unmarshal(raw, dest); err != nil {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// TicketCursor returns the list cursor pointing just past the given ticket.
+func TicketCursor(ticket Ticket) string {
+	return encodeCursor(TicketListCursor{StateOrder: ticket.StateOrder, Position: ticket.Position, ID: ticket.ID})
+}
+
+// ListTicketsAfter returns the page of tickets following cursor in the default
+// list ordering. An empty cursor starts from the beginning. Matching tickets
+// are not counted, so a page costs the same however deep it is.
+func (s *Store) ListTicketsAfter(ctx context.Context, filter TicketFilter, cursor string) ([]Ticket, *string, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = 50
+	}
+	if filter.Limit > 200 {
+		filter.Limit = 200
+	}
+
+	where, args := ticketFilterConditions(filter)
+
+	if cursor != "" {
+		var after TicketListCursor
+		if err := decodeCursor(cursor, &after); err != nil {
+			return nil, nil, err
+		}
+		args = append(args, after.StateOrder, after.Position, after.ID)
+		where += fmt.Sprintf(" AND (s.sort_order, t.position, t.id) > ($%d, $%d, $%d)", len(args)-2, len(args)-1, len(args))
+	}
+
+	args = append(args, filter.Limit+1)
+	query := mustSQL("tickets_list_after", map[string]any{
+		"Where":    where,
+		"LimitArg": len(args),
+	})
+
+	tickets, err := queryMany(ctx, s.db, query, scanTicket, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var next *string
+	if len(tickets) > filter.Limit {
+		tickets = tickets[:filter.Limit]
+		value := TicketCursor(tickets[len(tickets)-1])
+		next = &value
+	}
+	return tickets, next, nil
+}
+
+// ListTicketChanges returns tickets updated and tickets deleted in the window
+// (since, syncedAt]. The window is fixed on the first page and carried in the
+// cursor so every page of one sync sees the same bound. Updated tickets are
+// paged first, followed by deletions, with at most limit entries in total per
+// page.
+//
+// syncedAt trails now() by ticketChangesSafetyLag and stays before the start
+// of any open transaction. updated_at is stamped with the writing
+// transaction's start time, so without that bound a ticket committed after
+// the window was read could land inside a window the client already consumed.
+func (s *Store) ListTicketChanges(ctx context.Context, projectID uuid.UUID, since time.Time, cursor string, limit int) (TicketChanges, error) {
+	if limit <= 0 {
+		limit = 200
+	}
+	if limit > 500 {
+		limit = 500
+	}
+
+	var after *TicketChangesCursor
+	if cursor != "" {
+		var decoded TicketChangesCursor
+		if err := decodeCursor(cursor, &decoded); err != nil {
+			return TicketChanges{}, err
+		}
+		if decoded.Phase != "" && decoded.Phase != ticketChangesPhaseDeleted {
+			return TicketChanges{}, ErrInvalidCursor
+		}
+		after = &decoded
+	}
+
+	var syncedAt time.Time
+	if after != nil {
+		syncedAt = after.SyncedAt
+	} else if err := s.db.QueryRow(ctx, mustSQL("ticket_changes_now", nil), ticketChangesSafetyLag.Seconds()).Scan(&syncedAt); err != nil {
+		return TicketChanges{}, err
+	}
+
+	changes := TicketChanges{SyncedAt: syncedAt, Items: []Ticket{}, Deleted: []TicketDeletion{}}
+	nextCursor := func(phase string, updatedAt time.Time, id uuid.UUID) {
+		value := encodeCursor(TicketChangesCursor{Phase: phase, UpdatedAt: updatedAt, ID: id, SyncedAt: syncedAt})
+		changes.NextCursor = &value
+	}
+
+	if after == nil || after.Phase == "" {
+		args := []any{projectID, since, syncedAt}
+		if after != nil {
+			args = append(args, after.UpdatedAt, after.ID)
+		}
+		args = append(args, limit+1)
+		query := mustSQL("ticket_changes_list", map[string]any{
+			"HasCursor": after != nil,
+			"LimitArg":  len(args),
+		})
+
+		tickets, err := queryMany(ctx, s.db, query, scanTicket, args...)
+		if err != nil {
+			return TicketChanges{}, err
+		}
+		if len(tickets) > limit {
+			tickets = tickets[:limit]
+			last := tickets[len(tickets)-1]
+			changes.Items = tickets
+			nextCursor("", last.UpdatedAt, last.ID)
+			return changes, nil
+		}
+		changes.Items = tickets
+		after = nil
+	}
+
+	remaining := limit - len(changes.Items)
+	args := []any{projectID, since, syncedAt}
+	if after != nil {
+		args = append(args, after.UpdatedAt, after.ID)
+	}
+	args = append(args, remaining+1)
+	query := mustSQL("ticket_deletions_since", map[string]any{
+		"HasCursor": after != nil,
+		"LimitArg":  len(args),
+	})
+
+	deleted, err := queryMany(ctx, s.db, query, scanTicketDeletion, args...)
+	if err != nil {
+		return TicketChanges{}, err
+	}
+	if len(deleted) > remaining {
+		deleted = deleted[:remaining]
+		if len(deleted) > 0 {
+			last := deleted[len(deleted)-1]
+			nextCursor(ticketChangesPhaseDeleted, last.DeletedAt, last.TicketID)
+		} else {
+			nextCursor(ticketChangesPhaseDeleted, since, uuid.Nil)
+		}
+	}
+	changes.Deleted = deleted
+
+	return changes, nil
+}
+
+func scanTicketDeletion(row pgx.Row) (TicketDeletion, error) {
+	var d TicketDeletion
+	err := row.Scan(&d.TicketID, &d.ProjectID, &d.Key, &d.DeletedAt)
+	return d, err
+}
@@ -0,0 +1,57 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTicketCursorRoundTrip(t *testing.T) {
+	ticket := Ticket{ID: uuid.New(), StateOrder: 3, Position: 1.5}
+
+	var decoded TicketListCursor
+	if err := decodeCursor(TicketCursor(ticket), &decoded); err != nil {
+		t.Fatalf("decode cursor: %v", err)
+	}
+	if decoded.StateOrder != 3 || decoded.Position != 1.5 || decoded.ID != ticket.ID {
+		t.Fatalf("unexpected cursor %+v", decoded)
+	}
+}
+
+func TestTicketChangesCursorKeepsTimestampPrecision(t *testing.T) {
+	want := TicketChangesCursor{
+		Phase:     ticketChangesPhaseDeleted,
+		UpdatedAt: time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC),
+		ID:        uuid.New(),
+		SyncedAt:  time.Date(2026, 1, 2, 3, 5, 0, 999999000, time.UTC),
+	}
+
+	var got TicketChangesCursor
+	if err := decodeCursor(encodeCursor(want), &got); err != nil {
+		t.Fatalf("decode cursor: %v", err)
+	}
+	if got.Phase != want.Phase || !got.UpdatedAt.Equal(want.UpdatedAt) || !got.SyncedAt.Equal(want.SyncedAt) || got.ID != want.ID {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+}
+
+func TestDecodeCursorRejectsGarbage(t *testing.T) {
+	for _, cursor := range []string{"not base64!", "bm90IGpzb24"} {
+		var dest TicketListCursor
+		if err := decodeCursor(cursor, &dest); !errors.Is(err, ErrInvalidCursor) {
+			t.Fatalf("expected ErrInvalidCursor for %q, got %v", cursor, err)
+		}
+	}
+}
+
+func TestListTicketChangesRejectsUnknownPhase(t *testing.T) {
+	cursor := encodeCursor(TicketChangesCursor{Phase: "bogus", SyncedAt: time.Now()})
+
+	var s Store
+	if _, err := s.ListTicketChanges(context.Background(), uuid.New(), time.Time{}, cursor, 10); !errors.Is(err, ErrInvalidCursor) {
+		t.Fatalf("expected ErrInvalidCursor, got %v", err)
+	}
+}
@@ -0,0 +1,351 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type TicketTemplate struct {
+	ID               uuid.UUID
+	ProjectID        uuid.UUID
+	Name             string
+	TitlePattern     string
+	Description      string
+	Type             *string
+	Priority         *string
+	StoryPoints      *int
+	TimeEstimate     *int
+	IncidentEnabled  bool
+	IncidentSeverity *string
+	Keywords         []string
+	Subtasks         []TicketTemplateSubtask
+	CreatedBy        *uuid.UUID
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// TicketTemplateSubtask is a ticket created alongside one made from a template.
+// It is linked to the parent as a blocker.
+type TicketTemplateSubtask struct {
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	Type        *string `json:"type,omitempty"`
+	Priority    *string `json:"priority,omitempty"`
+	StoryPoints *int    `json:"storyPoints,omitempty"`
+}
+
+type TicketTemplateCreateInput struct {
+	Name             string
+	TitlePattern     string
+	Description      string
+	Type             *string
+	Priority         *string
+	StoryPoints      *int
+	TimeEstimate     *int
+	IncidentEnabled  bool
+	IncidentSeverity *string
+	Keywords         []string
+	Subtasks         []TicketTemplateSubtask
+	CreatedBy        *uuid.UUID
+}
+
+type TicketTemplateUpdateInput struct {
+	Name             *string
+	TitlePattern     *string
+	Description      *string
+	Type             *string
+	Priority         *string
+	StoryPoints      *int
+	TimeEstimate     *int
+	IncidentEnabled  *bool
+	IncidentSeverity *string
+	Keywords         *[]string
+	Subtasks         *[]TicketTemplateSubtask
+}
+
+const defaultTicketTitlePattern = "{title}"
+
+func (s *Store) ListTicketTemplates(ctx context.Context, projectID uuid.UUID) ([]TicketTemplate, error) {
+	query := mustSQL("ticket_templates_list", nil)
+	return queryMany(ctx, s.db, query, scanTicketTemplate, projectID)
+}
+
+func (s *Store) GetTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) (TicketTemplate, error) {
+	query := mustSQL("ticket_templates_get", nil)
+	return queryOne(ctx, s.db, query, scanTicketTemplate, projectID, templateID)
+}
+
+func (s *Store) CreateTicketTemplate(ctx context.Context, projectID uuid.UUID, input TicketTemplateCreateInput) (TicketTemplate, error) {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return TicketTemplate{}, errors.New("name required")
+	}
+	pattern := strings.TrimSpace(input.TitlePattern)
+	if pattern == "" {
+		pattern = defaultTicketTitlePattern
+	}
+	ticketType, err := normalizeTemplateTicketType(input.Type)
+	if err != nil {
+		return TicketTemplate{}, err
+	}
+	priority, err := normalizeTemplatePriority(input.Priority)
+	if err != nil {
+		return TicketTemplate{}, err
+	}
+	severity, err := normalizeIncidentSeverity(input.IncidentSeverity)
+	if err != nil {
+		return TicketTemplate{}, err
+	}
+	if err := validateTicketEstimates(input.StoryPoints, input.TimeEstimate); err != nil {
+		return TicketTemplate{}, err
+	}
+	subtasks, err := normalizeTemplateSubtasks(input.Subtasks)
+	if err != nil {
+		return TicketTemplate{}, err
+	}
+	payload, err := json.Marshal(subtasks)
+	if err != nil {
+		return TicketTemplate{}, err
+	}
+
+	query := mustSQL("ticket_templates_insert", nil)
+	return queryOne(ctx, s.db, query, scanTicketTemplate,
+		projectID,
+		name,
+		pattern,
+		input.Description,
+		ticketType,
+		priority,
+		input.StoryPoints,
+		input.TimeEstimate,
+		input.IncidentEnabled,
+		severity,
+		normalizeTemplateKeywords(input.Keywords),
+		payload,
+		input.CreatedBy,
+	)
+}
+
+func (s *Store) UpdateTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID, input TicketTemplateUpdateInput) (TicketTemplate, error) {
+	updates := []string{"updated_at = now()"}
+	args := []any{}
+	arg := func(value any) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(len(args))
+	}
+
+	if input.Name != nil {
+		name := strings.TrimSpace(*input.Name)
+		if name == "" {
+			return TicketTemplate{}, errors.New("name required")
+		}
+		updates = append(updates, "name = "+arg(name))
+	}
+	if input.TitlePattern != nil {
+		pattern := strings.TrimSpace(*input.TitlePattern)
+		if pattern == "" {
+			pattern = defaultTicketTitlePattern
+		}
+		updates = append(updates, "title_pattern = "+arg(pattern))
+	}
+	if input.Description != nil {
+		updates = append(updates, "description = "+arg(*input.Description))
+	}
+	if input.Type != nil {
+		ticketType, err := normalizeTemplateTicketType(input.Type)
+		if err != nil {
+			return TicketTemplate{}, err
+		}
+		updates = append(updates, "type = "+arg(ticketType))
+	}
+	if input.Priority != nil {
+		priority, err := normalizeTemplatePriority(input.Priority)
+		if err != nil {
+			return TicketTemplate{}, err
+		}
+		updates = append(updates, "priority = "+arg(priority))
+	}
+	if err := validateTicketEstimates(input.StoryPoints, input.TimeEstimate); err != nil {
+		return TicketTemplate{}, err
+	}
+	if input.StoryPoints != nil {
+		updates = append(updates, "story_points = "+arg(*input.StoryPoints))
+	}
+	if input.TimeEstimate != nil {
+		updates = append(updates, "time_estimate = "+arg(*input.TimeEstimate))
+	}
+	if input.IncidentEnabled != nil {
+		updates = append(updates, "incident_enabled = "+arg(*input.IncidentEnabled))
+	}
+	if input.IncidentSeverity != nil {
+		severity, err := normalizeIncidentSeverity(input.IncidentSeverity)
+		if err != nil {
+			return TicketTemplate{}, err
+		}
+		updates = append(updates, "incident_severity = "+arg(severity))
+	}
+	if input.Keywords != nil {
+		updates = append(updates, "keywords = "+arg(normalizeTemplateKeywords(*input.Keywords)))
+	}
+	if input.Subtasks != nil {
+		subtasks, err := normalizeTemplateSubtasks(*input.Subtasks)
+		if err != nil {
+			return TicketTemplate{}, err
+		}
+		payload, err := json.Marshal(subtasks)
+		if err != nil {
+			return TicketTemplate{}, err
+		}
+		updates = append(updates, "subtasks = "+arg(payload))
+	}
+
+	if len(updates) == 1 {
+		return TicketTemplate{}, errors.New("no updates")
+	}
+
+	args = append(args, projectID, templateID)
+	query := mustSQL("ticket_templates_update", map[string]any{
+		"Updates":    strings.Join(updates, ", "),
+		"ProjectArg": len(args) - 1,
+		"IDArg":      len(args),
+	})
+	return queryOne(ctx, s.db, query, scanTicketTemplate, args...)
+}
+
+func (s *Store) DeleteTicketTemplate(ctx context.Context, projectID, templateID uuid.UUID) error {
+	query := mustSQL("ticket_templates_delete", nil)
+	return execOne(ctx, s.db, query, pgx.ErrNoRows, projectID, templateID)
+}
+
+// RenderTicketTemplateTitle expands the placeholders {title}, {date} and
+// {project} in a template title pattern.
+func RenderTicketTemplateTitle(pattern, title, projectKey string, now time.Time) string {
+	pattern = strings.TrimSpace(pattern)
+	if pattern == "" {
+		pattern = defaultTicketTitlePattern
+	}
+	replacer := strings.NewReplacer(
+		"{title}", strings.TrimSpace(title),
+		"{date}", now.Format("2006-01-02"),
+		"{project}", projectKey,
+	)
+	return strings.TrimSpace(replacer.Replace(pattern))
+}
+
+func normalizeTemplateTicketType(value *string) (*string, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil, nil
+	}
+	normalized, err := normalizeTicketType(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &normalized, nil
+}
+
+func normalizeTemplatePriority(value *string) (*string, error) {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil, nil
+	}
+	normalized := strings.ToLower(strings.TrimSpace(*value))
+	switch normalized {
+	case "low", "medium", "high", "urgent":
+		return &normalized, nil
+	default:
+		return nil, errors.New("invalid priority")
+	}
+}
+
+func normalizeTemplateKeywords(values []string) []string {
+	out := make([]string, 0, len(values))
+	seen := map[string]struct{}{}
+	for _, value := range values {
+		norm := strings.ToLower(strings.TrimSpace(value))
+		if norm == "" {
+			continue
+		}
+		if _, ok := seen[norm]; ok {
+			continue
+		}
+		seen[norm] = struct{}{}
+		out = append(out, norm)
+	}
+	return out
+}
+
+func normalizeTemplateSubtasks(values []TicketTemplateSubtask) ([]TicketTemplateSubtask, error) {
+	if len(values) > 25 {
+		return nil, errors.New("templates support at most 25 subtasks")
+	}
+	out := make([]TicketTemplateSubtask, 0, len(values))
+	for _, subtask := range values {
+		subtask.Title = strings.TrimSpace(subtask.Title)
+		if subtask.Title == "" {
+			return nil, errors.New("subtask title required")
+		}
+		ticketType, err := normalizeTemplateTicketType(subtask.Type)
+		if err != nil {
+			return nil, err
+		}
+		priority, err := normalizeTemplatePriority(subtask.Priority)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateTicketEstimates(subtask.StoryPoints, nil); err != nil {
+			return nil, err
+		}
+		subtask.Type = ticketType
+		subtask.Priority = priority
+		out = append(out, subtask)
+	}
+	return out, nil
+}
+
+func validateTicketEstimates(storyPoints, timeEstimate *int) error {
+	if storyPoints != nil && *storyPoints < 0 {
+		return errors.New("story points must not be negative")
+	}
+	if timeEstimate != nil && *timeEstimate < 0 {
+		return errors.New("time estimate must not be negative")
+	}
+	return nil
+}
+
+func scanTicketTemplate(row pgx.Row) (TicketTemplate, error) {
+	var tmpl TicketTemplate
+	var subtasksRaw []byte
+	if err := row.Scan(
+		&tmpl.ID,
+		&tmpl.ProjectID,
+		&tmpl.Name,
+		&tmpl.TitlePattern,
+		&tmpl.Description,
+		&tmpl.Type,
+		&tmpl.Priority,
+		&tmpl.StoryPoints,
+		&tmpl.TimeEstimate,
+		&tmpl.IncidentEnabled,
+		&tmpl.IncidentSeverity,
+		&tmpl.Keywords,
+		&subtasksRaw,
+		&tmpl.CreatedBy,
+		&tmpl.CreatedAt,
+		&tmpl.UpdatedAt,
+	); err != nil {
+		return TicketTemplate{}, err
+	}
+	tmpl.Subtasks = []TicketTemplateSubtask{}
+	if len(subtasksRaw) > 0 {
+		if err := json.Unmarshal(subtasksRaw, &tmpl.Subtasks); err != nil {
+			return TicketTemplate{}, err
+		}
+	}
+	return tmpl, nil
+}
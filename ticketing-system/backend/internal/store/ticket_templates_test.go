@@ -0,0 +1,101 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestRenderTicketTemplateTitle(t *testing.T) {
+	now := time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		pattern  string
+		title    string
+		expected string
+	}{
+		{name: "empty pattern keeps title", pattern: "", title: " Disk full ", expected: "Disk full"},
+		{name: "all placeholders", pattern: "[{project}] {date}: {title}", title: "Disk full", expected: "[OPS] 2024-03-09: Disk full"},
+		{name: "fixed title", pattern: "Weekly review", title: "ignored", expected: "Weekly review"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RenderTicketTemplateTitle(tt.pattern, tt.title, "OPS", now); got != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNormalizeTemplateSubtasks(t *testing.T) {
+	if _, err := normalizeTemplateSubtasks([]TicketTemplateSubtask{{Title: "  "}}); err == nil {
+		t.Fatal("expected error for blank subtask title")
+	}
+	subtasks := make([]TicketTemplateSubtask, 26)
+	for i := range subtasks {
+		subtasks[i].Title = "task"
+	}
+	if _, err := normalizeTemplateSubtasks(subtasks); err == nil {
+		t.Fatal("expected error for too many subtasks")
+	}
+}
+
+func TestCreateTicketWithSubtasksLinksSubtasks(t *testing.T) {
+	s := openTestStore(t)
+	ctx := context.Background()
+
+	project, err := s.CreateProject(ctx, ProjectCreateInput{Key: strings.ToUpper(uuid.NewString()[:4]), Name: "Templates"})
+	if err != nil {
+		t.Fatalf("create project: %v", err)
+	}
+	t.Cleanup(func() { _ = s.DeleteProject(context.Background(), project.ID) })
+	if _, err := s.ReplaceWorkflowStates(ctx, project.ID, []WorkflowStateInput{{Name: "Open", Order: 0, IsDefault: true}}); err != nil {
+		t.Fatalf("workflow: %v", err)
+	}
+	story, err := s.CreateStory(ctx, project.ID, StoryCreateInput{Title: "Story"})
+	if err != nil {
+		t.Fatalf("create story: %v", err)
+	}
+
+	parent, children, err := s.CreateTicketWithSubtasks(ctx, project.ID,
+		TicketCreateInput{Title: "Disk full", StoryID: story.ID},
+		[]TicketCreateInput{{Title: "Reproduce", StoryID: story.ID}, {Title: "Fix", StoryID: story.ID}},
+		nil)
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if len(children) != 2 {
+		t.Fatalf("expected 2 subtasks, got %d", len(children))
+	}
+	for _, child := range children {
+		links, err := s.ListTicketDependencies(ctx, project.ID, child.ID)
+		if err != nil {
+			t.Fatalf("list dependencies: %v", err)
+		}
+		if len(links) != 1 || links[0].RelatedTicketID != parent.ID || links[0].RelationType != DependencyRelationBlocks {
+			t.Fatalf("expected %s to block the parent, got %+v", child.Key, links)
+		}
+	}
+
+	// A failing subtask leaves neither the parent nor earlier subtasks.
+	_, before, err := s.ListTickets(ctx, TicketFilter{ProjectID: project.ID})
+	if err != nil {
+		t.Fatalf("list tickets: %v", err)
+	}
+	if _, _, err := s.CreateTicketWithSubtasks(ctx, project.ID,
+		TicketCreateInput{Title: "Second", StoryID: story.ID},
+		[]TicketCreateInput{{Title: "Ok", StoryID: story.ID}, {Title: " ", StoryID: story.ID}},
+		nil); err == nil {
+		t.Fatal("expected a blank subtask title to fail")
+	}
+	_, after, err := s.ListTickets(ctx, TicketFilter{ProjectID: project.ID})
+	if err != nil {
+		t.Fatalf("list tickets: %v", err)
+	}
+	if after != before {
+		t.Fatalf("expected no tickets from the failed create, had %d now %d", before, after)
+	}
+}
@@ -41,12 +41,26 @@ type Ticket struct {
 	IncidentImpact        *string
 	IncidentCommanderID   *uuid.UUID
 	IncidentCommanderName *string
-	Position              float64
-	StoryPoints           *int
-	TimeEstimate          *int
-	TimeLogged            int
-	CreatedAt             time.Time
-	UpdatedAt             time.Time
+	// IncidentStatus and the timestamps are set once the ticket is declared
+	// an incident. Each timestamp is when the status was first reached.
+	IncidentStatus         *string
+	IncidentDetectedAt     *time.Time
+	IncidentAcknowledgedAt *time.Time
+	IncidentMitigatedAt    *time.Time
+	IncidentResolvedAt     *time.Time
+	IncidentScribeID       *uuid.UUID
+	IncidentScribeName     *string
+	IncidentCommsLeadID    *uuid.UUID
+	IncidentCommsLeadName  *string
+	// IncidentCustomerVisible publishes the incident on the project's status
+	// page.
+	IncidentCustomerVisible bool
+	Position                float64
+	StoryPoints             *int
+	TimeEstimate            *int
+	TimeLogged              int
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
 }
 
 type TicketFilter struct {
@@ -54,6 +68,8 @@ type TicketFilter struct {
 	StateID    *uuid.UUID
 	AssigneeID *uuid.UUID
 	Blocked    *bool
+	Priority   *string
+	Type       *string
 	Query      string
 	Limit      int
 	Offset     int
@@ -71,25 +87,37 @@ type TicketCreateInput struct {
 	IncidentSeverity    *string
 	IncidentImpact      *string
 	IncidentCommanderID *uuid.UUID
-	StoryPoints         *int
-	TimeEstimate        *int
+	IncidentScribeID    *uuid.UUID
+	IncidentCommsLeadID *uuid.UUID
+	// IncidentCustomerVisible is ignored unless IncidentEnabled is set.
+	IncidentCustomerVisible bool
+	StoryPoints             *int
+	TimeEstimate            *int
 }
 
 type TicketUpdateInput struct {
-	Title               *string
-	Description         *string
-	Type                *string
-	StoryID             *uuid.UUID
-	StateID             *uuid.UUID
-	AssigneeID          *uuid.UUID
-	Priority            *string
-	IncidentEnabled     *bool
-	IncidentSeverity    *string
-	IncidentImpact      *string
-	IncidentCommanderID *uuid.UUID
-	Position            *float64
-	StoryPoints         *int
-	TimeEstimate        *int
+	Title       *string
+	Description *string
+	Type        *string
+	StoryID     *uuid.UUID
+	StateID     *uuid.UUID
+	AssigneeID  *uuid.UUID
+	// ClearAssignee unassigns the ticket when AssigneeID is nil.
+	ClearAssignee           bool
+	Priority                *string
+	IncidentEnabled         *bool
+	IncidentSeverity        *string
+	IncidentImpact          *string
+	IncidentCommanderID     *uuid.UUID
+	IncidentScribeID        *uuid.UUID
+	IncidentCommsLeadID     *uuid.UUID
+	IncidentCustomerVisible *bool
+	// IncidentStatus moves an incident to a status and stamps it and any
+	// earlier status it skipped.
+	IncidentStatus *string
+	Position       *float64
+	StoryPoints    *int
+	TimeEstimate   *int
 }
 
 func (s *Store) ListTickets(ctx context.Context, filter TicketFilter) ([]Ticket, int, error) {
@@ -103,32 +131,7 @@ func (s *Store) ListTickets(ctx context.Context, filter TicketFilter) ([]Ticket,
 		filter.Offset = 0
 	}
 
-	conditions := []string{"t.project_id = $1"}
-	args := []any{filter.ProjectID}
-	arg := func(value any) string {
-		args = append(args, value)
-		return fmt.Sprintf("$%d", len(args))
-	}
-
-	if filter.StateID != nil {
-		conditions = append(conditions, fmt.Sprintf("t.state_id = %s", arg(*filter.StateID)))
-	}
-	if filter.AssigneeID != nil {
-		conditions = append(conditions, fmt.Sprintf("t.assignee_id = %s", arg(*filter.AssigneeID)))
-	}
-	if filter.Blocked != nil {
-		if *filter.Blocked {
-			conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_dependencies td WHERE td.relation_type = 'blocks' AND td.to_ticket_id = t.id)")
-		} else {
-			conditions = append(conditions, "NOT EXISTS (SELECT 1 FROM ticket_dependencies td WHERE td.relation_type = 'blocks' AND td.to_ticket_id = t.id)")
-		}
-	}
-	if strings.TrimSpace(filter.Query) != "" {
-		q := "%%" + strings.TrimSpace(filter.Query) + "%%"
-		conditions = append(conditions, fmt.Sprintf("(t.title ILIKE %s OR t.description ILIKE %s OR t.key ILIKE %s)", arg(q), arg(q), arg(q)))
-	}
-
-	where := strings.Join(conditions, " AND ")
+	where, args := ticketFilterConditions(filter)
 
 	countSQL := mustSQL("tickets_count", map[string]any{
 		"Where": where,
@@ -164,43 +167,101 @@ func (s *Store) GetTicket(ctx context.Context, id uuid.UUID) (Ticket, error) {
 }
 
 func (s *Store) CreateTicket(ctx context.Context, projectID uuid.UUID, input TicketCreateInput) (Ticket, error) {
+	ticketID, err := s.insertTicket(ctx, s.db, projectID, input)
+	if err != nil {
+		return Ticket{}, err
+	}
+	return s.GetTicket(ctx, ticketID)
+}
+
+// CreateTicketWithSubtasks creates a ticket and its subtasks in one
+// transaction. Each subtask is linked as blocking the parent; if any insert
+// fails nothing is created.
+func (s *Store) CreateTicketWithSubtasks(ctx context.Context, projectID uuid.UUID, input TicketCreateInput, subtasks []TicketCreateInput, createdBy *uuid.UUID) (Ticket, []Ticket, error) {
+	ids, err := withTx(ctx, s.db, func(tx pgx.Tx) ([]uuid.UUID, error) {
+		return s.insertTicketWithSubtasks(ctx, tx, projectID, input, subtasks, createdBy)
+	})
+	if err != nil {
+		return Ticket{}, nil, err
+	}
+	return s.getCreatedTickets(ctx, ids)
+}
+
+// insertTicketWithSubtasks inserts a parent ticket followed by its subtasks
+// and returns their ids in that order.
+func (s *Store) insertTicketWithSubtasks(ctx context.Context, tx pgx.Tx, projectID uuid.UUID, input TicketCreateInput, subtasks []TicketCreateInput, createdBy *uuid.UUID) ([]uuid.UUID, error) {
+	parentID, err := s.insertTicket(ctx, tx, projectID, input)
+	if err != nil {
+		return nil, err
+	}
+	ids := []uuid.UUID{parentID}
+	for _, subtask := range subtasks {
+		childID, err := s.insertTicket(ctx, tx, projectID, subtask)
+		if err != nil {
+			return nil, fmt.Errorf("subtask %q: %w", subtask.Title, err)
+		}
+		if _, err := tx.Exec(ctx, mustSQL("ticket_dependencies_insert", nil), projectID, childID, parentID, DependencyRelationBlocks, createdBy); err != nil {
+			return nil, err
+		}
+		ids = append(ids, childID)
+	}
+	return ids, nil
+}
+
+func (s *Store) getCreatedTickets(ctx context.Context, ids []uuid.UUID) (Ticket, []Ticket, error) {
+	tickets := make([]Ticket, 0, len(ids))
+	for _, id := range ids {
+		ticket, err := s.GetTicket(ctx, id)
+		if err != nil {
+			return Ticket{}, nil, err
+		}
+		tickets = append(tickets, ticket)
+	}
+	return tickets[0], tickets[1:], nil
+}
+
+func (s *Store) insertTicket(ctx context.Context, q dbQuerier, projectID uuid.UUID, input TicketCreateInput) (uuid.UUID, error) {
 	title := strings.TrimSpace(input.Title)
 	if title == "" {
-		return Ticket{}, errors.New("title required")
+		return uuid.Nil, errors.New("title required")
 	}
 
-	stateID, err := s.resolveState(ctx, projectID, input.StateID)
+	stateID, err := s.resolveState(ctx, q, projectID, input.StateID)
 	if err != nil {
-		return Ticket{}, err
+		return uuid.Nil, err
 	}
 
 	priority := normalizePriority(input.Priority)
 	ticketType, err := normalizeTicketType(input.Type)
 	if err != nil {
-		return Ticket{}, err
+		return uuid.Nil, err
 	}
 	incidentSeverity, err := normalizeIncidentSeverity(input.IncidentSeverity)
 	if err != nil {
-		return Ticket{}, err
+		return uuid.Nil, err
 	}
 	incidentImpact := normalizeIncidentImpact(input.IncidentImpact)
-	var incidentCommanderID *uuid.UUID
+	var incidentCommanderID, incidentScribeID, incidentCommsLeadID *uuid.UUID
+	incidentCustomerVisible := false
 	if input.IncidentEnabled {
 		incidentCommanderID = input.IncidentCommanderID
+		incidentScribeID = input.IncidentScribeID
+		incidentCommsLeadID = input.IncidentCommsLeadID
+		incidentCustomerVisible = input.IncidentCustomerVisible
 	}
 	if !input.IncidentEnabled {
 		incidentSeverity = nil
 		incidentImpact = nil
 	}
 
-	position, err := s.nextPosition(ctx, stateID)
+	position, err := s.nextPosition(ctx, q, stateID)
 	if err != nil {
-		return Ticket{}, err
+		return uuid.Nil, err
 	}
 
 	var ticketID uuid.UUID
 	query := mustSQL("tickets_insert", nil)
-	row := s.db.QueryRow(
+	row := q.QueryRow(
 		ctx,
 		query,
 		projectID,
@@ -218,158 +279,270 @@ func (s *Store) CreateTicket(ctx context.Context, projectID uuid.UUID, input Tic
 		position,
 		input.StoryPoints,
 		input.TimeEstimate,
+		incidentScribeID,
+		incidentCommsLeadID,
+		incidentCustomerVisible,
 	)
 
 	if err := row.Scan(&ticketID); err != nil {
-		return Ticket{}, err
+		return uuid.Nil, err
 	}
-
-	return s.GetTicket(ctx, ticketID)
+	return ticketID, nil
 }
 
 func (s *Store) UpdateTicket(ctx context.Context, id uuid.UUID, input TicketUpdateInput) (Ticket, error) {
 	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
-		var currentState uuid.UUID
-		currentStateQuery := mustSQL("tickets_current_state", nil)
-		if err := tx.QueryRow(ctx, currentStateQuery, id).Scan(&currentState); err != nil {
-			return struct{}{}, err
-		}
+		return struct{}{}, s.updateTicketTx(ctx, tx, id, input)
+	})
+	if err != nil {
+		return Ticket{}, err
+	}
 
-		newState := currentState
-		if input.StateID != nil {
-			newState = *input.StateID
-		}
+	return s.GetTicket(ctx, id)
+}
 
-		if input.Priority != nil {
-			if *input.Priority == "" {
-				return struct{}{}, errors.New("priority cannot be empty")
-			}
-		}
+func (s *Store) updateTicketTx(ctx context.Context, tx pgx.Tx, id uuid.UUID, input TicketUpdateInput) error {
+	var currentState uuid.UUID
+	currentStateQuery := mustSQL("tickets_current_state", nil)
+	if err := tx.QueryRow(ctx, currentStateQuery, id).Scan(&currentState); err != nil {
+		return err
+	}
+
+	newState := currentState
+	if input.StateID != nil {
+		newState = *input.StateID
+	}
 
-		updates := []string{"updated_at = now()"}
-		args := []any{}
-		arg := func(value any) string {
-			args = append(args, value)
-			return fmt.Sprintf("$%d", len(args))
+	if input.Priority != nil {
+		if *input.Priority == "" {
+			return errors.New("priority cannot be empty")
 		}
+	}
+
+	updates := []string{"updated_at = now()"}
+	args := []any{}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
 
-		if input.Title != nil {
-			updates = append(updates, fmt.Sprintf("title = %s", arg(strings.TrimSpace(*input.Title))))
+	if input.Title != nil {
+		updates = append(updates, fmt.Sprintf("title = %s", arg(strings.TrimSpace(*input.Title))))
+	}
+	if input.Description != nil {
+		updates = append(updates, fmt.Sprintf("description = %s", arg(*input.Description)))
+	}
+	if input.Type != nil {
+		ticketType, err := normalizeTicketType(*input.Type)
+		if err != nil {
+			return err
 		}
-		if input.Description != nil {
-			updates = append(updates, fmt.Sprintf("description = %s", arg(*input.Description)))
+		updates = append(updates, fmt.Sprintf("type = %s", arg(ticketType)))
+	}
+	if input.StoryID != nil {
+		updates = append(updates, fmt.Sprintf("story_id = %s", arg(*input.StoryID)))
+	}
+	if input.StateID != nil {
+		updates = append(updates, fmt.Sprintf("state_id = %s", arg(newState)))
+	}
+	if input.AssigneeID != nil {
+		updates = append(updates, fmt.Sprintf("assignee_id = %s", arg(*input.AssigneeID)))
+	} else if input.ClearAssignee {
+		updates = append(updates, "assignee_id = NULL")
+	}
+	if input.Priority != nil {
+		updates = append(updates, fmt.Sprintf("priority = %s", arg(normalizePriority(*input.Priority))))
+	}
+	incidentUpdates, err := ticketIncidentUpdates(input, arg)
+	if err != nil {
+		return err
+	}
+	updates = append(updates, incidentUpdates...)
+	if input.StoryPoints != nil {
+		updates = append(updates, fmt.Sprintf("story_points = %s", arg(*input.StoryPoints)))
+	}
+	if input.TimeEstimate != nil {
+		updates = append(updates, fmt.Sprintf("time_estimate = %s", arg(*input.TimeEstimate)))
+	}
+
+	position := input.Position
+	if position == nil && input.StateID != nil && newState != currentState {
+		nextPos, err := s.nextPositionTx(ctx, tx, newState)
+		if err != nil {
+			return err
 		}
-		if input.Type != nil {
-			ticketType, err := normalizeTicketType(*input.Type)
-			if err != nil {
-				return struct{}{}, err
+		position = &nextPos
+	}
+
+	if position != nil {
+		updates = append(updates, fmt.Sprintf("position = %s", arg(*position)))
+	}
+
+	if len(updates) == 1 {
+		return errors.New("no updates")
+	}
+
+	args = append(args, id)
+	query := mustSQL("tickets_update", map[string]any{
+		"Updates": strings.Join(updates, ", "),
+		"IDArg":   len(args),
+	})
+
+	if _, err := tx.Exec(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ticketIncidentUpdates builds the SET assignments for the incident fields of
+// an update, assigning each column at most once. Disabling an incident
+// clears all of its fields, so other incident fields in the same update are
+// ignored; enabling it with a status starts from that status instead of
+// detected.
+func ticketIncidentUpdates(input TicketUpdateInput, arg func(any) string) ([]string, error) {
+	var updates []string
+	if input.IncidentEnabled != nil {
+		updates = append(updates, fmt.Sprintf("incident_enabled = %s", arg(*input.IncidentEnabled)))
+		if !*input.IncidentEnabled {
+			updates = append(updates,
+				"incident_severity = NULL",
+				"incident_impact = NULL",
+				"incident_commander_id = NULL",
+				"incident_scribe_id = NULL",
+				"incident_comms_lead_id = NULL",
+				"incident_customer_visible = false",
+				"incident_status = NULL",
+			)
+			for _, status := range IncidentStatuses {
+				updates = append(updates, fmt.Sprintf("incident_%s_at = NULL", status))
 			}
-			updates = append(updates, fmt.Sprintf("type = %s", arg(ticketType)))
+			return updates, nil
 		}
-		if input.StoryID != nil {
-			updates = append(updates, fmt.Sprintf("story_id = %s", arg(*input.StoryID)))
+		if input.IncidentStatus == nil {
+			updates = append(updates,
+				"incident_status = COALESCE(incident_status, 'detected')",
+				"incident_detected_at = COALESCE(incident_detected_at, now())",
+			)
 		}
-		if input.StateID != nil {
-			updates = append(updates, fmt.Sprintf("state_id = %s", arg(newState)))
-		}
-		if input.AssigneeID != nil {
-			updates = append(updates, fmt.Sprintf("assignee_id = %s", arg(*input.AssigneeID)))
-		}
-		if input.Priority != nil {
-			updates = append(updates, fmt.Sprintf("priority = %s", arg(normalizePriority(*input.Priority))))
-		}
-		if input.IncidentEnabled != nil {
-			updates = append(updates, fmt.Sprintf("incident_enabled = %s", arg(*input.IncidentEnabled)))
-			if !*input.IncidentEnabled {
-				updates = append(updates, "incident_severity = NULL")
-				updates = append(updates, "incident_impact = NULL")
-				updates = append(updates, "incident_commander_id = NULL")
-			}
-		}
-		if input.IncidentSeverity != nil {
-			sev, err := normalizeIncidentSeverity(input.IncidentSeverity)
-			if err != nil {
-				return struct{}{}, err
-			}
-			if sev == nil {
-				updates = append(updates, "incident_severity = NULL")
-			} else {
-				updates = append(updates, fmt.Sprintf("incident_severity = %s", arg(*sev)))
-			}
+	}
+	if input.IncidentStatus != nil {
+		rank := IncidentStatusRank(*input.IncidentStatus)
+		if rank < 0 {
+			return nil, errors.New("invalid incident status")
 		}
-		if input.IncidentImpact != nil {
-			impact := normalizeIncidentImpact(input.IncidentImpact)
-			if impact == nil {
-				updates = append(updates, "incident_impact = NULL")
-			} else {
-				updates = append(updates, fmt.Sprintf("incident_impact = %s", arg(*impact)))
-			}
+		updates = append(updates, fmt.Sprintf("incident_status = %s", arg(*input.IncidentStatus)))
+		for _, status := range IncidentStatuses[:rank+1] {
+			updates = append(updates, fmt.Sprintf("incident_%[1]s_at = COALESCE(incident_%[1]s_at, now())", status))
 		}
-		if input.IncidentCommanderID != nil {
-			updates = append(updates, fmt.Sprintf("incident_commander_id = %s", arg(*input.IncidentCommanderID)))
+	}
+	if input.IncidentSeverity != nil {
+		sev, err := normalizeIncidentSeverity(input.IncidentSeverity)
+		if err != nil {
+			return nil, err
 		}
-		if input.StoryPoints != nil {
-			updates = append(updates, fmt.Sprintf("story_points = %s", arg(*input.StoryPoints)))
+		if sev == nil {
+			updates = append(updates, "incident_severity = NULL")
+		} else {
+			updates = append(updates, fmt.Sprintf("incident_severity = %s", arg(*sev)))
 		}
-		if input.TimeEstimate != nil {
-			updates = append(updates, fmt.Sprintf("time_estimate = %s", arg(*input.TimeEstimate)))
+	}
+	if input.IncidentImpact != nil {
+		impact := normalizeIncidentImpact(input.IncidentImpact)
+		if impact == nil {
+			updates = append(updates, "incident_impact = NULL")
+		} else {
+			updates = append(updates, fmt.Sprintf("incident_impact = %s", arg(*impact)))
 		}
-
-		position := input.Position
-		if position == nil && input.StateID != nil && newState != currentState {
-			nextPos, err := s.nextPositionTx(ctx, tx, newState)
-			if err != nil {
-				return struct{}{}, err
-			}
-			position = &nextPos
+	}
+	if input.IncidentCommanderID != nil {
+		updates = append(updates, fmt.Sprintf("incident_commander_id = %s", arg(*input.IncidentCommanderID)))
+	}
+	if input.IncidentScribeID != nil {
+		updates = append(updates, fmt.Sprintf("incident_scribe_id = %s", arg(*input.IncidentScribeID)))
+	}
+	if input.IncidentCommsLeadID != nil {
+		updates = append(updates, fmt.Sprintf("incident_comms_lead_id = %s", arg(*input.IncidentCommsLeadID)))
+	}
+	if input.IncidentCustomerVisible != nil {
+		if input.IncidentEnabled == nil {
+			// Only incidents can be shown on the status page.
+			updates = append(updates, fmt.Sprintf("incident_customer_visible = incident_enabled AND %s", arg(*input.IncidentCustomerVisible)))
+		} else {
+			updates = append(updates, fmt.Sprintf("incident_customer_visible = %s", arg(*input.IncidentCustomerVisible)))
 		}
+	}
+	return updates, nil
+}
 
-		if position != nil {
-			updates = append(updates, fmt.Sprintf("position = %s", arg(*position)))
-		}
+// ticketFilterConditions builds the WHERE clause shared by the offset and
+// cursor ticket listings. The returned args can be appended to.
+func ticketFilterConditions(filter TicketFilter) (string, []any) {
+	conditions := []string{"t.project_id = $1"}
+	args := []any{filter.ProjectID}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
 
-		if len(updates) == 1 {
-			return struct{}{}, errors.New("no updates")
+	if filter.StateID != nil {
+		conditions = append(conditions, fmt.Sprintf("t.state_id = %s", arg(*filter.StateID)))
+	}
+	if filter.AssigneeID != nil {
+		conditions = append(conditions, fmt.Sprintf("t.assignee_id = %s", arg(*filter.AssigneeID)))
+	}
+	if filter.Blocked != nil {
+		if *filter.Blocked {
+			conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_dependencies td WHERE td.relation_type = 'blocks' AND td.to_ticket_id = t.id)")
+		} else {
+			conditions = append(conditions, "NOT EXISTS (SELECT 1 FROM ticket_dependencies td WHERE td.relation_type = 'blocks' AND td.to_ticket_id = t.id)")
 		}
+	}
+	if filter.Priority != nil {
+		conditions = append(conditions, fmt.Sprintf("t.priority = %s", arg(strings.ToLower(strings.TrimSpace(*filter.Priority)))))
+	}
+	if filter.Type != nil {
+		conditions = append(conditions, fmt.Sprintf("t.type = %s", arg(strings.ToLower(strings.TrimSpace(*filter.Type)))))
+	}
+	if strings.TrimSpace(filter.Query) != "" {
+		q := "%%" + strings.TrimSpace(filter.Query) + "%%"
+		conditions = append(conditions, fmt.Sprintf("(t.title ILIKE %s OR t.description ILIKE %s OR t.key ILIKE %s)", arg(q), arg(q), arg(q)))
+	}
 
-		args = append(args, id)
-		query := mustSQL("tickets_update", map[string]any{
-			"Updates": strings.Join(updates, ", "),
-			"IDArg":   len(args),
-		})
+	return strings.Join(conditions, " AND "), args
+}
 
-		if _, err := tx.Exec(ctx, query, args...); err != nil {
+// DeleteTicket removes a ticket and records a tombstone so incremental sync
+// clients can drop it from their local copy.
+func (s *Store) DeleteTicket(ctx context.Context, id uuid.UUID) error {
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		var projectID uuid.UUID
+		var key string
+		if err := tx.QueryRow(ctx, mustSQL("tickets_delete", nil), id).Scan(&projectID, &key); err != nil {
+			return struct{}{}, err
+		}
+		if _, err := tx.Exec(ctx, mustSQL("ticket_deletions_insert", nil), id, projectID, key); err != nil {
 			return struct{}{}, err
 		}
-
 		return struct{}{}, nil
 	})
-	if err != nil {
-		return Ticket{}, err
-	}
-
-	return s.GetTicket(ctx, id)
-}
-
-func (s *Store) DeleteTicket(ctx context.Context, id uuid.UUID) error {
-	query := mustSQL("tickets_delete", nil)
-	return execOne(ctx, s.db, query, pgx.ErrNoRows, id)
+	return err
 }
 
-func (s *Store) resolveState(ctx context.Context, projectID uuid.UUID, provided *uuid.UUID) (uuid.UUID, error) {
+func (s *Store) resolveState(ctx context.Context, q dbQuerier, projectID uuid.UUID, provided *uuid.UUID) (uuid.UUID, error) {
 	if provided != nil {
 		return *provided, nil
 	}
 
 	var id uuid.UUID
 	query := mustSQL("tickets_state_default", nil)
-	err := s.db.QueryRow(ctx, query, projectID).Scan(&id)
+	err := q.QueryRow(ctx, query, projectID).Scan(&id)
 	if err == nil {
 		return id, nil
 	}
 
 	fallbackQuery := mustSQL("tickets_state_any", nil)
-	err = s.db.QueryRow(ctx, fallbackQuery, projectID).Scan(&id)
+	err = q.QueryRow(ctx, fallbackQuery, projectID).Scan(&id)
 	if err != nil {
 		return uuid.Nil, errors.New("no workflow state available")
 	}
@@ -377,10 +550,10 @@ func (s *Store) resolveState(ctx context.Context, projectID uuid.UUID, provided
 	return id, nil
 }
 
-func (s *Store) nextPosition(ctx context.Context, stateID uuid.UUID) (float64, error) {
+func (s *Store) nextPosition(ctx context.Context, q dbQuerier, stateID uuid.UUID) (float64, error) {
 	var position float64
 	query := mustSQL("tickets_next_position", nil)
-	err := s.db.QueryRow(ctx, query, stateID).Scan(&position)
+	err := q.QueryRow(ctx, query, stateID).Scan(&position)
 	return position, err
 }
 
@@ -429,6 +602,16 @@ func scanTicket(row pgx.Row) (Ticket, error) {
 		&ticket.IsBlocked,
 		&ticket.AssigneeName,
 		&ticket.IncidentCommanderName,
+		&ticket.IncidentStatus,
+		&ticket.IncidentDetectedAt,
+		&ticket.IncidentAcknowledgedAt,
+		&ticket.IncidentMitigatedAt,
+		&ticket.IncidentResolvedAt,
+		&ticket.IncidentScribeID,
+		&ticket.IncidentScribeName,
+		&ticket.IncidentCommsLeadID,
+		&ticket.IncidentCommsLeadName,
+		&ticket.IncidentCustomerVisible,
 	)
 	return ticket, err
 }
@@ -9,6 +9,10 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+// ErrTimePeriodLocked is returned when a time entry falls in a week whose
+// timesheet has been approved.
+var ErrTimePeriodLocked = errors.New("time period locked")
+
 type TimeEntry struct {
 	ID          uuid.UUID
 	TicketID    uuid.UUID
@@ -45,6 +49,15 @@ func (s *Store) ListTimeEntries(ctx context.Context, ticketID uuid.UUID) ([]Time
 }
 
 func (s *Store) CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input TimeEntryCreateInput) (TimeEntry, error) {
+	return withTx(ctx, s.db, func(tx pgx.Tx) (TimeEntry, error) {
+		return createTimeEntry(ctx, tx, ticketID, input)
+	})
+}
+
+// createTimeEntry inserts an entry unless its week has been approved. It
+// holds the week's lock until tx ends, so an approval cannot land between
+// the check and the insert.
+func createTimeEntry(ctx context.Context, tx pgx.Tx, ticketID uuid.UUID, input TimeEntryCreateInput) (TimeEntry, error) {
 	if input.Minutes <= 0 {
 		return TimeEntry{}, errors.New("minutes must be positive")
 	}
@@ -54,10 +67,21 @@ func (s *Store) CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input T
 		loggedAt = *input.LoggedAt
 	}
 
+	if _, err := tx.Exec(ctx, mustSQL("time_entries_week_lock", nil), ticketID, input.UserID, loggedAt); err != nil {
+		return TimeEntry{}, err
+	}
+	var locked bool
+	if err := tx.QueryRow(ctx, mustSQL("time_entries_locked", nil), ticketID, input.UserID, loggedAt).Scan(&locked); err != nil {
+		return TimeEntry{}, err
+	}
+	if locked {
+		return TimeEntry{}, ErrTimePeriodLocked
+	}
+
 	query := mustSQL("time_entries_insert", nil)
 	var id uuid.UUID
 	var createdAt time.Time
-	if err := s.db.QueryRow(ctx, query,
+	if err := tx.QueryRow(ctx, query,
 		ticketID,
 		input.UserID,
 		input.UserName,
@@ -80,9 +104,26 @@ func (s *Store) CreateTimeEntry(ctx context.Context, ticketID uuid.UUID, input T
 	}, nil
 }
 
+// DeleteTimeEntry removes an entry unless its week has been approved,
+// holding the week's lock like createTimeEntry.
 func (s *Store) DeleteTimeEntry(ctx context.Context, id uuid.UUID) error {
-	query := mustSQL("time_entries_delete", nil)
-	return execOne(ctx, s.db, query, pgx.ErrNoRows, id)
+	_, err := withTx(ctx, s.db, func(tx pgx.Tx) (struct{}, error) {
+		if _, err := tx.Exec(ctx, mustSQL("time_entries_week_lock_for_entry", nil), id); err != nil {
+			return struct{}{}, err
+		}
+		var locked *bool
+		if err := tx.QueryRow(ctx, mustSQL("time_entries_delete_unlocked", nil), id).Scan(&locked); err != nil {
+			return struct{}{}, err
+		}
+		if locked == nil {
+			return struct{}{}, pgx.ErrNoRows
+		}
+		if *locked {
+			return struct{}{}, ErrTimePeriodLocked
+		}
+		return struct{}{}, nil
+	})
+	return err
 }
 
 func scanTimeEntry(row pgx.Row) (TimeEntry, error) {
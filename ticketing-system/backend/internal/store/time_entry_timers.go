@@ -0,0 +1,179 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrTimerRunning is returned when starting a timer the user already has
+// running on the ticket.
+var ErrTimerRunning = errors.New("timer already running")
+
+// DefaultIdleTimerHours is how long a timer may run before its owner is
+// reminded about it, for projects that have not configured it.
+const DefaultIdleTimerHours = 8
+
+// TimeEntryTimer is a running timer. Stopping it logs the elapsed time as a
+// time entry.
+type TimeEntryTimer struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	TicketID       uuid.UUID
+	TicketKey      string
+	TicketTitle    string
+	UserID         uuid.UUID
+	UserName       string
+	Description    *string
+	StartedAt      time.Time
+	IdleNotifiedAt *time.Time
+}
+
+type TimeEntryTimerStartInput struct {
+	UserID      uuid.UUID
+	UserName    string
+	Description *string
+}
+
+type TimeTrackingSettings struct {
+	AutoStopOnClose bool
+	IdleTimerHours  int
+}
+
+type TimeTrackingSettingsUpdateInput struct {
+	AutoStopOnClose *bool
+	IdleTimerHours  *int
+}
+
+func (s *Store) ListTimeEntryTimers(ctx context.Context, projectID, userID uuid.UUID) ([]TimeEntryTimer, error) {
+	return queryMany(ctx, s.db, mustSQL("time_entry_timers_list", nil), scanTimeEntryTimer, projectID, userID)
+}
+
+// StartTimeEntryTimer starts the user's timer on a ticket. It returns
+// ErrTimerRunning if one is already running and pgx.ErrNoRows if the ticket
+// is not in the project.
+func (s *Store) StartTimeEntryTimer(ctx context.Context, projectID, ticketID uuid.UUID, input TimeEntryTimerStartInput) (TimeEntryTimer, error) {
+	timer, err := queryOne(ctx, s.db, mustSQL("time_entry_timers_start", nil), scanTimeEntryTimer,
+		projectID, ticketID, input.UserID, input.UserName, input.Description)
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return timer, err
+	}
+	if _, err := queryOne(ctx, s.db, mustSQL("time_entry_timers_get", nil), scanTimeEntryTimer, projectID, ticketID, input.UserID); err != nil {
+		return TimeEntryTimer{}, err
+	}
+	return TimeEntryTimer{}, ErrTimerRunning
+}
+
+// StopTimeEntryTimer stops the user's timer on a ticket and logs the elapsed
+// time against the day it started. A non-nil description replaces the one
+// given at start. The timer keeps running if that day is locked.
+func (s *Store) StopTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID, description *string) (TimeEntry, error) {
+	return withTx(ctx, s.db, func(tx pgx.Tx) (TimeEntry, error) {
+		timer, err := queryOne(ctx, tx, mustSQL("time_entry_timers_delete", nil), scanTimeEntryTimer, projectID, ticketID, userID)
+		if err != nil {
+			return TimeEntry{}, err
+		}
+		return logStoppedTimer(ctx, tx, timer, description, time.Now())
+	})
+}
+
+// DiscardTimeEntryTimer stops the user's timer on a ticket without logging
+// any time.
+func (s *Store) DiscardTimeEntryTimer(ctx context.Context, projectID, ticketID, userID uuid.UUID) error {
+	_, err := queryOne(ctx, s.db, mustSQL("time_entry_timers_delete", nil), scanTimeEntryTimer, projectID, ticketID, userID)
+	return err
+}
+
+// AutoStopTimeEntryTimers stops every timer on a ticket that is in a closed
+// state, unless its project has turned auto-stop off. Timers whose start day
+// is locked are left running.
+func (s *Store) AutoStopTimeEntryTimers(ctx context.Context, ticketID uuid.UUID) ([]TimeEntry, error) {
+	timers, err := queryMany(ctx, s.db, mustSQL("time_entry_timers_auto_stop", nil), scanTimeEntryTimer, ticketID)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]TimeEntry, 0, len(timers))
+	for _, timer := range timers {
+		entry, err := s.StopTimeEntryTimer(ctx, timer.ProjectID, timer.TicketID, timer.UserID, nil)
+		if errors.Is(err, ErrTimePeriodLocked) || errors.Is(err, pgx.ErrNoRows) {
+			continue
+		}
+		if err != nil {
+			return entries, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// ClaimIdleTimeEntryTimers marks up to limit timers that have run past their
+// project's idle threshold as notified and returns them. Each timer is
+// claimed once; row locks let several replicas claim concurrently.
+func (s *Store) ClaimIdleTimeEntryTimers(ctx context.Context, limit int) ([]TimeEntryTimer, error) {
+	return queryMany(ctx, s.db, mustSQL("time_entry_timers_claim_idle", nil), scanTimeEntryTimer, limit, DefaultIdleTimerHours)
+}
+
+func (s *Store) GetTimeTrackingSettings(ctx context.Context, projectID uuid.UUID) (TimeTrackingSettings, error) {
+	var settings TimeTrackingSettings
+	err := s.db.QueryRow(ctx, mustSQL("time_tracking_settings_get", nil), projectID).Scan(&settings.AutoStopOnClose, &settings.IdleTimerHours)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return TimeTrackingSettings{AutoStopOnClose: true, IdleTimerHours: DefaultIdleTimerHours}, nil
+	}
+	return settings, err
+}
+
+func (s *Store) UpdateTimeTrackingSettings(ctx context.Context, projectID uuid.UUID, input TimeTrackingSettingsUpdateInput) (TimeTrackingSettings, error) {
+	if input.IdleTimerHours != nil && (*input.IdleTimerHours < 1 || *input.IdleTimerHours > 168) {
+		return TimeTrackingSettings{}, errors.New("idleTimerHours must be between 1 and 168")
+	}
+	var settings TimeTrackingSettings
+	err := s.db.QueryRow(ctx, mustSQL("time_tracking_settings_upsert", nil),
+		projectID, input.AutoStopOnClose, input.IdleTimerHours, DefaultIdleTimerHours,
+	).Scan(&settings.AutoStopOnClose, &settings.IdleTimerHours)
+	return settings, err
+}
+
+// TimerMinutes is the time logged for a timer: the elapsed minutes rounded
+// up, and never less than one.
+func TimerMinutes(startedAt, stoppedAt time.Time) int {
+	minutes := int(math.Ceil(stoppedAt.Sub(startedAt).Minutes()))
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
+
+func logStoppedTimer(ctx context.Context, tx pgx.Tx, timer TimeEntryTimer, description *string, stoppedAt time.Time) (TimeEntry, error) {
+	if description == nil {
+		description = timer.Description
+	}
+	loggedAt := normalizeDateUTC(timer.StartedAt)
+	return createTimeEntry(ctx, tx, timer.TicketID, TimeEntryCreateInput{
+		UserID:      timer.UserID,
+		UserName:    timer.UserName,
+		Minutes:     TimerMinutes(timer.StartedAt, stoppedAt),
+		Description: description,
+		LoggedAt:    &loggedAt,
+	})
+}
+
+func scanTimeEntryTimer(row pgx.Row) (TimeEntryTimer, error) {
+	var out TimeEntryTimer
+	err := row.Scan(
+		&out.ID,
+		&out.ProjectID,
+		&out.TicketID,
+		&out.TicketKey,
+		&out.TicketTitle,
+		&out.UserID,
+		&out.UserName,
+		&out.Description,
+		&out.StartedAt,
+		&out.IdleNotifiedAt,
+	)
+	return out, err
+}
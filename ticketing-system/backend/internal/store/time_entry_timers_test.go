@@ -0,0 +1,27 @@
+package store
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimerMinutes(t *testing.T) {
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name    string
+		stopped time.Time
+		want    int
+	}{
+		{name: "rounds up partial minutes", stopped: start.Add(90 * time.Second), want: 2},
+		{name: "exact minutes", stopped: start.Add(45 * time.Minute), want: 45},
+		{name: "at least one minute", stopped: start.Add(5 * time.Second), want: 1},
+		{name: "clock skew", stopped: start.Add(-time.Minute), want: 1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := TimerMinutes(start, tc.stopped); got != tc.want {
+				t.Fatalf("expected %d minutes, got %d", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	TimeReportGroupUser  = "user"
+	TimeReportGroupStory = "story"
+	TimeReportGroupType  = "type"
+)
+
+// timesheetDays is the length of a timesheet week.
+const timesheetDays = 7
+
+type TimesheetApproval struct {
+	ID             uuid.UUID
+	ProjectID      uuid.UUID
+	UserID         uuid.UUID
+	WeekStart      time.Time
+	ApprovedBy     *uuid.UUID
+	ApprovedByName string
+	ApprovedAt     time.Time
+}
+
+type TimesheetRow struct {
+	TicketID     uuid.UUID
+	Key          string
+	Title        string
+	MinutesByDay []int
+	TotalMinutes int
+}
+
+// Timesheet is one user's logged time in a project for a Monday-to-Sunday
+// week, one row per ticket.
+type Timesheet struct {
+	ProjectID    uuid.UUID
+	UserID       uuid.UUID
+	UserName     string
+	WeekStart    time.Time
+	Days         []time.Time
+	Rows         []TimesheetRow
+	DailyTotals  []int
+	TotalMinutes int
+	Approval     *TimesheetApproval
+}
+
+// TimesheetEntry is the logged minutes for one ticket on one day.
+type TimesheetEntry struct {
+	TicketID uuid.UUID
+	Key      string
+	Title    string
+	LoggedAt time.Time
+	Minutes  int
+}
+
+type TimeReportGroup struct {
+	Key         string
+	Label       string
+	Minutes     int
+	EntryCount  int
+	TicketCount int
+}
+
+type TimeReport struct {
+	From         time.Time
+	To           time.Time
+	GroupBy      string
+	TotalMinutes int
+	Groups       []TimeReportGroup
+}
+
+// TimeVariance compares the estimate with time logged. Variance is logged
+// minus estimate, so positive values are overruns. Estimate and variance are
+// nil when nothing was estimated.
+type TimeVariance struct {
+	EstimateMinutes *int
+	LoggedMinutes   int
+	VarianceMinutes *int
+	VariancePercent *float64
+}
+
+type TicketTimeVariance struct {
+	TicketID   uuid.UUID
+	Key        string
+	Title      string
+	StoryID    uuid.UUID
+	StoryTitle string
+	TimeVariance
+}
+
+type StoryTimeVariance struct {
+	StoryID            uuid.UUID
+	Title              string
+	TicketCount        int
+	UnestimatedTickets int
+	TimeVariance
+}
+
+type TimeVarianceReport struct {
+	Tickets []TicketTimeVariance
+	Stories []StoryTimeVariance
+}
+
+// TimesheetWeekStart returns the Monday of the week containing day, in UTC.
+func TimesheetWeekStart(day time.Time) time.Time {
+	day = normalizeDateUTC(day)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+func NormalizeTimeReportGroup(groupBy string) (string, error) {
+	switch groupBy {
+	case "", TimeReportGroupUser:
+		return TimeReportGroupUser, nil
+	case TimeReportGroupStory, TimeReportGroupType:
+		return groupBy, nil
+	}
+	return "", errors.New("groupBy must be user, story or type")
+}
+
+func (s *Store) GetTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) (Timesheet, error) {
+	weekStart := TimesheetWeekStart(day)
+	entries, err := queryMany(ctx, s.db, mustSQL("timesheet_entries", nil), scanTimesheetEntry, projectID, userID, weekStart)
+	if err != nil {
+		return Timesheet{}, err
+	}
+	sheet := BuildTimesheet(weekStart, entries)
+	sheet.ProjectID = projectID
+	sheet.UserID = userID
+
+	if err := s.db.QueryRow(ctx, mustSQL("timesheet_user_name", nil), userID).Scan(&sheet.UserName); err != nil {
+		return Timesheet{}, err
+	}
+	approval, err := queryOne(ctx, s.db, mustSQL("timesheet_approvals_get", nil), scanTimesheetApproval, projectID, userID, weekStart)
+	if err == nil {
+		sheet.Approval = &approval
+	} else if !errors.Is(err, pgx.ErrNoRows) {
+		return Timesheet{}, err
+	}
+	return sheet, nil
+}
+
+// ApproveTimesheet approves and locks a user's week. Approving again
+// refreshes the approver. It waits for entries being added to or removed
+// from the week to commit first.
+func (s *Store) ApproveTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time, approvedBy *uuid.UUID, approvedByName string) (TimesheetApproval, error) {
+	weekStart := TimesheetWeekStart(day)
+	return withTx(ctx, s.db, func(tx pgx.Tx) (TimesheetApproval, error) {
+		if _, err := tx.Exec(ctx, mustSQL("timesheet_week_lock", nil), projectID, userID, weekStart); err != nil {
+			return TimesheetApproval{}, err
+		}
+		return queryOne(ctx, tx, mustSQL("timesheet_approvals_upsert", nil), scanTimesheetApproval,
+			projectID, userID, weekStart, approvedBy, approvedByName)
+	})
+}
+
+// ReopenTimesheet withdraws a week's approval so its entries can change
+// again.
+func (s *Store) ReopenTimesheet(ctx context.Context, projectID, userID uuid.UUID, day time.Time) error {
+	return execOne(ctx, s.db, mustSQL("timesheet_approvals_delete", nil), pgx.ErrNoRows, projectID, userID, TimesheetWeekStart(day))
+}
+
+func (s *Store) GetTimeReport(ctx context.Context, projectID uuid.UUID, from, to time.Time, groupBy string) (TimeReport, error) {
+	from, to = normalizeReportingRange(from, to)
+	groupBy, err := NormalizeTimeReportGroup(groupBy)
+	if err != nil {
+		return TimeReport{}, err
+	}
+	groups, err := queryMany(ctx, s.db, mustSQL("time_report_groups", map[string]any{"GroupBy": groupBy}), scanTimeReportGroup, projectID, from, to)
+	if err != nil {
+		return TimeReport{}, err
+	}
+	report := TimeReport{From: from, To: to, GroupBy: groupBy, Groups: groups}
+	for _, group := range groups {
+		report.TotalMinutes += group.Minutes
+	}
+	return report, nil
+}
+
+func (s *Store) GetTimeVariance(ctx context.Context, projectID uuid.UUID, storyID *uuid.UUID) (TimeVarianceReport, error) {
+	tickets, err := queryMany(ctx, s.db, mustSQL("time_variance_tickets", nil), scanTicketTimeVariance, projectID, storyID)
+	if err != nil {
+		return TimeVarianceReport{}, err
+	}
+	return BuildTimeVariance(tickets), nil
+}
+
+// BuildTimesheet lays out a week's entries as a ticket-by-day grid.
+func BuildTimesheet(weekStart time.Time, entries []TimesheetEntry) Timesheet {
+	sheet := Timesheet{
+		WeekStart:   weekStart,
+		Days:        make([]time.Time, timesheetDays),
+		Rows:        []TimesheetRow{},
+		DailyTotals: make([]int, timesheetDays),
+	}
+	for i := range sheet.Days {
+		sheet.Days[i] = weekStart.AddDate(0, 0, i)
+	}
+
+	rowIndex := map[uuid.UUID]int{}
+	for _, entry := range entries {
+		day := int(normalizeDateUTC(entry.LoggedAt).Sub(weekStart).Hours() / 24)
+		if day < 0 || day >= timesheetDays {
+			continue
+		}
+		i, ok := rowIndex[entry.TicketID]
+		if !ok {
+			i = len(sheet.Rows)
+			rowIndex[entry.TicketID] = i
+			sheet.Rows = append(sheet.Rows, TimesheetRow{
+				TicketID:     entry.TicketID,
+				Key:          entry.Key,
+				Title:        entry.Title,
+				MinutesByDay: make([]int, timesheetDays),
+			})
+		}
+		sheet.Rows[i].MinutesByDay[day] += entry.Minutes
+		sheet.Rows[i].TotalMinutes += entry.Minutes
+		sheet.DailyTotals[day] += entry.Minutes
+		sheet.TotalMinutes += entry.Minutes
+	}
+	return sheet
+}
+
+// BuildTimeVariance fills in ticket variances and rolls them up per story in
+// the order stories first appear.
+func BuildTimeVariance(tickets []TicketTimeVariance) TimeVarianceReport {
+	report := TimeVarianceReport{Tickets: make([]TicketTimeVariance, 0, len(tickets)), Stories: []StoryTimeVariance{}}
+	storyIndex := map[uuid.UUID]int{}
+	storyEstimates := map[uuid.UUID]int{}
+	for _, ticket := range tickets {
+		ticket.TimeVariance = newTimeVariance(ticket.EstimateMinutes, ticket.LoggedMinutes)
+		report.Tickets = append(report.Tickets, ticket)
+
+		i, ok := storyIndex[ticket.StoryID]
+		if !ok {
+			i = len(report.Stories)
+			storyIndex[ticket.StoryID] = i
+			report.Stories = append(report.Stories, StoryTimeVariance{StoryID: ticket.StoryID, Title: ticket.StoryTitle})
+		}
+		story := &report.Stories[i]
+		story.TicketCount++
+		story.LoggedMinutes += ticket.LoggedMinutes
+		if ticket.EstimateMinutes == nil {
+			story.UnestimatedTickets++
+		} else {
+			storyEstimates[ticket.StoryID] += *ticket.EstimateMinutes
+		}
+	}
+	for i := range report.Stories {
+		story := &report.Stories[i]
+		var estimate *int
+		if story.UnestimatedTickets < story.TicketCount {
+			value := storyEstimates[story.StoryID]
+			estimate = &value
+		}
+		story.TimeVariance = newTimeVariance(estimate, story.LoggedMinutes)
+	}
+	return report
+}
+
+func newTimeVariance(estimate *int, logged int) TimeVariance {
+	out := TimeVariance{EstimateMinutes: estimate, LoggedMinutes: logged}
+	if estimate == nil {
+		return out
+	}
+	variance := logged - *estimate
+	out.VarianceMinutes = &variance
+	if *estimate > 0 {
+		percent := float64(variance) / float64(*estimate) * 100
+		out.VariancePercent = &percent
+	}
+	return out
+}
+
+func scanTimesheetEntry(row pgx.Row) (TimesheetEntry, error) {
+	var out TimesheetEntry
+	err := row.Scan(&out.TicketID, &out.Key, &out.Title, &out.LoggedAt, &out.Minutes)
+	return out, err
+}
+
+func scanTimesheetApproval(row pgx.Row) (TimesheetApproval, error) {
+	var out TimesheetApproval
+	err := row.Scan(
+		&out.ID,
+		&out.ProjectID,
+		&out.UserID,
+		&out.WeekStart,
+		&out.ApprovedBy,
+		&out.ApprovedByName,
+		&out.ApprovedAt,
+	)
+	return out, err
+}
+
+func scanTimeReportGroup(row pgx.Row) (TimeReportGroup, error) {
+	var out TimeReportGroup
+	err := row.Scan(&out.Key, &out.Label, &out.Minutes, &out.EntryCount, &out.TicketCount)
+	return out, err
+}
+
+func scanTicketTimeVariance(row pgx.Row) (TicketTimeVariance, error) {
+	var out TicketTimeVariance
+	err := row.Scan(
+		&out.TicketID,
+		&out.Key,
+		&out.Title,
+		&out.StoryID,
+		&out.StoryTitle,
+		&out.EstimateMinutes,
+		&out.LoggedMinutes,
+	)
+	return out, err
+}
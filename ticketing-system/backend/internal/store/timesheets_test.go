@@ -0,0 +1,72 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestTimesheetWeekStart(t *testing.T) {
+	for day, want := range map[string]string{
+		"2026-03-02": "2026-03-02",
+		"2026-03-05": "2026-03-02",
+		"2026-03-08": "2026-03-02",
+		"2026-03-09": "2026-03-09",
+	} {
+		parsed, _ := time.Parse("2006-01-02", day)
+		if got := TimesheetWeekStart(parsed).Format("2006-01-02"); got != want {
+			t.Fatalf("TimesheetWeekStart(%s) = %s, want %s", day, got, want)
+		}
+	}
+}
+
+func TestBuildTimesheet(t *testing.T) {
+	weekStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	a, b := uuid.New(), uuid.New()
+	sheet := BuildTimesheet(weekStart, []TimesheetEntry{
+		{TicketID: a, Key: "OPS-1", LoggedAt: weekStart, Minutes: 60},
+		{TicketID: a, Key: "OPS-1", LoggedAt: weekStart.AddDate(0, 0, 2), Minutes: 30},
+		{TicketID: b, Key: "OPS-2", LoggedAt: weekStart.AddDate(0, 0, 2), Minutes: 15},
+		{TicketID: b, Key: "OPS-2", LoggedAt: weekStart.AddDate(0, 0, 7), Minutes: 99},
+	})
+
+	if len(sheet.Days) != 7 || !sheet.Days[6].Equal(weekStart.AddDate(0, 0, 6)) {
+		t.Fatalf("expected seven days from Monday, got %v", sheet.Days)
+	}
+	if len(sheet.Rows) != 2 || sheet.Rows[0].Key != "OPS-1" || sheet.Rows[0].TotalMinutes != 90 {
+		t.Fatalf("unexpected rows %+v", sheet.Rows)
+	}
+	if sheet.DailyTotals[2] != 45 || sheet.TotalMinutes != 105 {
+		t.Fatalf("expected entries outside the week to be ignored, got totals %v / %d", sheet.DailyTotals, sheet.TotalMinutes)
+	}
+}
+
+func TestBuildTimeVariance(t *testing.T) {
+	story := uuid.New()
+	other := uuid.New()
+	estimate, small := 120, 60
+	report := BuildTimeVariance([]TicketTimeVariance{
+		{TicketID: uuid.New(), Key: "OPS-1", StoryID: story, StoryTitle: "Login", TimeVariance: TimeVariance{EstimateMinutes: &estimate, LoggedMinutes: 150}},
+		{TicketID: uuid.New(), Key: "OPS-2", StoryID: story, StoryTitle: "Login", TimeVariance: TimeVariance{LoggedMinutes: 30}},
+		{TicketID: uuid.New(), Key: "OPS-3", StoryID: other, StoryTitle: "Export", TimeVariance: TimeVariance{EstimateMinutes: &small, LoggedMinutes: 45}},
+	})
+
+	first := report.Tickets[0]
+	if first.VarianceMinutes == nil || *first.VarianceMinutes != 30 || *first.VariancePercent != 25 {
+		t.Fatalf("unexpected ticket variance %+v", first.TimeVariance)
+	}
+	if report.Tickets[1].VarianceMinutes != nil {
+		t.Fatalf("expected unestimated ticket to have no variance")
+	}
+	if len(report.Stories) != 2 {
+		t.Fatalf("expected two stories, got %d", len(report.Stories))
+	}
+	login := report.Stories[0]
+	if login.TicketCount != 2 || login.UnestimatedTickets != 1 || *login.EstimateMinutes != 120 || login.LoggedMinutes != 180 || *login.VarianceMinutes != 60 {
+		t.Fatalf("unexpected story rollup %+v", login)
+	}
+	if *report.Stories[1].VarianceMinutes != -15 {
+		t.Fatalf("expected underrun on second story, got %d", *report.Stories[1].VarianceMinutes)
+	}
+}
@@ -2,6 +2,8 @@ package store
 
 import (
 	"testing"
+
+	"github.com/google/uuid"
 )
 
 func TestNormalizeProjectKey(t *testing.T) {
@@ -440,6 +442,10 @@ func TestValidateWebhookEvents(t *testing.T) {
 			name:   "all valid events",
 			events: []string{"ticket.created", "ticket.updated", "ticket.deleted", "ticket.state_changed"},
 		},
+		{
+			name:   "preset matches changed event",
+			events: []string{"preset.matches_changed"},
+		},
 		{
 			name:        "invalid event",
 			events:      []string{"ticket.invalid"},
@@ -498,3 +504,18 @@ func TestProjectKeyPattern(t *testing.T) {
 		}
 	}
 }
+
+func TestNormalizeDependencyCreateDuplicates(t *testing.T) {
+	duplicate := uuid.New()
+	original := uuid.New()
+
+	source, target, relation, err := normalizeDependencyCreate(duplicate, original, "duplicate_of")
+	if err != nil || source != duplicate || target != original || relation != DependencyRelationDuplicateOf {
+		t.Fatalf("duplicate_of: got %s -> %s %q, err %v", source, target, relation, err)
+	}
+
+	source, target, relation, err = normalizeDependencyCreate(original, duplicate, "Duplicated_By")
+	if err != nil || source != duplicate || target != original || relation != DependencyRelationDuplicateOf {
+		t.Fatalf("duplicated_by: got %s -> %s %q, err %v", source, target, relation, err)
+	}
+}
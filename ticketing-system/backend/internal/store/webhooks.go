@@ -152,10 +152,15 @@ func validateWebhookURL(raw string) error {
 
 func validateWebhookEvents(events []string) error {
 	allowed := map[string]bool{
-		"ticket.created":       true,
-		"ticket.updated":       true,
-		"ticket.deleted":       true,
-		"ticket.state_changed": true,
+		"ticket.created":         true,
+		"ticket.updated":         true,
+		"ticket.deleted":         true,
+		"ticket.state_changed":   true,
+		"preset.matches_changed": true,
+		"automation.triggered":   true,
+		"incident.declared":      true,
+		"incident.escalated":     true,
+		"incident.resolved":      true,
 	}
 	for _, event := range events {
 		if !allowed[event] {
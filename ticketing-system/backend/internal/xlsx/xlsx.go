@@ -0,0 +1,212 @@
+// Package xlsx writes minimal Office Open XML spreadsheets. Rows are
+// streamed straight into the zip archive, so a workbook never has to be held
+// in memory. Only numbers and inline strings are supported; there are no
+// styles, formulas or shared strings.
+package xlsx
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxSheetNameLength is Excel's limit on worksheet names.
+const maxSheetNameLength = 31
+
+var sheetNameReplacer = strings.NewReplacer(
+	"[", "(", "]", ")", ":", "-", "*", "-", "?", "", "/", "-", "\\", "-",
+)
+
+// Writer builds a workbook one sheet at a time. Sheets must be finished in
+// order: starting a new sheet closes the previous one.
+type Writer struct {
+	zip    *zip.Writer
+	sheets []string
+	sheet  io.Writer
+	row    int
+	closed bool
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{zip: zip.NewWriter(w)}
+}
+
+// AddSheet starts a new worksheet. Names are sanitised, truncated and made
+// unique the way Excel requires.
+func (w *Writer) AddSheet(name string) error {
+	if w.closed {
+		return errors.New("xlsx: writer closed")
+	}
+	return w.addSheet(name)
+}
+
+func (w *Writer) addSheet(name string) error {
+	if err := w.finishSheet(); err != nil {
+		return err
+	}
+	w.sheets = append(w.sheets, w.sheetName(name))
+	sheet, err := w.zip.Create(fmt.Sprintf("xl/worksheets/sheet%d.xml", len(w.sheets)))
+	if err != nil {
+		return err
+	}
+	w.sheet = sheet
+	w.row = 0
+	_, err = io.WriteString(sheet, xml.Header+`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return err
+}
+
+// sheetName sanitises a requested name, truncates it to Excel's limit
+// without splitting a character and adds a " (2)"-style suffix when it would
+// clash with an earlier sheet. Excel compares sheet names case-insensitively.
+func (w *Writer) sheetName(requested string) string {
+	base := strings.TrimSpace(sheetNameReplacer.Replace(requested))
+	if base == "" {
+		base = fmt.Sprintf("Sheet%d", len(w.sheets)+1)
+	}
+	name := truncateRunes(base, maxSheetNameLength)
+	for n := 2; w.hasSheet(name); n++ {
+		suffix := fmt.Sprintf(" (%d)", n)
+		name = strings.TrimSpace(truncateRunes(base, maxSheetNameLength-len(suffix))) + suffix
+	}
+	return name
+}
+
+func (w *Writer) hasSheet(name string) bool {
+	for _, existing := range w.sheets {
+		if strings.EqualFold(existing, name) {
+			return true
+		}
+	}
+	return false
+}
+
+func truncateRunes(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	return string([]rune(s)[:limit])
+}
+
+// WriteRow appends a row to the current sheet. Integers and floats become
+// numeric cells, nil an empty cell, times an ISO 8601 string and anything
+// else its string form.
+func (w *Writer) WriteRow(values ...any) error {
+	if w.sheet == nil {
+		return errors.New("xlsx: no sheet started")
+	}
+	w.row++
+	var b strings.Builder
+	fmt.Fprintf(&b, `<row r="%d">`, w.row)
+	for i, value := range values {
+		ref := columnName(i) + strconv.Itoa(w.row)
+		switch v := value.(type) {
+		case nil:
+			continue
+		case int:
+			fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case int64:
+			fmt.Fprintf(&b, `<c r="%s"><v>%d</v></c>`, ref, v)
+		case float64:
+			fmt.Fprintf(&b, `<c r="%s"><v>%s</v></c>`, ref, strconv.FormatFloat(v, 'f', -1, 64))
+		case time.Time:
+			writeInlineString(&b, ref, v.UTC().Format(time.RFC3339))
+		case string:
+			writeInlineString(&b, ref, v)
+		default:
+			writeInlineString(&b, ref, fmt.Sprint(v))
+		}
+	}
+	b.WriteString(`</row>`)
+	_, err := io.WriteString(w.sheet, b.String())
+	return err
+}
+
+// Close finishes the last sheet and writes the workbook parts that list the
+// sheets. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if len(w.sheets) == 0 {
+		if err := w.addSheet("Sheet1"); err != nil {
+			return err
+		}
+	}
+	if err := w.finishSheet(); err != nil {
+		return err
+	}
+
+	var contentTypes, workbook, workbookRels strings.Builder
+	contentTypes.WriteString(xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>`)
+	workbook.WriteString(xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets>`)
+	workbookRels.WriteString(xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for i, name := range w.sheets {
+		n := i + 1
+		fmt.Fprintf(&contentTypes, `<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, n)
+		fmt.Fprintf(&workbook, `<sheet name="%s" sheetId="%d" r:id="rId%d"/>`, escape(name), n, n)
+		fmt.Fprintf(&workbookRels, `<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, n, n)
+	}
+	contentTypes.WriteString(`</Types>`)
+	workbook.WriteString(`</sheets></workbook>`)
+	workbookRels.WriteString(`</Relationships>`)
+
+	parts := []struct{ name, body string }{
+		{"[Content_Types].xml", contentTypes.String()},
+		{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`},
+		{"xl/workbook.xml", workbook.String()},
+		{"xl/_rels/workbook.xml.rels", workbookRels.String()},
+	}
+	for _, part := range parts {
+		file, err := w.zip.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(file, part.body); err != nil {
+			return err
+		}
+	}
+	return w.zip.Close()
+}
+
+func (w *Writer) finishSheet() error {
+	if w.sheet == nil {
+		return nil
+	}
+	_, err := io.WriteString(w.sheet, `</sheetData></worksheet>`)
+	w.sheet = nil
+	return err
+}
+
+func writeInlineString(b *strings.Builder, ref, value string) {
+	fmt.Fprintf(b, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">%s</t></is></c>`, ref, escape(value))
+}
+
+func escape(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+// columnName converts a zero-based column index to its letter reference:
+// 0 is A, 25 is Z, 26 is AA.
+func columnName(index int) string {
+	name := ""
+	for index >= 0 {
+		name = string(rune('A'+index%26)) + name
+		index = index/26 - 1
+	}
+	return name
+}
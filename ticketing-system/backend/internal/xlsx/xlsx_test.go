@@ -0,0 +1,114 @@
+package xlsx
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestWriterProducesWorkbook(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	if err := w.AddSheet("Tickets"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow("key", "points", "closed"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow("OPS-1", 3, time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteRow("<R&D>", nil, 1.5); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.AddSheet("Open: by/state"); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("expected a valid zip: %v", err)
+	}
+	files := map[string]string{}
+	for _, file := range archive.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(rc)
+		rc.Close()
+		files[file.Name] = string(body)
+	}
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", "xl/worksheets/sheet1.xml", "xl/worksheets/sheet2.xml"} {
+		if _, ok := files[name]; !ok {
+			t.Fatalf("expected part %s", name)
+		}
+	}
+
+	sheet := files["xl/worksheets/sheet1.xml"]
+	checks := []string{
+		`<c r="B2"><v>3</v></c>`,
+		`<c r="C2" t="inlineStr"><is><t xml:space="preserve">2026-03-02T09:00:00Z</t></is></c>`,
+		`&lt;R&amp;D&gt;`,
+		`<c r="C3"><v>1.5</v></c>`,
+	}
+	for _, want := range checks {
+		if !strings.Contains(sheet, want) {
+			t.Fatalf("expected sheet to contain %q, got %s", want, sheet)
+		}
+	}
+	if strings.Contains(sheet, `r="B3"`) {
+		t.Fatalf("expected nil value to be skipped")
+	}
+	if !strings.Contains(files["xl/workbook.xml"], `<sheet name="Open- by-state" sheetId="2" r:id="rId2"/>`) {
+		t.Fatalf("expected sanitised sheet name, got %s", files["xl/workbook.xml"])
+	}
+}
+
+func TestSheetNamesAreTruncatedByRuneAndDeduplicated(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	long := strings.Repeat("é", 40)
+	for _, name := range []string{long, long, "Tickets", "tickets", "Tickets"} {
+		if err := w.AddSheet(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		strings.Repeat("é", 31),
+		strings.Repeat("é", 27) + " (2)",
+		"Tickets",
+		"tickets (2)",
+		"Tickets (3)",
+	}
+	if len(w.sheets) != len(want) {
+		t.Fatalf("expected %d sheets, got %v", len(want), w.sheets)
+	}
+	for i, name := range w.sheets {
+		if !utf8.ValidString(name) {
+			t.Fatalf("sheet %d name is not valid UTF-8: %q", i, name)
+		}
+		if name != want[i] {
+			t.Fatalf("sheet %d: expected %q, got %q", i, want[i], name)
+		}
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for index, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(index); got != want {
+			t.Fatalf("columnName(%d) = %q, want %q", index, got, want)
+		}
+	}
+}
@@ -0,0 +1,11 @@
+-- Tombstones for deleted tickets so incremental sync clients can drop them
+CREATE TABLE IF NOT EXISTS ticket_deletions (
+  ticket_id uuid PRIMARY KEY,
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  ticket_key text NOT NULL,
+  deleted_at timestamptz NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS ticket_deletions_project_deleted_idx ON ticket_deletions(project_id, deleted_at);
+
+-- Keyset access paths for the changes feed
+CREATE INDEX IF NOT EXISTS tickets_project_updated_idx ON tickets(project_id, updated_at, id);
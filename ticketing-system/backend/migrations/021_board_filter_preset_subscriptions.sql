@@ -0,0 +1,23 @@
+-- Scheduled subscriptions to saved board filter presets
+CREATE TABLE IF NOT EXISTS board_filter_preset_subscriptions (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  preset_id uuid NOT NULL REFERENCES board_filter_presets(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  interval_minutes integer NOT NULL DEFAULT 60,
+  notify_in_app boolean NOT NULL DEFAULT true,
+  send_webhook boolean NOT NULL DEFAULT false,
+  last_matches jsonb,
+  last_evaluated_at timestamptz,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT board_filter_preset_subscriptions_interval_range CHECK (interval_minutes BETWEEN 5 AND 10080),
+  CONSTRAINT board_filter_preset_subscriptions_unique UNIQUE (preset_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_filter_preset_subscriptions_due
+  ON board_filter_preset_subscriptions (last_evaluated_at NULLS FIRST);
+
+-- Allow notifications for tickets that newly match a subscribed preset
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+  CHECK (type IN ('mention', 'assignment', 'preset_match'));
@@ -0,0 +1,30 @@
+-- Project-shared and group-shared board filter presets
+ALTER TABLE board_filter_presets ADD COLUMN IF NOT EXISTS visibility text NOT NULL DEFAULT 'private';
+ALTER TABLE board_filter_presets ADD COLUMN IF NOT EXISTS pinned boolean NOT NULL DEFAULT false;
+ALTER TABLE board_filter_presets ADD COLUMN IF NOT EXISTS is_default boolean NOT NULL DEFAULT false;
+ALTER TABLE board_filter_presets ADD COLUMN IF NOT EXISTS position integer NOT NULL DEFAULT 0;
+
+ALTER TABLE board_filter_presets DROP CONSTRAINT IF EXISTS board_filter_presets_visibility_check;
+ALTER TABLE board_filter_presets ADD CONSTRAINT board_filter_presets_visibility_check
+  CHECK (visibility IN ('private', 'project', 'groups'));
+
+-- Pinned and default presets are shown to everyone, so they must be project-visible
+ALTER TABLE board_filter_presets DROP CONSTRAINT IF EXISTS board_filter_presets_pinned_visibility;
+ALTER TABLE board_filter_presets ADD CONSTRAINT board_filter_presets_pinned_visibility
+  CHECK (visibility = 'project' OR (NOT pinned AND NOT is_default));
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_board_filter_presets_project_default
+  ON board_filter_presets (project_id)
+  WHERE is_default;
+
+CREATE INDEX IF NOT EXISTS idx_board_filter_presets_project_visibility
+  ON board_filter_presets (project_id, visibility);
+
+CREATE TABLE IF NOT EXISTS board_filter_preset_groups (
+  preset_id uuid NOT NULL REFERENCES board_filter_presets(id) ON DELETE CASCADE,
+  group_id uuid NOT NULL REFERENCES groups(id) ON DELETE CASCADE,
+  PRIMARY KEY (preset_id, group_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_board_filter_preset_groups_group
+  ON board_filter_preset_groups (group_id);
@@ -0,0 +1,27 @@
+-- Per-project ticket templates
+CREATE TABLE IF NOT EXISTS ticket_templates (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  title_pattern text NOT NULL DEFAULT '{title}',
+  description text NOT NULL DEFAULT '',
+  type text,
+  priority text,
+  story_points integer,
+  time_estimate integer,
+  incident_enabled boolean NOT NULL DEFAULT false,
+  incident_severity text,
+  keywords text[] NOT NULL DEFAULT '{}',
+  subtasks jsonb NOT NULL DEFAULT '[]'::jsonb,
+  created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT ticket_templates_name_nonempty CHECK (length(trim(name)) > 0),
+  CONSTRAINT ticket_templates_project_name_unique UNIQUE (project_id, name)
+);
+
+-- Template suggestions from AI triage
+ALTER TABLE ai_triage_suggestions
+  ADD COLUMN IF NOT EXISTS suggested_template_id uuid REFERENCES ticket_templates(id) ON DELETE SET NULL;
+ALTER TABLE ai_triage_suggestions
+  ADD COLUMN IF NOT EXISTS confidence_template real NOT NULL DEFAULT 0;
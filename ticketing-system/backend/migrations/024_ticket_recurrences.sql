@@ -0,0 +1,32 @@
+-- Recurring tickets created from templates on cron or RRULE schedules
+CREATE TABLE IF NOT EXISTS ticket_recurrences (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  template_id uuid NOT NULL REFERENCES ticket_templates(id) ON DELETE CASCADE,
+  story_id uuid NOT NULL REFERENCES stories(id) ON DELETE CASCADE,
+  title text NOT NULL,
+  schedule_kind text NOT NULL,
+  schedule text NOT NULL,
+  timezone text NOT NULL DEFAULT 'UTC',
+  starts_at timestamptz NOT NULL DEFAULT now(),
+  assignee_group_id uuid REFERENCES groups(id) ON DELETE SET NULL,
+  last_assignee_id uuid REFERENCES users(id) ON DELETE SET NULL,
+  add_to_active_sprint boolean NOT NULL DEFAULT false,
+  enabled boolean NOT NULL DEFAULT true,
+  next_run_at timestamptz,
+  last_run_at timestamptz,
+  last_ticket_id uuid REFERENCES tickets(id) ON DELETE SET NULL,
+  last_error text,
+  locked_until timestamptz,
+  created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT ticket_recurrences_name_nonempty CHECK (length(trim(name)) > 0),
+  CONSTRAINT ticket_recurrences_schedule_kind_check CHECK (schedule_kind IN ('cron', 'rrule'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_ticket_recurrences_project ON ticket_recurrences (project_id, name);
+CREATE INDEX IF NOT EXISTS idx_ticket_recurrences_due
+  ON ticket_recurrences (next_run_at)
+  WHERE enabled AND next_run_at IS NOT NULL;
@@ -0,0 +1,58 @@
+-- Per-project automation rules (when/if/then) and their execution log
+CREATE TABLE IF NOT EXISTS automation_rules (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  trigger text NOT NULL,
+  conditions jsonb NOT NULL DEFAULT '{}'::jsonb,
+  actions jsonb NOT NULL DEFAULT '[]'::jsonb,
+  schedule_kind text,
+  schedule text,
+  timezone text NOT NULL DEFAULT 'UTC',
+  enabled boolean NOT NULL DEFAULT true,
+  next_run_at timestamptz,
+  last_run_at timestamptz,
+  locked_until timestamptz,
+  created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT automation_rules_name_nonempty CHECK (length(trim(name)) > 0),
+  CONSTRAINT automation_rules_trigger_check CHECK (
+    trigger IN ('ticket.created', 'ticket.updated', 'ticket.state_changed', 'comment.created', 'schedule')
+  ),
+  CONSTRAINT automation_rules_schedule_check CHECK (
+    (trigger = 'schedule') = (schedule_kind IS NOT NULL AND schedule IS NOT NULL)
+  ),
+  CONSTRAINT automation_rules_schedule_kind_check CHECK (schedule_kind IN ('cron', 'rrule'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_automation_rules_project_trigger
+  ON automation_rules (project_id, trigger)
+  WHERE enabled;
+CREATE INDEX IF NOT EXISTS idx_automation_rules_due
+  ON automation_rules (next_run_at)
+  WHERE enabled AND next_run_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS automation_rule_runs (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  rule_id uuid NOT NULL REFERENCES automation_rules(id) ON DELETE CASCADE,
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  ticket_id uuid REFERENCES tickets(id) ON DELETE SET NULL,
+  trigger text NOT NULL,
+  status text NOT NULL,
+  depth integer NOT NULL DEFAULT 0,
+  results jsonb NOT NULL DEFAULT '[]'::jsonb,
+  error text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT automation_rule_runs_status_check CHECK (status IN ('succeeded', 'failed', 'skipped'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_automation_rule_runs_project
+  ON automation_rule_runs (project_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_automation_rule_runs_rule
+  ON automation_rule_runs (rule_id, created_at DESC);
+
+-- Allow notifications sent by automation rules
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+  CHECK (type IN ('mention', 'assignment', 'preset_match', 'automation'));
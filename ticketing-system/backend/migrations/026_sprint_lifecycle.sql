@@ -0,0 +1,57 @@
+-- Sprint status transitions (planned -> active -> completed) and completion snapshots
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS status text NOT NULL DEFAULT 'planned';
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS started_at timestamptz;
+ALTER TABLE sprints ADD COLUMN IF NOT EXISTS completed_at timestamptz;
+
+ALTER TABLE sprints DROP CONSTRAINT IF EXISTS sprints_status_check;
+ALTER TABLE sprints ADD CONSTRAINT sprints_status_check CHECK (status IN ('planned', 'active', 'completed'));
+
+-- Existing sprints: anything already over is completed, and the most recently
+-- started sprint covering today becomes the active one.
+UPDATE sprints
+SET status = 'completed',
+    completed_at = COALESCE(completed_at, end_date::timestamptz + interval '1 day')
+WHERE status = 'planned' AND end_date < current_date;
+
+UPDATE sprints s
+SET status = 'active',
+    started_at = COALESCE(s.started_at, s.start_date::timestamptz)
+FROM (
+  SELECT DISTINCT ON (project_id) id
+  FROM sprints
+  WHERE status = 'planned' AND start_date <= current_date AND end_date >= current_date
+  ORDER BY project_id, start_date DESC, created_at DESC
+) current_sprint
+WHERE s.id = current_sprint.id
+  AND NOT EXISTS (
+    SELECT 1 FROM sprints other WHERE other.project_id = s.project_id AND other.status = 'active'
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS sprints_one_active_per_project_idx ON sprints(project_id) WHERE status = 'active';
+
+CREATE TABLE IF NOT EXISTS sprint_completions (
+  sprint_id uuid PRIMARY KEY REFERENCES sprints(id) ON DELETE CASCADE,
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  carry_over text NOT NULL,
+  carry_over_sprint_id uuid REFERENCES sprints(id) ON DELETE SET NULL,
+  committed_tickets integer NOT NULL DEFAULT 0,
+  completed_tickets integer NOT NULL DEFAULT 0,
+  committed_points integer NOT NULL DEFAULT 0,
+  completed_points integer NOT NULL DEFAULT 0,
+  completed_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT sprint_completions_carry_over_check CHECK (carry_over IN ('next_sprint', 'backlog'))
+);
+
+CREATE INDEX IF NOT EXISTS sprint_completions_project_id_idx ON sprint_completions(project_id, created_at DESC);
+
+CREATE TABLE IF NOT EXISTS sprint_completion_tickets (
+  sprint_id uuid NOT NULL REFERENCES sprint_completions(sprint_id) ON DELETE CASCADE,
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  ticket_key text NOT NULL,
+  title text NOT NULL,
+  state_name text NOT NULL,
+  story_points integer,
+  done boolean NOT NULL,
+  PRIMARY KEY (sprint_id, ticket_id)
+);
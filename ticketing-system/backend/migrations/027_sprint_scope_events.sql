@@ -0,0 +1,28 @@
+-- Sprint membership history for burndown/burnup scope-change tracking
+CREATE TABLE IF NOT EXISTS sprint_scope_events (
+  id bigserial PRIMARY KEY,
+  sprint_id uuid NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  action text NOT NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT sprint_scope_events_action_check CHECK (action IN ('added', 'removed', 'carried_over'))
+);
+
+CREATE INDEX IF NOT EXISTS sprint_scope_events_sprint_idx ON sprint_scope_events(sprint_id, created_at);
+
+-- Seed history from current membership and completed sprint snapshots.
+INSERT INTO sprint_scope_events (sprint_id, ticket_id, action, created_at)
+SELECT sprint_id, ticket_id, 'added', created_at
+FROM sprint_tickets;
+
+INSERT INTO sprint_scope_events (sprint_id, ticket_id, action, created_at)
+SELECT ct.sprint_id, ct.ticket_id, 'added', s.created_at
+FROM sprint_completion_tickets ct
+JOIN sprints s ON s.id = ct.sprint_id
+WHERE NOT ct.done;
+
+INSERT INTO sprint_scope_events (sprint_id, ticket_id, action, created_at)
+SELECT ct.sprint_id, ct.ticket_id, 'carried_over', c.created_at
+FROM sprint_completion_tickets ct
+JOIN sprint_completions c ON c.sprint_id = ct.sprint_id
+WHERE NOT ct.done;
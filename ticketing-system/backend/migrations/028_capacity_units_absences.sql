@@ -0,0 +1,17 @@
+-- Capacity in tickets, story points or hours, and planned absences per sprint
+ALTER TABLE capacity_settings ADD COLUMN IF NOT EXISTS unit text NOT NULL DEFAULT 'tickets';
+
+ALTER TABLE capacity_settings DROP CONSTRAINT IF EXISTS capacity_settings_unit_check;
+ALTER TABLE capacity_settings ADD CONSTRAINT capacity_settings_unit_check CHECK (unit IN ('tickets', 'points', 'hours'));
+
+CREATE TABLE IF NOT EXISTS sprint_absences (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  sprint_id uuid NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  days integer NOT NULL,
+  note text,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT sprint_absences_days_positive CHECK (days > 0),
+  CONSTRAINT sprint_absences_sprint_user_unique UNIQUE (sprint_id, user_id)
+);
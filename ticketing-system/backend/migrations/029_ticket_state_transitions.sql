@@ -0,0 +1,69 @@
+-- State-transition history keyed by state IDs, so reporting survives state
+-- renames and workflow replacement. State columns intentionally have no
+-- foreign key: history must outlive deleted states. to_closed snapshots the
+-- target state's closed flag for states that no longer exist.
+CREATE TABLE IF NOT EXISTS ticket_state_transitions (
+  id bigserial PRIMARY KEY,
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  from_state_id uuid,
+  to_state_id uuid NOT NULL,
+  to_closed boolean NOT NULL DEFAULT false,
+  transitioned_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS ticket_state_transitions_ticket_idx ON ticket_state_transitions(ticket_id, transitioned_at);
+CREATE INDEX IF NOT EXISTS ticket_state_transitions_project_idx ON ticket_state_transitions(project_id, transitioned_at);
+
+CREATE OR REPLACE FUNCTION record_ticket_state_transition() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'UPDATE' AND NEW.state_id IS NOT DISTINCT FROM OLD.state_id THEN
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO ticket_state_transitions (ticket_id, project_id, from_state_id, to_state_id, to_closed, transitioned_at)
+  SELECT NEW.id,
+         NEW.project_id,
+         CASE WHEN TG_OP = 'UPDATE' THEN OLD.state_id END,
+         NEW.state_id,
+         COALESCE((SELECT is_closed FROM workflow_states WHERE id = NEW.state_id), false),
+         CASE WHEN TG_OP = 'INSERT' THEN NEW.created_at ELSE now() END;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Backfill before the trigger exists: an initial transition per ticket, then
+-- one per recorded state change.
+-- Activities store state names, so they are resolved against the current
+-- workflow; unknown names are skipped.
+INSERT INTO ticket_state_transitions (ticket_id, project_id, from_state_id, to_state_id, to_closed, transitioned_at)
+SELECT t.id,
+       t.project_id,
+       NULL,
+       COALESCE(first_state.id, t.state_id),
+       COALESCE(first_state.is_closed, current_state.is_closed, false),
+       t.created_at
+FROM tickets t
+LEFT JOIN workflow_states current_state ON current_state.id = t.state_id
+LEFT JOIN LATERAL (
+  SELECT ws.id, ws.is_closed
+  FROM ticket_activities ta
+  JOIN workflow_states ws ON ws.project_id = t.project_id AND ws.name = ta.old_value
+  WHERE ta.ticket_id = t.id AND ta.action = 'state_changed'
+  ORDER BY ta.created_at ASC
+  LIMIT 1
+) first_state ON true;
+
+INSERT INTO ticket_state_transitions (ticket_id, project_id, from_state_id, to_state_id, to_closed, transitioned_at)
+SELECT t.id, t.project_id, ws_from.id, ws_to.id, ws_to.is_closed, ta.created_at
+FROM ticket_activities ta
+JOIN tickets t ON t.id = ta.ticket_id
+JOIN workflow_states ws_to ON ws_to.project_id = t.project_id AND ws_to.name = ta.new_value
+LEFT JOIN workflow_states ws_from ON ws_from.project_id = t.project_id AND ws_from.name = ta.old_value
+WHERE ta.action = 'state_changed';
+
+DROP TRIGGER IF EXISTS record_ticket_state_transition ON tickets;
+CREATE TRIGGER record_ticket_state_transition
+  AFTER INSERT OR UPDATE OF state_id ON tickets
+  FOR EACH ROW
+  EXECUTE FUNCTION record_ticket_state_transition();
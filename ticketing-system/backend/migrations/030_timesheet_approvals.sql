@@ -0,0 +1,16 @@
+-- An approved week locks a user's time entries in that project: they can no
+-- longer be logged or deleted until the approval is withdrawn. Weeks start
+-- on Monday.
+CREATE TABLE IF NOT EXISTS timesheet_approvals (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  week_start date NOT NULL,
+  approved_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  approved_by_name text NOT NULL,
+  approved_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT timesheet_approvals_week_start_monday CHECK (EXTRACT(ISODOW FROM week_start) = 1),
+  CONSTRAINT timesheet_approvals_unique UNIQUE (project_id, user_id, week_start)
+);
+
+CREATE INDEX IF NOT EXISTS time_entries_user_logged_at_idx ON time_entries(user_id, logged_at);
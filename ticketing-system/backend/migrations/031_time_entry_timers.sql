@@ -0,0 +1,33 @@
+-- Running timers: at most one per user and ticket. Stopping a timer turns it
+-- into a time entry; idle_notified_at records the idle reminder so it is
+-- only sent once per timer.
+CREATE TABLE IF NOT EXISTS time_entry_timers (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  user_name text NOT NULL,
+  description text,
+  started_at timestamptz NOT NULL DEFAULT now(),
+  idle_notified_at timestamptz,
+  CONSTRAINT time_entry_timers_unique UNIQUE (user_id, ticket_id)
+);
+
+CREATE INDEX IF NOT EXISTS time_entry_timers_ticket_id_idx ON time_entry_timers(ticket_id);
+CREATE INDEX IF NOT EXISTS time_entry_timers_idle_idx
+  ON time_entry_timers (started_at)
+  WHERE idle_notified_at IS NULL;
+
+-- Per-project timer behaviour. Projects without a row use the defaults.
+CREATE TABLE IF NOT EXISTS time_tracking_settings (
+  project_id uuid PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+  auto_stop_on_close boolean NOT NULL DEFAULT true,
+  idle_timer_hours integer NOT NULL DEFAULT 8,
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CONSTRAINT time_tracking_settings_idle_hours_range CHECK (idle_timer_hours BETWEEN 1 AND 168)
+);
+
+-- Allow reminders about timers left running
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+  CHECK (type IN ('mention', 'assignment', 'preset_match', 'automation', 'timer_idle'));
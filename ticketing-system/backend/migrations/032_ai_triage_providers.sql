@@ -0,0 +1,22 @@
+-- Per-project choice of triage provider. The heuristic provider needs no
+-- configuration; the http provider calls an OpenAI-compatible chat
+-- completions endpoint.
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS provider text NOT NULL DEFAULT 'heuristic';
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS endpoint_url text;
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS model text;
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS timeout_ms integer NOT NULL DEFAULT 10000;
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS prompt_version text NOT NULL DEFAULT 'triage-v1';
+
+ALTER TABLE ai_triage_settings DROP CONSTRAINT IF EXISTS ai_triage_settings_provider_check;
+ALTER TABLE ai_triage_settings ADD CONSTRAINT ai_triage_settings_provider_check
+  CHECK (provider IN ('heuristic', 'http'));
+ALTER TABLE ai_triage_settings DROP CONSTRAINT IF EXISTS ai_triage_settings_http_endpoint_check;
+ALTER TABLE ai_triage_settings ADD CONSTRAINT ai_triage_settings_http_endpoint_check
+  CHECK (provider <> 'http' OR endpoint_url IS NOT NULL);
+ALTER TABLE ai_triage_settings DROP CONSTRAINT IF EXISTS ai_triage_settings_timeout_range;
+ALTER TABLE ai_triage_settings ADD CONSTRAINT ai_triage_settings_timeout_range
+  CHECK (timeout_ms BETWEEN 500 AND 60000);
+
+-- Suggestions now include a ticket type
+ALTER TABLE ai_triage_suggestions ADD COLUMN IF NOT EXISTS suggested_type text;
+ALTER TABLE ai_triage_suggestions ADD COLUMN IF NOT EXISTS confidence_type real NOT NULL DEFAULT 0;
@@ -0,0 +1,14 @@
+-- Locally trained triage models, one per project. The model column holds the
+-- serialized classifiers; they are retrained from closed tickets and recorded
+-- suggestion decisions.
+CREATE TABLE IF NOT EXISTS ai_triage_models (
+  project_id uuid PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+  model jsonb NOT NULL,
+  example_count integer NOT NULL,
+  feedback_count integer NOT NULL DEFAULT 0,
+  trained_at timestamptz NOT NULL DEFAULT now()
+);
+
+ALTER TABLE ai_triage_settings DROP CONSTRAINT IF EXISTS ai_triage_settings_provider_check;
+ALTER TABLE ai_triage_settings ADD CONSTRAINT ai_triage_settings_provider_check
+  CHECK (provider IN ('heuristic', 'http', 'learned'));
@@ -0,0 +1,12 @@
+-- Trigram similarity for finding likely duplicate tickets
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- A duplicate_of relation points from the duplicate to the ticket it
+-- duplicates. A ticket can only duplicate one other ticket.
+ALTER TABLE ticket_dependencies DROP CONSTRAINT IF EXISTS ticket_dependencies_relation_type_check;
+ALTER TABLE ticket_dependencies ADD CONSTRAINT ticket_dependencies_relation_type_check
+  CHECK (relation_type IN ('blocks', 'related', 'duplicate_of'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS ticket_dependencies_duplicate_of_idx
+  ON ticket_dependencies(from_ticket_id)
+  WHERE relation_type = 'duplicate_of';
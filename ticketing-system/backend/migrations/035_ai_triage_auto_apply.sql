@@ -0,0 +1,18 @@
+-- Opt-in automatic triage on ticket creation. Thresholds map a field
+-- (priority, type, assignee, state) to the lowest confidence at which a
+-- suggestion is applied; fields without a threshold are never applied.
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS auto_apply boolean NOT NULL DEFAULT false;
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS auto_apply_thresholds jsonb NOT NULL DEFAULT '{}'::jsonb;
+
+-- What auto-triage changed on a ticket, so that it can be reverted
+CREATE TABLE IF NOT EXISTS ai_triage_auto_applications (
+  ticket_id uuid PRIMARY KEY REFERENCES tickets(id) ON DELETE CASCADE,
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  suggestion_id uuid NOT NULL REFERENCES ai_triage_suggestions(id) ON DELETE CASCADE,
+  fields jsonb NOT NULL,
+  applied_at timestamptz NOT NULL DEFAULT now(),
+  reverted_at timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS ai_triage_auto_applications_project_idx
+  ON ai_triage_auto_applications(project_id, applied_at DESC);
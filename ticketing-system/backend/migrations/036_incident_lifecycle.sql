@@ -0,0 +1,41 @@
+-- Incident lifecycle: status with the time each status was first reached,
+-- and scribe and comms lead roles next to the commander.
+ALTER TABLE tickets
+  ADD COLUMN IF NOT EXISTS incident_status text
+    CHECK (incident_status IN ('detected', 'acknowledged', 'mitigated', 'resolved')),
+  ADD COLUMN IF NOT EXISTS incident_detected_at timestamptz,
+  ADD COLUMN IF NOT EXISTS incident_acknowledged_at timestamptz,
+  ADD COLUMN IF NOT EXISTS incident_mitigated_at timestamptz,
+  ADD COLUMN IF NOT EXISTS incident_resolved_at timestamptz,
+  ADD COLUMN IF NOT EXISTS incident_scribe_id uuid REFERENCES users(id),
+  ADD COLUMN IF NOT EXISTS incident_comms_lead_id uuid REFERENCES users(id);
+
+UPDATE tickets
+SET incident_status = 'detected',
+    incident_detected_at = created_at
+WHERE incident_enabled
+  AND incident_status IS NULL;
+
+CREATE INDEX IF NOT EXISTS tickets_incident_detected_at_idx
+  ON tickets(project_id, incident_detected_at)
+  WHERE incident_detected_at IS NOT NULL;
+
+-- Every severity an incident has had, for escalation history
+CREATE TABLE IF NOT EXISTS incident_severity_changes (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  from_severity text,
+  to_severity text,
+  actor_id uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS incident_severity_changes_ticket_idx
+  ON incident_severity_changes(ticket_id, created_at);
+
+INSERT INTO incident_severity_changes (ticket_id, from_severity, to_severity, created_at)
+SELECT t.id, NULL, t.incident_severity, t.created_at
+FROM tickets t
+WHERE t.incident_enabled
+  AND t.incident_severity IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM incident_severity_changes c WHERE c.ticket_id = t.id);
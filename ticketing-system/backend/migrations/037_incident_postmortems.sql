@@ -0,0 +1,22 @@
+-- Postmortem document of an incident ticket
+CREATE TABLE IF NOT EXISTS incident_postmortems (
+  ticket_id uuid PRIMARY KEY REFERENCES tickets(id) ON DELETE CASCADE,
+  summary text NOT NULL DEFAULT '',
+  impact text NOT NULL DEFAULT '',
+  root_cause text NOT NULL DEFAULT '',
+  contributing_factors text NOT NULL DEFAULT '',
+  lessons text NOT NULL DEFAULT '',
+  updated_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
+
+-- Tickets created as postmortem action items. ticket_id is the action item.
+CREATE TABLE IF NOT EXISTS incident_postmortem_action_items (
+  ticket_id uuid PRIMARY KEY REFERENCES tickets(id) ON DELETE CASCADE,
+  incident_ticket_id uuid NOT NULL REFERENCES incident_postmortems(ticket_id) ON DELETE CASCADE,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS incident_postmortem_action_items_incident_idx
+  ON incident_postmortem_action_items(incident_ticket_id, created_at);
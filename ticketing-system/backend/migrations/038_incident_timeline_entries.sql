@@ -0,0 +1,39 @@
+-- Timeline entries added to an incident by responders (annotation) or by
+-- alert ingestion (alert). occurred_at is when the event happened, which
+-- may be earlier than when it was recorded.
+CREATE TABLE IF NOT EXISTS incident_timeline_entries (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  kind text NOT NULL CHECK (kind IN ('annotation', 'alert')),
+  occurred_at timestamptz NOT NULL,
+  title text NOT NULL,
+  body text,
+  author_id uuid REFERENCES users(id) ON DELETE SET NULL,
+  alert_status text CHECK (alert_status IN ('firing', 'resolved')),
+  alert_fingerprint text,
+  alert_group_key text,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS incident_timeline_entries_ticket_idx
+  ON incident_timeline_entries(ticket_id, occurred_at);
+
+-- Alertmanager repeats notifications; the same alert state is recorded once.
+CREATE UNIQUE INDEX IF NOT EXISTS incident_timeline_entries_alert_uniq
+  ON incident_timeline_entries(ticket_id, alert_fingerprint, alert_status, occurred_at)
+  WHERE alert_fingerprint IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS incident_timeline_entries_group_idx
+  ON incident_timeline_entries(alert_group_key, created_at DESC)
+  WHERE alert_group_key IS NOT NULL;
+
+-- Alert ingestion settings of a project. token authenticates the ingestion
+-- endpoint; story_id receives incidents declared from alerts.
+CREATE TABLE IF NOT EXISTS incident_alert_settings (
+  project_id uuid PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+  enabled boolean NOT NULL DEFAULT false,
+  token text UNIQUE,
+  story_id uuid REFERENCES stories(id) ON DELETE SET NULL,
+  default_severity text,
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
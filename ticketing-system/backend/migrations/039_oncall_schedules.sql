@@ -0,0 +1,50 @@
+-- On-call schedules, owned by a project or by a group. Group schedules
+-- cover every project the group is assigned to.
+CREATE TABLE IF NOT EXISTS oncall_schedules (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  project_id uuid REFERENCES projects(id) ON DELETE CASCADE,
+  group_id uuid REFERENCES groups(id) ON DELETE CASCADE,
+  name text NOT NULL,
+  timezone text NOT NULL DEFAULT 'UTC',
+  created_at timestamptz NOT NULL DEFAULT now(),
+  updated_at timestamptz NOT NULL DEFAULT now(),
+  CHECK ((project_id IS NULL) <> (group_id IS NULL))
+);
+
+CREATE INDEX IF NOT EXISTS oncall_schedules_project_idx ON oncall_schedules(project_id) WHERE project_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS oncall_schedules_group_idx ON oncall_schedules(group_id) WHERE group_id IS NOT NULL;
+
+-- Rotations of a schedule. Participants take turns, handing off at each
+-- occurrence of the rotation (a cron expression or RRULE) after starts_at.
+-- Where layers overlap, the one with the higher position is on call.
+CREATE TABLE IF NOT EXISTS oncall_layers (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  schedule_id uuid NOT NULL REFERENCES oncall_schedules(id) ON DELETE CASCADE,
+  position int NOT NULL,
+  name text NOT NULL,
+  rotation_kind text NOT NULL CHECK (rotation_kind IN ('cron', 'rrule')),
+  rotation text NOT NULL,
+  starts_at timestamptz NOT NULL,
+  ends_at timestamptz,
+  participant_ids uuid[] NOT NULL,
+  UNIQUE (schedule_id, position)
+);
+
+-- Overrides put a user on call for a fixed period, ahead of all layers.
+CREATE TABLE IF NOT EXISTS oncall_overrides (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+  schedule_id uuid NOT NULL REFERENCES oncall_schedules(id) ON DELETE CASCADE,
+  user_id uuid NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+  starts_at timestamptz NOT NULL,
+  ends_at timestamptz NOT NULL,
+  created_by uuid REFERENCES users(id) ON DELETE SET NULL,
+  created_at timestamptz NOT NULL DEFAULT now(),
+  CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS oncall_overrides_schedule_idx ON oncall_overrides(schedule_id, ends_at);
+
+-- Tell the on-call user they were made incident commander
+ALTER TABLE notifications DROP CONSTRAINT IF EXISTS notifications_type_check;
+ALTER TABLE notifications ADD CONSTRAINT notifications_type_check
+  CHECK (type IN ('mention', 'assignment', 'preset_match', 'automation', 'timer_idle', 'incident_commander'));
@@ -0,0 +1,20 @@
+-- Public status page: incidents flagged customer-visible and their public
+-- comments are published in a per-project feed.
+ALTER TABLE tickets
+  ADD COLUMN IF NOT EXISTS incident_customer_visible boolean NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS tickets_incident_customer_visible_idx
+  ON tickets(project_id, incident_detected_at DESC)
+  WHERE incident_customer_visible;
+
+ALTER TABLE ticket_comments
+  ADD COLUMN IF NOT EXISTS is_public boolean NOT NULL DEFAULT false;
+
+-- Status page settings of a project. slug is the public address of the feed.
+CREATE TABLE IF NOT EXISTS status_page_settings (
+  project_id uuid PRIMARY KEY REFERENCES projects(id) ON DELETE CASCADE,
+  enabled boolean NOT NULL DEFAULT false,
+  slug text UNIQUE,
+  title text,
+  updated_at timestamptz NOT NULL DEFAULT now()
+);
@@ -0,0 +1,30 @@
+-- Sprints marked completed by the 026 backfill never got a completion
+-- snapshot, so velocity history skipped them. Record one from the tickets
+-- still in the sprint. Those sprints never carried work over, so the
+-- snapshot uses the backlog carry-over and keeps membership as is.
+WITH backfilled AS (
+  INSERT INTO sprint_completions (
+    sprint_id, project_id, carry_over,
+    committed_tickets, completed_tickets, committed_points, completed_points, created_at
+  )
+  SELECT s.id, s.project_id, 'backlog',
+         COUNT(t.id)::int,
+         COUNT(t.id) FILTER (WHERE ws.is_closed)::int,
+         COALESCE(SUM(t.story_points), 0)::int,
+         COALESCE(SUM(t.story_points) FILTER (WHERE ws.is_closed), 0)::int,
+         COALESCE(s.completed_at, s.end_date::timestamptz + interval '1 day')
+  FROM sprints s
+  LEFT JOIN sprint_tickets st ON st.sprint_id = s.id
+  LEFT JOIN tickets t ON t.id = st.ticket_id
+  LEFT JOIN workflow_states ws ON ws.id = t.state_id
+  WHERE s.status = 'completed'
+    AND NOT EXISTS (SELECT 1 FROM sprint_completions c WHERE c.sprint_id = s.id)
+  GROUP BY s.id
+  RETURNING sprint_id
+)
+INSERT INTO sprint_completion_tickets (sprint_id, ticket_id, ticket_key, title, state_name, story_points, done)
+SELECT st.sprint_id, t.id, t.key, t.title, ws.name, t.story_points, ws.is_closed
+FROM backfilled b
+JOIN sprint_tickets st ON st.sprint_id = b.sprint_id
+JOIN tickets t ON t.id = st.ticket_id
+JOIN workflow_states ws ON ws.id = t.state_id;
@@ -0,0 +1,3 @@
+-- Per-project bearer token for the http triage provider. It is write-only
+-- through the API.
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS api_key text;
@@ -0,0 +1,3 @@
+-- Lease on a project's model training, so that only one server instance
+-- retrains it at a time.
+ALTER TABLE ai_triage_settings ADD COLUMN IF NOT EXISTS training_locked_until timestamptz;
@@ -0,0 +1,12 @@
+-- The incident an alert group declared, written in the same transaction as
+-- the ticket. A group maps to one incident per project; the mapping moves
+-- to a new incident once the previous one is resolved.
+CREATE TABLE IF NOT EXISTS incident_alert_groups (
+  project_id uuid NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+  group_key text NOT NULL,
+  ticket_id uuid NOT NULL REFERENCES tickets(id) ON DELETE CASCADE,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS incident_alert_groups_project_group_uniq
+  ON incident_alert_groups(project_id, group_key);
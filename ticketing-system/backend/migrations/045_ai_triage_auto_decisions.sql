@@ -0,0 +1,15 @@
+-- Decisions recorded by auto-triage rather than a person. Training and the
+-- accuracy report only learn from human decisions.
+ALTER TABLE ai_triage_suggestion_decisions ADD COLUMN IF NOT EXISTS auto_applied boolean NOT NULL DEFAULT false;
+
+-- Auto-triage recorded the first decision on each suggestion it applied.
+UPDATE ai_triage_suggestion_decisions d
+SET auto_applied = true
+FROM ai_triage_auto_applications a
+WHERE a.suggestion_id = d.suggestion_id
+  AND cardinality(d.rejected_fields) = 0
+  AND d.created_at = (
+    SELECT min(first.created_at)
+    FROM ai_triage_suggestion_decisions first
+    WHERE first.suggestion_id = d.suggestion_id
+  );
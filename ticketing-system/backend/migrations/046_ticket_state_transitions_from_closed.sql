@@ -0,0 +1,46 @@
+-- from_closed snapshots the source state's closed flag, like to_closed does
+-- for the target, so a move out of a since-deleted closed state is not
+-- mistaken for a fresh close.
+ALTER TABLE ticket_state_transitions ADD COLUMN IF NOT EXISTS from_closed boolean NOT NULL DEFAULT false;
+
+-- Existing rows take the source state's flag when it still exists and the
+-- ticket's previous transition's snapshot otherwise.
+UPDATE ticket_state_transitions tr
+SET from_closed = COALESCE(ws_from.is_closed, prev.to_closed, false)
+FROM ticket_state_transitions self
+LEFT JOIN workflow_states ws_from ON ws_from.id = self.from_state_id
+LEFT JOIN LATERAL (
+  SELECT p.to_closed
+  FROM ticket_state_transitions p
+  WHERE p.ticket_id = self.ticket_id
+    AND (p.transitioned_at, p.id) < (self.transitioned_at, self.id)
+  ORDER BY p.transitioned_at DESC, p.id DESC
+  LIMIT 1
+) prev ON true
+WHERE self.id = tr.id
+  AND self.from_state_id IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION record_ticket_state_transition() RETURNS trigger AS $$
+BEGIN
+  IF TG_OP = 'UPDATE' AND NEW.state_id IS NOT DISTINCT FROM OLD.state_id THEN
+    RETURN NEW;
+  END IF;
+
+  INSERT INTO ticket_state_transitions (ticket_id, project_id, from_state_id, to_state_id, from_closed, to_closed, transitioned_at)
+  SELECT NEW.id,
+         NEW.project_id,
+         CASE WHEN TG_OP = 'UPDATE' THEN OLD.state_id END,
+         NEW.state_id,
+         CASE WHEN TG_OP = 'UPDATE' THEN COALESCE(
+           (SELECT is_closed FROM workflow_states WHERE id = OLD.state_id),
+           (SELECT to_closed FROM ticket_state_transitions
+            WHERE ticket_id = NEW.id
+            ORDER BY transitioned_at DESC, id DESC
+            LIMIT 1),
+           false
+         ) ELSE false END,
+         COALESCE((SELECT is_closed FROM workflow_states WHERE id = NEW.state_id), false),
+         CASE WHEN TG_OP = 'INSERT' THEN NEW.created_at ELSE now() END;
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,7 @@
+-- Claiming a subscription now only leases it; last_evaluated_at moves when an
+-- evaluation is recorded, so a failed run is retried once the lease expires.
+ALTER TABLE board_filter_preset_subscriptions ADD COLUMN IF NOT EXISTS locked_until timestamptz;
+
+-- Set while the preset matches more tickets than a subscription tracks, so
+-- the subscriber is told once instead of on every run.
+ALTER TABLE board_filter_preset_subscriptions ADD COLUMN IF NOT EXISTS matches_truncated boolean NOT NULL DEFAULT false;
@@ -0,0 +1,10 @@
+-- Lets duplicate detection find similar titles with the pg_trgm % operator
+-- instead of scoring every open ticket in the project.
+--
+-- pg_trgm comes from 034_ticket_duplicates.sql. It is a trusted extension
+-- from PostgreSQL 13, so a role with CREATE on the database can install it.
+-- On older servers, or for a role without that privilege, a superuser must
+-- run CREATE EXTENSION pg_trgm in the database before migrating; 034 then
+-- finds it already installed.
+CREATE INDEX IF NOT EXISTS tickets_title_trgm_idx
+  ON tickets USING gin (title gin_trgm_ops);